@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -93,3 +95,42 @@ func (suite *KeeperTestHelper) PrepareBalancerPoolWithPoolAsset(assets []balance
 	suite.NoError(err)
 	return poolId
 }
+
+// PrepareBasicStableswapPool returns a two asset stableswap pool, with 1_000_000 foo and 1_000_000 bar of liquidity,
+// governed by the first test account.
+func (suite *KeeperTestHelper) PrepareBasicStableswapPool() uint64 {
+	// Mint some assets to the account.
+	suite.FundAcc(suite.TestAccs[0], DefaultAcctFunds)
+
+	params := stableswap.PoolParams{
+		SwapFee: sdk.NewDecWithPrec(1, 2),
+		ExitFee: sdk.NewDecWithPrec(1, 2),
+	}
+
+	msg := stableswap.NewMsgCreateStableswapPool(suite.TestAccs[0], params, sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(1000000)),
+		sdk.NewCoin("bar", sdk.NewInt(1000000)),
+	), nil, suite.TestAccs[0].String())
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	return poolId
+}
+
+// PrepareBasicConcentratedPool returns a concentrated liquidity pool with 1_000_000 foo and 4_000_000 uosmo
+// of liquidity over the full price range, owned by the first test account.
+func (suite *KeeperTestHelper) PrepareBasicConcentratedPool() uint64 {
+	// Mint some assets to the account.
+	suite.FundAcc(suite.TestAccs[0], DefaultAcctFunds)
+
+	params := concentrated.PoolParams{
+		SwapFee: sdk.NewDecWithPrec(3, 3),
+	}
+
+	msg := concentrated.NewMsgCreateConcentratedPool(suite.TestAccs[0], params, sdk.NewCoins(
+		sdk.NewCoin("foo", sdk.NewInt(1000000)),
+		sdk.NewCoin("uosmo", sdk.NewInt(4000000)),
+	), 10)
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, &msg)
+	suite.Require().NoError(err)
+	return poolId
+}
@@ -163,7 +163,8 @@ func (s *KeeperTestHelper) AllocateRewardsToValidator(valAddr sdk.ValAddress, re
 // SetupGammPoolsWithBondDenomMultiplier uses given multipliers to set initial pool supply of bond denom.
 func (s *KeeperTestHelper) SetupGammPoolsWithBondDenomMultiplier(multipliers []sdk.Dec) []gammtypes.PoolI {
 	s.App.GAMMKeeper.SetParams(s.Ctx, gammtypes.Params{
-		PoolCreationFee: sdk.Coins{},
+		PoolCreationFee:               sdk.Coins{},
+		OsmoMultihopSwapFeeMultiplier: gammtypes.DefaultParams().OsmoMultihopSwapFeeMultiplier,
 	})
 
 	bondDenom := s.App.StakingKeeper.BondDenom(s.Ctx)
@@ -59,6 +59,8 @@ import (
 	superfluidtypes "github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 	"github.com/osmosis-labs/osmosis/v7/x/tokenfactory"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v7/x/tokenfactory/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twap"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/twap/types"
 	"github.com/osmosis-labs/osmosis/v7/x/txfees"
 	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 )
@@ -117,6 +119,7 @@ func appModules(
 		params.NewAppModule(*app.ParamsKeeper),
 		app.TransferModule,
 		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
+		twap.NewAppModule(*app.TwapKeeper),
 		txfees.NewAppModule(appCodec, *app.TxFeesKeeper),
 		incentives.NewAppModule(appCodec, *app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
 		lockup.NewAppModule(appCodec, *app.LockupKeeper, app.AccountKeeper, app.BankKeeper),
@@ -163,6 +166,7 @@ func orderBeginBlockers() []string {
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		gammtypes.ModuleName,
+		twaptypes.ModuleName,
 		incentivestypes.ModuleName,
 		lockuptypes.ModuleName,
 		poolincentivestypes.ModuleName,
@@ -181,6 +185,8 @@ func OrderEndBlockers(allModuleNames []string) []string {
 	ord.LastElements(epochstypes.ModuleName)
 	// txfees auto-swap code should occur before any potential gamm end block code.
 	ord.Before(txfeestypes.ModuleName, gammtypes.ModuleName)
+	// superfluid votes must be cast before gov tallies the proposals.
+	ord.Before(superfluidtypes.ModuleName, govtypes.ModuleName)
 	// only remaining modules that aren;t no-ops are: crisis & govtypes
 	// we don't care about the relative ordering between them.
 
@@ -200,6 +206,7 @@ var modulesOrderInitGenesis = []string{
 	crisistypes.ModuleName,
 	ibchost.ModuleName,
 	gammtypes.ModuleName,
+	twaptypes.ModuleName,
 	txfeestypes.ModuleName,
 	genutiltypes.ModuleName,
 	evidencetypes.ModuleName,
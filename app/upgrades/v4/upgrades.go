@@ -21,10 +21,19 @@ func CreateUpgradeHandler(
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, _plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		// configure upgrade for x/gamm module pool creation fee param
-		keepers.GAMMKeeper.SetParams(ctx, gammtypes.NewParams(sdk.Coins{sdk.NewInt64Coin("uosmo", 1)})) // 1 uOSMO
+		keepers.GAMMKeeper.SetParams(ctx, newGammParams(sdk.Coins{sdk.NewInt64Coin("uosmo", 1)})) // 1 uOSMO
 
 		Prop12(ctx, keepers.BankKeeper, keepers.DistrKeeper)
 
 		return vm, nil
 	}
 }
+
+// newGammParams returns the x/gamm params set by the v4 upgrade. The osmo multihop swap fee discount
+// didn't exist at v4, so it isn't applied; the v8 upgrade sets its multiplier.
+func newGammParams(poolCreationFee sdk.Coins) gammtypes.Params {
+	return gammtypes.Params{
+		PoolCreationFee:               poolCreationFee,
+		OsmoMultihopSwapFeeMultiplier: sdk.OneDec(),
+	}
+}
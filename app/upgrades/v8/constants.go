@@ -6,6 +6,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v7/x/tokenfactory/types"
+	twaptypes "github.com/osmosis-labs/osmosis/v7/x/twap/types"
 )
 
 // UpgradeName defines the on-chain upgrade name for the Osmosis v7 upgrade.
@@ -19,7 +20,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{tokenfactorytypes.ModuleName},
+		Added:   []string{tokenfactorytypes.ModuleName, twaptypes.ModuleName},
 		Deleted: []string{ClaimsModuleName},
 	},
 }
@@ -6,6 +6,9 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/osmosis-labs/osmosis/v7/app/keepers"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 )
 
 func CreateUpgradeHandler(
@@ -15,6 +18,25 @@ func CreateUpgradeHandler(
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		ExecuteProp214(ctx, keepers.GAMMKeeper)
+
+		// Set the superfluid param added in v8, to its default value.
+		keepers.GetSubspace(superfluidtypes.ModuleName).Set(ctx, superfluidtypes.KeyOsmoMultiplierTwapWindow, superfluidtypes.DefaultParams().OsmoMultiplierTwapWindow)
+
+		// Set the gamm param added in v8, to its default value.
+		keepers.GetSubspace(gammtypes.ModuleName).Set(ctx, gammtypes.KeyOsmoMultihopSwapFeeMultiplier, gammtypes.DefaultParams().OsmoMultihopSwapFeeMultiplier)
+
+		// Set the incentives params added in v8, to their default values.
+		incentivesParams := incentivestypes.DefaultParams()
+		incentivesSubspace := keepers.GetSubspace(incentivestypes.ModuleName)
+		incentivesSubspace.Set(ctx, incentivestypes.KeyGaugeCreationFee, incentivesParams.GaugeCreationFee)
+		incentivesSubspace.Set(ctx, incentivestypes.KeyMinRewardValuePerEpoch, incentivesParams.MinRewardValuePerEpoch)
+		incentivesSubspace.Set(ctx, incentivestypes.KeyMaxActiveGaugesPerDenom, incentivesParams.MaxActiveGaugesPerDenom)
+		incentivesSubspace.Set(ctx, incentivestypes.KeyRewardHistoryEpochs, incentivesParams.RewardHistoryEpochs)
+
+		// Checkpoint the existing locks, so that they accrue the rewards of incentives gauges from now on.
+		if err := keepers.IncentivesKeeper.CheckpointAllLocks(ctx); err != nil {
+			return nil, err
+		}
 		return mm.RunMigrations(ctx, configurator, vm)
 	}
 }
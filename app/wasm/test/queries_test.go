@@ -11,6 +11,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v7/app/wasm"
 	wasmbindings "github.com/osmosis-labs/osmosis/v7/app/wasm/bindings"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 )
 
 // func TestFullDenom(t *testing.T) {
@@ -487,3 +488,58 @@ func TestEstimateSwap(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimateSwapOsmoRoutedMultihop(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	// OSMO is the bond denom.
+	stakingParams := osmosis.StakingKeeper.GetParams(ctx)
+	stakingParams.BondDenom = "uosmo"
+	osmosis.StakingKeeper.SetParams(ctx, stakingParams)
+
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	var poolIds []uint64
+	for _, poolFunds := range [][]sdk.Coin{
+		{sdk.NewInt64Coin("uatom", 6000000), sdk.NewInt64Coin("uosmo", 12000000)},
+		{sdk.NewInt64Coin("uosmo", 12000000), sdk.NewInt64Coin("ustar", 240000000)},
+	} {
+		msg := balancer.NewMsgCreateBalancerPool(actor, balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()}, []balancer.PoolAsset{
+			{Weight: sdk.NewInt(100), Token: poolFunds[0]},
+			{Weight: sdk.NewInt(100), Token: poolFunds[1]},
+		}, "")
+		poolId, err := osmosis.GAMMKeeper.CreatePool(ctx, &msg)
+		require.NoError(t, err)
+		poolIds = append(poolIds, poolId)
+	}
+
+	// Both hops are in OSMO pools, so both charge the discounted swap fee.
+	discountedSwapFee := swapFee.Mul(osmosis.GAMMKeeper.GetParams(ctx).OsmoMultihopSwapFeeMultiplier)
+	amountIn := sdk.NewInt(10000)
+	expectedOut := sdk.NewCoin("uatom", amountIn)
+	for i, denomOut := range []string{"uosmo", "ustar"} {
+		pool, err := osmosis.GAMMKeeper.GetPoolAndPoke(ctx, poolIds[i])
+		require.NoError(t, err)
+		expectedOut, err = pool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(expectedOut), denomOut, discountedSwapFee)
+		require.NoError(t, err)
+	}
+
+	queryPlugin := wasm.NewQueryPlugin(osmosis.GAMMKeeper)
+	cacheCtx, _ := ctx.CacheContext()
+	gotCost, err := queryPlugin.EstimateSwap(cacheCtx, &wasmbindings.EstimateSwap{
+		Sender: actor.String(),
+		First: wasmbindings.Swap{
+			PoolId:   poolIds[0],
+			DenomIn:  "uatom",
+			DenomOut: "uosmo",
+		},
+		Route: []wasmbindings.Step{{PoolId: poolIds[1], DenomOut: "ustar"}},
+		Amount: wasmbindings.SwapAmount{
+			In: &amountIn,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedOut.Amount, *gotCost.Out)
+}
@@ -50,4 +50,6 @@ func panicCatchingEpochHook(
 	cacheCtx, write := ctx.CacheContext()
 	hookFn(cacheCtx, epochIdentifier, epochNumber)
 	write()
+	// the cache context has its own event manager, so its events are emitted along with its writes
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 }
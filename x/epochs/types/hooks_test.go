@@ -26,6 +26,8 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.queryClient = types.NewQueryClient(suite.QueryHelper)
 }
 
+const dummyEventType = "dummy_epoch_hook"
+
 // dummyEpochHook is a struct satisfying the epoch hook interface,
 // that maintains a counter for how many times its been succesfully called,
 // and a boolean for whether it should panic during its execution.
@@ -35,6 +37,7 @@ type dummyEpochHook struct {
 }
 
 func (hook *dummyEpochHook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(dummyEventType))
 	if hook.shouldPanic {
 		panic("dummyEpochHook is panicking")
 	}
@@ -42,6 +45,7 @@ func (hook *dummyEpochHook) AfterEpochEnd(ctx sdk.Context, epochIdentifier strin
 }
 
 func (hook *dummyEpochHook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(dummyEventType))
 	if hook.shouldPanic {
 		panic("dummyEpochHook is panicking")
 	}
@@ -78,6 +82,7 @@ func (suite *KeeperTestSuite) TestHooksPanicRecovery() {
 			}
 
 			hooks := types.NewMultiEpochHooks(hookRefs...)
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
 			suite.NotPanics(func() {
 				if epochActionSelector == 0 {
 					hooks.BeforeEpochStart(suite.Ctx, "id", 0)
@@ -90,6 +95,13 @@ func (suite *KeeperTestSuite) TestHooksPanicRecovery() {
 				epochHook := hookRefs[i].(*dummyEpochHook)
 				suite.Require().Equal(tc.expectedCounterValues[i], epochHook.successCounter, "test case index %d", tcIndex)
 			}
+
+			// only the events of hooks that did not panic are emitted
+			expectedNumEvents := 0
+			for _, counterValue := range tc.expectedCounterValues {
+				expectedNumEvents += counterValue
+			}
+			suite.Require().Len(suite.Ctx.EventManager().Events(), expectedNumEvents, "test case index %d", tcIndex)
 		}
 	}
 }
@@ -2,6 +2,8 @@ package cli
 
 import (
 	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 )
 
 const (
@@ -37,6 +39,31 @@ const (
 	FlagSwapRouteAmounts = "swap-route-amounts"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+
+	// Will be parsed to time.Duration.
+	FlagScalingFactorsDuration = "duration"
+
+	// Will be parsed to int64.
+	FlagLowerTick = "lower-tick"
+	// Will be parsed to int64.
+	FlagUpperTick = "upper-tick"
+	// Will be parsed to []sdk.Coin.
+	FlagTokenMins = "token-mins"
+
+	// Will be parsed to sdk.Coin.
+	FlagTokenIn = "token-in"
+	// Will be parsed to string.
+	FlagTokenOutDenom = "token-out-denom"
+	// Will be parsed to uint64.
+	FlagMaxHops = "max-hops"
+
+	// Will be parsed to []types.SwapAmountInSplitRoute.
+	FlagSplitRoutesFile = "routes-file"
+
+	// Names of fields in split routes json file.
+	SplitRoutesFileTokenInAmount = "token-in-amount"
+	SplitRoutesFilePoolIds       = "pool-ids"
+	SplitRoutesFileDenoms        = "denoms"
 )
 
 type createPoolInputs struct {
@@ -48,6 +75,12 @@ type createPoolInputs struct {
 	SmoothWeightChangeParams smoothWeightChangeParamsInputs `json:"lbp-params"`
 }
 
+type splitRouteInputs struct {
+	TokenInAmount string   `json:"token-in-amount"`
+	PoolIds       []uint64 `json:"pool-ids"`
+	Denoms        []string `json:"denoms"`
+}
+
 type smoothWeightChangeParamsInputs struct {
 	StartTime         string `json:"start-time"`
 	Duration          string `json:"duration"`
@@ -62,6 +95,15 @@ func FlagSetQuerySwapRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetQueryBestRoute() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagTokenIn, "", "The token to swap in")
+	fs.String(FlagTokenOutDenom, "", "The denom to swap to")
+	fs.Uint64(FlagMaxHops, 3, "The maximum number of pools in the route")
+	return fs
+}
+
 func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -70,6 +112,13 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSplitRouteSwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSplitRoutesFile, "", "Split routes json file path")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -104,3 +153,31 @@ func FlagSetJoinSwapExternAmount() *flag.FlagSet {
 
 	return fs
 }
+
+func FlagSetAdjustScalingFactors() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolId, 0, "The id of pool")
+	fs.Duration(FlagScalingFactorsDuration, stableswap.MinScalingFactorChangeDuration, "Duration over which the scaling factors change linearly, at least 1h")
+
+	return fs
+}
+
+func FlagSetCreatePosition() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolId, 0, "The id of pool")
+	fs.Int64(FlagLowerTick, 0, "The lower tick of the position's price range")
+	fs.Int64(FlagUpperTick, 0, "The upper tick of the position's price range")
+	fs.String(FlagTokenMins, "", "Minimum amounts of tokens the position must take")
+
+	return fs
+}
+
+func FlagSetPosition() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolId, 0, "The id of pool")
+
+	return fs
+}
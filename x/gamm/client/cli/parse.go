@@ -51,3 +51,25 @@ func parseCreatePoolFlags(fs *pflag.FlagSet) (*createPoolInputs, error) {
 
 	return pool, nil
 }
+
+func parseSplitRoutesFlags(fs *pflag.FlagSet) ([]splitRouteInputs, error) {
+	routes := []splitRouteInputs{}
+	routesFile, _ := fs.GetString(FlagSplitRoutesFile)
+
+	if routesFile == "" {
+		return nil, fmt.Errorf("must pass in a split routes json using the --%s flag", FlagSplitRoutesFile)
+	}
+
+	contents, err := ioutil.ReadFile(routesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&routes); err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
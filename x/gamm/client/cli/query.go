@@ -13,6 +13,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -33,10 +34,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdNumPools(),
 		GetCmdPoolParams(),
 		GetCmdTotalShares(),
+		GetCmdScalingFactors(),
 		GetCmdSpotPrice(),
 		GetCmdQueryTotalLiquidity(),
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
+		GetCmdBestRoute(),
+		GetCmdPositions(),
 	)
 
 	return cmd
@@ -327,6 +331,48 @@ $ %s query gamm total-share 1
 	return cmd
 }
 
+// GetCmdScalingFactors returns the current and target scaling factors of a stableswap pool.
+func GetCmdScalingFactors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scaling-factors <poolID>",
+		Short: "Query the scaling factors of a stableswap pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current scaling factors of a stableswap pool, and the target scaling factors if they are being changed.
+Example:
+$ %s query gamm scaling-factors 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScalingFactors(cmd.Context(), &types.QueryScalingFactorsRequest{
+				PoolId: uint64(poolID),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryTotalLiquidity return total liquidity.
 func GetCmdQueryTotalLiquidity() *cobra.Command {
 	cmd := &cobra.Command{
@@ -503,3 +549,109 @@ $ %s query gamm estimate-swap-exact-amount-out 1 osm11vmx8jtggpd9u7qr0t8vxclycz8
 
 	return cmd
 }
+
+// GetCmdBestRoute returns the best route for swapping an exact amount in.
+func GetCmdBestRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-route",
+		Short: "Query the route through all pools with the largest output for swapping an exact amount in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the route through all pools with the largest output for swapping an exact amount in,
+along with the amount out and the price impact of the route.
+Example:
+$ %s query gamm best-route --token-in 1000uatom --token-out-denom uosmo --max-hops 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tokenIn, err := cmd.Flags().GetString(FlagTokenIn)
+			if err != nil {
+				return err
+			}
+
+			tokenOutDenom, err := cmd.Flags().GetString(FlagTokenOutDenom)
+			if err != nil {
+				return err
+			}
+
+			maxHops, err := cmd.Flags().GetUint64(FlagMaxHops)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BestRoute(cmd.Context(), &types.QueryBestRouteRequest{
+				TokenIn:       tokenIn,
+				TokenOutDenom: tokenOutDenom,
+				MaxHops:       maxHops,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetQueryBestRoute())
+	flags.AddQueryFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagTokenIn)
+	_ = cmd.MarkFlagRequired(FlagTokenOutDenom)
+
+	return cmd
+}
+
+// GetCmdPositions returns the positions of a concentrated liquidity pool.
+func GetCmdPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions <poolID> [owner]",
+		Short: "Query the positions of a concentrated liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the positions of a concentrated liquidity pool, optionally only those of a given owner.
+Example:
+$ %s query gamm positions 1 osmo1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := concentrated.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			owner := ""
+			if len(args) > 1 {
+				owner = args[1]
+			}
+
+			res, err := queryClient.Positions(cmd.Context(), &concentrated.QueryPositionsRequest{
+				PoolId: uint64(poolID),
+				Owner:  owner,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
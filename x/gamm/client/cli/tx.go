@@ -4,12 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -33,10 +36,16 @@ func NewTxCmd() *cobra.Command {
 		NewExitPoolCmd(),
 		NewSwapExactAmountInCmd(),
 		NewSwapExactAmountOutCmd(),
+		NewSplitRouteSwapExactAmountInCmd(),
 		NewJoinSwapExternAmountIn(),
 		NewJoinSwapShareAmountOut(),
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
+		NewStableSwapAdjustScalingFactorsCmd(),
+		NewCreateConcentratedPoolCmd(),
+		NewCreatePositionCmd(),
+		NewWithdrawPositionCmd(),
+		NewCollectFeesCmd(),
 	)
 
 	return txCmd
@@ -206,6 +215,50 @@ func NewSwapExactAmountOutCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSplitRouteSwapExactAmountInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amount in, split across several routes",
+		Long:  `Must provide path to a split routes JSON file (--routes-file). All routes must end in the same denom, and token-out-min-amount applies to the sum of the amounts out of all routes`,
+		Example: `Sample split routes JSON file contents, swapping 60% via pool 1 and 40% via pools 5 and 9:
+[
+	{
+		"token-in-amount": "6000000",
+		"pool-ids": [1],
+		"denoms": ["uatom"]
+	},
+	{
+		"token-in-amount": "4000000",
+		"pool-ids": [5, 9],
+		"denoms": ["uion", "uatom"]
+	}
+]
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSplitRouteSwapExactAmountInMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRouteSwap())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
 func NewJoinSwapExternAmountIn() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "join-swap-extern-amount-in [token-in] [share-out-min-amount]",
@@ -322,6 +375,158 @@ func NewExitSwapShareAmountIn() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "adjust-scaling-factors [scaling-factors]",
+		Short:   "change the scaling factors of a stableswap pool, as its future governor",
+		Example: "adjust-scaling-factors 1000000,1 --pool-id=1 --duration=168h",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildStableSwapAdjustScalingFactorsMsg(clientCtx, args[0], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetAdjustScalingFactors())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+
+	return cmd
+}
+
+func NewCreateConcentratedPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-concentrated-pool [initial-deposit] [swap-fee] [tick-spacing]",
+		Short: "create a new concentrated liquidity pool, priced at the ratio of the initial deposit",
+		Long: `Create a concentrated liquidity pool of the two initial deposit denoms.
+The initial deposit is provided over the full price range, in a position owned by the sender.`,
+		Example: "create-concentrated-pool 1000000uatom,4000000uosmo 0.003 10",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCreateConcentratedPoolMsg(clientCtx, args[0], args[1], args[2], txf)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCreatePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-position [tokens-desired]",
+		Short:   "provide liquidity to a concentrated liquidity pool over a price range",
+		Example: "create-position 1000000uatom,4000000uosmo --pool-id=1 --lower-tick=-1000 --upper-tick=1000",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCreatePositionMsg(clientCtx, args[0], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreatePosition())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagLowerTick)
+	_ = cmd.MarkFlagRequired(FlagUpperTick)
+
+	return cmd
+}
+
+func NewWithdrawPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-position [position-id] [liquidity]",
+		Short:   "withdraw liquidity from a position of a concentrated liquidity pool",
+		Example: "withdraw-position 2 1000000.5 --pool-id=1",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildWithdrawPositionMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetPosition())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+
+	return cmd
+}
+
+func NewCollectFeesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "collect-fees [position-id]",
+		Short:   "collect the fees accrued by a position of a concentrated liquidity pool",
+		Example: "collect-fees 2 --pool-id=1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildCollectFeesMsg(clientCtx, args[0], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetPosition())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+
+	return cmd
+}
+
 func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	pool, err := parseCreatePoolFlags(fs)
 	if err != nil {
@@ -584,6 +789,51 @@ func NewBuildSwapExactAmountInMsg(clientCtx client.Context, tokenInStr, tokenOut
 	return txf, msg, nil
 }
 
+func NewBuildSplitRouteSwapExactAmountInMsg(clientCtx client.Context, tokenInDenom, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	routeInputs, err := parseSplitRoutesFlags(fs)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	routes := make([]types.SwapAmountInSplitRoute, 0, len(routeInputs))
+	for _, routeInput := range routeInputs {
+		if len(routeInput.PoolIds) != len(routeInput.Denoms) {
+			return txf, nil, errors.New("split route pool ids and denoms mismatch")
+		}
+
+		tokenInAmount, ok := sdk.NewIntFromString(routeInput.TokenInAmount)
+		if !ok {
+			return txf, nil, errors.New("invalid split route token in amount")
+		}
+
+		pools := make([]types.SwapAmountInRoute, 0, len(routeInput.PoolIds))
+		for index, poolId := range routeInput.PoolIds {
+			pools = append(pools, types.SwapAmountInRoute{
+				PoolId:        poolId,
+				TokenOutDenom: routeInput.Denoms[index],
+			})
+		}
+
+		routes = append(routes, types.SwapAmountInSplitRoute{
+			Pools:         pools,
+			TokenInAmount: tokenInAmount,
+		})
+	}
+
+	tokenOutMinAmt, ok := sdk.NewIntFromString(tokenOutMinAmtStr)
+	if !ok {
+		return txf, nil, errors.New("invalid token out min amount")
+	}
+	msg := &types.MsgSplitRouteSwapExactAmountIn{
+		Sender:            clientCtx.GetFromAddress().String(),
+		Routes:            routes,
+		TokenInDenom:      tokenInDenom,
+		TokenOutMinAmount: tokenOutMinAmt,
+	}
+
+	return txf, msg, nil
+}
+
 func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, tokenOutStr, tokenInMaxAmountStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
 	routes, err := swapAmountOutRoutes(fs)
 	if err != nil {
@@ -713,3 +963,148 @@ func NewBuildExitSwapShareAmountInMsg(clientCtx client.Context, tokenOutDenom, s
 
 	return txf, msg, nil
 }
+
+func NewBuildStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, scalingFactorsStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	duration, err := fs.GetDuration(FlagScalingFactorsDuration)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	scalingFactorStrs := strings.Split(scalingFactorsStr, ",")
+	scalingFactors := make([]uint64, len(scalingFactorStrs))
+	for i, scalingFactorStr := range scalingFactorStrs {
+		scalingFactors[i], err = strconv.ParseUint(strings.TrimSpace(scalingFactorStr), 10, 64)
+		if err != nil {
+			return txf, nil, fmt.Errorf("invalid scaling factor %s: %w", scalingFactorStr, err)
+		}
+	}
+
+	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
+		Sender:         clientCtx.GetFromAddress().String(),
+		PoolId:         poolID,
+		ScalingFactors: scalingFactors,
+		Duration:       duration,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildCreateConcentratedPoolMsg(clientCtx client.Context, initialDepositStr, swapFeeStr, tickSpacingStr string, txf tx.Factory) (tx.Factory, sdk.Msg, error) {
+	initialDeposit, err := sdk.ParseCoinsNormalized(initialDepositStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	swapFee, err := sdk.NewDecFromStr(swapFeeStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tickSpacing, err := strconv.ParseUint(tickSpacingStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &concentrated.MsgCreateConcentratedPool{
+		Sender:               clientCtx.GetFromAddress().String(),
+		PoolParams:           &concentrated.PoolParams{SwapFee: swapFee},
+		InitialPoolLiquidity: initialDeposit,
+		TickSpacing:          tickSpacing,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildCreatePositionMsg(clientCtx client.Context, tokensDesiredStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	lowerTick, err := fs.GetInt64(FlagLowerTick)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	upperTick, err := fs.GetInt64(FlagUpperTick)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokensDesired, err := sdk.ParseCoinsNormalized(tokensDesiredStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenMinsStr, err := fs.GetString(FlagTokenMins)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	tokenMins, err := sdk.ParseCoinsNormalized(tokenMinsStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &concentrated.MsgCreatePosition{
+		Sender:        clientCtx.GetFromAddress().String(),
+		PoolId:        poolID,
+		LowerTick:     lowerTick,
+		UpperTick:     upperTick,
+		TokensDesired: tokensDesired,
+		TokenMins:     tokenMins,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildWithdrawPositionMsg(clientCtx client.Context, positionIdStr, liquidityStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	positionId, err := strconv.ParseUint(positionIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	liquidity, err := sdk.NewDecFromStr(liquidityStr)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &concentrated.MsgWithdrawPosition{
+		Sender:     clientCtx.GetFromAddress().String(),
+		PoolId:     poolID,
+		PositionId: positionId,
+		Liquidity:  liquidity,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildCollectFeesMsg(clientCtx client.Context, positionIdStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	positionId, err := strconv.ParseUint(positionIdStr, 10, 64)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	msg := &concentrated.MsgCollectFees{
+		Sender:     clientCtx.GetFromAddress().String(),
+		PoolId:     poolID,
+		PositionId: positionId,
+	}
+
+	return txf, msg, nil
+}
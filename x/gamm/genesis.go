@@ -33,6 +33,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState,
 	}
 
 	k.SetTotalLiquidity(ctx, liquidity)
+
+	for _, any := range genState.ConcentratedPoolRecords {
+		var records types.ConcentratedPoolRecordsI
+		err := unpacker.UnpackAny(any, &records)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ImportConcentratedPoolRecords(ctx, records)
+		if err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -49,9 +61,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		}
 		poolAnys = append(poolAnys, any)
 	}
+	recordsAnys := []*codectypes.Any{}
+	for _, records := range k.ExportConcentratedPoolRecords(ctx, pools) {
+		any, err := codectypes.NewAnyWithValue(records)
+		if err != nil {
+			panic(err)
+		}
+		recordsAnys = append(recordsAnys, any)
+	}
 	return &types.GenesisState{
-		NextPoolNumber: k.GetNextPoolNumberAndIncrement(ctx),
-		Pools:          poolAnys,
-		Params:         k.GetParams(ctx),
+		NextPoolNumber:          k.GetNextPoolNumberAndIncrement(ctx),
+		Pools:                   poolAnys,
+		Params:                  k.GetParams(ctx),
+		ConcentratedPoolRecords: recordsAnys,
 	}
 }
@@ -13,7 +13,9 @@ import (
 
 	osmoapp "github.com/osmosis-labs/osmosis/v7/app"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -43,7 +45,8 @@ func TestGammInitGenesis(t *testing.T) {
 		Pools:          []*codectypes.Any{any},
 		NextPoolNumber: 2,
 		Params: types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			PoolCreationFee:               sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			OsmoMultihopSwapFeeMultiplier: sdk.NewDecWithPrec(5, 1),
 		},
 	}, app.AppCodec())
 
@@ -144,3 +147,50 @@ func TestMarshalUnmarshalGenesis(t *testing.T) {
 		am.InitGenesis(ctx, appCodec, genesis)
 	})
 }
+
+func TestConcentratedPoolRecordsGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	acc1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address().Bytes())
+	err := simapp.FundAccount(app.BankKeeper, ctx, acc1, sdk.NewCoins(
+		sdk.NewCoin("uosmo", sdk.NewInt(10000000000)),
+		sdk.NewInt64Coin("foo", 100000),
+	))
+	require.NoError(t, err)
+
+	msg := concentrated.NewMsgCreateConcentratedPool(acc1, concentrated.PoolParams{
+		SwapFee: sdk.NewDecWithPrec(3, 3),
+	}, sdk.NewCoins(sdk.NewInt64Coin("foo", 10000), sdk.NewInt64Coin("uosmo", 40000)), 10)
+	poolId, err := app.GAMMKeeper.CreatePool(ctx, &msg)
+	require.NoError(t, err)
+
+	genesis := gamm.ExportGenesis(ctx, *app.GAMMKeeper)
+	require.Len(t, genesis.ConcentratedPoolRecords, 1)
+
+	newApp := osmoapp.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{})
+	gamm.InitGenesis(newCtx, *newApp.GAMMKeeper, *genesis, newApp.AppCodec())
+
+	req := &concentrated.QueryPositionsRequest{PoolId: poolId}
+	positions, err := keeper.NewQuerier(*app.GAMMKeeper).Positions(sdk.WrapSDKContext(ctx), req)
+	require.NoError(t, err)
+	require.Len(t, positions.Positions, 1)
+	newPositions, err := keeper.NewQuerier(*newApp.GAMMKeeper).Positions(sdk.WrapSDKContext(newCtx), req)
+	require.NoError(t, err)
+	require.Equal(t, positions, newPositions)
+
+	// swaps cross the imported ticks the same way, once the bank balances are imported too
+	pool, err := app.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+	require.NoError(t, err)
+	err = simapp.FundAccount(newApp.BankKeeper, newCtx, pool.GetAddress(), pool.GetTotalPoolLiquidity(ctx))
+	require.NoError(t, err)
+	tokenIn := sdk.NewInt64Coin("foo", 1000)
+	err = simapp.FundAccount(newApp.BankKeeper, newCtx, acc1, sdk.NewCoins(tokenIn))
+	require.NoError(t, err)
+	tokenOut, err := app.GAMMKeeper.SwapExactAmountIn(ctx, acc1, poolId, tokenIn, "uosmo", sdk.OneInt())
+	require.NoError(t, err)
+	newTokenOut, err := newApp.GAMMKeeper.SwapExactAmountIn(newCtx, acc1, poolId, tokenIn, "uosmo", sdk.OneInt())
+	require.NoError(t, err)
+	require.Equal(t, tokenOut, newTokenOut)
+}
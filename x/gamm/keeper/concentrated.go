@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// getConcentratedPool returns pool #{poolId}, and errors if it is not a concentrated liquidity pool.
+func (k Keeper) getConcentratedPool(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	concentratedPool, ok := pool.(types.ConcentratedPoolExtension)
+	if !ok {
+		return nil, fmt.Errorf("pool with id %d is not a concentrated liquidity pool", poolId)
+	}
+	return concentratedPool, nil
+}
+
+// calcOutAmtGivenIn returns the amount out of swapping tokenIn for tokenOutDenom against pool.
+// Concentrated liquidity pools are given the store, which holds their ticks.
+func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context, pool types.PoolI, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if concentratedPool, ok := pool.(types.ConcentratedPoolExtension); ok {
+		return concentratedPool.CalcOutAmtGivenInWithStore(ctx.KVStore(k.storeKey), tokenIn, tokenOutDenom, swapFee)
+	}
+	return pool.CalcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee)
+}
+
+// swapOutAmtGivenIn swaps tokenIn for tokenOutDenom against pool, and returns the amount out.
+// Concentrated liquidity pools are given the store, which holds their ticks.
+func (k Keeper) swapOutAmtGivenIn(ctx sdk.Context, pool types.PoolI, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if concentratedPool, ok := pool.(types.ConcentratedPoolExtension); ok {
+		return concentratedPool.SwapOutAmtGivenInWithStore(ctx.KVStore(k.storeKey), tokenIn, tokenOutDenom, swapFee)
+	}
+	return pool.SwapOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee)
+}
+
+// calcInAmtGivenOut returns the amount in of swapping tokenInDenom for tokenOut against pool.
+// Concentrated liquidity pools are given the store, which holds their ticks.
+func (k Keeper) calcInAmtGivenOut(ctx sdk.Context, pool types.PoolI, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if concentratedPool, ok := pool.(types.ConcentratedPoolExtension); ok {
+		return concentratedPool.CalcInAmtGivenOutWithStore(ctx.KVStore(k.storeKey), tokenOut, tokenInDenom, swapFee)
+	}
+	return pool.CalcInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee)
+}
+
+// swapInAmtGivenOut swaps tokenInDenom for tokenOut against pool, and returns the amount in.
+// Concentrated liquidity pools are given the store, which holds their ticks.
+func (k Keeper) swapInAmtGivenOut(ctx sdk.Context, pool types.PoolI, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, error) {
+	if concentratedPool, ok := pool.(types.ConcentratedPoolExtension); ok {
+		return concentratedPool.SwapInAmtGivenOutWithStore(ctx.KVStore(k.storeKey), tokenOut, tokenInDenom, swapFee)
+	}
+	return pool.SwapInAmtGivenOut(ctx, tokenOut, tokenInDenom, swapFee)
+}
+
+// deleteConcentratedPoolRecords deletes the ticks and positions of concentrated liquidity pool #{poolId}.
+func (k Keeper) deleteConcentratedPoolRecords(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, keyPrefix := range [][]byte{types.GetKeyPrefixConcentratedTicks(poolId), types.GetKeyPrefixConcentratedPositions(poolId)} {
+		iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+		keys := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+// CreateConcentratedPosition provides liquidity to concentrated liquidity pool #{poolId},
+// over the price range [lowerTick, upperTick), in a new position owned by sender.
+// The position takes as much of tokensDesired as it can at the current price.
+// If it takes less than tokenMins, returns an error & the message reverts.
+func (k Keeper) CreateConcentratedPosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+	tokensDesired sdk.Coins,
+	tokenMins sdk.Coins,
+) (positionId uint64, liquidity sdk.Dec, tokensIn sdk.Coins, err error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	positionId, liquidity, tokensIn, err = pool.CreatePosition(ctx.KVStore(k.storeKey), sender, lowerTick, upperTick, tokensDesired)
+	if err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+	if !tokensIn.IsAllGTE(tokenMins) {
+		return 0, sdk.Dec{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMinAmount,
+			"position takes %s, minimum tokens in specified as %s", tokensIn, tokenMins)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), tokensIn); err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+	if err := k.SetPool(ctx, pool); err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+
+	ctx.EventManager().EmitEvent(types.CreatePositionCreatedEvent(ctx, sender, poolId, positionId, liquidity, tokensIn))
+	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
+	return positionId, liquidity, tokensIn, nil
+}
+
+// WithdrawConcentratedPosition withdraws liquidity from position #{positionId} of
+// concentrated liquidity pool #{poolId}, and sends the tokens it held to sender, who must own the position.
+func (k Keeper) WithdrawConcentratedPosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	positionId uint64,
+	liquidity sdk.Dec,
+) (tokensOut sdk.Coins, err error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	tokensOut, err = pool.WithdrawPosition(ctx.KVStore(k.storeKey), sender, positionId, liquidity)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, tokensOut); err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := k.SetPool(ctx, pool); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	ctx.EventManager().EmitEvent(types.CreatePositionWithdrawnEvent(ctx, sender, poolId, positionId, liquidity, tokensOut))
+	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+	return tokensOut, nil
+}
+
+// CollectConcentratedPositionFees sends the fees accrued by position #{positionId} of
+// concentrated liquidity pool #{poolId} to sender, who must own the position.
+func (k Keeper) CollectConcentratedPositionFees(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	positionId uint64,
+) (fees sdk.Coins, err error) {
+	pool, err := k.getConcentratedPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	fees, err = pool.CollectFees(ctx.KVStore(k.storeKey), sender, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, fees); err != nil {
+		return sdk.Coins{}, err
+	}
+	if err := k.SetPool(ctx, pool); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	ctx.EventManager().EmitEvent(types.CreatePositionFeesCollectedEvent(ctx, sender, poolId, positionId, fees))
+	k.RecordTotalLiquidityDecrease(ctx, fees)
+	return fees, nil
+}
+
+// ExportConcentratedPoolRecords returns the ticks and positions of the concentrated liquidity pools among pools,
+// which are stored apart from them, for genesis.
+func (k Keeper) ExportConcentratedPoolRecords(ctx sdk.Context, pools []types.PoolI) []types.ConcentratedPoolRecordsI {
+	records := []types.ConcentratedPoolRecordsI{}
+	for _, pool := range pools {
+		if concentratedPool, ok := pool.(types.ConcentratedPoolExtension); ok {
+			records = append(records, concentratedPool.ExportRecords(ctx.KVStore(k.storeKey)))
+		}
+	}
+	return records
+}
+
+// ImportConcentratedPoolRecords stores the ticks and positions of a concentrated liquidity pool from genesis.
+// The pool must already be set.
+func (k Keeper) ImportConcentratedPoolRecords(ctx sdk.Context, records types.ConcentratedPoolRecordsI) error {
+	pool, err := k.getConcentratedPool(ctx, records.GetPoolId())
+	if err != nil {
+		return err
+	}
+	return pool.ImportRecords(ctx.KVStore(k.storeKey), records)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestConcentratedPoolLifecycle() {
+	suite.SetupTest()
+	gammKeeper := suite.App.GAMMKeeper
+	creator, lp, trader := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+
+	poolId := suite.PrepareBasicConcentratedPool()
+
+	// the pool has no shares, its creator owns a full range position instead
+	pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().True(pool.GetTotalShares().IsZero())
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, creator, types.GetPoolShareDenom(poolId)).IsZero())
+	spotPrice, err := gammKeeper.CalculateSpotPrice(suite.Ctx, poolId, "uosmo", "foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal("4.000000000000000000", spotPrice.String())
+
+	// joining or exiting with shares is not supported
+	suite.FundAcc(lp, concentratedTestFunds)
+	_, err = gammKeeper.JoinSwapExactAmountIn(suite.Ctx, lp, poolId, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)), sdk.OneInt())
+	suite.Require().ErrorIs(err, types.ErrPoolHasNoShares)
+	err = gammKeeper.JoinPoolNoSwap(suite.Ctx, lp, poolId, types.OneShare, sdk.Coins{})
+	suite.Require().ErrorIs(err, types.ErrPoolHasNoShares)
+
+	// provide liquidity around the current price
+	lpBalance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp)
+	tokensDesired := sdk.NewCoins(sdk.NewInt64Coin("foo", 100_000), sdk.NewInt64Coin("uosmo", 400_000))
+	_, _, _, err = gammKeeper.CreateConcentratedPosition(suite.Ctx, lp, poolId, 12000, 15800, tokensDesired, tokensDesired)
+	suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+	positionId, liquidity, tokensIn, err := gammKeeper.CreateConcentratedPosition(suite.Ctx, lp, poolId, 12000, 15800, tokensDesired, sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().True(liquidity.IsPositive())
+	suite.Require().Equal(lpBalance.Sub(tokensIn), suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp))
+
+	// swaps through the pool, directly and in multihop routes
+	suite.FundAcc(trader, concentratedTestFunds)
+	tokenOut, err := gammKeeper.SwapExactAmountIn(suite.Ctx, trader, poolId, sdk.NewInt64Coin("foo", 10_000), "uosmo", sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(tokenOut.GT(sdk.NewInt(39_000)))
+	_, err = gammKeeper.SwapExactAmountOut(suite.Ctx, trader, poolId, "uosmo", sdk.NewInt(100_000), sdk.NewInt64Coin("foo", 10_000))
+	suite.Require().NoError(err)
+
+	balancerPoolId := suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("uosmo", 1_000_000))
+	routes := []types.SwapAmountInRoute{
+		{PoolId: balancerPoolId, TokenOutDenom: "uosmo"},
+		{PoolId: poolId, TokenOutDenom: "foo"},
+	}
+	multihopOut, err := gammKeeper.MultihopSwapExactAmountIn(suite.Ctx, trader, routes, sdk.NewInt64Coin("bar", 10_000), sdk.OneInt())
+	suite.Require().NoError(err)
+	suite.Require().True(multihopOut.IsPositive())
+
+	spotPrice, err = gammKeeper.CalculateSpotPrice(suite.Ctx, poolId, "uosmo", "foo")
+	suite.Require().NoError(err)
+	suite.Require().NotEqual("4.000000000000000000", spotPrice.String())
+
+	// the position earned fees
+	querier := keeper.NewQuerier(*gammKeeper)
+	res, err := querier.Positions(sdk.WrapSDKContext(suite.Ctx), &concentrated.QueryPositionsRequest{PoolId: poolId, Owner: lp.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Positions, 1)
+	suite.Require().Equal(positionId, res.Positions[0].Id)
+	suite.Require().True(res.Positions[0].FeesOwed0.IsPositive())
+	suite.Require().True(res.Positions[0].FeesOwed1.IsPositive())
+
+	// only the owner can withdraw the position and collect its fees
+	_, err = gammKeeper.WithdrawConcentratedPosition(suite.Ctx, trader, poolId, positionId, liquidity)
+	suite.Require().Error(err)
+	_, err = gammKeeper.CollectConcentratedPositionFees(suite.Ctx, trader, poolId, positionId)
+	suite.Require().Error(err)
+
+	tokensOut, err := gammKeeper.WithdrawConcentratedPosition(suite.Ctx, lp, poolId, positionId, liquidity)
+	suite.Require().NoError(err)
+	fees, err := gammKeeper.CollectConcentratedPositionFees(suite.Ctx, lp, poolId, positionId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Positions[0].FeesOwed0.TruncateInt(), fees.AmountOf("foo"))
+	suite.Require().Equal(res.Positions[0].FeesOwed1.TruncateInt(), fees.AmountOf("uosmo"))
+	suite.Require().Equal(lpBalance.Sub(tokensIn).Add(tokensOut...).Add(fees...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, lp))
+
+	res, err = querier.Positions(sdk.WrapSDKContext(suite.Ctx), &concentrated.QueryPositionsRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Positions, 1)
+	suite.Require().Equal(creator.String(), res.Positions[0].Owner)
+
+	_, broken := keeper.AllInvariants(*gammKeeper, suite.App.BankKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
+
+var concentratedTestFunds = sdk.NewCoins(
+	sdk.NewInt64Coin("foo", 10_000_000),
+	sdk.NewInt64Coin("bar", 10_000_000),
+	sdk.NewInt64Coin("uosmo", 10_000_000),
+)
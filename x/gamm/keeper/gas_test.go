@@ -73,7 +73,7 @@ func (suite *KeeperTestSuite) TestJoinPoolGas() {
 	))
 
 	firstJoinGas := suite.measureJoinPoolGas(defaultAddr, poolId, minShareOutAmount, defaultCoins)
-	suite.Assert().LessOrEqual(int(firstJoinGas), 100000)
+	suite.Assert().LessOrEqual(int(firstJoinGas), 120000)
 
 	for i := 1; i < startAveragingAt; i++ {
 		err := suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, defaultAddr, poolId, minShareOutAmount, sdk.Coins{})
@@ -82,8 +82,9 @@ func (suite *KeeperTestSuite) TestJoinPoolGas() {
 
 	avgGas, maxGas := suite.measureAvgAndMaxJoinPoolGas(totalNumJoins, defaultAddr, poolIDFn, minShareOutAmountFn, maxCoinsFn)
 	fmt.Printf("test deets: total %d of pools joined, begin average at %d\n", totalNumJoins, startAveragingAt)
-	suite.Assert().LessOrEqual(int(avgGas), 100000, "average gas / join pool")
-	suite.Assert().LessOrEqual(int(maxGas), 100000, "max gas / join pool")
+	// includes updating the twap records of every denom pair in the pool
+	suite.Assert().LessOrEqual(int(avgGas), 120000, "average gas / join pool")
+	suite.Assert().LessOrEqual(int(maxGas), 120000, "max gas / join pool")
 }
 
 func (suite *KeeperTestSuite) TestRepeatedJoinPoolDistinctDenom() {
@@ -143,3 +144,29 @@ func (suite *KeeperTestSuite) TestRepeatedJoinPoolDistinctDenom() {
 	gasIncrease := lastJoinGas - firstJoinGas
 	suite.Require().LessOrEqual(gasIncrease, uint64(5000))
 }
+
+// This maintains hard coded gas test vectors of single asset stableswap joins and exits,
+// whose binary searches over the pool's CFMM run in bounded iterations.
+func (suite *KeeperTestSuite) TestStableswapSingleAssetJoinExitGas() {
+	suite.SetupTest()
+	poolId := suite.PrepareBasicStableswapPool()
+	sender := suite.TestAccs[0]
+
+	alreadySpent := suite.Ctx.GasMeter().GasConsumed()
+	_, err := suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoins(sdk.NewInt64Coin("foo", 100000)), sdk.OneInt())
+	suite.Require().NoError(err)
+	joinGas := suite.Ctx.GasMeter().GasConsumed() - alreadySpent
+	suite.Assert().LessOrEqual(int(joinGas), 70000, "gas / join swap extern amount in")
+
+	alreadySpent = suite.Ctx.GasMeter().GasConsumed()
+	_, err = suite.App.GAMMKeeper.JoinSwapShareAmountOut(suite.Ctx, sender, poolId, "foo", types.OneShare, sdk.NewInt(1000000))
+	suite.Require().NoError(err)
+	joinShareGas := suite.Ctx.GasMeter().GasConsumed() - alreadySpent
+	suite.Assert().LessOrEqual(int(joinShareGas), 70000, "gas / join swap share amount out")
+
+	alreadySpent = suite.Ctx.GasMeter().GasConsumed()
+	_, err = suite.App.GAMMKeeper.ExitSwapExactAmountOut(suite.Ctx, sender, poolId, sdk.NewInt64Coin("foo", 100000), types.OneShare.MulRaw(100))
+	suite.Require().NoError(err)
+	exitGas := suite.Ctx.GasMeter().GasConsumed() - alreadySpent
+	suite.Assert().LessOrEqual(int(exitGas), 70000, "gas / exit swap extern amount out")
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -32,7 +35,10 @@ func init() {
 	sdkIntMaxValue = _sdkIntMaxValue
 }
 
-var _ types.QueryServer = Querier{}
+var (
+	_ types.QueryServer        = Querier{}
+	_ concentrated.QueryServer = Querier{}
+)
 
 // Querier defines a wrapper around the x/gamm keeper providing gRPC method
 // handlers.
@@ -189,6 +195,60 @@ func (q Querier) TotalShares(ctx context.Context, req *types.QueryTotalSharesReq
 	}, nil
 }
 
+func (q Querier) ScalingFactors(ctx context.Context, req *types.QueryScalingFactorsRequest) (*types.QueryScalingFactorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	poolI, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pool, ok := poolI.(*stableswap.Pool)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "pool (%d) is not a stableswap pool", req.PoolId)
+	}
+
+	targetScalingFactors, targetTime := pool.GetTargetScalingFactors()
+	return &types.QueryScalingFactorsResponse{
+		ScalingFactors:       pool.GetScalingFactors(),
+		TargetScalingFactors: targetScalingFactors,
+		TargetTime:           targetTime,
+	}, nil
+}
+
+func (q Querier) Positions(ctx context.Context, req *concentrated.QueryPositionsRequest) (*concentrated.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var owner sdk.AccAddress
+	if req.Owner != "" {
+		var err error
+		owner, err = sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	poolI, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pool, ok := poolI.(*concentrated.Pool)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "pool (%d) is not a concentrated liquidity pool", req.PoolId)
+	}
+
+	return &concentrated.QueryPositionsResponse{Positions: pool.GetPositions(sdkCtx.KVStore(q.Keeper.storeKey), owner)}, nil
+}
+
 func (q Querier) SpotPrice(ctx context.Context, req *types.QuerySpotPriceRequest) (*types.QuerySpotPriceResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -304,3 +364,38 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 		TokenInAmount: tokenInAmount,
 	}, nil
 }
+
+func (q Querier) BestRoute(ctx context.Context, req *types.QueryBestRouteRequest) (*types.QueryBestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	if req.MaxHops == 0 || req.MaxHops > types.MaxBestRouteHops {
+		return nil, status.Errorf(codes.InvalidArgument, "max hops must be between 1 and %d", types.MaxBestRouteHops)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	routes, tokenOutAmount, priceImpact, err := q.Keeper.BestSwapExactAmountInRoute(sdkCtx, tokenIn, req.TokenOutDenom, int(req.MaxHops))
+	if err != nil {
+		if errors.Is(err, types.ErrNoRouteFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBestRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+		PriceImpact:    priceImpact,
+	}, nil
+}
@@ -2,10 +2,12 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -283,3 +285,36 @@ func (suite *KeeperTestSuite) TestQueryBalancerPoolSpotPrice() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryScalingFactors() {
+	queryClient := suite.queryClient
+
+	// Pool not exist
+	_, err := queryClient.ScalingFactors(gocontext.Background(), &types.QueryScalingFactorsRequest{PoolId: 1})
+	suite.Require().Error(err)
+
+	// Not a stableswap pool
+	balancerPoolId := suite.PrepareBalancerPool()
+	_, err = queryClient.ScalingFactors(gocontext.Background(), &types.QueryScalingFactorsRequest{PoolId: balancerPoolId})
+	suite.Require().Error(err)
+
+	poolId := suite.PrepareBasicStableswapPool()
+	res, err := queryClient.ScalingFactors(gocontext.Background(), &types.QueryScalingFactorsRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 1}, res.ScalingFactors)
+	suite.Require().Equal([]uint64{1, 1}, res.TargetScalingFactors)
+	suite.Require().Nil(res.TargetTime)
+
+	// Start ramping the scaling factors, and query halfway through
+	err = suite.App.GAMMKeeper.SetStableswapScalingFactors(suite.Ctx, suite.TestAccs[0], poolId, []uint64{3, 1}, 2*time.Hour)
+	suite.Require().NoError(err)
+	targetTime := suite.Ctx.BlockTime().Add(2 * time.Hour)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+
+	querier := keeper.NewQuerier(*suite.App.GAMMKeeper)
+	res, err = querier.ScalingFactors(sdk.WrapSDKContext(suite.Ctx), &types.QueryScalingFactorsRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 1}, res.ScalingFactors)
+	suite.Require().Equal([]uint64{3, 1}, res.TargetScalingFactors)
+	suite.Require().Equal(targetTime, *res.TargetTime)
+}
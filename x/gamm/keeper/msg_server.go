@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/concentrated"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
@@ -33,10 +34,17 @@ func NewStableswapMsgServerImpl(keeper *Keeper) stableswap.MsgServer {
 	}
 }
 
+func NewConcentratedMsgServerImpl(keeper *Keeper) concentrated.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
 var (
-	_ types.MsgServer      = msgServer{}
-	_ balancer.MsgServer   = msgServer{}
-	_ stableswap.MsgServer = msgServer{}
+	_ types.MsgServer        = msgServer{}
+	_ balancer.MsgServer     = msgServer{}
+	_ stableswap.MsgServer   = msgServer{}
+	_ concentrated.MsgServer = msgServer{}
 )
 
 func (server msgServer) CreateBalancerPool(goCtx context.Context, msg *balancer.MsgCreateBalancerPool) (*balancer.MsgCreateBalancerPoolResponse, error) {
@@ -52,6 +60,118 @@ func (server msgServer) CreateStableswapPool(goCtx context.Context, msg *stables
 	return &stableswap.MsgCreateStableswapPoolResponse{PoolID: poolId}, nil
 }
 
+func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapAdjustScalingFactors) (*stableswap.MsgStableSwapAdjustScalingFactorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.keeper.SetStableswapScalingFactors(ctx, sender, msg.PoolId, msg.ScalingFactors, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtScalingFactorsAdjusted,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
+}
+
+func (server msgServer) CreateConcentratedPool(goCtx context.Context, msg *concentrated.MsgCreateConcentratedPool) (*concentrated.MsgCreateConcentratedPoolResponse, error) {
+	poolId, err := server.CreatePool(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &concentrated.MsgCreateConcentratedPoolResponse{PoolID: poolId}, nil
+}
+
+func (server msgServer) CreatePosition(goCtx context.Context, msg *concentrated.MsgCreatePosition) (*concentrated.MsgCreatePositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, liquidity, tokensIn, err := server.keeper.CreateConcentratedPosition(ctx, sender, msg.PoolId, msg.LowerTick, msg.UpperTick, msg.TokensDesired, msg.TokenMins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &concentrated.MsgCreatePositionResponse{
+		PositionId: positionId,
+		Liquidity:  liquidity,
+		TokensIn:   tokensIn,
+	}, nil
+}
+
+func (server msgServer) WithdrawPosition(goCtx context.Context, msg *concentrated.MsgWithdrawPosition) (*concentrated.MsgWithdrawPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.WithdrawConcentratedPosition(ctx, sender, msg.PoolId, msg.PositionId, msg.Liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &concentrated.MsgWithdrawPositionResponse{TokensOut: tokensOut}, nil
+}
+
+func (server msgServer) CollectFees(goCtx context.Context, msg *concentrated.MsgCollectFees) (*concentrated.MsgCollectFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := server.keeper.CollectConcentratedPositionFees(ctx, sender, msg.PoolId, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &concentrated.MsgCollectFeesResponse{Fees: fees}, nil
+}
+
 func (server msgServer) CreatePool(goCtx context.Context, msg types.CreatePoolMsg) (poolId uint64, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -156,6 +276,31 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
 }
 
+func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountIn) (*types.MsgSplitRouteSwapExactAmountInResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOutAmount, err := server.keeper.SplitRouteSwapExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap events are handled elsewhere
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+}
+
 func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.MsgSwapExactAmountOut) (*types.MsgSwapExactAmountOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
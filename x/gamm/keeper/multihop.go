@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
@@ -9,6 +10,7 @@ import (
 // MultihopSwapExactAmountIn defines the input denom and input amount for the first pool,
 // the output of the first pool is chained as the input for the next routed pool
 // transaction succeeds when final amount out is greater than tokenOutMinAmount defined.
+// Every hop charges the swap fee returned by getMultihopSwapFees.
 func (k Keeper) MultihopSwapExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -16,13 +18,23 @@ func (k Keeper) MultihopSwapExactAmountIn(
 	tokenIn sdk.Coin,
 	tokenOutMinAmount sdk.Int,
 ) (tokenOutAmount sdk.Int, err error) {
+	swapFees, err := k.getMultihopSwapFees(ctx, types.SwapAmountInRoutes(routes).PoolIds(), types.SwapAmountInRoutes(routes).IntermediateDenoms())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
 	for i, route := range routes {
 		_outMinAmount := sdk.NewInt(1)
 		if len(routes)-1 == i {
 			_outMinAmount = tokenOutMinAmount
 		}
 
-		tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, route.PoolId, tokenIn, route.TokenOutDenom, _outMinAmount)
+		pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenOutAmount, err = k.swapExactAmountIn(ctx, sender, pool, tokenIn, route.TokenOutDenom, _outMinAmount, swapFees[i])
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -31,10 +43,62 @@ func (k Keeper) MultihopSwapExactAmountIn(
 	return
 }
 
+// SplitRouteSwapExactAmountIn swaps the tokenInAmount of every route through that route's pools,
+// starting from tokenInDenom. Every route is swapped with MultihopSwapExactAmountIn, and the
+// transaction succeeds when the sum of the amounts out of all routes is at least tokenOutMinAmount.
+// A single split route swap event with the totals swapped is emitted on success.
+func (k Keeper) SplitRouteSwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if len(routes) == 0 {
+		return sdk.Int{}, types.ErrEmptyRoutes
+	}
+
+	tokenOutDenom := ""
+	tokenInAmount := sdk.ZeroInt()
+	tokenOutAmount = sdk.ZeroInt()
+	for i, route := range routes {
+		if len(route.Pools) == 0 {
+			return sdk.Int{}, types.ErrEmptyRoutes
+		}
+
+		routeTokenOutDenom := route.Pools[len(route.Pools)-1].TokenOutDenom
+		if i == 0 {
+			tokenOutDenom = routeTokenOutDenom
+		} else if routeTokenOutDenom != tokenOutDenom {
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidSplitRoutes, "routes end in both %s and %s", tokenOutDenom, routeTokenOutDenom)
+		}
+
+		// the minimum amount out is only enforced on the sum over all routes.
+		routeTokenOutAmount, err := k.MultihopSwapExactAmountIn(ctx, sender, route.Pools, sdk.NewCoin(tokenInDenom, route.TokenInAmount), sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenInAmount = tokenInAmount.Add(route.TokenInAmount)
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", tokenOutDenom)
+	}
+
+	ctx.EventManager().EmitEvent(types.CreateSplitRouteSwapEvent(ctx, sender, len(routes),
+		sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInAmount)),
+		sdk.NewCoins(sdk.NewCoin(tokenOutDenom, tokenOutAmount))))
+
+	return tokenOutAmount, nil
+}
+
 // MultihopSwapExactAmountOut defines the output denom and output amount for the last pool.
 // Calculation starts by providing the tokenOutAmount of the final pool to calculate the required tokenInAmount
 // the calculated tokenInAmount is used as defined tokenOutAmount of the previous pool, calculating in reverse order of the swap
 // Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined tokenInMaxAmount defined.
+// Every hop charges the swap fee returned by getMultihopSwapFees.
 func (k Keeper) MultihopSwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -42,7 +106,12 @@ func (k Keeper) MultihopSwapExactAmountOut(
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
 ) (tokenInAmount sdk.Int, err error) {
-	insExpected, err := k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	swapFees, err := k.getMultihopSwapFees(ctx, types.SwapAmountOutRoutes(routes).PoolIds(), types.SwapAmountOutRoutes(routes).IntermediateDenoms())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	insExpected, err := k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut, swapFees)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -55,7 +124,12 @@ func (k Keeper) MultihopSwapExactAmountOut(
 			_tokenOut = sdk.NewCoin(routes[i+1].TokenInDenom, insExpected[i+1])
 		}
 
-		_tokenInAmount, err := k.SwapExactAmountOut(ctx, sender, route.PoolId, route.TokenInDenom, insExpected[i], _tokenOut)
+		pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		_tokenInAmount, err := k.swapExactAmountOut(ctx, sender, pool, route.TokenInDenom, insExpected[i], _tokenOut, swapFees[i])
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -69,7 +143,7 @@ func (k Keeper) MultihopSwapExactAmountOut(
 }
 
 // TODO: Document this function.
-func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.SwapAmountOutRoute, tokenOut sdk.Coin) ([]sdk.Int, error) {
+func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.SwapAmountOutRoute, tokenOut sdk.Coin, swapFees []sdk.Dec) ([]sdk.Int, error) {
 	insExpected := make([]sdk.Int, len(routes))
 	for i := len(routes) - 1; i >= 0; i-- {
 		route := routes[i]
@@ -79,7 +153,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.S
 			return nil, err
 		}
 
-		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), route.TokenInDenom, pool.GetSwapFee(ctx))
+		tokenIn, err := k.calcInAmtGivenOut(ctx, pool, sdk.NewCoins(tokenOut), route.TokenInDenom, swapFees[i])
 		if err != nil {
 			return nil, err
 		}
@@ -90,3 +164,51 @@ func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.S
 
 	return insExpected, nil
 }
+
+// getMultihopSwapFees returns the swap fee charged on every hop of a multihop swap through the pools of poolIds,
+// which swaps intermediateDenoms between its hops. When there are several hops, and every hop swaps through OSMO,
+// i.e. every intermediate denom is the bond denom, the swap fee of every pool is multiplied by the
+// OsmoMultihopSwapFeeMultiplier param, so that routing through OSMO is not charged the full swap fee on every hop.
+// Otherwise every hop charges its pool's swap fee.
+func (k Keeper) getMultihopSwapFees(ctx sdk.Context, poolIds []uint64, intermediateDenoms []string) ([]sdk.Dec, error) {
+	pools := make([]types.PoolI, len(poolIds))
+	for i, poolId := range poolIds {
+		pool, err := k.getPoolForSwap(ctx, poolId)
+		if err != nil {
+			return nil, err
+		}
+		pools[i] = pool
+	}
+
+	return k.getMultihopSwapFeesOfPools(ctx, pools, intermediateDenoms), nil
+}
+
+// getMultihopSwapFeesOfPools returns the swap fees of getMultihopSwapFees, for already fetched pools.
+func (k Keeper) getMultihopSwapFeesOfPools(ctx sdk.Context, pools []types.PoolI, intermediateDenoms []string) []sdk.Dec {
+	swapFeeMultiplier := sdk.OneDec()
+	if k.isOsmoRoutedMultihop(ctx, intermediateDenoms) {
+		swapFeeMultiplier = k.GetParams(ctx).OsmoMultihopSwapFeeMultiplier
+	}
+
+	swapFees := make([]sdk.Dec, len(pools))
+	for i, pool := range pools {
+		swapFees[i] = pool.GetSwapFee(ctx).Mul(swapFeeMultiplier)
+	}
+	return swapFees
+}
+
+// isOsmoRoutedMultihop returns whether a route has several hops, which all swap through OSMO,
+// given the denoms it swaps between its hops.
+func (k Keeper) isOsmoRoutedMultihop(ctx sdk.Context, intermediateDenoms []string) bool {
+	if len(intermediateDenoms) == 0 {
+		return false
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, denom := range intermediateDenoms {
+		if denom != bondDenom {
+			return false
+		}
+	}
+	return true
+}
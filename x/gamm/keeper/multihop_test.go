@@ -5,6 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v7/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/keeper"
+	balancertypes "github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -101,6 +104,102 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleMultihopSwapExactAmountIn()
 	}
 }
 
+func (suite *KeeperTestSuite) TestSplitRouteSwapExactAmountIn() {
+	// foo -> baz via pool 1, and foo -> bar via pool 2 then bar -> baz via pool 1.
+	defaultRoutes := []types.SwapAmountInSplitRoute{
+		{
+			Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "baz"}},
+			TokenInAmount: sdk.NewInt(60000),
+		},
+		{
+			Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "bar"}, {PoolId: 1, TokenOutDenom: "baz"}},
+			TokenInAmount: sdk.NewInt(40000),
+		},
+	}
+
+	tests := []struct {
+		name              string
+		routes            []types.SwapAmountInSplitRoute
+		tokenOutMinAmount sdk.Int
+		expectPass        bool
+	}{
+		{
+			name:              "Proper split route swap",
+			routes:            defaultRoutes,
+			tokenOutMinAmount: sdk.NewInt(1),
+			expectPass:        true,
+		},
+		{
+			name:              "min amount out is checked against the sum over all routes",
+			routes:            defaultRoutes,
+			tokenOutMinAmount: sdk.NewInt(1000000),
+			expectPass:        false,
+		},
+		{
+			name: "routes ending in different denoms",
+			routes: []types.SwapAmountInSplitRoute{
+				defaultRoutes[0],
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: "bar"}},
+					TokenInAmount: sdk.NewInt(40000),
+				},
+			},
+			tokenOutMinAmount: sdk.NewInt(1),
+			expectPass:        false,
+		},
+		{
+			name:              "no routes",
+			routes:            []types.SwapAmountInSplitRoute{},
+			tokenOutMinAmount: sdk.NewInt(1),
+			expectPass:        false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		suite.PrepareBalancerPool()
+		suite.PrepareBalancerPool()
+
+		keeper := suite.App.GAMMKeeper
+		sender := suite.TestAccs[0]
+
+		if !test.expectPass {
+			_, err := keeper.SplitRouteSwapExactAmountIn(suite.Ctx, sender, test.routes, "foo", test.tokenOutMinAmount)
+			suite.Error(err, "test: %v", test.name)
+			continue
+		}
+
+		// Swapping the whole amount through the single pool route gives a worse price.
+		cacheCtx, _ := suite.Ctx.CacheContext()
+		singleRouteOut, err := keeper.MultihopSwapExactAmountIn(cacheCtx, sender, defaultRoutes[0].Pools, sdk.NewCoin("foo", sdk.NewInt(100000)), sdk.OneInt())
+		suite.Require().NoError(err)
+
+		bazBalanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "baz").Amount
+		suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+		tokenOutAmount, err := keeper.SplitRouteSwapExactAmountIn(suite.Ctx, sender, test.routes, "foo", test.tokenOutMinAmount)
+		suite.Require().NoError(err, "test: %v", test.name)
+
+		bazBalanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "baz").Amount
+		suite.Require().Equal(bazBalanceAfter.Sub(bazBalanceBefore), tokenOutAmount)
+		suite.Require().True(tokenOutAmount.GT(singleRouteOut), "test: %v", test.name)
+
+		// a single aggregated event is emitted along with the swap event of every pool.
+		splitRouteEvents := 0
+		for _, event := range suite.Ctx.EventManager().Events() {
+			if event.Type != types.TypeEvtSplitRouteTokenSwapped {
+				continue
+			}
+			splitRouteEvents++
+			suite.Require().Equal("2", string(event.Attributes[2].Value))
+			suite.Require().Equal("100000foo", string(event.Attributes[3].Value))
+			suite.Require().Equal(sdk.NewCoin("baz", tokenOutAmount).String(), string(event.Attributes[4].Value))
+		}
+		suite.Require().Equal(1, splitRouteEvents)
+	}
+}
+
 func (suite *KeeperTestSuite) TestBalancerPoolSimpleMultihopSwapExactAmountOut() {
 	type param struct {
 		routes           []types.SwapAmountOutRoute
@@ -196,3 +295,111 @@ func (suite *KeeperTestSuite) TestBalancerPoolSimpleMultihopSwapExactAmountOut()
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestOsmoRoutedMultihopSwapFee() {
+	swapFee := sdk.NewDecWithPrec(1, 2)
+	discountedSwapFee := swapFee.Mul(types.DefaultParams().OsmoMultihopSwapFeeMultiplier)
+
+	tests := []struct {
+		name             string
+		poolAssets       [][]sdk.Coin
+		routes           []types.SwapAmountInRoute
+		expectedSwapFees []sdk.Dec
+	}{
+		{
+			name:             "single hop through an OSMO pool charges the full swap fee",
+			poolAssets:       [][]sdk.Coin{{sdk.NewInt64Coin("foo", 1000000), sdk.NewInt64Coin("uosmo", 1000000)}},
+			routes:           []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}},
+			expectedSwapFees: []sdk.Dec{swapFee},
+		},
+		{
+			name: "every hop in an OSMO pool charges the discounted swap fee",
+			poolAssets: [][]sdk.Coin{
+				{sdk.NewInt64Coin("foo", 1000000), sdk.NewInt64Coin("uosmo", 1000000)},
+				{sdk.NewInt64Coin("uosmo", 1000000), sdk.NewInt64Coin("bar", 1000000)},
+			},
+			routes:           []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}, {PoolId: 2, TokenOutDenom: "bar"}},
+			expectedSwapFees: []sdk.Dec{discountedSwapFee, discountedSwapFee},
+		},
+		{
+			name: "a hop outside of OSMO pools charges the full swap fee on every hop",
+			poolAssets: [][]sdk.Coin{
+				{sdk.NewInt64Coin("foo", 1000000), sdk.NewInt64Coin("uosmo", 1000000)},
+				{sdk.NewInt64Coin("uosmo", 1000000), sdk.NewInt64Coin("bar", 1000000)},
+				{sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("baz", 1000000)},
+			},
+			routes:           []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}, {PoolId: 2, TokenOutDenom: "bar"}, {PoolId: 3, TokenOutDenom: "baz"}},
+			expectedSwapFees: []sdk.Dec{swapFee, swapFee, swapFee},
+		},
+		{
+			name: "hops in OSMO pools that don't swap through OSMO charge the full swap fee",
+			poolAssets: [][]sdk.Coin{
+				{sdk.NewInt64Coin("foo", 1000000), sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("uosmo", 1000000)},
+				{sdk.NewInt64Coin("bar", 1000000), sdk.NewInt64Coin("baz", 1000000), sdk.NewInt64Coin("uosmo", 1000000)},
+			},
+			routes:           []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "bar"}, {PoolId: 2, TokenOutDenom: "baz"}},
+			expectedSwapFees: []sdk.Dec{swapFee, swapFee},
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		// OSMO is the bond denom.
+		stakingParams := suite.App.StakingKeeper.GetParams(suite.Ctx)
+		stakingParams.BondDenom = "uosmo"
+		suite.App.StakingKeeper.SetParams(suite.Ctx, stakingParams)
+
+		for _, assets := range test.poolAssets {
+			poolAssets := []balancertypes.PoolAsset{}
+			for _, asset := range assets {
+				poolAssets = append(poolAssets, balancertypes.PoolAsset{Weight: sdk.NewInt(100), Token: asset})
+			}
+			suite.prepareCustomBalancerPool(apptesting.DefaultAcctFunds, poolAssets, balancertypes.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+		}
+		gammKeeper := suite.App.GAMMKeeper
+		tokenIn := sdk.NewInt64Coin("foo", 100000)
+
+		// the expected amount out charges the expected swap fee on every hop.
+		expectedTokenOut := tokenIn
+		for i, route := range test.routes {
+			pool, err := gammKeeper.GetPoolAndPoke(suite.Ctx, route.PoolId)
+			suite.Require().NoError(err)
+			expectedTokenOut, err = pool.CalcOutAmtGivenIn(suite.Ctx, sdk.NewCoins(expectedTokenOut), route.TokenOutDenom, test.expectedSwapFees[i])
+			suite.Require().NoError(err)
+		}
+
+		// the estimate query reports the same amount out as the swap.
+		cacheCtx, _ := suite.Ctx.CacheContext()
+		estimate, err := keeper.NewQuerier(*suite.App.GAMMKeeper).EstimateSwapExactAmountIn(sdk.WrapSDKContext(cacheCtx), &types.QuerySwapExactAmountInRequest{
+			Sender:  suite.TestAccs[0].String(),
+			PoolId:  test.routes[0].PoolId,
+			TokenIn: tokenIn.String(),
+			Routes:  test.routes,
+		})
+		suite.Require().NoError(err, "test: %v", test.name)
+		suite.Require().Equal(expectedTokenOut.Amount, estimate.TokenOutAmount, "test: %v", test.name)
+
+		suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+		tokenOutAmount, err := gammKeeper.MultihopSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], test.routes, tokenIn, sdk.OneInt())
+		suite.Require().NoError(err, "test: %v", test.name)
+		suite.Require().Equal(expectedTokenOut.Amount, tokenOutAmount, "test: %v", test.name)
+
+		// the swap event of every hop reports the swap fee charged.
+		swapFees := []string{}
+		for _, event := range suite.Ctx.EventManager().Events() {
+			if event.Type != types.TypeEvtTokenSwapped {
+				continue
+			}
+			for _, attribute := range event.Attributes {
+				if string(attribute.Key) == types.AttributeKeySwapFee {
+					swapFees = append(swapFees, string(attribute.Value))
+				}
+			}
+		}
+		suite.Require().Len(swapFees, len(test.expectedSwapFees), "test: %v", test.name)
+		for i, expectedSwapFee := range test.expectedSwapFees {
+			suite.Require().Equal(expectedSwapFee.String(), swapFees[i], "test: %v", test.name)
+		}
+	}
+}
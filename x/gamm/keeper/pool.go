@@ -100,6 +100,7 @@ func (k Keeper) DeletePool(ctx sdk.Context, poolId uint64) error {
 	}
 
 	store.Delete(poolKey)
+	k.deleteConcentratedPoolRecords(ctx, poolId)
 	return nil
 }
 
@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -70,7 +72,12 @@ func (k Keeper) validateCreatedPool(
 			"Pool was attempted to be created, with initial liquidity not equal to what was specified.")
 	}
 	// This check can be removed later, and replaced with a minimum.
-	if !pool.GetTotalShares().Equal(types.InitPoolSharesSupply) {
+	// Concentrated liquidity pools have no shares, as their liquidity is provided by positions.
+	expectedShares := types.InitPoolSharesSupply
+	if _, ok := pool.(types.ConcentratedPoolExtension); ok {
+		expectedShares = sdk.ZeroInt()
+	}
+	if !pool.GetTotalShares().Equal(expectedShares) {
 		return sdkerrors.Wrapf(types.ErrInvalidPool,
 			"Pool was attempted to be created with incorrect number of initial shares.")
 	}
@@ -90,6 +97,7 @@ func (k Keeper) validateCreatedPool(
 // and sent to the pool creator. The shares are created using a denomination in
 // the form of gamm/pool/{poolID}. In addition, the x/bank metadata is updated
 // to reflect the newly created GAMM share denomination.
+// Concentrated liquidity pools have no shares, their creator owns a position instead.
 func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, error) {
 	err := validateCreatePoolMsg(ctx, msg)
 	if err != nil {
@@ -131,10 +139,33 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		return 0, err
 	}
 
+	if concentratedPool, ok := pool.(types.ConcentratedPoolExtension); ok {
+		if err := concentratedPool.CreateInitialPosition(ctx.KVStore(k.storeKey), sender); err != nil {
+			return 0, err
+		}
+	} else {
+		if err := k.mintInitialPoolShares(ctx, pool, sender); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.SetPool(ctx, pool); err != nil {
+		return 0, err
+	}
+
+	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
+	k.RecordTotalLiquidityIncrease(ctx, initialPoolLiquidity)
+
+	return pool.GetId(), nil
+}
+
+// mintInitialPoolShares mints the initial shares of pool to its creator,
+// and adds the share token's meta data to the bank keeper.
+func (k Keeper) mintInitialPoolShares(ctx sdk.Context, pool types.PoolI, creator sdk.AccAddress) error {
 	// Mint the initial pool shares share token to the sender
-	err = k.MintPoolShareToAccount(ctx, pool, sender, pool.GetTotalShares())
+	err := k.MintPoolShareToAccount(ctx, pool, creator, pool.GetTotalShares())
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	// Finally, add the share token's meta data to the bank keeper.
@@ -159,15 +190,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		Base:    poolShareBaseDenom,
 		Display: poolShareDisplayDenom,
 	})
-
-	if err := k.SetPool(ctx, pool); err != nil {
-		return 0, err
-	}
-
-	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
-	k.RecordTotalLiquidityIncrease(ctx, initialPoolLiquidity)
-
-	return pool.GetId(), nil
+	return nil
 }
 
 // JoinPoolNoSwap aims to LP exactly enough to pool #{poolId} to get shareOutAmount number of LP shares.
@@ -219,6 +242,9 @@ func (k Keeper) JoinPoolNoSwap(
 
 func getMaximalNoSwapLPAmount(ctx sdk.Context, pool types.PoolI, shareOutAmount sdk.Int) (neededLpLiquidity sdk.Coins, err error) {
 	totalSharesAmount := pool.GetTotalShares()
+	if !totalSharesAmount.IsPositive() {
+		return sdk.Coins{}, types.ErrPoolHasNoShares
+	}
 	// shareRatio is the desired number of shares, divided by the total number of
 	// shares currently in the pool. It is intended to be used in scenarios where you want
 	// (tokens per share) * number of shares out = # tokens * (# shares out / cur total shares)
@@ -417,3 +443,30 @@ func (k Keeper) ExitSwapExactAmountOut(
 
 	return shareInAmount, nil
 }
+
+// SetStableswapScalingFactors changes the scaling factors of stableswap pool #{poolId}
+// to scalingFactors, linearly over duration. The change is applied when the pool is poked.
+// Only the pool's future governor may change its scaling factors.
+func (k Keeper) SetStableswapScalingFactors(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	scalingFactors []uint64,
+	duration time.Duration,
+) error {
+	poolI, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	pool, ok := poolI.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool with id %d is not a stableswap pool", poolId)
+	}
+
+	if err := pool.SetScalingFactors(ctx.BlockTime(), scalingFactors, duration, sender); err != nil {
+		return err
+	}
+
+	return k.SetPool(ctx, pool)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 	balancertypes "github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
@@ -211,7 +212,8 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 		fn: func() {
 			keeper := suite.App.GAMMKeeper
 			keeper.SetParams(suite.Ctx, types.Params{
-				PoolCreationFee: sdk.Coins{},
+				PoolCreationFee:               sdk.Coins{},
+				OsmoMultihopSwapFeeMultiplier: types.DefaultParams().OsmoMultihopSwapFeeMultiplier,
 			})
 			msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], balancer.PoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
@@ -227,7 +229,8 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 		fn: func() {
 			keeper := suite.App.GAMMKeeper
 			keeper.SetParams(suite.Ctx, types.Params{
-				PoolCreationFee: nil,
+				PoolCreationFee:               nil,
+				OsmoMultihopSwapFeeMultiplier: types.DefaultParams().OsmoMultihopSwapFeeMultiplier,
 			})
 			msg := balancer.NewMsgCreateBalancerPool(suite.TestAccs[0], balancer.PoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
@@ -573,3 +576,119 @@ func (suite *KeeperTestSuite) TestJoinSwapExactAmountInConsistency() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestStableswapJoinAndExit() {
+	shareDenom := func(poolId uint64) string { return types.GetPoolShareDenom(poolId) }
+
+	tests := map[string]struct {
+		fn func(poolId uint64, sender sdk.AccAddress)
+	}{
+		"join pool no swap, then exit pool": {
+			fn: func(poolId uint64, sender sdk.AccAddress) {
+				keeper := suite.App.GAMMKeeper
+				sharesOut := types.InitPoolSharesSupply.QuoRaw(10)
+				err := keeper.JoinPoolNoSwap(suite.Ctx, sender, poolId, sharesOut, sdk.Coins{})
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.InitPoolSharesSupply.Add(sharesOut), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, shareDenom(poolId)).Amount)
+
+				balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+				exitCoins, err := keeper.ExitPool(suite.Ctx, sender, poolId, sharesOut, sdk.Coins{})
+				suite.Require().NoError(err)
+				balancesAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+				suite.Require().Equal(balancesBefore.Add(exitCoins...).Sub(sdk.NewCoins(sdk.NewCoin(shareDenom(poolId), sharesOut))), balancesAfter)
+				// 1% exit fee on 100_000 of each asset
+				suite.Require().Equal(sdk.NewInt(99000), exitCoins.AmountOf("foo"))
+				suite.Require().Equal(sdk.NewInt(99000), exitCoins.AmountOf("bar"))
+			},
+		},
+		"join swap extern amount in, then exit swap share amount in": {
+			fn: func(poolId uint64, sender sdk.AccAddress) {
+				keeper := suite.App.GAMMKeeper
+				tokenIn := sdk.NewCoin("foo", sdk.NewInt(10000))
+				sharesOut, err := keeper.JoinSwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoins(tokenIn), sdk.OneInt())
+				suite.Require().NoError(err)
+				suite.Require().True(sharesOut.IsPositive())
+
+				// slippage bound on the shares out
+				_, err = keeper.JoinSwapExactAmountIn(suite.Ctx, sender, poolId, sdk.NewCoins(tokenIn), types.InitPoolSharesSupply)
+				suite.Require().ErrorIs(err, types.ErrLimitMinAmount)
+
+				tokenOutAmount, err := keeper.ExitSwapShareAmountIn(suite.Ctx, sender, poolId, "foo", sharesOut, sdk.OneInt())
+				suite.Require().NoError(err)
+				// swap and exit fees are charged on the round trip
+				suite.Require().True(tokenOutAmount.LT(tokenIn.Amount))
+				suite.Require().True(tokenOutAmount.GT(tokenIn.Amount.QuoRaw(100).MulRaw(97)))
+			},
+		},
+		"join swap share amount out, then exit swap extern amount out": {
+			fn: func(poolId uint64, sender sdk.AccAddress) {
+				keeper := suite.App.GAMMKeeper
+				sharesOut := types.InitPoolSharesSupply.QuoRaw(100)
+				fooBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo").Amount
+
+				_, err := keeper.JoinSwapShareAmountOut(suite.Ctx, sender, poolId, "foo", sharesOut, sdk.OneInt())
+				suite.Require().ErrorIs(err, types.ErrLimitMaxAmount)
+
+				tokenInAmount, err := keeper.JoinSwapShareAmountOut(suite.Ctx, sender, poolId, "foo", sharesOut, sdk.NewInt(1000000))
+				suite.Require().NoError(err)
+				suite.Require().Equal(fooBefore.Sub(tokenInAmount), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo").Amount)
+
+				tokenOut := sdk.NewCoin("foo", tokenInAmount.QuoRaw(2))
+				sharesIn, err := keeper.ExitSwapExactAmountOut(suite.Ctx, sender, poolId, tokenOut, sharesOut)
+				suite.Require().NoError(err)
+				suite.Require().True(sharesIn.LT(sharesOut))
+				suite.Require().Equal(fooBefore.Sub(tokenInAmount).Add(tokenOut.Amount), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, "foo").Amount)
+
+				pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				suite.Require().Equal(pool.GetTotalPoolLiquidity(suite.Ctx), suite.App.BankKeeper.GetAllBalances(suite.Ctx, pool.GetAddress()))
+			},
+		},
+	}
+
+	for name, test := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolId := suite.PrepareBasicStableswapPool()
+			test.fn(poolId, suite.TestAccs[0])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetStableswapScalingFactors() {
+	suite.SetupTest()
+	keeper := suite.App.GAMMKeeper
+	poolId := suite.PrepareBasicStableswapPool()
+	governor := suite.TestAccs[0]
+
+	// only the pool's future governor can change its scaling factors
+	err := keeper.SetStableswapScalingFactors(suite.Ctx, suite.TestAccs[1], poolId, []uint64{2, 1}, time.Hour)
+	suite.Require().Error(err)
+
+	// balancer pools have no scaling factors
+	balancerPoolId := suite.PrepareBalancerPool()
+	err = keeper.SetStableswapScalingFactors(suite.Ctx, governor, balancerPoolId, []uint64{2, 1}, time.Hour)
+	suite.Require().Error(err)
+
+	// the scaling factors can't be changed immediately
+	err = keeper.SetStableswapScalingFactors(suite.Ctx, governor, poolId, []uint64{2, 1}, 0)
+	suite.Require().Error(err)
+	pool, err := keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 1}, pool.(*stableswap.Pool).GetScalingFactors())
+	suite.Require().Nil(pool.(*stableswap.Pool).ScalingFactorChange)
+
+	// a ramped change is stored with the pool, and applied as the pool gets poked
+	err = keeper.SetStableswapScalingFactors(suite.Ctx, governor, poolId, []uint64{3, 1}, 2*time.Hour)
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 1}, pool.(*stableswap.Pool).GetScalingFactors())
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Hour))
+	pool, err = keeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3, 1}, pool.(*stableswap.Pool).GetScalingFactors())
+	suite.Require().Nil(pool.(*stableswap.Pool).ScalingFactorChange)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// routeCandidate is a route found by BestSwapExactAmountInRoute, along with its simulated output.
+type routeCandidate struct {
+	routes   []types.SwapAmountInRoute
+	pools    []types.PoolI
+	tokenOut sdk.Coin
+	// spotAmountOut is the amount out at the spot prices of the route's pools, after swap fees.
+	spotAmountOut sdk.Dec
+}
+
+// BestSwapExactAmountInRoute returns the route through at most maxHops active pools that gives
+// the largest amount of tokenOutDenom for tokenIn, along with that amount and the route's price impact.
+// Every route that does not visit a denom or a pool twice is simulated with CalcOutAmtGivenIn,
+// charging the swap fees MultihopSwapExactAmountIn charges on it,
+// and ties are broken by fewer hops, then by lower pool ids.
+// The price impact is the fraction by which the amount out is less than the amount out
+// at the spot prices of the route's pools, after swap fees.
+// This does not mutate state.
+func (k Keeper) BestSwapExactAmountInRoute(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops int,
+) (routes []types.SwapAmountInRoute, tokenOutAmount sdk.Int, priceImpact sdk.Dec, err error) {
+	if maxHops < 1 || maxHops > types.MaxBestRouteHops {
+		return nil, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidMaxHops, "max hops must be between 1 and %d, got %d", types.MaxBestRouteHops, maxHops)
+	}
+	if !tokenIn.IsPositive() {
+		return nil, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token in must be positive, got %s", tokenIn)
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, sdk.Int{}, sdk.Dec{}, fmt.Errorf("token in and token out denoms must differ, both are %s", tokenOutDenom)
+	}
+
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return nil, sdk.Int{}, sdk.Dec{}, err
+	}
+	poolsByDenom := map[string][]types.PoolI{}
+	for _, pool := range pools {
+		if !pool.IsActive(ctx) {
+			continue
+		}
+		for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+			poolsByDenom[asset.Denom] = append(poolsByDenom[asset.Denom], pool)
+		}
+	}
+
+	var best *routeCandidate
+	visitedDenoms := map[string]bool{tokenIn.Denom: true}
+	visitedPools := map[uint64]bool{}
+
+	var search func(candidate routeCandidate)
+	search = func(candidate routeCandidate) {
+		if candidate.tokenOut.Denom == tokenOutDenom {
+			// the route's own pool swap fees are discounted on OSMO routed multihops.
+			if k.isOsmoRoutedMultihop(ctx, types.SwapAmountInRoutes(candidate.routes).IntermediateDenoms()) {
+				var err error
+				candidate.tokenOut, candidate.spotAmountOut, err = k.simulateRoute(ctx, tokenIn, candidate.routes, candidate.pools)
+				if err != nil || !candidate.tokenOut.IsPositive() {
+					return
+				}
+			}
+			if best == nil || candidate.tokenOut.Amount.GT(best.tokenOut.Amount) ||
+				(candidate.tokenOut.Amount.Equal(best.tokenOut.Amount) && len(candidate.routes) < len(best.routes)) {
+				best = &candidate
+			}
+			return
+		}
+		if len(candidate.routes) == maxHops {
+			return
+		}
+
+		for _, pool := range poolsByDenom[candidate.tokenOut.Denom] {
+			if visitedPools[pool.GetId()] {
+				continue
+			}
+			for _, asset := range pool.GetTotalPoolLiquidity(ctx) {
+				if visitedDenoms[asset.Denom] {
+					continue
+				}
+				// the last hop must swap to tokenOutDenom
+				if len(candidate.routes) == maxHops-1 && asset.Denom != tokenOutDenom {
+					continue
+				}
+
+				swapFee := pool.GetSwapFee(ctx)
+				tokenOut, err := k.calcOutAmtGivenIn(ctx, pool, sdk.NewCoins(candidate.tokenOut), asset.Denom, swapFee)
+				if err != nil || !tokenOut.IsPositive() {
+					continue
+				}
+				spotPrice, err := pool.SpotPrice(ctx, asset.Denom, candidate.tokenOut.Denom)
+				if err != nil {
+					continue
+				}
+
+				// copy the routes, so that routes of other candidates are not overwritten
+				nextRoutes := make([]types.SwapAmountInRoute, len(candidate.routes), len(candidate.routes)+1)
+				copy(nextRoutes, candidate.routes)
+				nextPools := make([]types.PoolI, len(candidate.pools), len(candidate.pools)+1)
+				copy(nextPools, candidate.pools)
+
+				visitedDenoms[asset.Denom] = true
+				visitedPools[pool.GetId()] = true
+				search(routeCandidate{
+					routes:        append(nextRoutes, types.SwapAmountInRoute{PoolId: pool.GetId(), TokenOutDenom: asset.Denom}),
+					pools:         append(nextPools, pool),
+					tokenOut:      tokenOut,
+					spotAmountOut: candidate.spotAmountOut.Mul(spotPrice).Mul(sdk.OneDec().Sub(swapFee)),
+				})
+				visitedDenoms[asset.Denom] = false
+				visitedPools[pool.GetId()] = false
+			}
+		}
+	}
+	search(routeCandidate{tokenOut: tokenIn, spotAmountOut: tokenIn.Amount.ToDec()})
+
+	if best == nil {
+		return nil, sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoRouteFound, "from %s to %s within %d hops", tokenIn.Denom, tokenOutDenom, maxHops)
+	}
+	priceImpact = sdk.OneDec().Sub(best.tokenOut.Amount.ToDec().Quo(best.spotAmountOut))
+	return best.routes, best.tokenOut.Amount, priceImpact, nil
+}
+
+// simulateRoute returns the amount out of swapping tokenIn through routes, whose pools are given, charging the
+// swap fees MultihopSwapExactAmountIn charges on it, along with the amount out at the spot prices of the
+// route's pools, after those swap fees. This does not mutate state, so pools must not repeat.
+func (k Keeper) simulateRoute(ctx sdk.Context, tokenIn sdk.Coin, routes []types.SwapAmountInRoute, pools []types.PoolI) (tokenOut sdk.Coin, spotAmountOut sdk.Dec, err error) {
+	swapFees := k.getMultihopSwapFeesOfPools(ctx, pools, types.SwapAmountInRoutes(routes).IntermediateDenoms())
+
+	tokenOut = tokenIn
+	spotAmountOut = tokenIn.Amount.ToDec()
+	for i, route := range routes {
+		spotPrice, err := pools[i].SpotPrice(ctx, route.TokenOutDenom, tokenOut.Denom)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, err
+		}
+		spotAmountOut = spotAmountOut.Mul(spotPrice).Mul(sdk.OneDec().Sub(swapFees[i]))
+
+		tokenOut, err = k.calcOutAmtGivenIn(ctx, pools[i], sdk.NewCoins(tokenOut), route.TokenOutDenom, swapFees[i])
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, err
+		}
+	}
+	return tokenOut, spotAmountOut, nil
+}
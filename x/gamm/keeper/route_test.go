@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// prepareRoutePools creates a large foo/bar and bar/baz pool, and a small foo/baz pool,
+// so that swapping much foo to baz is best routed through bar.
+func (suite *KeeperTestSuite) prepareRoutePools() (fooBarPoolId, barBazPoolId, fooBazPoolId uint64) {
+	fooBarPoolId = suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+	barBazPoolId = suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("baz", 1_000_000))
+	fooBazPoolId = suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 10_000), sdk.NewInt64Coin("baz", 10_000))
+	return fooBarPoolId, barBazPoolId, fooBazPoolId
+}
+
+func (suite *KeeperTestSuite) TestBestSwapExactAmountInRoute() {
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       int
+
+		expectedPoolIds func(fooBar, barBaz, fooBaz uint64) []uint64
+		expectErr       bool
+	}{
+		"large amount is routed through the deeper pools": {
+			tokenIn:         sdk.NewInt64Coin("foo", 10_000),
+			tokenOutDenom:   "baz",
+			maxHops:         3,
+			expectedPoolIds: func(fooBar, barBaz, fooBaz uint64) []uint64 { return []uint64{fooBar, barBaz} },
+		},
+		"single hop uses the direct pool": {
+			tokenIn:         sdk.NewInt64Coin("foo", 10_000),
+			tokenOutDenom:   "baz",
+			maxHops:         1,
+			expectedPoolIds: func(fooBar, barBaz, fooBaz uint64) []uint64 { return []uint64{fooBaz} },
+		},
+		"small amount uses the direct pool": {
+			tokenIn:         sdk.NewInt64Coin("foo", 10),
+			tokenOutDenom:   "baz",
+			maxHops:         3,
+			expectedPoolIds: func(fooBar, barBaz, fooBaz uint64) []uint64 { return []uint64{fooBaz} },
+		},
+		"no pool with the out denom": {
+			tokenIn:       sdk.NewInt64Coin("foo", 10_000),
+			tokenOutDenom: "uosmo",
+			maxHops:       3,
+			expectErr:     true,
+		},
+		"same denom": {
+			tokenIn:       sdk.NewInt64Coin("foo", 10_000),
+			tokenOutDenom: "foo",
+			maxHops:       3,
+			expectErr:     true,
+		},
+		"too many hops": {
+			tokenIn:       sdk.NewInt64Coin("foo", 10_000),
+			tokenOutDenom: "baz",
+			maxHops:       types.MaxBestRouteHops + 1,
+			expectErr:     true,
+		},
+		"zero hops": {
+			tokenIn:       sdk.NewInt64Coin("foo", 10_000),
+			tokenOutDenom: "baz",
+			maxHops:       0,
+			expectErr:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			fooBar, barBaz, fooBaz := suite.prepareRoutePools()
+
+			routes, tokenOutAmount, priceImpact, err := suite.App.GAMMKeeper.BestSwapExactAmountInRoute(suite.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			poolIds := []uint64{}
+			for _, route := range routes {
+				poolIds = append(poolIds, route.PoolId)
+			}
+			suite.Require().Equal(tc.expectedPoolIds(fooBar, barBaz, fooBaz), poolIds)
+			suite.Require().Equal(tc.tokenOutDenom, routes[len(routes)-1].TokenOutDenom)
+			suite.Require().True(priceImpact.IsPositive())
+			suite.Require().True(priceImpact.LT(sdk.OneDec()))
+
+			// the estimate matches the amount out of swapping through the route
+			suite.FundAcc(suite.TestAccs[1], sdk.NewCoins(tc.tokenIn))
+			swapOutAmount, err := suite.App.GAMMKeeper.MultihopSwapExactAmountIn(suite.Ctx, suite.TestAccs[1], routes, tc.tokenIn, sdk.OneInt())
+			suite.Require().NoError(err)
+			suite.Require().Equal(swapOutAmount, tokenOutAmount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryBestRoute() {
+	suite.SetupTest()
+	fooBar, barBaz, _ := suite.prepareRoutePools()
+
+	res, err := suite.queryClient.BestRoute(sdk.WrapSDKContext(suite.Ctx), &types.QueryBestRouteRequest{
+		TokenIn:       "10000foo",
+		TokenOutDenom: "baz",
+		MaxHops:       3,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SwapAmountInRoute{
+		{PoolId: fooBar, TokenOutDenom: "bar"},
+		{PoolId: barBaz, TokenOutDenom: "baz"},
+	}, res.Routes)
+
+	// the price impact of swapping 1% of each pool's liquidity is about 2%
+	suite.Require().True(res.PriceImpact.GT(sdk.NewDecWithPrec(1, 2)))
+	suite.Require().True(res.PriceImpact.LT(sdk.NewDecWithPrec(3, 2)))
+
+	_, err = suite.queryClient.BestRoute(sdk.WrapSDKContext(suite.Ctx), &types.QueryBestRouteRequest{
+		TokenIn:       "10000foo",
+		TokenOutDenom: "uosmo",
+		MaxHops:       3,
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.BestRoute(sdk.WrapSDKContext(suite.Ctx), &types.QueryBestRouteRequest{
+		TokenIn:       "10000foo",
+		TokenOutDenom: "baz",
+	})
+	suite.Require().Error(err)
+}
@@ -306,7 +306,11 @@ func (p *Pool) CalcJoinPoolShares(_ctx sdk.Context, tokensIn sdk.Coins, swapFee
 	totalShares := p.GetTotalShares()
 
 	if tokensIn.Len() == 1 {
-		numShares, err = p.calcSingleAssetJoin(tokensIn[0], swapFee, poolAssetsByDenom[tokensIn[0].Denom], totalShares)
+		_, tokenInPoolAsset, err := p.getPoolAssetAndIndex(tokensIn[0].Denom)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.NewCoins(), err
+		}
+		numShares, err = p.calcSingleAssetJoin(tokensIn[0], swapFee, tokenInPoolAsset, totalShares)
 		newLiquidity = tokensIn
 		return numShares, newLiquidity, err
 	} else if tokensIn.Len() != p.NumAssets() {
@@ -324,3 +324,20 @@ func TestCalcSingleAssetInAndOut_InverseRelationship(t *testing.T) {
 		}
 	}
 }
+
+// TestCalcJoinPoolShares_SingleAssetNotInPool tests that single asset joins of a denom the pool doesn't contain fail,
+// rather than being priced against an empty pool asset.
+func TestCalcJoinPoolShares_SingleAssetNotInPool(t *testing.T) {
+	pool := createTestPool(t, []balancer.PoolAsset{
+		{Token: sdk.NewInt64Coin("foo", 1_000_000), Weight: sdk.NewInt(100)},
+		{Token: sdk.NewInt64Coin("bar", 1_000_000), Weight: sdk.NewInt(100)},
+	}, sdk.ZeroDec(), sdk.ZeroDec())
+	ctx := createTestContext(t)
+
+	shares, _, err := pool.CalcJoinPoolShares(ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 1000)), sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, shares.IsPositive())
+
+	_, _, err = pool.CalcJoinPoolShares(ctx, sdk.NewCoins(sdk.NewInt64Coin("baz", 1000)), sdk.ZeroDec())
+	require.Error(t, err)
+}
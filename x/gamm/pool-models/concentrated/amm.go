@@ -0,0 +1,288 @@
+package concentrated
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// Within a price range, a position with liquidity L behaves like a constant product pool
+// with reserves x * y = L^2, where the price of token0 is P = y / x.
+// Hence with sqrt prices, moving the price from sqrt(Pa) to sqrt(Pb) > sqrt(Pa) changes
+// the reserves of token0 by L * (sqrt(Pb) - sqrt(Pa)) / (sqrt(Pa) * sqrt(Pb)),
+// and those of token1 by L * (sqrt(Pb) - sqrt(Pa)).
+
+// amount0Delta returns the amount of token0 that liquidity holds between the sqrt prices a < b.
+func amount0Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return liquidity.Mul(sqrtPriceB.Sub(sqrtPriceA)).Quo(sqrtPriceA).Quo(sqrtPriceB)
+}
+
+// amount1Delta returns the amount of token1 that liquidity holds between the sqrt prices a < b.
+func amount1Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return liquidity.Mul(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// liquidity0 returns the liquidity that amount0 of token0 provides between the sqrt prices a < b.
+func liquidity0(amount0 sdk.Int, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return amount0.ToDec().Mul(sqrtPriceA).Mul(sqrtPriceB).Quo(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// liquidity1 returns the liquidity that amount1 of token1 provides between the sqrt prices a < b.
+func liquidity1(amount1 sdk.Int, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	return amount1.ToDec().Quo(sqrtPriceB.Sub(sqrtPriceA))
+}
+
+// amountsForLiquidity returns the amounts of token0 and token1 that liquidity holds
+// over the sqrt price range [sqrtPriceLower, sqrtPriceUpper), at the current sqrtPrice.
+func amountsForLiquidity(sqrtPrice, sqrtPriceLower, sqrtPriceUpper, liquidity sdk.Dec) (amount0, amount1 sdk.Dec) {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		return amount0Delta(liquidity, sqrtPriceLower, sqrtPriceUpper), sdk.ZeroDec()
+	case sqrtPrice.LT(sqrtPriceUpper):
+		return amount0Delta(liquidity, sqrtPrice, sqrtPriceUpper), amount1Delta(liquidity, sqrtPriceLower, sqrtPrice)
+	default:
+		return sdk.ZeroDec(), amount1Delta(liquidity, sqrtPriceLower, sqrtPriceUpper)
+	}
+}
+
+// liquidityForAmounts returns the maximal liquidity over the sqrt price range
+// [sqrtPriceLower, sqrtPriceUpper) that at most amount0 of token0 and amount1 of token1
+// can provide, at the current sqrtPrice.
+func liquidityForAmounts(sqrtPrice, sqrtPriceLower, sqrtPriceUpper sdk.Dec, amount0, amount1 sdk.Int) sdk.Dec {
+	switch {
+	case sqrtPrice.LTE(sqrtPriceLower):
+		return liquidity0(amount0, sqrtPriceLower, sqrtPriceUpper)
+	case sqrtPrice.LT(sqrtPriceUpper):
+		return sdk.MinDec(
+			liquidity0(amount0, sqrtPrice, sqrtPriceUpper),
+			liquidity1(amount1, sqrtPriceLower, sqrtPrice),
+		)
+	default:
+		return liquidity1(amount1, sqrtPriceLower, sqrtPriceUpper)
+	}
+}
+
+// swapStep is the result of swapping within a single price range of constant liquidity.
+type swapStep struct {
+	sqrtPriceNext sdk.Dec
+	// amount in, excluding the swap fee
+	amountIn  sdk.Dec
+	feeAmount sdk.Dec
+	amountOut sdk.Dec
+}
+
+// computeSwapStep swaps against liquidity, from sqrtPrice towards sqrtPriceTarget,
+// until either the target is reached, or the remaining amount is used up.
+// If exactIn, remaining is the amount in left to swap, including swap fees.
+// Otherwise, it is the amount out left to get.
+// zeroForOne is whether token0 is swapped in, i.e. whether the price moves down.
+func computeSwapStep(sqrtPrice, sqrtPriceTarget, liquidity, remaining, swapFee sdk.Dec, zeroForOne, exactIn bool) swapStep {
+	// without liquidity, the price moves to the target for free
+	if !liquidity.IsPositive() {
+		return swapStep{sqrtPriceNext: sqrtPriceTarget, amountIn: sdk.ZeroDec(), feeAmount: sdk.ZeroDec(), amountOut: sdk.ZeroDec()}
+	}
+
+	step := swapStep{}
+	oneMinusFee := sdk.OneDec().Sub(swapFee)
+	if exactIn {
+		amountInMax := amountInBetween(liquidity, sqrtPrice, sqrtPriceTarget, zeroForOne)
+		amountInLessFee := remaining.Mul(oneMinusFee)
+		if amountInLessFee.GTE(amountInMax) {
+			step.sqrtPriceNext = sqrtPriceTarget
+			step.amountIn = amountInMax
+			step.feeAmount = sdk.MinDec(amountInMax.Quo(oneMinusFee), remaining).Sub(amountInMax)
+		} else {
+			step.sqrtPriceNext = sqrtPriceFromAmountIn(liquidity, sqrtPrice, sqrtPriceTarget, amountInLessFee, zeroForOne)
+			step.amountIn = amountInLessFee
+			step.feeAmount = remaining.Sub(amountInLessFee)
+		}
+		step.amountOut = amountOutBetween(liquidity, sqrtPrice, step.sqrtPriceNext, zeroForOne)
+		return step
+	}
+
+	amountOutMax := amountOutBetween(liquidity, sqrtPrice, sqrtPriceTarget, zeroForOne)
+	if remaining.GTE(amountOutMax) {
+		step.sqrtPriceNext = sqrtPriceTarget
+		step.amountOut = amountOutMax
+	} else {
+		step.sqrtPriceNext = sqrtPriceFromAmountOut(liquidity, sqrtPrice, sqrtPriceTarget, remaining, zeroForOne)
+		step.amountOut = remaining
+	}
+	step.amountIn = amountInBetween(liquidity, sqrtPrice, step.sqrtPriceNext, zeroForOne)
+	step.feeAmount = step.amountIn.Quo(oneMinusFee).Sub(step.amountIn)
+	return step
+}
+
+// amountInBetween returns the amount in, excluding fees, needed to move the price from sqrtPrice to sqrtPriceNext.
+func amountInBetween(liquidity, sqrtPrice, sqrtPriceNext sdk.Dec, zeroForOne bool) sdk.Dec {
+	if zeroForOne {
+		return amount0Delta(liquidity, sqrtPriceNext, sqrtPrice)
+	}
+	return amount1Delta(liquidity, sqrtPrice, sqrtPriceNext)
+}
+
+// amountOutBetween returns the amount out of moving the price from sqrtPrice to sqrtPriceNext.
+func amountOutBetween(liquidity, sqrtPrice, sqrtPriceNext sdk.Dec, zeroForOne bool) sdk.Dec {
+	if zeroForOne {
+		return amount1Delta(liquidity, sqrtPriceNext, sqrtPrice)
+	}
+	return amount0Delta(liquidity, sqrtPrice, sqrtPriceNext)
+}
+
+// sqrtPriceFromAmountIn returns the sqrt price after swapping amountIn, excluding fees, against liquidity.
+// The result is rounded against the swapper, and kept between sqrtPrice and sqrtPriceTarget.
+func sqrtPriceFromAmountIn(liquidity, sqrtPrice, sqrtPriceTarget, amountIn sdk.Dec, zeroForOne bool) sdk.Dec {
+	if zeroForOne {
+		// sqrt(P') = L * sqrt(P) / (L + amountIn * sqrt(P))
+		next := liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Add(amountIn.Mul(sqrtPrice)))
+		return sdk.MinDec(sdk.MaxDec(next, sqrtPriceTarget), sqrtPrice)
+	}
+	// sqrt(P') = sqrt(P) + amountIn / L
+	next := sqrtPrice.Add(amountIn.QuoTruncate(liquidity))
+	return sdk.MaxDec(sdk.MinDec(next, sqrtPriceTarget), sqrtPrice)
+}
+
+// sqrtPriceFromAmountOut returns the sqrt price after swapping for amountOut against liquidity.
+// The result is rounded against the swapper, and kept between sqrtPrice and sqrtPriceTarget.
+func sqrtPriceFromAmountOut(liquidity, sqrtPrice, sqrtPriceTarget, amountOut sdk.Dec, zeroForOne bool) sdk.Dec {
+	if zeroForOne {
+		// sqrt(P') = sqrt(P) - amountOut / L
+		next := sqrtPrice.Sub(amountOut.QuoRoundUp(liquidity))
+		return sdk.MinDec(sdk.MaxDec(next, sqrtPriceTarget), sqrtPrice)
+	}
+	// sqrt(P') = L * sqrt(P) / (L - amountOut * sqrt(P))
+	denominator := liquidity.Sub(amountOut.Mul(sqrtPrice))
+	if !denominator.IsPositive() {
+		return sqrtPriceTarget
+	}
+	next := liquidity.Mul(sqrtPrice).QuoRoundUp(denominator)
+	return sdk.MaxDec(sdk.MinDec(next, sqrtPriceTarget), sqrtPrice)
+}
+
+// tickCrossing records that a swap crossed a tick, and the fee growth of the
+// token swapped in at that time.
+type tickCrossing struct {
+	tick              int64
+	feeGrowthGlobalIn sdk.Dec
+}
+
+// swapResult is the outcome of a swap against the pool.
+type swapResult struct {
+	zeroForOne bool
+	// amount in, including swap fees
+	amountIn          sdk.Dec
+	amountOut         sdk.Dec
+	sqrtPrice         sdk.Dec
+	tick              int64
+	liquidity         sdk.Dec
+	feeGrowthGlobalIn sdk.Dec
+	crossings         []tickCrossing
+}
+
+// computeSwap swaps tokenInDenom for tokenOutDenom against the pool, crossing ticks as needed,
+// and returns the resulting state, without mutating the pool.
+// If exactIn, amount is the amount in to swap, including swap fees.
+// Otherwise, it is the amount out to get.
+func (p Pool) computeSwap(store sdk.KVStore, tokenInDenom, tokenOutDenom string, amount, swapFee sdk.Dec, exactIn bool) (swapResult, error) {
+	if err := p.validateSwapDenoms(tokenInDenom, tokenOutDenom); err != nil {
+		return swapResult{}, err
+	}
+	if !amount.IsPositive() {
+		return swapResult{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "swap amount must be positive")
+	}
+
+	zeroForOne := tokenInDenom == p.Token0
+	result := swapResult{
+		zeroForOne:        zeroForOne,
+		amountIn:          sdk.ZeroDec(),
+		amountOut:         sdk.ZeroDec(),
+		sqrtPrice:         p.CurrentSqrtPrice,
+		tick:              p.CurrentTick,
+		liquidity:         p.Liquidity,
+		feeGrowthGlobalIn: p.FeeGrowthGlobal1,
+	}
+	if zeroForOne {
+		result.feeGrowthGlobalIn = p.FeeGrowthGlobal0
+	}
+
+	remaining := amount
+	for remaining.IsPositive() {
+		nextTick, ok := p.nextInitializedTick(store, result.tick, zeroForOne)
+		if !ok {
+			return swapResult{}, sdkerrors.Wrapf(types.ErrNotEnoughLiquidity, "cannot swap %s %s for %s", amount, tokenInDenom, tokenOutDenom)
+		}
+
+		sqrtPriceTarget := TickToSqrtPrice(nextTick)
+		step := computeSwapStep(result.sqrtPrice, sqrtPriceTarget, result.liquidity, remaining, swapFee, zeroForOne, exactIn)
+		if exactIn {
+			remaining = remaining.Sub(step.amountIn).Sub(step.feeAmount)
+		} else {
+			remaining = remaining.Sub(step.amountOut)
+		}
+		result.amountIn = result.amountIn.Add(step.amountIn).Add(step.feeAmount)
+		result.amountOut = result.amountOut.Add(step.amountOut)
+		if result.liquidity.IsPositive() {
+			result.feeGrowthGlobalIn = result.feeGrowthGlobalIn.Add(step.feeAmount.Quo(result.liquidity))
+		}
+		result.sqrtPrice = step.sqrtPriceNext
+
+		if !step.sqrtPriceNext.Equal(sqrtPriceTarget) {
+			result.tick = SqrtPriceToTick(step.sqrtPriceNext)
+			continue
+		}
+
+		// cross the tick, which changes the liquidity in range
+		result.crossings = append(result.crossings, tickCrossing{tick: nextTick, feeGrowthGlobalIn: result.feeGrowthGlobalIn})
+		liquidityNet := p.mustGetTick(store, nextTick).LiquidityNet
+		if zeroForOne {
+			result.liquidity = result.liquidity.Sub(liquidityNet)
+			result.tick = nextTick - 1
+		} else {
+			result.liquidity = result.liquidity.Add(liquidityNet)
+			result.tick = nextTick
+		}
+	}
+
+	return result, nil
+}
+
+// applySwap sets the pool's state to the outcome of a swap, computed by computeSwap on this pool.
+func (p *Pool) applySwap(store sdk.KVStore, result swapResult) {
+	for _, crossing := range result.crossings {
+		tick := p.mustGetTick(store, crossing.tick)
+		if result.zeroForOne {
+			tick.FeeGrowthOutside0 = crossing.feeGrowthGlobalIn.Sub(tick.FeeGrowthOutside0)
+			tick.FeeGrowthOutside1 = p.FeeGrowthGlobal1.Sub(tick.FeeGrowthOutside1)
+		} else {
+			tick.FeeGrowthOutside0 = p.FeeGrowthGlobal0.Sub(tick.FeeGrowthOutside0)
+			tick.FeeGrowthOutside1 = crossing.feeGrowthGlobalIn.Sub(tick.FeeGrowthOutside1)
+		}
+		p.setTick(store, tick)
+	}
+
+	p.CurrentSqrtPrice = result.sqrtPrice
+	p.CurrentTick = result.tick
+	p.Liquidity = result.liquidity
+	if result.zeroForOne {
+		p.FeeGrowthGlobal0 = result.feeGrowthGlobalIn
+	} else {
+		p.FeeGrowthGlobal1 = result.feeGrowthGlobalIn
+	}
+}
+
+func (p Pool) validateSwapDenoms(tokenInDenom, tokenOutDenom string) error {
+	isPoolDenom := func(denom string) bool { return denom == p.Token0 || denom == p.Token1 }
+	if tokenInDenom == tokenOutDenom {
+		return fmt.Errorf("cannot swap %s for itself", tokenInDenom)
+	}
+	if !isPoolDenom(tokenInDenom) {
+		return fmt.Errorf("denom %s does not exist in pool", tokenInDenom)
+	}
+	if !isPoolDenom(tokenOutDenom) {
+		return fmt.Errorf("denom %s does not exist in pool", tokenOutDenom)
+	}
+	return nil
+}
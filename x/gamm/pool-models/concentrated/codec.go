@@ -0,0 +1,58 @@
+package concentrated
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+
+	types "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/gamm interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/ConcentratedPool", nil)
+	cdc.RegisterConcrete(&MsgCreateConcentratedPool{}, "osmosis/gamm/create-concentrated-pool", nil)
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "osmosis/gamm/create-position", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "osmosis/gamm/withdraw-position", nil)
+	cdc.RegisterConcrete(&MsgCollectFees{}, "osmosis/gamm/collect-fees", nil)
+	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/ConcentratedPoolParams", nil)
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"osmosis.gamm.v1beta1.PoolI",
+		(*types.PoolI)(nil),
+		&Pool{},
+	)
+	registry.RegisterImplementations(
+		(*types.ConcentratedPoolRecordsI)(nil),
+		&PoolRecords{},
+	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateConcentratedPool{},
+		&MsgCreatePosition{},
+		&MsgWithdrawPosition{},
+		&MsgCollectFees{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/bank module codec. Note, the codec should
+	// ONLY be used in certain instances of tests and for JSON encoding as Amino is
+	// still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/staking and
+	// defined at the application level.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
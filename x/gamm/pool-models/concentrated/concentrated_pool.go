@@ -0,0 +1,265 @@
+package concentrated
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// NewConcentratedPool returns a concentrated liquidity pool of the two initial liquidity denoms,
+// priced at the ratio of the initial liquidity amounts. The initial liquidity is in the pool,
+// but is only provided by a position once CreateInitialPosition stores it.
+// Invariants that are assumed to be satisfied and not checked:
+// * len(initialLiquidity) == 2, and initialLiquidity is sorted
+// * poolID doesn't already exist
+func NewConcentratedPool(poolId uint64, poolParams PoolParams, initialLiquidity sdk.Coins, tickSpacing uint64) (Pool, error) {
+	if err := validateTickSpacing(tickSpacing); err != nil {
+		return Pool{}, err
+	}
+
+	amount0, amount1 := initialLiquidity[0].Amount, initialLiquidity[1].Amount
+	sqrtPrice, err := amount1.ToDec().Quo(amount0.ToDec()).ApproxSqrt()
+	if err != nil {
+		return Pool{}, err
+	}
+	if err := validateSqrtPrice(sqrtPrice); err != nil {
+		return Pool{}, sdkerrors.Wrap(types.ErrInvalidPool, err.Error())
+	}
+
+	return Pool{
+		Address:          types.NewPoolAddress(poolId).String(),
+		Id:               poolId,
+		PoolParams:       poolParams,
+		Token0:           initialLiquidity[0].Denom,
+		Token1:           initialLiquidity[1].Denom,
+		TickSpacing:      tickSpacing,
+		CurrentSqrtPrice: sqrtPrice,
+		CurrentTick:      SqrtPriceToTick(sqrtPrice),
+		Liquidity:        sdk.ZeroDec(),
+		FeeGrowthGlobal0: sdk.ZeroDec(),
+		FeeGrowthGlobal1: sdk.ZeroDec(),
+		NextPositionId:   1,
+		PoolLiquidity:    initialLiquidity,
+	}, nil
+}
+
+// CreateInitialPosition provides the pool's initial liquidity over the full price range,
+// in a position owned by creator. Any initial liquidity that the position cannot use
+// due to rounding stays in the pool.
+func (p *Pool) CreateInitialPosition(store sdk.KVStore, creator sdk.AccAddress) error {
+	initialLiquidity := p.PoolLiquidity
+	_, _, _, err := p.CreatePosition(store, creator, minUsableTick(p.TickSpacing), maxUsableTick(p.TickSpacing), initialLiquidity)
+	if err != nil {
+		return err
+	}
+	p.PoolLiquidity = initialLiquidity
+	return nil
+}
+
+// getTick returns the state of tick, and whether it is initialized.
+func (p Pool) getTick(store sdk.KVStore, tick int64) (TickInfo, bool) {
+	bz := store.Get(types.GetKeyConcentratedTick(p.Id, tick))
+	if bz == nil {
+		return TickInfo{}, false
+	}
+	info := TickInfo{}
+	if err := proto.Unmarshal(bz, &info); err != nil {
+		panic(err)
+	}
+	return info, true
+}
+
+// mustGetTick returns the state of tick, and panics if it is not initialized.
+func (p Pool) mustGetTick(store sdk.KVStore, tick int64) TickInfo {
+	info, found := p.getTick(store, tick)
+	if !found {
+		panic(fmt.Sprintf("tick %d of pool %d is not initialized", tick, p.Id))
+	}
+	return info
+}
+
+func (p Pool) setTick(store sdk.KVStore, info TickInfo) {
+	bz, err := proto.Marshal(&info)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetKeyConcentratedTick(p.Id, info.Index), bz)
+}
+
+// nextInitializedTick returns the next initialized tick that a swap from the current tick would cross.
+// That is the largest tick <= tick when the price is moving down, and the smallest tick > tick otherwise.
+func (p Pool) nextInitializedTick(store sdk.KVStore, tick int64, down bool) (int64, bool) {
+	ticksPrefix := types.GetKeyPrefixConcentratedTicks(p.Id)
+	// the ticks > tick start from the key of tick + 1
+	var iterator sdk.Iterator
+	if down {
+		iterator = store.ReverseIterator(ticksPrefix, types.GetKeyConcentratedTick(p.Id, tick+1))
+	} else {
+		iterator = store.Iterator(types.GetKeyConcentratedTick(p.Id, tick+1), sdk.PrefixEndBytes(ticksPrefix))
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+	info := TickInfo{}
+	if err := proto.Unmarshal(iterator.Value(), &info); err != nil {
+		panic(err)
+	}
+	return info.Index, true
+}
+
+// getTicks returns the pool's initialized ticks, sorted by index.
+func (p Pool) getTicks(store sdk.KVStore) []TickInfo {
+	iterator := sdk.KVStorePrefixIterator(store, types.GetKeyPrefixConcentratedTicks(p.Id))
+	defer iterator.Close()
+
+	ticks := []TickInfo{}
+	for ; iterator.Valid(); iterator.Next() {
+		info := TickInfo{}
+		if err := proto.Unmarshal(iterator.Value(), &info); err != nil {
+			panic(err)
+		}
+		ticks = append(ticks, info)
+	}
+	return ticks
+}
+
+// updateTick adds liquidityDelta to the liquidity of the positions bounded by tick,
+// initializing it if needed. upper is whether tick is the upper bound of the positions.
+// The tick is uninitialized if no position is bounded by it anymore.
+func (p Pool) updateTick(store sdk.KVStore, tick int64, liquidityDelta sdk.Dec, upper bool) {
+	info, found := p.getTick(store, tick)
+	if !found {
+		// by convention, all fee growth before a tick is initialized happened below it
+		info = TickInfo{
+			Index:             tick,
+			LiquidityGross:    sdk.ZeroDec(),
+			LiquidityNet:      sdk.ZeroDec(),
+			FeeGrowthOutside0: sdk.ZeroDec(),
+			FeeGrowthOutside1: sdk.ZeroDec(),
+		}
+		if tick <= p.CurrentTick {
+			info.FeeGrowthOutside0 = p.FeeGrowthGlobal0
+			info.FeeGrowthOutside1 = p.FeeGrowthGlobal1
+		}
+	}
+
+	info.LiquidityGross = info.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		info.LiquidityNet = info.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		info.LiquidityNet = info.LiquidityNet.Add(liquidityDelta)
+	}
+
+	if !info.LiquidityGross.IsPositive() {
+		store.Delete(types.GetKeyConcentratedTick(p.Id, tick))
+		return
+	}
+	p.setTick(store, info)
+}
+
+// feeGrowthInside returns the fee growth per unit of liquidity of token0 and token1
+// within [lowerTick, upperTick). Both ticks must be initialized.
+// Only differences of these values over time are meaningful.
+func (p Pool) feeGrowthInside(store sdk.KVStore, lowerTick, upperTick int64) (sdk.Dec, sdk.Dec) {
+	lower := p.mustGetTick(store, lowerTick)
+	upper := p.mustGetTick(store, upperTick)
+
+	below0, below1 := lower.FeeGrowthOutside0, lower.FeeGrowthOutside1
+	if p.CurrentTick < lowerTick {
+		below0, below1 = p.FeeGrowthGlobal0.Sub(below0), p.FeeGrowthGlobal1.Sub(below1)
+	}
+	above0, above1 := upper.FeeGrowthOutside0, upper.FeeGrowthOutside1
+	if p.CurrentTick >= upperTick {
+		above0, above1 = p.FeeGrowthGlobal0.Sub(above0), p.FeeGrowthGlobal1.Sub(above1)
+	}
+
+	return p.FeeGrowthGlobal0.Sub(below0).Sub(above0), p.FeeGrowthGlobal1.Sub(below1).Sub(above1)
+}
+
+// getPosition returns the position with id, and whether it exists.
+func (p Pool) getPosition(store sdk.KVStore, id uint64) (Position, bool) {
+	bz := store.Get(types.GetKeyConcentratedPosition(p.Id, id))
+	if bz == nil {
+		return Position{}, false
+	}
+	position := Position{}
+	if err := proto.Unmarshal(bz, &position); err != nil {
+		panic(err)
+	}
+	return position, true
+}
+
+func (p Pool) setPosition(store sdk.KVStore, position Position) {
+	bz, err := proto.Marshal(&position)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetKeyConcentratedPosition(p.Id, position.Id), bz)
+}
+
+func (p Pool) deletePosition(store sdk.KVStore, id uint64) {
+	store.Delete(types.GetKeyConcentratedPosition(p.Id, id))
+}
+
+// getAllPositions returns the pool's positions, sorted by id.
+func (p Pool) getAllPositions(store sdk.KVStore) []Position {
+	iterator := sdk.KVStorePrefixIterator(store, types.GetKeyPrefixConcentratedPositions(p.Id))
+	defer iterator.Close()
+
+	positions := []Position{}
+	for ; iterator.Valid(); iterator.Next() {
+		position := Position{}
+		if err := proto.Unmarshal(iterator.Value(), &position); err != nil {
+			panic(err)
+		}
+		positions = append(positions, position)
+	}
+	return positions
+}
+
+// getOwnedPosition returns the position with id, and errors if it does not exist or is not owned by owner.
+func (p Pool) getOwnedPosition(store sdk.KVStore, owner sdk.AccAddress, id uint64) (Position, error) {
+	position, found := p.getPosition(store, id)
+	if !found {
+		return Position{}, sdkerrors.Wrapf(types.ErrInvalidPosition, "position %d does not exist in pool %d", id, p.Id)
+	}
+	if position.Owner != owner.String() {
+		return Position{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "position %d is not owned by %s", id, owner)
+	}
+	return position, nil
+}
+
+// accrueFees adds the fees earned by position since they were last accrued to its fees owed.
+func (p Pool) accrueFees(store sdk.KVStore, position *Position) {
+	if !position.Liquidity.IsPositive() {
+		return
+	}
+	inside0, inside1 := p.feeGrowthInside(store, position.LowerTick, position.UpperTick)
+	position.FeesOwed0 = position.FeesOwed0.Add(inside0.Sub(position.FeeGrowthInsideLast0).Mul(position.Liquidity))
+	position.FeesOwed1 = position.FeesOwed1.Add(inside1.Sub(position.FeeGrowthInsideLast1).Mul(position.Liquidity))
+	position.FeeGrowthInsideLast0, position.FeeGrowthInsideLast1 = inside0, inside1
+}
+
+// modifyPosition accrues the fees of position, and adds liquidityDelta to its liquidity.
+// The caller stores the position.
+func (p *Pool) modifyPosition(store sdk.KVStore, position *Position, liquidityDelta sdk.Dec) {
+	p.accrueFees(store, position)
+
+	p.updateTick(store, position.LowerTick, liquidityDelta, false)
+	p.updateTick(store, position.UpperTick, liquidityDelta, true)
+	if !position.Liquidity.IsPositive() {
+		// a position without liquidity starts earning fees from now on
+		position.FeeGrowthInsideLast0, position.FeeGrowthInsideLast1 = p.feeGrowthInside(store, position.LowerTick, position.UpperTick)
+	}
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+	if position.LowerTick <= p.CurrentTick && p.CurrentTick < position.UpperTick {
+		p.Liquidity = p.Liquidity.Add(liquidityDelta)
+	}
+}
@@ -0,0 +1,2184 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/concentrated/concentrated_pool.proto
+
+package concentrated
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PoolParams defined the parameters that will be managed by the pool
+// governance in the future. This params are not managed by the chain
+// governance.
+type PoolParams struct {
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swapFee" yaml:"swap_fee"`
+}
+
+func (m *PoolParams) Reset()         { *m = PoolParams{} }
+func (m *PoolParams) String() string { return proto.CompactTextString(m) }
+func (*PoolParams) ProtoMessage()    {}
+func (*PoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{0}
+}
+func (m *PoolParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolParams.Merge(m, src)
+}
+func (m *PoolParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolParams proto.InternalMessageInfo
+
+// TickInfo is the state of an initialized tick, i.e. a tick that bounds the
+// price range of at least one position.
+type TickInfo struct {
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty" yaml:"index"`
+	// total liquidity of the positions that have this tick as a bound
+	LiquidityGross github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	// liquidity added to the active liquidity when the price crosses this tick
+	// upwards, and removed from it when it crosses this tick downwards
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// fee growth per unit of liquidity of token0 and token1, on the other side
+	// of this tick from the current tick. Only differences of these values are
+	// meaningful.
+	FeeGrowthOutside0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fee_growth_outside0,json=feeGrowthOutside0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside0" yaml:"fee_growth_outside0"`
+	FeeGrowthOutside1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fee_growth_outside1,json=feeGrowthOutside1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_outside1" yaml:"fee_growth_outside1"`
+}
+
+func (m *TickInfo) Reset()         { *m = TickInfo{} }
+func (m *TickInfo) String() string { return proto.CompactTextString(m) }
+func (*TickInfo) ProtoMessage()    {}
+func (*TickInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{1}
+}
+func (m *TickInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickInfo.Merge(m, src)
+}
+func (m *TickInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickInfo proto.InternalMessageInfo
+
+func (m *TickInfo) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// Position is liquidity provided by owner over the price range
+// [lower_tick, upper_tick).
+type Position struct {
+	Id        uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	Owner     string                                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	LowerTick int64                                  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64                                  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// fee growth per unit of liquidity inside the position's range, as of the
+	// last time the position's fees were accrued
+	FeeGrowthInsideLast0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee_growth_inside_last0,json=feeGrowthInsideLast0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside_last0" yaml:"fee_growth_inside_last0"`
+	FeeGrowthInsideLast1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=fee_growth_inside_last1,json=feeGrowthInsideLast1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_inside_last1" yaml:"fee_growth_inside_last1"`
+	// accrued fees of token0 and token1 that have not been collected yet
+	FeesOwed0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=fees_owed0,json=feesOwed0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fees_owed0" yaml:"fees_owed0"`
+	FeesOwed1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=fees_owed1,json=feesOwed1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fees_owed1" yaml:"fees_owed1"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{2}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Position) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Position) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+// Pool is the concentrated liquidity Pool struct. Its liquidity is provided
+// by positions over price ranges, instead of by LP shares.
+type Pool struct {
+	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Id         uint64     `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	PoolParams PoolParams `protobuf:"bytes,3,opt,name=poolParams,proto3" json:"poolParams" yaml:"concentrated_pool_params"`
+	// the pool's two denoms, with token0 < token1
+	Token0 string `protobuf:"bytes,4,opt,name=token0,proto3" json:"token0,omitempty" yaml:"token0"`
+	Token1 string `protobuf:"bytes,5,opt,name=token1,proto3" json:"token1,omitempty" yaml:"token1"`
+	// positions' ticks must be multiples of the tick spacing
+	TickSpacing uint64 `protobuf:"varint,6,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	// square root of the price of token0, in units of token1
+	CurrentSqrtPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
+	// largest tick whose price is less than or equal to the current price
+	CurrentTick int64 `protobuf:"varint,8,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	// total liquidity of the positions in range at the current price
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// total fee growth per unit of liquidity of token0 and token1
+	FeeGrowthGlobal0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=fee_growth_global0,json=feeGrowthGlobal0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global0" yaml:"fee_growth_global0"`
+	FeeGrowthGlobal1 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=fee_growth_global1,json=feeGrowthGlobal1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_growth_global1" yaml:"fee_growth_global1"`
+	NextPositionId   uint64                                 `protobuf:"varint,14,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
+	// all tokens held by the pool, including uncollected fees
+	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,15,rep,name=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"poolLiquidity"`
+}
+
+func (m *Pool) Reset()      { *m = Pool{} }
+func (*Pool) ProtoMessage() {}
+func (*Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{3}
+}
+func (m *Pool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pool.Merge(m, src)
+}
+func (m *Pool) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pool proto.InternalMessageInfo
+
+// PoolRecords are the initialized ticks and open positions of a pool, which
+// are stored apart from it. They are used to export and import them in genesis.
+type PoolRecords struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// initialized ticks, sorted by index
+	Ticks []TickInfo `protobuf:"bytes,2,rep,name=ticks,proto3" json:"ticks" yaml:"ticks"`
+	// open positions, sorted by id
+	Positions []Position `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions" yaml:"positions"`
+}
+
+func (m *PoolRecords) Reset()         { *m = PoolRecords{} }
+func (m *PoolRecords) String() string { return proto.CompactTextString(m) }
+func (*PoolRecords) ProtoMessage()    {}
+func (*PoolRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f6d4f20db5d256d, []int{4}
+}
+func (m *PoolRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRecords.Merge(m, src)
+}
+func (m *PoolRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRecords proto.InternalMessageInfo
+
+func (m *PoolRecords) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolRecords) GetTicks() []TickInfo {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
+func (m *PoolRecords) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.PoolParams")
+	proto.RegisterType((*TickInfo)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.TickInfo")
+	proto.RegisterType((*Position)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.Position")
+	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.Pool")
+	proto.RegisterType((*PoolRecords)(nil), "osmosis.gamm.poolmodels.concentrated.v1beta1.PoolRecords")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/concentrated/concentrated_pool.proto", fileDescriptor_5f6d4f20db5d256d)
+}
+
+var fileDescriptor_5f6d4f20db5d256d = []byte{
+	// 1082 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xb7, 0x13, 0x27, 0xb1, 0xc7, 0xb1, 0xe3, 0x4c, 0x03, 0xd9, 0x04, 0xf0, 0x56, 0x73, 0x28,
+	0x41, 0x34, 0x76, 0xb6, 0x20, 0x40, 0x91, 0x38, 0xb0, 0x81, 0x06, 0x97, 0x88, 0x46, 0x53, 0x4e,
+	0x80, 0xb4, 0xac, 0x77, 0x27, 0xee, 0x28, 0xf6, 0xce, 0x66, 0x67, 0xd2, 0x24, 0x12, 0x17, 0x4e,
+	0x70, 0x42, 0x1c, 0x39, 0xf6, 0x8c, 0x38, 0xf6, 0x13, 0x70, 0xea, 0xb1, 0xea, 0x09, 0x71, 0x58,
+	0x50, 0xf2, 0x0d, 0x7c, 0xe5, 0x82, 0x66, 0x76, 0x76, 0xb3, 0xb1, 0xad, 0x0a, 0x43, 0x7a, 0xda,
+	0x7d, 0x7f, 0x7f, 0x6f, 0xde, 0xbf, 0x19, 0xf0, 0x21, 0xe3, 0x03, 0xc6, 0x29, 0x6f, 0xf7, 0xdc,
+	0xc1, 0xa0, 0x1d, 0x32, 0xd6, 0xdf, 0x1c, 0x30, 0x9f, 0xf4, 0x79, 0xdb, 0x63, 0x81, 0x47, 0x02,
+	0x11, 0xb9, 0x82, 0xf8, 0x57, 0x08, 0x47, 0x6a, 0xb5, 0xc2, 0x88, 0x09, 0x06, 0x6f, 0x6b, 0xf3,
+	0x96, 0x34, 0x6f, 0x49, 0x41, 0x62, 0xdd, 0xca, 0x1b, 0xb4, 0x1e, 0x59, 0x5d, 0x22, 0x5c, 0x6b,
+	0x7d, 0xcd, 0x53, 0xea, 0x8e, 0xb2, 0x6d, 0x27, 0x44, 0xe2, 0x68, 0x7d, 0xa5, 0xc7, 0x7a, 0x2c,
+	0xe1, 0xcb, 0x3f, 0xcd, 0x6d, 0x26, 0x3a, 0xed, 0xae, 0xcb, 0x49, 0x5b, 0x7b, 0x69, 0x7b, 0x8c,
+	0x06, 0x89, 0x1c, 0x51, 0x00, 0xf6, 0x19, 0xeb, 0xef, 0xbb, 0x91, 0x3b, 0xe0, 0xf0, 0x2b, 0xb0,
+	0xc0, 0x4f, 0xdc, 0xf0, 0x2e, 0x21, 0x46, 0xf1, 0x66, 0x71, 0xa3, 0x62, 0x7f, 0xf4, 0x34, 0x36,
+	0x0b, 0x7f, 0xc4, 0xe6, 0xad, 0x1e, 0x15, 0x0f, 0x8f, 0xbb, 0x2d, 0x8f, 0x0d, 0x34, 0xaa, 0xfe,
+	0x6c, 0x72, 0xff, 0xb0, 0x2d, 0xce, 0x42, 0xc2, 0x5b, 0x1f, 0x13, 0x6f, 0x18, 0x9b, 0x4b, 0x67,
+	0xee, 0xa0, 0xbf, 0x8d, 0xa4, 0x1b, 0xe7, 0x80, 0x10, 0x84, 0x53, 0x8f, 0xe8, 0xc7, 0x12, 0x28,
+	0x7f, 0x41, 0xbd, 0xc3, 0x4e, 0x70, 0xc0, 0xe0, 0x2d, 0x30, 0x47, 0x03, 0x9f, 0x9c, 0x2a, 0x9c,
+	0x59, 0xbb, 0x31, 0x8c, 0xcd, 0xc5, 0xc4, 0x52, 0xb1, 0x11, 0x4e, 0xc4, 0xf0, 0x08, 0x2c, 0xf5,
+	0xe9, 0xd1, 0x31, 0xf5, 0xa9, 0x38, 0x73, 0x7a, 0x11, 0xe3, 0xdc, 0x98, 0x51, 0x91, 0x7d, 0x3a,
+	0x75, 0x64, 0xaf, 0x26, 0xfe, 0x47, 0xdc, 0x21, 0x5c, 0xcf, 0x38, 0xbb, 0x92, 0x01, 0x0f, 0x41,
+	0xed, 0x52, 0x27, 0x20, 0xc2, 0x98, 0x55, 0x80, 0x77, 0xa7, 0x06, 0x5c, 0x19, 0x05, 0x0c, 0x88,
+	0x40, 0x78, 0x31, 0xa3, 0x3f, 0x27, 0x02, 0x7e, 0x0b, 0x6e, 0x1c, 0x10, 0x22, 0x43, 0x39, 0x11,
+	0x0f, 0x1d, 0x76, 0x2c, 0x38, 0xf5, 0xc9, 0x96, 0x51, 0x52, 0x90, 0x7b, 0x53, 0x43, 0xae, 0x27,
+	0x90, 0x13, 0x5c, 0x22, 0xbc, 0x7c, 0x40, 0xc8, 0xae, 0x62, 0xde, 0xd7, 0xbc, 0xc9, 0xe8, 0x96,
+	0x31, 0x77, 0xdd, 0xe8, 0xd6, 0x04, 0x74, 0x0b, 0xfd, 0x3d, 0x07, 0xca, 0xfb, 0x8c, 0x53, 0x41,
+	0x59, 0x00, 0xdf, 0x00, 0x33, 0xd4, 0x57, 0xdd, 0x50, 0xb2, 0x6b, 0xc3, 0xd8, 0xac, 0xe8, 0x6e,
+	0xf0, 0x11, 0x9e, 0xa1, 0xbe, 0xec, 0x17, 0x76, 0x12, 0x90, 0x48, 0x57, 0x3f, 0xd7, 0x2f, 0x8a,
+	0x8d, 0x70, 0x22, 0x86, 0xef, 0x02, 0xd0, 0x67, 0x27, 0x24, 0x72, 0x04, 0xf5, 0x0e, 0x55, 0xe5,
+	0x66, 0xed, 0x57, 0x86, 0xb1, 0xb9, 0xac, 0x6b, 0x91, 0xc9, 0x10, 0xae, 0x28, 0x42, 0x76, 0xa4,
+	0xb4, 0x3a, 0x0e, 0xc3, 0xd4, 0xaa, 0x34, 0x6a, 0x75, 0x29, 0x43, 0xb8, 0xa2, 0x08, 0x65, 0xf5,
+	0x0d, 0xa8, 0x64, 0xb5, 0xd4, 0x39, 0xb3, 0xa7, 0xce, 0x59, 0x63, 0xa4, 0x49, 0x64, 0x5c, 0xe9,
+	0x3f, 0xfc, 0xbe, 0x08, 0x56, 0x73, 0xd9, 0xa4, 0x81, 0x4c, 0x9c, 0xd3, 0x77, 0xb9, 0xd8, 0x32,
+	0xe6, 0x15, 0xe0, 0xfe, 0xd4, 0x80, 0xcd, 0xb1, 0x22, 0xe5, 0xdd, 0x22, 0xbc, 0x92, 0x15, 0xaa,
+	0xa3, 0xf8, 0x7b, 0x92, 0xfd, 0x82, 0x48, 0x2c, 0x63, 0xe1, 0x65, 0x44, 0x62, 0x4d, 0x8e, 0xc4,
+	0x82, 0x5d, 0x00, 0x0e, 0x08, 0xe1, 0x0e, 0x3b, 0x21, 0xfe, 0x96, 0x51, 0x56, 0xd8, 0x3b, 0x53,
+	0x63, 0x2f, 0x67, 0xd8, 0xda, 0x13, 0xc2, 0x15, 0x49, 0xdc, 0x97, 0xff, 0x57, 0x30, 0x2c, 0xa3,
+	0x72, 0x4d, 0x18, 0x56, 0x0e, 0xc3, 0x42, 0xbf, 0x95, 0x41, 0x49, 0xae, 0x5e, 0x78, 0x1b, 0x2c,
+	0xb8, 0xbe, 0x1f, 0x11, 0xce, 0xf5, 0xd2, 0x85, 0xc3, 0xd8, 0xac, 0x27, 0xb6, 0x5a, 0x80, 0x70,
+	0xaa, 0x02, 0xeb, 0x6a, 0x4e, 0xe4, 0x14, 0x94, 0xd4, 0x60, 0x7c, 0x57, 0x04, 0x20, 0xcc, 0x36,
+	0xb8, 0xea, 0xf8, 0xea, 0x9d, 0x0f, 0x5a, 0xd3, 0xdc, 0x2a, 0xad, 0xcb, 0x1b, 0xc0, 0x7e, 0x53,
+	0x9e, 0x72, 0x18, 0x9b, 0x66, 0x82, 0x3f, 0x76, 0x6b, 0x39, 0xa1, 0xd2, 0x43, 0x38, 0x07, 0x0a,
+	0xdf, 0x02, 0xf3, 0x82, 0x1d, 0x92, 0x20, 0xdd, 0x5b, 0xcb, 0xc3, 0xd8, 0xac, 0x25, 0x0e, 0x12,
+	0x3e, 0xc2, 0x5a, 0x21, 0x53, 0x4d, 0x97, 0xcc, 0xa8, 0xaa, 0x95, 0xaa, 0x5a, 0x70, 0x1b, 0x2c,
+	0xca, 0x91, 0x73, 0x78, 0xe8, 0x7a, 0x34, 0xe8, 0xa9, 0x86, 0x2f, 0xd9, 0xab, 0xc3, 0xd8, 0xbc,
+	0xa1, 0x0d, 0x72, 0x52, 0x84, 0xab, 0x92, 0x7c, 0x90, 0x50, 0xf0, 0x0c, 0x40, 0xef, 0x38, 0x8a,
+	0x48, 0x20, 0x1c, 0x7e, 0x14, 0x09, 0x27, 0x8c, 0xa8, 0x47, 0x74, 0xa3, 0x7e, 0x36, 0x75, 0x21,
+	0xd7, 0x74, 0x32, 0xc6, 0x3c, 0x22, 0xdc, 0xd0, 0xcc, 0x07, 0x47, 0x91, 0xd8, 0x97, 0x2c, 0x19,
+	0x76, 0xaa, 0xa8, 0xb6, 0x49, 0x59, 0x6d, 0x93, 0x5c, 0xd8, 0x79, 0x29, 0xc2, 0x55, 0x4d, 0x8e,
+	0x6f, 0x94, 0xca, 0xcb, 0xd8, 0x28, 0x67, 0x00, 0xe6, 0xe6, 0xad, 0xd7, 0x67, 0x5d, 0xb7, 0xbf,
+	0x65, 0x80, 0xff, 0x97, 0x98, 0x71, 0x8f, 0x08, 0x37, 0xb2, 0xe1, 0xdd, 0x4d, 0x58, 0x13, 0xa1,
+	0x2d, 0xa3, 0x7a, 0xcd, 0xd0, 0xd6, 0x38, 0xb4, 0x05, 0x3f, 0x01, 0x8d, 0x80, 0x9c, 0x0a, 0x27,
+	0xd4, 0xb7, 0x8d, 0x43, 0x7d, 0xa3, 0xae, 0xda, 0xe9, 0xb5, 0x61, 0x6c, 0xae, 0x26, 0xae, 0x46,
+	0x35, 0x10, 0xae, 0x4b, 0x56, 0x7a, 0x43, 0x75, 0x7c, 0x78, 0x04, 0x6a, 0xb2, 0xeb, 0xf7, 0xb2,
+	0x12, 0x2d, 0xdd, 0x9c, 0xdd, 0xa8, 0xde, 0x59, 0x6b, 0xe9, 0x87, 0x98, 0x7c, 0x64, 0x65, 0x43,
+	0xb5, 0xc3, 0x68, 0x60, 0x6f, 0xc9, 0x73, 0xfd, 0xf2, 0xa7, 0xb9, 0xf1, 0x2f, 0xce, 0x25, 0x0d,
+	0x38, 0xbe, 0x8a, 0xb0, 0xbd, 0xfc, 0xc3, 0x63, 0xb3, 0xf0, 0xf3, 0x63, 0xb3, 0xf0, 0xfc, 0xc9,
+	0xe6, 0x9c, 0x9c, 0xd3, 0xce, 0xbd, 0x52, 0x79, 0xb1, 0x51, 0xbb, 0x57, 0x2a, 0xd7, 0x1a, 0x75,
+	0xf4, 0xeb, 0x0c, 0xa8, 0x4a, 0x2e, 0x26, 0x1e, 0x8b, 0x7c, 0x0e, 0xdf, 0x06, 0x0b, 0x6a, 0x4a,
+	0xb3, 0xab, 0x34, 0xb7, 0x4b, 0xb4, 0x00, 0xe1, 0x79, 0xf9, 0xd7, 0xf1, 0x61, 0x17, 0xcc, 0xc9,
+	0x1e, 0x94, 0x2f, 0x2a, 0x79, 0x8c, 0xf7, 0xa6, 0x5b, 0x1a, 0xe9, 0x53, 0xce, 0x5e, 0xd1, 0x2b,
+	0x63, 0xf1, 0x72, 0x2a, 0x39, 0xc2, 0x89, 0x6b, 0x18, 0x80, 0x4a, 0x9a, 0x52, 0xb9, 0x9c, 0xfe,
+	0x03, 0x4e, 0x9a, 0x7f, 0xdb, 0xd0, 0x38, 0x8d, 0xf4, 0x38, 0xda, 0x2d, 0xc2, 0x97, 0x10, 0xdb,
+	0xaf, 0x3f, 0x7f, 0xb2, 0x69, 0xec, 0xe4, 0xfc, 0xe4, 0xb2, 0xd3, 0xb1, 0xbf, 0x7e, 0x7a, 0xde,
+	0x2c, 0x3e, 0x3b, 0x6f, 0x16, 0xff, 0x3a, 0x6f, 0x16, 0x7f, 0xba, 0x68, 0x16, 0x9e, 0x5d, 0x34,
+	0x0b, 0xbf, 0x5f, 0x34, 0x0b, 0x5f, 0xda, 0xb9, 0x02, 0xe9, 0xf0, 0x36, 0xfb, 0x6e, 0x97, 0xa7,
+	0x44, 0xfb, 0xd1, 0xfb, 0xed, 0xd3, 0x17, 0x3f, 0xf1, 0xbb, 0xf3, 0xea, 0x49, 0xfd, 0xce, 0x3f,
+	0x03, 0x00, 0x1f, 0xef, 0x47, 0xdf, 0x12, 0x0c, 0x00, 0x00,
+}
+
+func (m *PoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TickInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeGrowthOutside1.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeeGrowthOutside0.Size()
+		i -= size
+		if _, err := m.FeeGrowthOutside0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeesOwed1.Size()
+		i -= size
+		if _, err := m.FeesOwed1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FeesOwed0.Size()
+		i -= size
+		if _, err := m.FeesOwed0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FeeGrowthInsideLast1.Size()
+		i -= size
+		if _, err := m.FeeGrowthInsideLast1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FeeGrowthInsideLast0.Size()
+		i -= size
+		if _, err := m.FeeGrowthInsideLast0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UpperTick != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolLiquidity) > 0 {
+		for iNdEx := len(m.PoolLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NextPositionId != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.NextPositionId))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.FeeGrowthGlobal1.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FeeGrowthGlobal0.Size()
+		i -= size
+		if _, err := m.FeeGrowthGlobal0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.CurrentTick != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TickSpacing != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Token1) > 0 {
+		i -= len(m.Token1)
+		copy(dAtA[i:], m.Token1)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Token1)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Token0) > 0 {
+		i -= len(m.Token0)
+		copy(dAtA[i:], m.Token0)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Token0)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.PoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConcentratedPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintConcentratedPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConcentratedPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConcentratedPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	return n
+}
+
+func (m *TickInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.Index))
+	}
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthOutside0.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthOutside1.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthInsideLast0.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthInsideLast1.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeesOwed0.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeesOwed1.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.Id))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = len(m.Token0)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	l = len(m.Token1)
+	if l > 0 {
+		n += 1 + l + sovConcentratedPool(uint64(l))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.TickSpacing))
+	}
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if m.CurrentTick != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.CurrentTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthGlobal0.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	l = m.FeeGrowthGlobal1.Size()
+	n += 1 + l + sovConcentratedPool(uint64(l))
+	if m.NextPositionId != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.NextPositionId))
+	}
+	if len(m.PoolLiquidity) > 0 {
+		for _, e := range m.PoolLiquidity {
+			l = e.Size()
+			n += 1 + l + sovConcentratedPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovConcentratedPool(uint64(m.PoolId))
+	}
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovConcentratedPool(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovConcentratedPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovConcentratedPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConcentratedPool(x uint64) (n int) {
+	return sovConcentratedPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthOutside1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideLast0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthInsideLast1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesOwed0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesOwed0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesOwed1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesOwed1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeGrowthGlobal1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPositionId", wireType)
+			}
+			m.NextPositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolLiquidity = append(m.PoolLiquidity, types.Coin{})
+			if err := m.PoolLiquidity[len(m.PoolLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, TickInfo{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConcentratedPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConcentratedPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConcentratedPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConcentratedPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConcentratedPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthConcentratedPool
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupConcentratedPool
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthConcentratedPool
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthConcentratedPool        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConcentratedPool          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupConcentratedPool = fmt.Errorf("proto: unexpected end of group")
+)
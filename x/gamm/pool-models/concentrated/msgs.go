@@ -0,0 +1,240 @@
+package concentrated
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+const (
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+	TypeMsgCreatePosition         = "create_position"
+	TypeMsgWithdrawPosition       = "withdraw_position"
+	TypeMsgCollectFees            = "collect_fees"
+)
+
+var (
+	_ sdk.Msg             = &MsgCreateConcentratedPool{}
+	_ types.CreatePoolMsg = &MsgCreateConcentratedPool{}
+	_ sdk.Msg             = &MsgCreatePosition{}
+	_ sdk.Msg             = &MsgWithdrawPosition{}
+	_ sdk.Msg             = &MsgCollectFees{}
+)
+
+func NewMsgCreateConcentratedPool(
+	sender sdk.AccAddress,
+	poolParams PoolParams,
+	initialLiquidity sdk.Coins,
+	tickSpacing uint64,
+) MsgCreateConcentratedPool {
+	return MsgCreateConcentratedPool{
+		Sender:               sender.String(),
+		PoolParams:           &poolParams,
+		InitialPoolLiquidity: initialLiquidity,
+		TickSpacing:          tickSpacing,
+	}
+}
+
+func (msg MsgCreateConcentratedPool) Route() string { return types.RouterKey }
+func (msg MsgCreateConcentratedPool) Type() string  { return TypeMsgCreateConcentratedPool }
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolParams == nil {
+		return sdkerrors.Wrap(types.ErrInvalidPool, "pool params must be set")
+	}
+	err = msg.PoolParams.Validate()
+	if err != nil {
+		return err
+	}
+
+	// concentrated liquidity pools are between exactly two assets
+	numAssets := len(msg.InitialPoolLiquidity)
+	if numAssets < types.MinPoolAssets {
+		return types.ErrTooFewPoolAssets
+	} else if numAssets > types.MinPoolAssets {
+		return sdkerrors.Wrapf(types.ErrTooManyPoolAssets, "concentrated pools have two assets, got %d", numAssets)
+	}
+
+	if err = msg.InitialPoolLiquidity.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid initial pool liquidity: %s", err)
+	}
+
+	return validateTickSpacing(msg.TickSpacing)
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateConcentratedPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateConcentratedPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+func (msg MsgCreateConcentratedPool) InitialLiquidity() sdk.Coins {
+	return msg.InitialPoolLiquidity
+}
+
+func (msg MsgCreateConcentratedPool) CreatePool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+	concentratedPool, err := NewConcentratedPool(poolId, *msg.PoolParams, msg.InitialPoolLiquidity, msg.TickSpacing)
+	if err != nil {
+		return nil, err
+	}
+
+	return &concentratedPool, nil
+}
+
+func NewMsgCreatePosition(
+	sender sdk.AccAddress,
+	poolId uint64,
+	lowerTick, upperTick int64,
+	tokensDesired, tokenMins sdk.Coins,
+) MsgCreatePosition {
+	return MsgCreatePosition{
+		Sender:        sender.String(),
+		PoolId:        poolId,
+		LowerTick:     lowerTick,
+		UpperTick:     upperTick,
+		TokensDesired: tokensDesired,
+		TokenMins:     tokenMins,
+	}
+}
+
+func (msg MsgCreatePosition) Route() string { return types.RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return sdkerrors.Wrapf(types.ErrInvalidPosition, "lower tick %d must be less than upper tick %d", msg.LowerTick, msg.UpperTick)
+	}
+	if msg.LowerTick < MinTick || msg.UpperTick > MaxTick {
+		return sdkerrors.Wrapf(types.ErrInvalidPosition, "ticks must be within [%d, %d]", MinTick, MaxTick)
+	}
+
+	if err = msg.TokensDesired.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if !msg.TokensDesired.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.TokensDesired.String())
+	}
+
+	if err = msg.TokenMins.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgWithdrawPosition(
+	sender sdk.AccAddress,
+	poolId uint64,
+	positionId uint64,
+	liquidity sdk.Dec,
+) MsgWithdrawPosition {
+	return MsgWithdrawPosition{
+		Sender:     sender.String(),
+		PoolId:     poolId,
+		PositionId: positionId,
+		Liquidity:  liquidity,
+	}
+}
+
+func (msg MsgWithdrawPosition) Route() string { return types.RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Liquidity.IsNil() || !msg.Liquidity.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidPosition, "liquidity to withdraw must be positive")
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func NewMsgCollectFees(
+	sender sdk.AccAddress,
+	poolId uint64,
+	positionId uint64,
+) MsgCollectFees {
+	return MsgCollectFees{
+		Sender:     sender.String(),
+		PoolId:     poolId,
+		PositionId: positionId,
+	}
+}
+
+func (msg MsgCollectFees) Route() string { return types.RouterKey }
+func (msg MsgCollectFees) Type() string  { return TypeMsgCollectFees }
+func (msg MsgCollectFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
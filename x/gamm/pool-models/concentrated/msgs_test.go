@@ -0,0 +1,117 @@
+package concentrated
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appParams "github.com/osmosis-labs/osmosis/v7/app/params"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func TestMsgCreateConcentratedPool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	defaultMsg := NewMsgCreateConcentratedPool(addr1, PoolParams{SwapFee: sdk.NewDecWithPrec(1, 2)}, defaultLiquidity, 10)
+	require.Equal(t, types.RouterKey, defaultMsg.Route())
+	require.Equal(t, TypeMsgCreateConcentratedPool, defaultMsg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, defaultMsg.GetSigners())
+
+	createMsg := func(after func(msg MsgCreateConcentratedPool) MsgCreateConcentratedPool) MsgCreateConcentratedPool {
+		return after(NewMsgCreateConcentratedPool(addr1, PoolParams{SwapFee: sdk.NewDecWithPrec(1, 2)}, defaultLiquidity, 10))
+	}
+
+	tests := []struct {
+		name       string
+		msg        MsgCreateConcentratedPool
+		expectPass bool
+	}{
+		{"two assets", defaultMsg, true},
+		{
+			"single asset",
+			createMsg(func(msg MsgCreateConcentratedPool) MsgCreateConcentratedPool {
+				msg.InitialPoolLiquidity = msg.InitialPoolLiquidity[:1]
+				return msg
+			}),
+			false,
+		},
+		{
+			"three assets",
+			createMsg(func(msg MsgCreateConcentratedPool) MsgCreateConcentratedPool {
+				msg.InitialPoolLiquidity = msg.InitialPoolLiquidity.Add(sdk.NewInt64Coin("baz", 1_000_000))
+				return msg
+			}),
+			false,
+		},
+		{
+			"zero tick spacing",
+			createMsg(func(msg MsgCreateConcentratedPool) MsgCreateConcentratedPool {
+				msg.TickSpacing = 0
+				return msg
+			}),
+			false,
+		},
+		{
+			"swap fee of 100%",
+			createMsg(func(msg MsgCreateConcentratedPool) MsgCreateConcentratedPool {
+				msg.PoolParams = &PoolParams{SwapFee: sdk.OneDec()}
+				return msg
+			}),
+			false,
+		},
+		{
+			"no pool params",
+			createMsg(func(msg MsgCreateConcentratedPool) MsgCreateConcentratedPool {
+				msg.PoolParams = nil
+				return msg
+			}),
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		err := test.msg.ValidateBasic()
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
+
+func TestPositionMsgs(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	tests := []struct {
+		name       string
+		msg        interface{ ValidateBasic() error }
+		expectPass bool
+	}{
+		{"create position", NewMsgCreatePosition(addr1, 1, -100, 100, defaultLiquidity, sdk.Coins{}), true},
+		{"create position with empty range", NewMsgCreatePosition(addr1, 1, 100, 100, defaultLiquidity, sdk.Coins{}), false},
+		{"create position out of range", NewMsgCreatePosition(addr1, 1, MinTick-1, 100, defaultLiquidity, sdk.Coins{}), false},
+		{"create position without tokens", NewMsgCreatePosition(addr1, 1, -100, 100, sdk.Coins{}, sdk.Coins{}), false},
+		{"create position with invalid sender", &MsgCreatePosition{Sender: "invalid", PoolId: 1, LowerTick: -100, UpperTick: 100}, false},
+		{"withdraw position", NewMsgWithdrawPosition(addr1, 1, 1, sdk.OneDec()), true},
+		{"withdraw no liquidity", NewMsgWithdrawPosition(addr1, 1, 1, sdk.ZeroDec()), false},
+		{"withdraw negative liquidity", NewMsgWithdrawPosition(addr1, 1, 1, sdk.OneDec().Neg()), false},
+		{"collect fees", NewMsgCollectFees(addr1, 1, 1), true},
+		{"collect fees with invalid sender", &MsgCollectFees{Sender: "invalid", PoolId: 1, PositionId: 1}, false},
+	}
+
+	for _, test := range tests {
+		err := test.msg.ValidateBasic()
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
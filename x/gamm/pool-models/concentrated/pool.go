@@ -0,0 +1,206 @@
+package concentrated
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var _ types.PoolI = &Pool{}
+
+var errSwapNeedsStore = sdkerrors.Wrap(types.ErrNotImplemented, "concentrated liquidity pools are swapped against through the gamm keeper")
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return p.PoolParams.SwapFee
+}
+
+// GetExitFee returns zero, as concentrated liquidity pools are not exited.
+func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return true
+}
+
+// Returns all coins in the pool, including the uncollected fees of positions
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	return p.PoolLiquidity
+}
+
+// GetTotalShares returns zero, as the pool's liquidity is provided by positions instead of LP shares.
+func (p Pool) GetTotalShares() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+// updatePoolLiquidityForSwap updates the pool liquidity.
+// It requires caller to validate that tokensIn and tokensOut only consist of
+// the pool's denominations. Unlike in other pools, the pool may run out of either of them.
+// The function sanity checks this, and panics if not the case.
+func (p *Pool) updatePoolLiquidityForSwap(tokensIn sdk.Coins, tokensOut sdk.Coins) {
+	// update liquidity
+	p.PoolLiquidity = p.PoolLiquidity.Add(tokensIn...).Sub(tokensOut)
+	// sanity check that no new denoms were added
+	for _, coin := range p.PoolLiquidity {
+		if coin.Denom != p.Token0 && coin.Denom != p.Token1 {
+			panic("updatePoolLiquidityForSwap added a denom to the pool")
+		}
+	}
+}
+
+// CalcOutAmtGivenIn errors, as swaps need the pool's ticks, which are stored apart from the pool.
+// The gamm keeper swaps against concentrated liquidity pools with CalcOutAmtGivenInWithStore instead.
+func (p Pool) CalcOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	return sdk.Coin{}, errSwapNeedsStore
+}
+
+// SwapOutAmtGivenIn errors, as swaps need the pool's ticks, which are stored apart from the pool.
+// The gamm keeper swaps against concentrated liquidity pools with SwapOutAmtGivenInWithStore instead.
+func (p *Pool) SwapOutAmtGivenIn(ctx sdk.Context, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	return sdk.Coin{}, errSwapNeedsStore
+}
+
+// CalcInAmtGivenOut errors, as swaps need the pool's ticks, which are stored apart from the pool.
+// The gamm keeper swaps against concentrated liquidity pools with CalcInAmtGivenOutWithStore instead.
+func (p Pool) CalcInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	return sdk.Coin{}, errSwapNeedsStore
+}
+
+// SwapInAmtGivenOut errors, as swaps need the pool's ticks, which are stored apart from the pool.
+// The gamm keeper swaps against concentrated liquidity pools with SwapInAmtGivenOutWithStore instead.
+func (p *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	return sdk.Coin{}, errSwapNeedsStore
+}
+
+func (p Pool) CalcOutAmtGivenInWithStore(store sdk.KVStore, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	tokenOut, _, err = p.calcOutAmtGivenIn(store, tokenIn, tokenOutDenom, swapFee)
+	return tokenOut, err
+}
+
+func (p Pool) calcOutAmtGivenIn(store sdk.KVStore, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (sdk.Coin, swapResult, error) {
+	if tokenIn.Len() != 1 {
+		return sdk.Coin{}, swapResult{}, errors.New("concentrated CalcOutAmtGivenIn: tokenIn is of wrong length")
+	}
+	result, err := p.computeSwap(store, tokenIn[0].Denom, tokenOutDenom, tokenIn[0].Amount.ToDec(), swapFee, true)
+	if err != nil {
+		return sdk.Coin{}, swapResult{}, err
+	}
+
+	// we ignore the decimal component, as token out amount must round down
+	tokenOutAmt := result.amountOut.TruncateInt()
+	if !tokenOutAmt.IsPositive() {
+		return sdk.Coin{}, swapResult{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+	if tokenOutAmt.GTE(p.PoolLiquidity.AmountOf(tokenOutDenom)) {
+		return sdk.Coin{}, swapResult{}, types.ErrTooManyTokensOut
+	}
+	return sdk.NewCoin(tokenOutDenom, tokenOutAmt), result, nil
+}
+
+func (p *Pool) SwapOutAmtGivenInWithStore(store sdk.KVStore, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error) {
+	tokenOut, result, err := p.calcOutAmtGivenIn(store, tokenIn, tokenOutDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	p.applySwap(store, result)
+	p.updatePoolLiquidityForSwap(tokenIn, sdk.NewCoins(tokenOut))
+
+	return tokenOut, nil
+}
+
+func (p Pool) CalcInAmtGivenOutWithStore(store sdk.KVStore, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	tokenIn, _, err = p.calcInAmtGivenOut(store, tokenOut, tokenInDenom, swapFee)
+	return tokenIn, err
+}
+
+func (p Pool) calcInAmtGivenOut(store sdk.KVStore, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (sdk.Coin, swapResult, error) {
+	if tokenOut.Len() != 1 {
+		return sdk.Coin{}, swapResult{}, errors.New("concentrated CalcInAmtGivenOut: tokenOut is of wrong length")
+	}
+	if tokenOut[0].Amount.GTE(p.PoolLiquidity.AmountOf(tokenOut[0].Denom)) {
+		return sdk.Coin{}, swapResult{}, types.ErrTooManyTokensOut
+	}
+	result, err := p.computeSwap(store, tokenInDenom, tokenOut[0].Denom, tokenOut[0].Amount.ToDec(), swapFee, false)
+	if err != nil {
+		return sdk.Coin{}, swapResult{}, err
+	}
+
+	// We round up tokenInAmt, as this is whats charged for the swap, for the precise amount out.
+	// Otherwise, the pool would under-charge by this rounding error.
+	tokenInAmt := result.amountIn.Ceil().TruncateInt()
+	if !tokenInAmt.IsPositive() {
+		return sdk.Coin{}, swapResult{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive")
+	}
+	return sdk.NewCoin(tokenInDenom, tokenInAmt), result, nil
+}
+
+func (p *Pool) SwapInAmtGivenOutWithStore(store sdk.KVStore, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error) {
+	tokenIn, result, err := p.calcInAmtGivenOut(store, tokenOut, tokenInDenom, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	p.applySwap(store, result)
+	p.updatePoolLiquidityForSwap(sdk.NewCoins(tokenIn), tokenOut)
+
+	return tokenIn, nil
+}
+
+// SpotPrice returns the current price of the quote asset in units of the base asset.
+func (p Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
+	if err := p.validateSwapDenoms(quoteAssetDenom, baseAssetDenom); err != nil {
+		return sdk.Dec{}, err
+	}
+	// the current price is that of token0 in units of token1
+	price := p.CurrentSqrtPrice.Mul(p.CurrentSqrtPrice)
+	if baseAssetDenom == p.Token0 {
+		return sdk.OneDec().Quo(price), nil
+	}
+	return price, nil
+}
+
+func (p Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	return sdk.Int{}, sdk.Coins{}, types.ErrPoolHasNoShares
+}
+
+func (p *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	return sdk.Int{}, types.ErrPoolHasNoShares
+}
+
+func (p *Pool) ExitPool(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	return sdk.Coins{}, types.ErrPoolHasNoShares
+}
+
+func (p Pool) CalcExitPoolShares(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	return sdk.Coins{}, types.ErrPoolHasNoShares
+}
+
+// PokePool is a no-op, as concentrated liquidity pools have no time dependent state.
+func (p *Pool) PokePool(blockTime time.Time) {}
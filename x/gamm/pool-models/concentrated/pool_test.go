@@ -0,0 +1,330 @@
+package concentrated
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var (
+	defaultSwapFee     = sdk.MustNewDecFromStr("0.003")
+	defaultTickSpacing = uint64(10)
+
+	creator = sdk.AccAddress([]byte("creator_____________"))
+	lp      = sdk.AccAddress([]byte("lp__________________"))
+
+	// bar is token0 and foo is token1, so the price of bar is 4 foo
+	defaultLiquidity = sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 1_000_000),
+		sdk.NewInt64Coin("foo", 4_000_000),
+	)
+)
+
+// newTestPool returns a pool whose initial position is owned by creator, and the store of its ticks and positions.
+func newTestPool(t *testing.T, liquidity sdk.Coins, swapFee sdk.Dec) (Pool, sdk.KVStore) {
+	pool, err := NewConcentratedPool(1, PoolParams{SwapFee: swapFee}, liquidity, defaultTickSpacing)
+	require.NoError(t, err)
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	require.NoError(t, pool.CreateInitialPosition(store, creator))
+	return pool, store
+}
+
+// requireApproxEqual checks that actual is within a relative error of tolerance of expected.
+func requireApproxEqual(t *testing.T, expected, actual sdk.Dec, tolerance sdk.Dec) {
+	diff := expected.Sub(actual).Abs()
+	require.True(t, diff.LTE(expected.Abs().Mul(tolerance)), "expected %s, got %s", expected, actual)
+}
+
+func TestTickToSqrtPrice(t *testing.T) {
+	require.Equal(t, sdk.OneDec(), TickToSqrtPrice(0))
+	// 1.0001^23027 ~= 10
+	requireApproxEqual(t, sdk.NewDec(10), TickToSqrtPrice(23027).Power(2), sdk.NewDecWithPrec(1, 4))
+	requireApproxEqual(t, sdk.NewDecWithPrec(1, 1), TickToSqrtPrice(-23027).Power(2), sdk.NewDecWithPrec(1, 4))
+
+	for _, tick := range []int64{MinTick, -100_001, -1, 0, 1, 7, 99_999, MaxTick - 1} {
+		sqrtPrice := TickToSqrtPrice(tick)
+		require.Equal(t, tick, SqrtPriceToTick(sqrtPrice))
+		// prices in between two ticks are rounded down to the lower tick
+		between := sqrtPrice.Add(TickToSqrtPrice(tick + 1)).QuoInt64(2)
+		require.Equal(t, tick, SqrtPriceToTick(between))
+	}
+	require.Equal(t, MaxTick, SqrtPriceToTick(TickToSqrtPrice(MaxTick)))
+}
+
+func TestNewConcentratedPool(t *testing.T) {
+	pool, store := newTestPool(t, defaultLiquidity, defaultSwapFee)
+
+	require.Equal(t, "bar", pool.Token0)
+	require.Equal(t, "foo", pool.Token1)
+	requireApproxEqual(t, sdk.NewDec(2), pool.CurrentSqrtPrice, sdk.NewDecWithPrec(1, 12))
+	require.Equal(t, defaultLiquidity, pool.GetTotalPoolLiquidity(sdk.Context{}))
+	require.True(t, pool.GetTotalShares().IsZero())
+
+	// the creator owns a full range position with all of the initial liquidity
+	positions := pool.getAllPositions(store)
+	require.Len(t, positions, 1)
+	position := positions[0]
+	require.Equal(t, creator.String(), position.Owner)
+	require.Equal(t, minUsableTick(defaultTickSpacing), position.LowerTick)
+	require.Equal(t, maxUsableTick(defaultTickSpacing), position.UpperTick)
+	require.Equal(t, position.Liquidity, pool.Liquidity)
+	// the liquidity of a constant product pool is sqrt(x * y)
+	requireApproxEqual(t, sdk.NewDec(2_000_000), pool.Liquidity, sdk.NewDecWithPrec(1, 6))
+
+	spotPrice, err := pool.SpotPrice(sdk.Context{}, "foo", "bar")
+	require.NoError(t, err)
+	requireApproxEqual(t, sdk.NewDec(4), spotPrice, sdk.NewDecWithPrec(1, 12))
+	spotPrice, err = pool.SpotPrice(sdk.Context{}, "bar", "foo")
+	require.NoError(t, err)
+	requireApproxEqual(t, sdk.NewDecWithPrec(25, 2), spotPrice, sdk.NewDecWithPrec(1, 12))
+
+	_, err = pool.SpotPrice(sdk.Context{}, "foo", "baz")
+	require.Error(t, err)
+
+	_, err = NewConcentratedPool(1, PoolParams{SwapFee: defaultSwapFee}, defaultLiquidity, 0)
+	require.ErrorIs(t, err, types.ErrInvalidTickSpacing)
+}
+
+func TestSwapWithinFullRange(t *testing.T) {
+	pool, store := newTestPool(t, defaultLiquidity, sdk.ZeroDec())
+
+	// a full range position behaves like a constant product pool
+	tokenIn := sdk.NewInt64Coin("bar", 10_000)
+	tokenOut, err := pool.CalcOutAmtGivenInWithStore(store, sdk.NewCoins(tokenIn), "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	// 4_000_000 * 10_000 / 1_010_000 ~= 39603.96
+	require.Equal(t, sdk.NewInt(39603), tokenOut.Amount)
+
+	tokenOutFromSwap, err := pool.SwapOutAmtGivenInWithStore(store, sdk.NewCoins(tokenIn), "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, tokenOut, tokenOutFromSwap)
+	require.Equal(t, defaultLiquidity.Add(tokenIn).Sub(sdk.NewCoins(tokenOut)), pool.PoolLiquidity)
+	requireApproxEqual(t, sdk.MustNewDecFromStr("3.92118"), pool.CurrentSqrtPrice.Power(2), sdk.NewDecWithPrec(1, 5))
+
+	// swapping back for the same amount out costs about as much as was swapped in
+	tokenInBack, err := pool.CalcInAmtGivenOutWithStore(store, sdk.NewCoins(tokenIn), "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(39604), tokenInBack.Amount)
+}
+
+func TestSwapCrossingTicks(t *testing.T) {
+	pool, store := newTestPool(t, defaultLiquidity, sdk.ZeroDec())
+	fullRangeLiquidity := pool.Liquidity
+
+	// concentrate liquidity between the prices ~3.9 and ~4.1
+	lowerTick, upperTick := int64(13610), int64(14110)
+	require.True(t, lowerTick < pool.CurrentTick && pool.CurrentTick < upperTick)
+	positionId, liquidity, tokensIn, err := pool.CreatePosition(store, lp, lowerTick, upperTick, sdk.NewCoins(sdk.NewInt64Coin("bar", 100_000), sdk.NewInt64Coin("foo", 400_000)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), positionId)
+	require.Equal(t, fullRangeLiquidity.Add(liquidity), pool.Liquidity)
+	// the range is concentrated, so the same tokens provide much more liquidity
+	require.True(t, liquidity.GT(fullRangeLiquidity.MulInt64(5)))
+	require.Equal(t, defaultLiquidity.Add(tokensIn...), pool.PoolLiquidity)
+	require.Len(t, pool.getTicks(store), 4)
+
+	// a small swap stays within the concentrated range, so gets a better price than in the full range only pool
+	smallIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 10_000))
+	smallOut, err := pool.CalcOutAmtGivenInWithStore(store, smallIn, "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, smallOut.Amount.GT(sdk.NewInt(39603)))
+
+	// a large swap moves the price below the concentrated range
+	largeIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 300_000))
+	calcOut, err := pool.CalcOutAmtGivenInWithStore(store, largeIn, "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	largeOut, err := pool.SwapOutAmtGivenInWithStore(store, largeIn, "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, calcOut, largeOut)
+	require.True(t, pool.CurrentTick < lowerTick)
+	require.Equal(t, fullRangeLiquidity, pool.Liquidity)
+	require.Equal(t, pool.CurrentTick, SqrtPriceToTick(pool.CurrentSqrtPrice))
+
+	// the concentrated position now only holds bar
+	tokensOut, err := pool.WithdrawPosition(store, lp, positionId, liquidity)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bar"}, []string{tokensOut[0].Denom})
+	require.Len(t, tokensOut, 1)
+	require.Len(t, pool.getTicks(store), 2)
+
+	// swapping back up crosses the ticks of the full range position only
+	backOut := sdk.NewCoins(sdk.NewInt64Coin("bar", 100_000))
+	calcIn, err := pool.CalcInAmtGivenOutWithStore(store, backOut, "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	backIn, err := pool.SwapInAmtGivenOutWithStore(store, backOut, "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, calcIn, backIn)
+	require.Equal(t, fullRangeLiquidity, pool.Liquidity)
+}
+
+func TestSwapOutOfLiquidity(t *testing.T) {
+	pool, store := newTestPool(t, defaultLiquidity, sdk.ZeroDec())
+
+	// only liquidity in a range above the current price remains, which only holds bar
+	_, _, _, err := pool.CreatePosition(store, lp, 20000, 20100, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000)))
+	require.NoError(t, err)
+	_, err = pool.WithdrawPosition(store, creator, 1, pool.getAllPositions(store)[0].Liquidity)
+	require.NoError(t, err)
+	require.True(t, pool.Liquidity.IsZero())
+
+	// buying bar moves the price up into the range
+	_, err = pool.SwapInAmtGivenOutWithStore(store, sdk.NewCoins(sdk.NewInt64Coin("bar", 500)), "foo", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, pool.Liquidity.IsPositive())
+
+	// selling bar moves the price down out of the range, after which there is no liquidity left
+	_, err = pool.CalcOutAmtGivenInWithStore(store, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000)), "foo", sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrNotEnoughLiquidity)
+}
+
+func TestFeeAccrual(t *testing.T) {
+	pool, store := newTestPool(t, defaultLiquidity, defaultSwapFee)
+
+	// a position with the same liquidity as the creator's, in a range around the current price
+	positionId, liquidity, _, err := pool.CreatePosition(store, lp, 12000, 15800, defaultLiquidity)
+	require.NoError(t, err)
+	_, err = pool.WithdrawPosition(store, lp, positionId, liquidity.Sub(pool.getAllPositions(store)[0].Liquidity))
+	require.NoError(t, err)
+	require.Equal(t, pool.getAllPositions(store)[0].Liquidity, pool.getAllPositions(store)[1].Liquidity)
+
+	// swaps within the range split fees evenly between both positions
+	_, err = pool.SwapOutAmtGivenInWithStore(store, sdk.NewCoins(sdk.NewInt64Coin("bar", 100_000)), "foo", defaultSwapFee)
+	require.NoError(t, err)
+	_, err = pool.SwapOutAmtGivenInWithStore(store, sdk.NewCoins(sdk.NewInt64Coin("foo", 200_000)), "bar", defaultSwapFee)
+	require.NoError(t, err)
+
+	positions := pool.GetPositions(store, nil)
+	require.Len(t, positions, 2)
+	// 0.3% of 100_000 bar and of 200_000 foo
+	requireApproxEqual(t, sdk.NewDec(150), positions[0].FeesOwed0, sdk.NewDecWithPrec(1, 9))
+	requireApproxEqual(t, sdk.NewDec(300), positions[0].FeesOwed1, sdk.NewDecWithPrec(1, 9))
+	requireApproxEqual(t, positions[0].FeesOwed0, positions[1].FeesOwed0, sdk.NewDecWithPrec(1, 9))
+	require.Equal(t, positions[1:], pool.GetPositions(store, lp))
+
+	// a swap that leaves the range only pays fees to the full range position from then on
+	_, err = pool.SwapOutAmtGivenInWithStore(store, sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000_000)), "foo", defaultSwapFee)
+	require.NoError(t, err)
+	require.True(t, pool.CurrentTick < 12000)
+	positions = pool.GetPositions(store, nil)
+	require.True(t, positions[0].FeesOwed0.GT(positions[1].FeesOwed0.MulInt64(2)))
+
+	// only the owner can collect the fees
+	_, err = pool.CollectFees(store, creator, positionId)
+	require.Error(t, err)
+	fees, err := pool.CollectFees(store, lp, positionId)
+	require.NoError(t, err)
+	require.Equal(t, positions[1].FeesOwed0.TruncateInt(), fees.AmountOf("bar"))
+	require.Equal(t, positions[1].FeesOwed1.TruncateInt(), fees.AmountOf("foo"))
+	require.True(t, pool.GetPositions(store, lp)[0].FeesOwed0.LT(sdk.OneDec()))
+
+	// the position is closed once it is withdrawn and its fees are collected
+	_, err = pool.WithdrawPosition(store, lp, positionId, pool.getAllPositions(store)[1].Liquidity)
+	require.NoError(t, err)
+	require.Len(t, pool.GetPositions(store, lp), 1)
+	_, err = pool.CollectFees(store, lp, positionId)
+	require.NoError(t, err)
+	require.Len(t, pool.GetPositions(store, lp), 0)
+	require.Len(t, pool.getTicks(store), 2)
+}
+
+func TestCreatePositionValidation(t *testing.T) {
+	tests := map[string]struct {
+		lowerTick, upperTick int64
+		tokensDesired        sdk.Coins
+	}{
+		"lower tick above upper tick": {
+			lowerTick: 100, upperTick: 0, tokensDesired: defaultLiquidity,
+		},
+		"tick not a multiple of the tick spacing": {
+			lowerTick: 0, upperTick: 105, tokensDesired: defaultLiquidity,
+		},
+		"tick out of range": {
+			lowerTick: 0, upperTick: MaxTick + 10, tokensDesired: defaultLiquidity,
+		},
+		"denom not in pool": {
+			lowerTick: 0, upperTick: 100, tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("baz", 100)),
+		},
+		"range below the price, without foo": {
+			lowerTick: 0, upperTick: 100, tokensDesired: sdk.NewCoins(sdk.NewInt64Coin("bar", 100)),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, store := newTestPool(t, defaultLiquidity, defaultSwapFee)
+			_, _, _, err := pool.CreatePosition(store, lp, tc.lowerTick, tc.upperTick, tc.tokensDesired)
+			require.ErrorIs(t, err, types.ErrInvalidPosition)
+		})
+	}
+}
+
+func TestNoShares(t *testing.T) {
+	pool, _ := newTestPool(t, defaultLiquidity, defaultSwapFee)
+
+	_, err := pool.JoinPool(sdk.Context{}, defaultLiquidity, defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrPoolHasNoShares)
+	_, err = pool.ExitPool(sdk.Context{}, sdk.OneInt(), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPoolHasNoShares)
+}
+
+func TestSwapNeedsStore(t *testing.T) {
+	pool, _ := newTestPool(t, defaultLiquidity, defaultSwapFee)
+	tokenIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 1_000))
+
+	_, err := pool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+	_, err = pool.SwapOutAmtGivenIn(sdk.Context{}, tokenIn, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+	_, err = pool.CalcInAmtGivenOut(sdk.Context{}, tokenIn, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+	_, err = pool.SwapInAmtGivenOut(sdk.Context{}, tokenIn, "foo", defaultSwapFee)
+	require.ErrorIs(t, err, types.ErrNotImplemented)
+}
+
+func TestNextInitializedTick(t *testing.T) {
+	pool, store := newTestPool(t, defaultLiquidity, defaultSwapFee)
+	_, _, _, err := pool.CreatePosition(store, lp, -20000, -10000, sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000)))
+	require.NoError(t, err)
+
+	ticks := pool.getTicks(store)
+	require.Len(t, ticks, 4)
+	require.Equal(t, []int64{MinTick, -20000, -10000, MaxTick}, []int64{ticks[0].Index, ticks[1].Index, ticks[2].Index, ticks[3].Index})
+
+	next, ok := pool.nextInitializedTick(store, -10001, true)
+	require.True(t, ok)
+	require.Equal(t, int64(-20000), next)
+	next, ok = pool.nextInitializedTick(store, -10000, true)
+	require.True(t, ok)
+	require.Equal(t, int64(-10000), next)
+	next, ok = pool.nextInitializedTick(store, -20000, false)
+	require.True(t, ok)
+	require.Equal(t, int64(-10000), next)
+	next, ok = pool.nextInitializedTick(store, -10000, false)
+	require.True(t, ok)
+	require.Equal(t, MaxTick, next)
+	_, ok = pool.nextInitializedTick(store, MaxTick, false)
+	require.False(t, ok)
+}
+
+func TestExportImportRecords(t *testing.T) {
+	pool, store := newTestPool(t, defaultLiquidity, defaultSwapFee)
+	_, _, _, err := pool.CreatePosition(store, lp, 12000, 15800, defaultLiquidity)
+	require.NoError(t, err)
+	_, err = pool.SwapOutAmtGivenInWithStore(store, sdk.NewCoins(sdk.NewInt64Coin("bar", 100_000)), "foo", defaultSwapFee)
+	require.NoError(t, err)
+
+	records := pool.ExportRecords(store)
+	newStore := dbadapter.Store{DB: dbm.NewMemDB()}
+	require.NoError(t, pool.ImportRecords(newStore, records))
+	require.Equal(t, pool.getTicks(store), pool.getTicks(newStore))
+	require.Equal(t, pool.getAllPositions(store), pool.getAllPositions(newStore))
+
+	otherPool, err := NewConcentratedPool(2, PoolParams{SwapFee: defaultSwapFee}, defaultLiquidity, defaultTickSpacing)
+	require.NoError(t, err)
+	require.Error(t, otherPool.ImportRecords(dbadapter.Store{DB: dbm.NewMemDB()}, records))
+}
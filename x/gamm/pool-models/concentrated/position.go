@@ -0,0 +1,154 @@
+package concentrated
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var _ types.ConcentratedPoolExtension = &Pool{}
+
+// CreatePosition opens a position for owner over [lowerTick, upperTick), with the maximal
+// liquidity that at most tokensDesired can provide at the current price.
+// It returns the id of the new position, its liquidity, and the tokens it takes.
+// Balance transfers are done in the keeper, but this method updates the internal pool state.
+func (p *Pool) CreatePosition(store sdk.KVStore, owner sdk.AccAddress, lowerTick, upperTick int64, tokensDesired sdk.Coins) (positionId uint64, liquidity sdk.Dec, tokensIn sdk.Coins, err error) {
+	if err := validatePositionTicks(lowerTick, upperTick, p.TickSpacing); err != nil {
+		return 0, sdk.Dec{}, sdk.Coins{}, err
+	}
+	for _, coin := range tokensDesired {
+		if coin.Denom != p.Token0 && coin.Denom != p.Token1 {
+			return 0, sdk.Dec{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidPosition, "denom %s does not exist in pool", coin.Denom)
+		}
+	}
+
+	sqrtPriceLower, sqrtPriceUpper := TickToSqrtPrice(lowerTick), TickToSqrtPrice(upperTick)
+	liquidity = liquidityForAmounts(p.CurrentSqrtPrice, sqrtPriceLower, sqrtPriceUpper,
+		tokensDesired.AmountOf(p.Token0), tokensDesired.AmountOf(p.Token1))
+	if !liquidity.IsPositive() {
+		return 0, sdk.Dec{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidPosition, "%s provide no liquidity in range [%d, %d)", tokensDesired, lowerTick, upperTick)
+	}
+
+	amount0, amount1 := amountsForLiquidity(p.CurrentSqrtPrice, sqrtPriceLower, sqrtPriceUpper, liquidity)
+	// amounts in are rounded up, but can't exceed the desired amounts that the liquidity is derived from
+	tokensIn = sdk.NewCoins(
+		sdk.NewCoin(p.Token0, sdk.MinInt(amount0.Ceil().TruncateInt(), tokensDesired.AmountOf(p.Token0))),
+		sdk.NewCoin(p.Token1, sdk.MinInt(amount1.Ceil().TruncateInt(), tokensDesired.AmountOf(p.Token1))),
+	)
+
+	position := Position{
+		Id:                   p.NextPositionId,
+		Owner:                owner.String(),
+		LowerTick:            lowerTick,
+		UpperTick:            upperTick,
+		Liquidity:            sdk.ZeroDec(),
+		FeeGrowthInsideLast0: sdk.ZeroDec(),
+		FeeGrowthInsideLast1: sdk.ZeroDec(),
+		FeesOwed0:            sdk.ZeroDec(),
+		FeesOwed1:            sdk.ZeroDec(),
+	}
+	p.modifyPosition(store, &position, liquidity)
+	p.setPosition(store, position)
+	p.NextPositionId++
+	p.PoolLiquidity = p.PoolLiquidity.Add(tokensIn...)
+
+	return position.Id, liquidity, tokensIn, nil
+}
+
+// WithdrawPosition removes liquidity from position positionId of owner,
+// and returns the tokens that it held. The position keeps its accrued fees until they are collected.
+// Balance transfers are done in the keeper, but this method updates the internal pool state.
+func (p *Pool) WithdrawPosition(store sdk.KVStore, owner sdk.AccAddress, positionId uint64, liquidity sdk.Dec) (tokensOut sdk.Coins, err error) {
+	position, err := p.getOwnedPosition(store, owner, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if !liquidity.IsPositive() || liquidity.GT(position.Liquidity) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidPosition, "liquidity to withdraw must be in (0, %s]", position.Liquidity)
+	}
+
+	amount0, amount1 := amountsForLiquidity(p.CurrentSqrtPrice, TickToSqrtPrice(position.LowerTick), TickToSqrtPrice(position.UpperTick), liquidity)
+	tokensOut = sdk.NewCoins(sdk.NewCoin(p.Token0, amount0.TruncateInt()), sdk.NewCoin(p.Token1, amount1.TruncateInt()))
+	if !p.PoolLiquidity.IsAllGTE(tokensOut) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut, "withdrawing %s from a pool with %s", tokensOut, p.PoolLiquidity)
+	}
+
+	p.modifyPosition(store, &position, liquidity.Neg())
+	p.setPosition(store, position)
+	p.PoolLiquidity = p.PoolLiquidity.Sub(tokensOut)
+
+	return tokensOut, nil
+}
+
+// CollectFees returns the whole tokens of the fees accrued by position positionId of owner,
+// and removes them from the position. If the position has no liquidity left, it is closed.
+// Balance transfers are done in the keeper, but this method updates the internal pool state.
+func (p *Pool) CollectFees(store sdk.KVStore, owner sdk.AccAddress, positionId uint64) (fees sdk.Coins, err error) {
+	position, err := p.getOwnedPosition(store, owner, positionId)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	p.accrueFees(store, &position)
+
+	fees = sdk.NewCoins(sdk.NewCoin(p.Token0, position.FeesOwed0.TruncateInt()), sdk.NewCoin(p.Token1, position.FeesOwed1.TruncateInt()))
+	if !p.PoolLiquidity.IsAllGTE(fees) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrTooManyTokensOut, "collecting %s from a pool with %s", fees, p.PoolLiquidity)
+	}
+	position.FeesOwed0 = position.FeesOwed0.Sub(fees.AmountOf(p.Token0).ToDec())
+	position.FeesOwed1 = position.FeesOwed1.Sub(fees.AmountOf(p.Token1).ToDec())
+	p.PoolLiquidity = p.PoolLiquidity.Sub(fees)
+
+	// fractional fees left in a closed position stay in the pool
+	if !position.Liquidity.IsPositive() {
+		p.deletePosition(store, positionId)
+	} else {
+		p.setPosition(store, position)
+	}
+
+	return fees, nil
+}
+
+// GetPositions returns the pool's positions, or only those of owner if it is not empty,
+// with their fees accrued up to the pool's current state.
+// This does not mutate the pool.
+func (p Pool) GetPositions(store sdk.KVStore, owner sdk.AccAddress) []Position {
+	positions := []Position{}
+	for _, position := range p.getAllPositions(store) {
+		if !owner.Empty() && position.Owner != owner.String() {
+			continue
+		}
+		p.accrueFees(store, &position)
+		positions = append(positions, position)
+	}
+	return positions
+}
+
+// ExportRecords returns the pool's initialized ticks and open positions, for genesis.
+func (p Pool) ExportRecords(store sdk.KVStore) types.ConcentratedPoolRecordsI {
+	return &PoolRecords{
+		PoolId:    p.Id,
+		Ticks:     p.getTicks(store),
+		Positions: p.getAllPositions(store),
+	}
+}
+
+// ImportRecords stores the pool's initialized ticks and open positions from genesis.
+func (p Pool) ImportRecords(store sdk.KVStore, records types.ConcentratedPoolRecordsI) error {
+	poolRecords, ok := records.(*PoolRecords)
+	if !ok || poolRecords.PoolId != p.Id {
+		return fmt.Errorf("invalid records of concentrated liquidity pool %d", p.Id)
+	}
+	for _, tick := range poolRecords.Ticks {
+		p.setTick(store, tick)
+	}
+	for _, position := range poolRecords.Positions {
+		if position.Id >= p.NextPositionId {
+			return fmt.Errorf("position %d of concentrated liquidity pool %d is not below its next position id", position.Id, p.Id)
+		}
+		p.setPosition(store, position)
+	}
+	return nil
+}
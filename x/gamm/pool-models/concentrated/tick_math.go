@@ -0,0 +1,94 @@
+package concentrated
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// The price of tick i is 1.0001^i units of token1 per unit of token0.
+// Ticks are bounded so that prices stay within [~1.4e-15, ~7.1e14],
+// which keeps square root prices well within sdk.Dec precision.
+const (
+	MinTick int64 = -342000
+	MaxTick int64 = 342000
+
+	// MaxTickSpacing is the largest allowed tick spacing of a pool.
+	MaxTickSpacing uint64 = 10000
+)
+
+// sqrtTickBase is sqrt(1.0001), the ratio between the square root prices of two adjacent ticks.
+var sqrtTickBase = func() sdk.Dec {
+	base, err := sdk.MustNewDecFromStr("1.0001").ApproxSqrt()
+	if err != nil {
+		panic(err)
+	}
+	return base
+}()
+
+// TickToSqrtPrice returns the square root of the price of tick.
+// CONTRACT: MinTick <= tick <= MaxTick
+func TickToSqrtPrice(tick int64) sdk.Dec {
+	if tick < 0 {
+		return sdk.OneDec().Quo(sqrtTickBase.Power(uint64(-tick)))
+	}
+	return sqrtTickBase.Power(uint64(tick))
+}
+
+// SqrtPriceToTick returns the largest tick whose square root price is at most sqrtPrice.
+// CONTRACT: TickToSqrtPrice(MinTick) <= sqrtPrice <= TickToSqrtPrice(MaxTick)
+func SqrtPriceToTick(sqrtPrice sdk.Dec) int64 {
+	// sort.Search finds the smallest tick in (MinTick, MaxTick] above sqrtPrice
+	numTicks := int(MaxTick - MinTick)
+	i := sort.Search(numTicks, func(i int) bool {
+		return TickToSqrtPrice(MinTick + 1 + int64(i)).GT(sqrtPrice)
+	})
+	return MinTick + int64(i)
+}
+
+// minUsableTick and maxUsableTick return the lowest and highest ticks that positions
+// of a pool with the given tick spacing may use.
+func minUsableTick(tickSpacing uint64) int64 {
+	return -maxUsableTick(tickSpacing)
+}
+
+func maxUsableTick(tickSpacing uint64) int64 {
+	spacing := int64(tickSpacing)
+	return MaxTick / spacing * spacing
+}
+
+func validateTickSpacing(tickSpacing uint64) error {
+	if tickSpacing == 0 || tickSpacing > MaxTickSpacing {
+		return sdkerrors.Wrapf(types.ErrInvalidTickSpacing, "tick spacing %d must be in [1, %d]", tickSpacing, MaxTickSpacing)
+	}
+	return nil
+}
+
+// validatePositionTicks checks that [lowerTick, upperTick) is a non-empty range of ticks
+// that are multiples of tickSpacing, within [MinTick, MaxTick].
+func validatePositionTicks(lowerTick, upperTick int64, tickSpacing uint64) error {
+	if lowerTick >= upperTick {
+		return sdkerrors.Wrapf(types.ErrInvalidPosition, "lower tick %d must be less than upper tick %d", lowerTick, upperTick)
+	}
+	if lowerTick < MinTick || upperTick > MaxTick {
+		return sdkerrors.Wrapf(types.ErrInvalidPosition, "ticks must be within [%d, %d]", MinTick, MaxTick)
+	}
+	spacing := int64(tickSpacing)
+	if lowerTick%spacing != 0 || upperTick%spacing != 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidPosition, "ticks must be multiples of the tick spacing %d", tickSpacing)
+	}
+	return nil
+}
+
+// validateSqrtPrice checks that sqrtPrice is within the price range of the ticks.
+func validateSqrtPrice(sqrtPrice sdk.Dec) error {
+	minSqrtPrice, maxSqrtPrice := TickToSqrtPrice(MinTick), TickToSqrtPrice(MaxTick)
+	if sqrtPrice.LT(minSqrtPrice) || sqrtPrice.GT(maxSqrtPrice) {
+		return fmt.Errorf("square root price %s is out of range [%s, %s]", sqrtPrice, minSqrtPrice, maxSqrtPrice)
+	}
+	return nil
+}
@@ -1,7 +1,13 @@
 package stableswap
 
 import (
+	"errors"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
 var (
@@ -39,6 +45,20 @@ func solveCfmm(xReserve, yReserve, yIn sdk.Dec) sdk.Dec {
 		panic("invalid yReserve, yIn combo")
 	}
 
+	// The closed form solution below takes roots of terms that are up to degree 8 in the reserves,
+	// which loses almost all precision (or overflows) once reserves get large.
+	// The CFMM is homogeneous, so we solve it with every amount divided by the larger reserve,
+	// and scale the result back up.
+	scale := sdk.MaxDec(xReserve, yReserve)
+	if scale.GT(sdk.OneDec()) {
+		return solveCfmmNormalized(xReserve.Quo(scale), yReserve.Quo(scale), yIn.Quo(scale)).MulMut(scale)
+	}
+	return solveCfmmNormalized(xReserve, yReserve, yIn)
+}
+
+// solveCfmmNormalized implements solveCfmm, assuming the reserves are at most 1.
+func solveCfmmNormalized(xReserve, yReserve, yIn sdk.Dec) sdk.Dec {
+
 	// use the following wolfram alpha link to solve the equation
 	// https://www.wolframalpha.com/input?i=solve+for+a%2C+xy%28x%5E2+%2B+y%5E2%29+%3D+%28x+-+a%29%28y+%2B+b%29%28%28x+-+a%29%5E2+%2B+%28y+%2Bb%29%5E2%29+
 	// This returns (copied from wolfram):
@@ -159,6 +179,26 @@ func solveCfmmMulti(xReserve, yReserve, wSumSquares, yIn sdk.Dec) sdk.Dec {
 		panic("invalid yReserve, yIn combo")
 	}
 
+	// As in solveCfmm, we solve with every amount divided by the largest reserve to keep precision,
+	// which divides the sum of squares w by the square of it.
+	scale := sdk.MaxDec(xReserve, yReserve)
+	if wSumSquares.GT(scale.Mul(scale)) {
+		wRoot, err := wSumSquares.ApproxSqrt()
+		if err != nil {
+			panic(err)
+		}
+		scale = wRoot
+	}
+	if scale.GT(sdk.OneDec()) {
+		scale2 := scale.Mul(scale)
+		return solveCfmmMultiNormalized(xReserve.Quo(scale), yReserve.Quo(scale), wSumSquares.Quo(scale2), yIn.Quo(scale)).MulMut(scale)
+	}
+	return solveCfmmMultiNormalized(xReserve, yReserve, wSumSquares, yIn)
+}
+
+// solveCfmmMultiNormalized implements solveCfmmMulti, assuming all reserves are at most 1.
+func solveCfmmMultiNormalized(xReserve, yReserve, wSumSquares, yIn sdk.Dec) sdk.Dec {
+
 	// Use the following wolfram alpha link to solve the equation
 	// https://www.wolframalpha.com/input?i=solve+for+a%2C+xyz%28x%5E2+%2B+y%5E2+%2B+w%29+%3D+%28x+-+a%29%28y+%2B+b%29z%28%28x+-+a%29%5E2+%2B+%28y+%2Bb%29%5E2+%2B+w%29
 	// This returns (copied from wolfram):
@@ -310,9 +350,10 @@ func solveCFMMBinarySearchMulti(constantFunction func(sdk.Dec, sdk.Dec, sdk.Dec,
 	}
 }
 
-//nolint:unused
-func spotPrice(baseReserve, quoteReserve sdk.Dec) sdk.Dec {
-	// y = baseAsset, x = quoteAsset
+// spotPrice returns the spot price of the quote asset in terms of the base asset,
+// i.e. how many units of base asset one unit of quote asset is worth at the margin, for scaled reserves.
+func spotPrice(baseReserve, quoteReserve sdk.Dec, remReserves []sdk.Dec) sdk.Dec {
+	// y = quoteAsset, x = baseAsset
 	// Define f_{y -> x}(a) as the function that outputs the amount of tokens X you'd get by
 	// trading "a" units of Y against the pool, assuming 0 swap fee, at the current liquidity.
 	// The spot price of the pool is then lim a -> 0, f_{y -> x}(a) / a
@@ -320,43 +361,445 @@ func spotPrice(baseReserve, quoteReserve sdk.Dec) sdk.Dec {
 	// The spot price equation of y in terms of x is X_SUPPLY/Y_SUPPLY.
 	// You can work out that it follows from the above relation!
 	//
-	// Now we have to work this out for the much more complex CFMM xy(x^2 + y^2).
-	// Or we can sidestep this, by just picking a small value a, and computing f_{y -> x}(a) / a,
-	// and accept the precision error.
-
-	// We arbitrarily choose a = 1, and anticipate that this is a small value at the scale of
-	// xReserve & yReserve.
-	a := sdk.OneDec()
-	// no need to divide by a, since a = 1.
-	return solveCfmm(baseReserve, quoteReserve, a)
+	// For a CFMM g(x, y) = k, moving along the curve keeps dg = 0, so the limit above is
+	// (dg/dy) / (dg/dx). For our CFMM g = xyu(x^2 + y^2 + w), with u and w held constant:
+	// dg/dx = yu(3x^2 + y^2 + w)
+	// dg/dy = xu(x^2 + 3y^2 + w)
+	// so the spot price is x(x^2 + 3y^2 + w) / (y(3x^2 + y^2 + w)).
+	// This is exact, and doesn't suffer from the precision loss of solving the CFMM for a small input.
+	x := baseReserve
+	y := quoteReserve
+	w := sumOfSquares(remReserves)
+	x2 := x.Mul(x)
+	y2 := y.Mul(y)
+
+	numerator := x.Mul(x2.Add(y2.MulInt64(3)).Add(w))
+	denominator := y.Mul(x2.MulInt64(3).Add(y2).Add(w))
+	return numerator.Quo(denominator)
+}
+
+// sumOfSquares returns the sum of the squares of the given reserves.
+func sumOfSquares(reserves []sdk.Dec) sdk.Dec {
+	sum := sdk.ZeroDec()
+	for _, reserve := range reserves {
+		sum = sum.Add(reserve.Mul(reserve))
+	}
+	return sum
+}
+
+// solveCfmmWithReserves solves the CFMM for how many units of x come out of the pool, for yIn units of y in,
+// where remReserves are the reserves of every other asset in the pool.
+// Two asset pools use solidly's CFMM, and pools with more assets use the multi-asset CFMM.
+func solveCfmmWithReserves(xReserve, yReserve sdk.Dec, remReserves []sdk.Dec, yIn sdk.Dec) sdk.Dec {
+	if len(remReserves) == 0 {
+		return solveCfmm(xReserve, yReserve, yIn)
+	}
+	return solveCfmmMulti(xReserve, yReserve, sumOfSquares(remReserves), yIn)
 }
 
 // returns outAmt as a decimal
 func (pa *Pool) calcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
-	reserves, err := pa.getScaledPoolAmts(tokenIn.Denom, tokenOutDenom)
-
+	tokenInSupply, tokenOutSupply, remReserves, err := pa.scaledSortedPoolReserves(tokenIn.Denom, tokenOutDenom)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	tokenInSupply := reserves[0].ToDec()
-	tokenOutSupply := reserves[1].ToDec()
+	// deduct the swap fee on the token in, and scale it the same way as the reserves
+	tokenInAmtAfterFee := tokenIn.Amount.ToDec().Mul(sdk.OneDec().Sub(swapFee))
+	scaledTokenIn := pa.getScaledTokenAmt(tokenIn.Denom, tokenInAmtAfterFee)
 	// We are solving for the amount of token out, hence x = tokenOutSupply, y = tokenInSupply
-	cfmmOut := solveCfmm(tokenOutSupply, tokenInSupply, tokenIn.Amount.ToDec())
+	cfmmOut := solveCfmmWithReserves(tokenOutSupply, tokenInSupply, remReserves, scaledTokenIn)
 	outAmt := pa.getDescaledPoolAmt(tokenOutDenom, cfmmOut)
 	return outAmt, nil
 }
 
 // returns inAmt as a decimal
 func (pa *Pool) calcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string, swapFee sdk.Dec) (sdk.Dec, error) {
-	reserves, err := pa.getScaledPoolAmts(tokenInDenom, tokenOut.Denom)
+	tokenInSupply, tokenOutSupply, remReserves, err := pa.scaledSortedPoolReserves(tokenInDenom, tokenOut.Denom)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	tokenInSupply := reserves[0].ToDec()
-	tokenOutSupply := reserves[1].ToDec()
+	scaledTokenOut := pa.getScaledTokenAmt(tokenOut.Denom, tokenOut.Amount.ToDec())
+	if scaledTokenOut.GTE(tokenOutSupply) {
+		return sdk.Dec{}, types.ErrTooManyTokensOut
+	}
 	// We are solving for the amount of token in, cfmm(x,y) = cfmm(x + x_in, y - y_out)
 	// x = tokenInSupply, y = tokenOutSupply, yIn = -tokenOutAmount
-	cfmmIn := solveCfmm(tokenInSupply, tokenOutSupply, tokenOut.Amount.ToDec().Neg())
+	cfmmIn := solveCfmmWithReserves(tokenInSupply, tokenOutSupply, remReserves, scaledTokenOut.Neg())
 	inAmt := pa.getDescaledPoolAmt(tokenInDenom, cfmmIn.NegMut())
-	return inAmt, nil
+	// The swap fee is charged on the token in, so we divide by (1 - swapFee)
+	// to get the amount that has to be provided for the invariant input.
+	return inAmt.Quo(sdk.OneDec().Sub(swapFee)), nil
+}
+
+// maximalExactRatioJoin LP's the maximal amount of tokens in possible, and returns the number of shares that'd be
+// and how many coins of each token in remain after the join.
+// tokensIn is expected to contain every asset in the pool.
+func (pa Pool) maximalExactRatioJoin(tokensIn sdk.Coins) (numShares sdk.Int, remCoins sdk.Coins, err error) {
+	coinShareRatios := make([]sdk.Dec, len(tokensIn))
+	minShareRatio := sdk.MaxSortableDec
+	maxShareRatio := sdk.ZeroDec()
+
+	poolLiquidity := pa.PoolLiquidity
+	for i, coin := range tokensIn {
+		shareRatio := coin.Amount.ToDec().QuoInt(poolLiquidity.AmountOf(coin.Denom))
+		if shareRatio.LT(minShareRatio) {
+			minShareRatio = shareRatio
+		}
+		if shareRatio.GT(maxShareRatio) {
+			maxShareRatio = shareRatio
+		}
+		coinShareRatios[i] = shareRatio
+	}
+
+	remCoins = sdk.Coins{}
+	if minShareRatio.Equal(sdk.MaxSortableDec) {
+		return sdk.ZeroInt(), remCoins, errors.New("unexpected error in stableswap maximalExactRatioJoin")
+	}
+	numShares = minShareRatio.MulInt(pa.TotalShares.Amount).TruncateInt()
+
+	// if the ratios differ, some of the provided coins could not be LP'd at the exact ratio
+	if !minShareRatio.Equal(maxShareRatio) {
+		for i, coin := range tokensIn {
+			if coinShareRatios[i].Equal(minShareRatio) {
+				continue
+			}
+			usedAmount := minShareRatio.MulInt(poolLiquidity.AmountOf(coin.Denom)).Ceil().TruncateInt()
+			newAmt := coin.Amount.Sub(usedAmount)
+			if newAmt.IsPositive() {
+				remCoins = remCoins.Add(sdk.NewCoin(coin.Denom, newAmt))
+			}
+		}
+	}
+
+	return numShares, remCoins, nil
+}
+
+// calcExitPoolCoinsFromShares returns the coins that exiting numShares from the pool would return,
+// after charging exitFee on the exiting shares.
+// The exit fee stays in the pool, accruing to the remaining LPs.
+func (pa Pool) calcExitPoolCoinsFromShares(numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
+	totalShares := pa.GetTotalShares()
+	if !numShares.IsPositive() {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "shares amount must be positive, was %s", numShares)
+	}
+	if numShares.GTE(totalShares) {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s exiting shares is larger than or equal to the pool's total shares %s", numShares, totalShares)
+	}
+
+	refundedShares := numShares.ToDec()
+	if !exitFee.IsZero() {
+		// exitingShares * (1 - exit fee)
+		refundedShares = refundedShares.Mul(sdk.OneDec().Sub(exitFee))
+	}
+
+	shareOutRatio := refundedShares.QuoInt(totalShares)
+	exitedCoins = sdk.Coins{}
+	for _, asset := range pa.PoolLiquidity {
+		exitAmt := shareOutRatio.MulInt(asset.Amount).TruncateInt()
+		if !exitAmt.IsPositive() {
+			continue
+		}
+		exitedCoins = exitedCoins.Add(sdk.NewCoin(asset.Denom, exitAmt))
+	}
+	return exitedCoins, nil
+}
+
+// exitPool removes exitingCoins and exitingShares from the pool.
+func (pa *Pool) exitPool(exitingCoins sdk.Coins, exitingShares sdk.Int) error {
+	if !exitingCoins.DenomsSubsetOf(pa.PoolLiquidity) {
+		return errors.New("exiting coins contain denoms that are not in the pool")
+	}
+	if exitingCoins.IsAnyGT(pa.PoolLiquidity) {
+		return types.ErrTooManyTokensOut
+	}
+	if exitingShares.GTE(pa.GetTotalShares()) {
+		return sdkerrors.Wrapf(types.ErrLimitMaxAmount, "cannot exit all shares of the pool")
+	}
+
+	pa.PoolLiquidity = pa.PoolLiquidity.Sub(exitingCoins)
+	pa.TotalShares = sdk.NewCoin(pa.TotalShares.Denom, pa.TotalShares.Amount.Sub(exitingShares))
+	return nil
+}
+
+// calcExitSwapShareAmountIn returns how many tokens of tokenOutDenom exiting numShares from the pool,
+// and then swapping every other exited asset against the pool into tokenOutDenom, would yield.
+// This is computed over decimal reserves, without rounding any of the intermediate amounts,
+// so that the searches built on top of it are not thrown off by truncation at low amounts.
+// This does not mutate the pool.
+func (pa Pool) calcExitSwapShareAmountIn(numShares sdk.Int, tokenOutDenom string, swapFee, exitFee sdk.Dec) (sdk.Dec, error) {
+	totalShares := pa.GetTotalShares()
+	if !numShares.IsPositive() || numShares.GTE(totalShares) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "shares amount %s must be positive and less than the pool's total shares", numShares)
+	}
+	outIndex, ok := pa.getLiquidityIndexMap()[tokenOutDenom]
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("denom %s does not exist in pool", tokenOutDenom)
+	}
+
+	// exitingShares * (1 - exit fee) / totalShares
+	shareOutRatio := numShares.ToDec().Mul(sdk.OneDec().Sub(exitFee)).QuoInt(totalShares)
+
+	reserves := pa.getScaledPoolAmtsDec()
+	exited := make([]sdk.Dec, len(reserves))
+	for i, reserve := range reserves {
+		exited[i] = reserve.Mul(shareOutRatio)
+		reserves[i] = reserve.Sub(exited[i])
+	}
+
+	tokenOut := exited[outIndex]
+	for i := range reserves {
+		if i == outIndex || !exited[i].IsPositive() {
+			continue
+		}
+		remReserves := make([]sdk.Dec, 0, len(reserves)-2)
+		for j, reserve := range reserves {
+			if j != outIndex && j != i {
+				remReserves = append(remReserves, reserve)
+			}
+		}
+		amtInAfterFee := exited[i].Mul(sdk.OneDec().Sub(swapFee))
+		amtOut := solveCfmmWithReserves(reserves[outIndex], reserves[i], remReserves, amtInAfterFee)
+		reserves[outIndex] = reserves[outIndex].Sub(amtOut)
+		reserves[i] = reserves[i].Add(exited[i])
+		tokenOut = tokenOut.Add(amtOut)
+	}
+
+	return pa.getDescaledPoolAmt(tokenOutDenom, tokenOut), nil
+}
+
+// withAddedLiquidityAndShares returns a copy of the pool, with coinsIn added to its liquidity
+// and numShares added to its total shares.
+func (pa Pool) withAddedLiquidityAndShares(coinsIn sdk.Coins, numShares sdk.Int) *Pool {
+	poolCopy := pa
+	poolCopy.IncreaseLiquidity(numShares, coinsIn)
+	return &poolCopy
+}
+
+// searchPrecisionFactor is the inverse of the relative precision that the binary searches used for single asset
+// joins and exits converge to, 10^-18 being the precision of sdk.Dec.
+var searchPrecisionFactor = sdk.NewIntWithDecimal(1, sdk.Precision)
+
+// maxSearchIterations bounds the binary searches used for single asset joins and exits, whose computation isn't metered.
+// Each iteration halves the search range, so about 60 iterations reach the precision of searchPrecisionFactor
+// relative to the initial range; the rest leaves room for results much smaller than their initial range.
+// A search that doesn't converge within it fails.
+const maxSearchIterations = 100
+
+// searchConverged returns whether a binary search between lowerBound and upperBound has converged,
+// i.e. they are within one unit, or within the precision of searchPrecisionFactor relative to upperBound.
+func searchConverged(lowerBound, upperBound sdk.Int) bool {
+	diff := upperBound.Sub(lowerBound)
+	return diff.LTE(sdk.OneInt()) || diff.LTE(upperBound.Quo(searchPrecisionFactor))
+}
+
+// singleAssetJoinSwapFeeRatio returns the fraction of a single asset join in denom
+// that is swapped into the other pool assets, and hence is charged the swap fee.
+// This is the share of the pool's scaled liquidity that is not in denom.
+func (pa Pool) singleAssetJoinSwapFeeRatio(denom string) sdk.Dec {
+	reserves := pa.getScaledPoolAmtsDec()
+	total := sdk.ZeroDec()
+	for _, reserve := range reserves {
+		total = total.Add(reserve)
+	}
+	denomReserve := reserves[pa.getLiquidityIndexMap()[denom]]
+	return sdk.OneDec().Sub(denomReserve.Quo(total))
+}
+
+// calcSingleAssetJoinShares returns the number of shares that joining with tokenIn alone creates.
+// A single asset join is equivalent to first swapping the right portion of tokenIn into
+// the other pool assets along the CFMM curve, and then LP'ing all of them at the exact pool ratio.
+// We charge the swap fee upfront on the portion of tokenIn that gets swapped, and then search for the
+// largest number of shares N, such that if the remaining tokenIn were added to the pool while minting N shares,
+// exiting those N shares and swapping everything back into tokenIn.Denom yields no more than that remainder.
+func (pa Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, swapFee sdk.Dec) (numShares sdk.Int, err error) {
+	poolAmtIn := pa.PoolLiquidity.AmountOf(tokenIn.Denom)
+	if !poolAmtIn.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("denom %s does not exist in pool", tokenIn.Denom)
+	}
+
+	oneMinusSwapFee := sdk.OneDec().Sub(swapFee.Mul(pa.singleAssetJoinSwapFeeRatio(tokenIn.Denom)))
+	tokenInAfterFee := sdk.NewCoin(tokenIn.Denom, tokenIn.Amount.ToDec().Mul(oneMinusSwapFee).TruncateInt())
+	if !tokenInAfterFee.Amount.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	// Joining with tokenIn at the value of an exact ratio join in tokenIn.Denom alone
+	// would create totalShares * tokenIn / poolAmtIn shares. Since exiting that many shares
+	// returns at least all of tokenIn, this is an upper bound on the shares created.
+	lowerBound := sdk.ZeroInt()
+	upperBound := pa.GetTotalShares().Mul(tokenInAfterFee.Amount).Quo(poolAmtIn).AddRaw(1)
+	for i := 0; i < maxSearchIterations && !searchConverged(lowerBound, upperBound); i++ {
+		mid := lowerBound.Add(upperBound).QuoRaw(2)
+		poolWithAddedLiquidity := pa.withAddedLiquidityAndShares(sdk.NewCoins(tokenInAfterFee), mid)
+		tokenOut, err := poolWithAddedLiquidity.calcExitSwapShareAmountIn(mid, tokenIn.Denom, sdk.ZeroDec(), sdk.ZeroDec())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if tokenOut.GT(tokenInAfterFee.Amount.ToDec()) {
+			upperBound = mid
+		} else {
+			lowerBound = mid
+		}
+	}
+	if !searchConverged(lowerBound, upperBound) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "could not find shares for %s", tokenIn)
+	}
+
+	return lowerBound, nil
+}
+
+// calcSingleAssetInGivenSharesOut returns the amount of tokenInDenom that a single asset join
+// needs in order to create sharesOut shares.
+// It is the inverse of calcSingleAssetJoinShares: we search for the smallest amount of tokenInDenom
+// (after swap fees), such that adding it to the pool while minting sharesOut, and then exiting sharesOut
+// into tokenInDenom, returns no more than the amount that was put in.
+func (pa Pool) calcSingleAssetInGivenSharesOut(tokenInDenom string, sharesOut sdk.Int, swapFee sdk.Dec) (tokenInAmount sdk.Int, err error) {
+	poolAmtIn := pa.PoolLiquidity.AmountOf(tokenInDenom)
+	if !poolAmtIn.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("denom %s does not exist in pool", tokenInDenom)
+	}
+	if !sharesOut.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "shares amount must be positive, was %s", sharesOut)
+	}
+
+	sufficesForShares := func(amountIn sdk.Int) (bool, error) {
+		poolWithAddedLiquidity := pa.withAddedLiquidityAndShares(sdk.NewCoins(sdk.NewCoin(tokenInDenom, amountIn)), sharesOut)
+		tokenOut, err := poolWithAddedLiquidity.calcExitSwapShareAmountIn(sharesOut, tokenInDenom, sdk.ZeroDec(), sdk.ZeroDec())
+		if err != nil {
+			return false, err
+		}
+		return tokenOut.LTE(amountIn.ToDec()), nil
+	}
+
+	// The exact ratio value of sharesOut in tokenInDenom alone is a lower bound on the tokens needed,
+	// we double it until it also covers the curve slippage of the internal swaps.
+	lowerBound := sdk.ZeroInt()
+	upperBound := sharesOut.Mul(poolAmtIn).Quo(pa.GetTotalShares()).AddRaw(1)
+	for i := 0; ; i++ {
+		if i == maxSearchIterations {
+			return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "could not find token in amount for %s shares", sharesOut)
+		}
+		ok, err := sufficesForShares(upperBound)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if ok {
+			break
+		}
+		lowerBound = upperBound
+		upperBound = upperBound.MulRaw(2)
+	}
+
+	for i := 0; i < maxSearchIterations && !searchConverged(lowerBound, upperBound); i++ {
+		mid := lowerBound.Add(upperBound).QuoRaw(2)
+		ok, err := sufficesForShares(mid)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if ok {
+			upperBound = mid
+		} else {
+			lowerBound = mid
+		}
+	}
+	if !searchConverged(lowerBound, upperBound) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "could not find token in amount for %s shares", sharesOut)
+	}
+
+	// gross the amount up by the swap fee that calcSingleAssetJoinShares charges
+	oneMinusSwapFee := sdk.OneDec().Sub(swapFee.Mul(pa.singleAssetJoinSwapFeeRatio(tokenInDenom)))
+	tokenInAmount = upperBound.ToDec().Quo(oneMinusSwapFee).Ceil().TruncateInt()
+	return tokenInAmount, nil
+}
+
+// calcSharesInGivenSingleAssetOut returns the number of shares that must be exited, with the
+// exit fee charged, and swapped into tokenOut.Denom to get at least tokenOut out of the pool.
+func (pa Pool) calcSharesInGivenSingleAssetOut(tokenOut sdk.Coin, swapFee, exitFee sdk.Dec) (sharesIn sdk.Int, err error) {
+	poolAmtOut := pa.PoolLiquidity.AmountOf(tokenOut.Denom)
+	if !poolAmtOut.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("denom %s does not exist in pool", tokenOut.Denom)
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount must be positive, was %s", tokenOut.Amount)
+	}
+	if tokenOut.Amount.GTE(poolAmtOut) {
+		return sdk.Int{}, types.ErrTooManyTokensOut
+	}
+
+	sufficesForTokenOut := func(shares sdk.Int) (bool, error) {
+		amountOut, err := pa.calcExitSwapShareAmountIn(shares, tokenOut.Denom, swapFee, exitFee)
+		if err != nil {
+			return false, err
+		}
+		return amountOut.GTE(tokenOut.Amount.ToDec()), nil
+	}
+
+	// exiting all but one share is the most that can be exited
+	lowerBound := sdk.ZeroInt()
+	upperBound := pa.GetTotalShares().SubRaw(1)
+	ok, err := sufficesForTokenOut(upperBound)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !ok {
+		return sdk.Int{}, types.ErrTooManyTokensOut
+	}
+
+	for i := 0; i < maxSearchIterations && !searchConverged(lowerBound, upperBound); i++ {
+		mid := lowerBound.Add(upperBound).QuoRaw(2)
+		ok, err := sufficesForTokenOut(mid)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if ok {
+			upperBound = mid
+		} else {
+			lowerBound = mid
+		}
+	}
+	if !searchConverged(lowerBound, upperBound) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "could not find shares in for %s", tokenOut)
+	}
+
+	return upperBound, nil
+}
+
+// calcJoinPoolShares returns the number of shares created by joining the pool with tokensIn,
+// and the liquidity that is added to the pool.
+// If tokensIn contains every asset in the pool, we first LP the maximal amount possible at the
+// exact pool ratio, and then single asset join each of the remaining coins.
+// Otherwise every coin in tokensIn is single asset joined, one after another.
+func (pa Pool) calcJoinPoolShares(tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
+	if tokensIn.Empty() {
+		return sdk.Int{}, sdk.Coins{}, errors.New("no tokens provided to join the pool with")
+	}
+	if !tokensIn.DenomsSubsetOf(pa.PoolLiquidity) {
+		return sdk.Int{}, sdk.Coins{}, errors.New("attempted joining pool with assets that do not exist in pool")
+	}
+
+	numShares = sdk.ZeroInt()
+	remCoins := tokensIn
+	poolCopy := pa
+	if tokensIn.Len() == pa.PoolLiquidity.Len() {
+		numShares, remCoins, err = pa.maximalExactRatioJoin(tokensIn)
+		if err != nil {
+			return sdk.Int{}, sdk.Coins{}, err
+		}
+		poolCopy = *pa.withAddedLiquidityAndShares(tokensIn.Sub(remCoins), numShares)
+	}
+
+	for _, coin := range remCoins {
+		newShares, err := poolCopy.calcSingleAssetJoinShares(coin, swapFee)
+		if err != nil {
+			return sdk.Int{}, sdk.Coins{}, err
+		}
+		poolCopy = *poolCopy.withAddedLiquidityAndShares(sdk.NewCoins(coin), newShares)
+		numShares = numShares.Add(newShares)
+	}
+
+	if !numShares.IsPositive() {
+		return sdk.Int{}, sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "shares amount must be positive, was %s", numShares)
+	}
+
+	return numShares, tokensIn, nil
 }
@@ -3,6 +3,7 @@ package stableswap
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -20,6 +21,53 @@ func BenchmarkBinarySearch(b *testing.B) {
 	}
 }
 
+// Pools of 18 decimal assets have the widest single asset join and exit searches.
+var benchmarkEighteenDecimalLiquidity = sdk.NewCoins(
+	sdk.NewCoin("bar", sdk.NewIntWithDecimal(1_000_000_000, 18)),
+	sdk.NewCoin("foo", sdk.NewIntWithDecimal(1_000_000_000, 18)),
+)
+
+func BenchmarkSingleAssetJoinShares(b *testing.B) {
+	pool := newBenchmarkPool(b)
+	tokenIn := sdk.NewCoin("foo", sdk.NewIntWithDecimal(1_000_000, 18))
+	for i := 0; i < b.N; i++ {
+		_, err := pool.calcSingleAssetJoinShares(tokenIn, defaultSwapFee)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSingleAssetInGivenSharesOut(b *testing.B) {
+	pool := newBenchmarkPool(b)
+	sharesOut := pool.GetTotalShares().QuoRaw(1000)
+	for i := 0; i < b.N; i++ {
+		_, err := pool.calcSingleAssetInGivenSharesOut("foo", sharesOut, defaultSwapFee)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSharesInGivenSingleAssetOut(b *testing.B) {
+	pool := newBenchmarkPool(b)
+	tokenOut := sdk.NewCoin("foo", sdk.NewIntWithDecimal(1_000_000, 18))
+	for i := 0; i < b.N; i++ {
+		_, err := pool.calcSharesInGivenSingleAssetOut(tokenOut, defaultSwapFee, defaultExitFee)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newBenchmarkPool(b *testing.B) Pool {
+	pool, err := NewStableswapPool(1, PoolParams{SwapFee: defaultSwapFee, ExitFee: defaultExitFee}, benchmarkEighteenDecimalLiquidity, nil, "", time.Time{})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return pool
+}
+
 func runCalc(solve func(sdk.Dec, sdk.Dec, sdk.Dec) sdk.Dec) {
 	xReserve := sdk.NewDec(rand.Int63n(100000) + 50000)
 	yReserve := sdk.NewDec(rand.Int63n(100000) + 50000)
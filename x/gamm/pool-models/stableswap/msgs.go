@@ -1,6 +1,8 @@
 package stableswap
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -8,24 +10,28 @@ import (
 )
 
 const (
-	TypeMsgCreateStableswapPool = "create_stableswap_pool"
+	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
+	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
 )
 
 var (
 	_ sdk.Msg             = &MsgCreateStableswapPool{}
 	_ types.CreatePoolMsg = &MsgCreateStableswapPool{}
+	_ sdk.Msg             = &MsgStableSwapAdjustScalingFactors{}
 )
 
 func NewMsgCreateStableswapPool(
 	sender sdk.AccAddress,
 	poolParams PoolParams,
 	initialLiquidity sdk.Coins,
+	scalingFactors []uint64,
 	futurePoolGovernor string,
 ) MsgCreateStableswapPool {
 	return MsgCreateStableswapPool{
 		Sender:               sender.String(),
 		PoolParams:           &poolParams,
 		InitialPoolLiquidity: initialLiquidity,
+		ScalingFactors:       scalingFactors,
 		FuturePoolGovernor:   futurePoolGovernor,
 	}
 }
@@ -44,11 +50,19 @@ func (msg MsgCreateStableswapPool) ValidateBasic() error {
 	}
 
 	// validation for pool initial liquidity
-	// TO DO: expand this check to accommodate multi-asset pools for stableswap
-	if len(msg.InitialPoolLiquidity) < 2 {
+	numAssets := len(msg.InitialPoolLiquidity)
+	if numAssets < types.MinPoolAssets {
 		return types.ErrTooFewPoolAssets
-	} else if len(msg.InitialPoolLiquidity) > 2 {
-		return types.ErrTooManyPoolAssets
+	} else if numAssets > types.MaxPoolAssets {
+		return sdkerrors.Wrapf(types.ErrTooManyPoolAssets, "pool has too many assets (%d)", numAssets)
+	}
+
+	if err = msg.InitialPoolLiquidity.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid initial pool liquidity: %s", err)
+	}
+
+	if err = validateScalingFactors(msg.ScalingFactors, numAssets); err != nil {
+		return err
 	}
 
 	// validation for future owner
@@ -90,10 +104,58 @@ func (msg MsgCreateStableswapPool) InitialLiquidity() sdk.Coins {
 }
 
 func (msg MsgCreateStableswapPool) CreatePool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
-	stableswapPool, err := NewStableswapPool(poolId, *msg.PoolParams, msg.InitialPoolLiquidity, msg.FuturePoolGovernor, ctx.BlockTime())
+	stableswapPool, err := NewStableswapPool(poolId, *msg.PoolParams, msg.InitialPoolLiquidity, msg.ScalingFactors, msg.FuturePoolGovernor, ctx.BlockTime())
 	if err != nil {
 		return nil, err
 	}
 
 	return &stableswapPool, nil
 }
+
+func NewMsgStableSwapAdjustScalingFactors(
+	sender sdk.AccAddress,
+	poolId uint64,
+	scalingFactors []uint64,
+	duration time.Duration,
+) MsgStableSwapAdjustScalingFactors {
+	return MsgStableSwapAdjustScalingFactors{
+		Sender:         sender.String(),
+		PoolId:         poolId,
+		ScalingFactors: scalingFactors,
+		Duration:       duration,
+	}
+}
+
+func (msg MsgStableSwapAdjustScalingFactors) Route() string { return types.RouterKey }
+func (msg MsgStableSwapAdjustScalingFactors) Type() string {
+	return TypeMsgStableSwapAdjustScalingFactors
+}
+func (msg MsgStableSwapAdjustScalingFactors) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	numAssets := len(msg.ScalingFactors)
+	if numAssets < types.MinPoolAssets || numAssets > types.MaxPoolAssets {
+		return sdkerrors.Wrapf(types.ErrInvalidScalingFactors, "got %d scaling factors", numAssets)
+	}
+
+	if err = validateScalingFactors(msg.ScalingFactors, numAssets); err != nil {
+		return err
+	}
+
+	return validateScalingFactorChangeDuration(msg.Duration)
+}
+
+func (msg MsgStableSwapAdjustScalingFactors) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,152 @@
+package stableswap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appParams "github.com/osmosis-labs/osmosis/v7/app/params"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+func TestMsgCreateStableswapPool(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+
+	createMsg := func(after func(msg MsgCreateStableswapPool) MsgCreateStableswapPool) MsgCreateStableswapPool {
+		msg := MsgCreateStableswapPool{
+			Sender: addr1,
+			PoolParams: &PoolParams{
+				SwapFee: sdk.NewDecWithPrec(1, 2),
+				ExitFee: sdk.NewDecWithPrec(1, 2),
+			},
+			InitialPoolLiquidity: sdk.NewCoins(
+				sdk.NewInt64Coin("dai", 1_000_000),
+				sdk.NewInt64Coin("usdc", 1_000_000),
+			),
+			FuturePoolGovernor: "",
+		}
+		return after(msg)
+	}
+
+	defaultMsg := createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool { return msg })
+	require.Equal(t, types.RouterKey, defaultMsg.Route())
+	require.Equal(t, TypeMsgCreateStableswapPool, defaultMsg.Type())
+
+	tests := []struct {
+		name       string
+		msg        MsgCreateStableswapPool
+		expectPass bool
+	}{
+		{
+			name:       "two assets",
+			msg:        defaultMsg,
+			expectPass: true,
+		},
+		{
+			name: "four assets with scaling factors",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = msg.InitialPoolLiquidity.Add(
+					sdk.NewInt64Coin("usdt", 1_000_000),
+					sdk.NewInt64Coin("ust", 1_000_000),
+				)
+				msg.ScalingFactors = []uint64{1_000_000_000_000, 1, 1, 1}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "single asset",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = msg.InitialPoolLiquidity[:1]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too many assets",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				for _, denom := range []string{"aaa", "bbb", "ccc", "ddd", "eee", "fff", "ggg"} {
+					msg.InitialPoolLiquidity = msg.InitialPoolLiquidity.Add(sdk.NewInt64Coin(denom, 1_000_000))
+				}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "wrong number of scaling factors",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.ScalingFactors = []uint64{1, 1, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero scaling factor",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.ScalingFactors = []uint64{1, 0}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unsorted initial liquidity",
+			msg: createMsg(func(msg MsgCreateStableswapPool) MsgCreateStableswapPool {
+				msg.InitialPoolLiquidity = sdk.Coins{msg.InitialPoolLiquidity[1], msg.InitialPoolLiquidity[0]}
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		err := test.msg.ValidateBasic()
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgStableSwapAdjustScalingFactors(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	defaultMsg := NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 1}, time.Hour)
+	require.Equal(t, types.RouterKey, defaultMsg.Route())
+	require.Equal(t, TypeMsgStableSwapAdjustScalingFactors, defaultMsg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, defaultMsg.GetSigners())
+
+	tests := []struct {
+		name       string
+		msg        MsgStableSwapAdjustScalingFactors
+		expectPass bool
+	}{
+		{"ramped change", defaultMsg, true},
+		{"minimum duration", NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1_000_000, 1, 1}, MinScalingFactorChangeDuration), true},
+		{"invalid sender", MsgStableSwapAdjustScalingFactors{Sender: "invalid", PoolId: 1, ScalingFactors: []uint64{1, 1}}, false},
+		{"no scaling factors", NewMsgStableSwapAdjustScalingFactors(addr1, 1, nil, time.Hour), false},
+		{"single scaling factor", NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1}, time.Hour), false},
+		{"zero scaling factor", NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 0}, time.Hour), false},
+		{"negative duration", NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 1}, -time.Hour), false},
+		{"immediate change", NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 1}, 0), false},
+		{"sub millisecond duration", NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 1}, time.Microsecond), false},
+		{"duration under the minimum", NewMsgStableSwapAdjustScalingFactors(addr1, 1, []uint64{1, 1}, MinScalingFactorChangeDuration-time.Second), false},
+	}
+
+	for _, test := range tests {
+		err := test.msg.ValidateBasic()
+		if test.expectPass {
+			require.NoError(t, err, "test: %v", test.name)
+		} else {
+			require.Error(t, err, "test: %v", test.name)
+		}
+	}
+}
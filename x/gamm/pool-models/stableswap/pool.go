@@ -12,7 +12,14 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
 
-var _ types.PoolI = &Pool{}
+var (
+	_ types.PoolI                  = &Pool{}
+	_ types.PoolAmountOutExtension = &Pool{}
+)
+
+// MinScalingFactorChangeDuration is the minimum duration over which the scaling factors of a pool can be changed,
+// so that they can't be changed at once, in the same block as a swap against the new curve.
+const MinScalingFactorChangeDuration = time.Hour
 
 func (pa Pool) GetAddress() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(pa.Address)
@@ -78,23 +85,51 @@ func (pa Pool) getPoolAmts(denoms ...string) ([]sdk.Int, error) {
 	return result, nil
 }
 
-// getScaledPoolAmts returns scaled amount of pool liquidity based on each asset's precisions
-func (pa Pool) getScaledPoolAmts(denoms ...string) ([]sdk.Int, error) {
-	result := make([]sdk.Int, len(denoms))
-	poolLiquidity := pa.PoolLiquidity
-	liquidityIndexes := pa.getLiquidityIndexMap()
+// scaledSortedPoolReserves returns the scaled pool reserves of first and second,
+// followed by the scaled reserves of every other asset in the pool.
+// Errors if either first or second is not in the pool.
+func (pa Pool) scaledSortedPoolReserves(first string, second string) (firstReserve, secondReserve sdk.Dec, remReserves []sdk.Dec, err error) {
+	if first == second {
+		return sdk.Dec{}, sdk.Dec{}, nil, errors.New("first and second denoms must be different")
+	}
 
-	for i, denom := range denoms {
-		liquidityIndex := liquidityIndexes[denom]
+	liquidityIndexes := pa.getLiquidityIndexMap()
+	firstIndex, ok := liquidityIndexes[first]
+	if !ok {
+		return sdk.Dec{}, sdk.Dec{}, nil, fmt.Errorf("denom %s does not exist in pool", first)
+	}
+	secondIndex, ok := liquidityIndexes[second]
+	if !ok {
+		return sdk.Dec{}, sdk.Dec{}, nil, fmt.Errorf("denom %s does not exist in pool", second)
+	}
 
-		amt := poolLiquidity.AmountOf(denom)
-		if amt.IsZero() {
-			return []sdk.Int{}, fmt.Errorf("denom %s does not exist in pool", denom)
+	reserves := pa.getScaledPoolAmtsDec()
+	remReserves = make([]sdk.Dec, 0, len(reserves)-2)
+	for i, reserve := range reserves {
+		if i != firstIndex && i != secondIndex {
+			remReserves = append(remReserves, reserve)
 		}
-		scalingFactor := pa.GetScalingFactorByLiquidityIndex(liquidityIndex)
-		result[i] = amt.QuoRaw(int64(scalingFactor))
 	}
-	return result, nil
+	return reserves[firstIndex], reserves[secondIndex], remReserves, nil
+}
+
+// getScaledPoolAmtsDec returns the scaled amount of every asset's pool liquidity, ordered as in pool liquidity.
+func (pa Pool) getScaledPoolAmtsDec() []sdk.Dec {
+	result := make([]sdk.Dec, pa.PoolLiquidity.Len())
+	for i, coin := range pa.PoolLiquidity {
+		result[i] = coin.Amount.ToDec().QuoInt64(int64(pa.GetScalingFactorByLiquidityIndex(i)))
+	}
+	return result
+}
+
+// getScaledTokenAmt scales the given amount of denom by its scaling factor,
+// so that it can be used alongside the scaled pool reserves.
+func (pa Pool) getScaledTokenAmt(denom string, amount sdk.Dec) sdk.Dec {
+	liquidityIndexes := pa.getLiquidityIndexMap()
+	liquidityIndex := liquidityIndexes[denom]
+
+	scalingFactor := pa.GetScalingFactorByLiquidityIndex(liquidityIndex)
+	return amount.QuoInt64(int64(scalingFactor))
 }
 
 // getDescaledPoolAmts gets descaled amount of given denom and amount
@@ -191,31 +226,189 @@ func (pa *Pool) SwapInAmtGivenOut(ctx sdk.Context, tokenOut sdk.Coins, tokenInDe
 }
 
 func (pa Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
-	reserves, err := pa.getScaledPoolAmts(baseAssetDenom, quoteAssetDenom)
+	baseReserve, quoteReserve, remReserves, err := pa.scaledSortedPoolReserves(baseAssetDenom, quoteAssetDenom)
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	scaledSpotPrice := spotPrice(reserves[0].ToDec(), reserves[1].ToDec())
+	scaledSpotPrice := spotPrice(baseReserve, quoteReserve, remReserves)
+	// one unit of quote asset is 1 / quoteScalingFactor scaled units, which buy
+	// scaledSpotPrice / quoteScalingFactor scaled units of the base asset.
 	spotPrice := pa.getDescaledPoolAmt(baseAssetDenom, scaledSpotPrice)
+	spotPrice = pa.getScaledTokenAmt(quoteAssetDenom, spotPrice)
 
 	return spotPrice, nil
 }
 
 func (pa Pool) CalcJoinPoolShares(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, newLiquidity sdk.Coins, err error) {
-	return sdk.Int{}, sdk.Coins{}, types.ErrNotImplemented
+	return pa.calcJoinPoolShares(tokensIn, swapFee)
 }
 
 func (pa *Pool) JoinPool(ctx sdk.Context, tokensIn sdk.Coins, swapFee sdk.Dec) (numShares sdk.Int, err error) {
-	return sdk.Int{}, types.ErrNotImplemented
+	numShares, newLiquidity, err := pa.CalcJoinPoolShares(ctx, tokensIn, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	pa.IncreaseLiquidity(numShares, newLiquidity)
+	return numShares, nil
 }
 
 func (pa *Pool) ExitPool(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
-	return sdk.Coins{}, types.ErrNotImplemented
+	exitedCoins, err = pa.CalcExitPoolShares(ctx, numShares, exitFee)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+
+	if err := pa.exitPool(exitedCoins, numShares); err != nil {
+		return sdk.Coins{}, err
+	}
+
+	return exitedCoins, nil
 }
 
 func (pa Pool) CalcExitPoolShares(ctx sdk.Context, numShares sdk.Int, exitFee sdk.Dec) (exitedCoins sdk.Coins, err error) {
-	return sdk.Coins{}, types.ErrNotImplemented
+	return pa.calcExitPoolCoinsFromShares(numShares, exitFee)
+}
+
+// IncreaseLiquidity adds coinsIn to the pool's liquidity, and sharesOut to its total shares.
+func (pa *Pool) IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins) {
+	pa.PoolLiquidity = pa.PoolLiquidity.Add(coinsIn...)
+	pa.TotalShares = sdk.NewCoin(pa.TotalShares.Denom, pa.TotalShares.Amount.Add(sharesOut))
+}
+
+// CalcTokenInShareAmountOut returns the number of tokenInDenom tokens that a single asset join
+// needs in order to get exactly shareOutAmount shares.
+// This does not mutate the pool.
+func (pa Pool) CalcTokenInShareAmountOut(
+	ctx sdk.Context,
+	tokenInDenom string,
+	shareOutAmount sdk.Int,
+	swapFee sdk.Dec,
+) (tokenInAmount sdk.Int, err error) {
+	return pa.calcSingleAssetInGivenSharesOut(tokenInDenom, shareOutAmount, swapFee)
 }
 
-// no-op for stableswap
-func (pa *Pool) PokePool(blockTime time.Time) {}
+// JoinPoolTokenInMaxShareAmountOut single asset joins the pool with exactly enough tokenInDenom
+// to get shareOutAmount shares, and returns how many tokens that took.
+func (pa *Pool) JoinPoolTokenInMaxShareAmountOut(
+	ctx sdk.Context,
+	tokenInDenom string,
+	shareOutAmount sdk.Int,
+) (tokenInAmount sdk.Int, err error) {
+	tokenInAmount, err = pa.CalcTokenInShareAmountOut(ctx, tokenInDenom, shareOutAmount, pa.GetSwapFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	pa.IncreaseLiquidity(shareOutAmount, sdk.NewCoins(sdk.NewCoin(tokenInDenom, tokenInAmount)))
+	return tokenInAmount, nil
+}
+
+// ExitSwapExactAmountOut exits the pool with the number of shares needed to get exactly tokenOut out,
+// if swapping all other exited assets into tokenOut.Denom.
+// Errors if that takes more than shareInMaxAmount shares.
+func (pa *Pool) ExitSwapExactAmountOut(
+	ctx sdk.Context,
+	tokenOut sdk.Coin,
+	shareInMaxAmount sdk.Int,
+) (shareInAmount sdk.Int, err error) {
+	shareInAmount, err = pa.calcSharesInGivenSingleAssetOut(tokenOut, pa.GetSwapFee(ctx), pa.GetExitFee(ctx))
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if shareInAmount.GT(shareInMaxAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "%s resulted shares is larger than the max amount of %s", shareInAmount, shareInMaxAmount)
+	}
+
+	if err := pa.exitPool(sdk.NewCoins(tokenOut), shareInAmount); err != nil {
+		return sdk.Int{}, err
+	}
+
+	return shareInAmount, nil
+}
+
+// PokePool checks to see if the pool's scaling factors are being changed, and
+// if so, updates them to their value at blockTime.
+func (pa *Pool) PokePool(blockTime time.Time) {
+	if pa.ScalingFactorChange == nil {
+		return
+	}
+
+	change := *pa.ScalingFactorChange
+
+	// The scaling factors s(t) of the pool at time `t` are defined as:
+	//
+	// 1. t <= start_time: s(t) = initial_scaling_factors
+	//
+	// 2. start_time < t < start_time + duration:
+	//     s(t) = initial_scaling_factors + (t - start_time) *
+	//       (target_scaling_factors - initial_scaling_factors) / (duration)
+	//
+	// 3. t >= start_time + duration: s(t) = target_scaling_factors
+	switch {
+	case !blockTime.After(change.StartTime):
+		// case 1: t <= start_time
+		return
+
+	case !blockTime.Before(change.StartTime.Add(change.Duration)):
+		// case 3: t >= start_time + duration
+		pa.ScalingFactor = change.TargetScalingFactors
+
+		// we've finished updating the scaling factors, so reset the change
+		pa.ScalingFactorChange = nil
+		return
+
+	default:
+		// case 2: start_time < t < start_time + duration
+		shiftedBlockTime := blockTime.Sub(change.StartTime).Milliseconds()
+		percentDurationElapsed := sdk.NewDec(shiftedBlockTime).QuoInt64(change.Duration.Milliseconds())
+		pa.ScalingFactor = interpolateScalingFactors(change.InitialScalingFactors, change.TargetScalingFactors, percentDurationElapsed)
+	}
+}
+
+// SetScalingFactors starts changing the pool's scaling factors to scalingFactors,
+// linearly over duration from blockTime. duration must be at least MinScalingFactorChangeDuration.
+// Only the pool's future governor, when it is an address, is allowed to change the scaling factors.
+// The pool is assumed to have been poked at blockTime.
+func (pa *Pool) SetScalingFactors(blockTime time.Time, scalingFactors []uint64, duration time.Duration, sender sdk.AccAddress) error {
+	if pa.FuturePoolGovernor != sender.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "only the future pool governor (%s) can change the scaling factors", pa.FuturePoolGovernor)
+	}
+
+	if len(scalingFactors) != len(pa.PoolLiquidity) {
+		return sdkerrors.Wrapf(types.ErrInvalidScalingFactors, "got %d scaling factors for %d assets", len(scalingFactors), len(pa.PoolLiquidity))
+	}
+	if err := validateScalingFactors(scalingFactors, len(pa.PoolLiquidity)); err != nil {
+		return err
+	}
+	if err := validateScalingFactorChangeDuration(duration); err != nil {
+		return err
+	}
+
+	pa.ScalingFactorChange = &ScalingFactorChange{
+		StartTime:             blockTime,
+		Duration:              duration,
+		InitialScalingFactors: pa.ScalingFactor,
+		TargetScalingFactors:  scalingFactors,
+	}
+	return nil
+}
+
+func validateScalingFactorChangeDuration(duration time.Duration) error {
+	if duration < MinScalingFactorChangeDuration {
+		return sdkerrors.Wrapf(types.ErrInvalidScalingFactors, "scaling factor change duration %s is shorter than %s", duration, MinScalingFactorChangeDuration)
+	}
+	return nil
+}
+
+// GetTargetScalingFactors returns the scaling factors the pool is changing to,
+// and the time at which it will reach them. If no change is in progress,
+// these are the current scaling factors, and a nil time.
+func (pa Pool) GetTargetScalingFactors() ([]uint64, *time.Time) {
+	if pa.ScalingFactorChange == nil {
+		return pa.ScalingFactor, nil
+	}
+	targetTime := pa.ScalingFactorChange.StartTime.Add(pa.ScalingFactorChange.Duration)
+	return pa.ScalingFactorChange.TargetScalingFactors, &targetTime
+}
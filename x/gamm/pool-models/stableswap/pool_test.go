@@ -0,0 +1,498 @@
+package stableswap
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+var (
+	defaultSwapFee = sdk.MustNewDecFromStr("0.003")
+	defaultExitFee = sdk.MustNewDecFromStr("0.01")
+
+	defaultTwoAssetLiquidity = sdk.NewCoins(
+		sdk.NewInt64Coin("bar", 1_000_000),
+		sdk.NewInt64Coin("foo", 1_000_000),
+	)
+)
+
+func newTestPool(t *testing.T, liquidity sdk.Coins, swapFee, exitFee sdk.Dec) Pool {
+	return newTestPoolWithScalingFactors(t, liquidity, nil, swapFee, exitFee)
+}
+
+func newTestPoolWithScalingFactors(t *testing.T, liquidity sdk.Coins, scalingFactors []uint64, swapFee, exitFee sdk.Dec) Pool {
+	pool, err := NewStableswapPool(1, PoolParams{SwapFee: swapFee, ExitFee: exitFee}, liquidity, scalingFactors, "", time.Time{})
+	require.NoError(t, err)
+	return pool
+}
+
+func TestJoinPoolExactRatio(t *testing.T) {
+	pool := newTestPool(t, defaultTwoAssetLiquidity, defaultSwapFee, sdk.ZeroDec())
+	totalShares := pool.GetTotalShares()
+
+	// joining with 10% of each asset creates exactly 10% more shares, without paying any swap fee
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 100_000), sdk.NewInt64Coin("foo", 100_000))
+	numShares, err := pool.JoinPool(sdk.Context{}, tokensIn, defaultSwapFee)
+	require.NoError(t, err)
+	require.Equal(t, totalShares.QuoRaw(10), numShares)
+	require.Equal(t, totalShares.Add(numShares), pool.GetTotalShares())
+	require.Equal(t, defaultTwoAssetLiquidity.Add(tokensIn...), pool.GetTotalPoolLiquidity(sdk.Context{}))
+}
+
+func TestJoinPoolSingleAsset(t *testing.T) {
+	tests := map[string]struct {
+		tokenIn sdk.Coin
+		swapFee sdk.Dec
+	}{
+		"small join, no swap fee": {
+			tokenIn: sdk.NewInt64Coin("foo", 1_000),
+			swapFee: sdk.ZeroDec(),
+		},
+		"small join, with swap fee": {
+			tokenIn: sdk.NewInt64Coin("foo", 1_000),
+			swapFee: defaultSwapFee,
+		},
+		"large join, with swap fee": {
+			tokenIn: sdk.NewInt64Coin("bar", 500_000),
+			swapFee: defaultSwapFee,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := newTestPool(t, defaultTwoAssetLiquidity, tc.swapFee, sdk.ZeroDec())
+			totalShares := pool.GetTotalShares()
+
+			numShares, err := pool.JoinPool(sdk.Context{}, sdk.NewCoins(tc.tokenIn), tc.swapFee)
+			require.NoError(t, err)
+			require.True(t, numShares.IsPositive())
+			require.Equal(t, totalShares.Add(numShares), pool.GetTotalShares())
+			require.Equal(t, defaultTwoAssetLiquidity.Add(tc.tokenIn), pool.GetTotalPoolLiquidity(sdk.Context{}))
+
+			// a single asset join can never create more shares than the exact ratio join of the same value
+			exactRatioShares := totalShares.Mul(tc.tokenIn.Amount).QuoRaw(2).Quo(defaultTwoAssetLiquidity.AmountOf(tc.tokenIn.Denom))
+			require.True(t, numShares.LTE(exactRatioShares), "single asset join shares %s > exact ratio shares %s", numShares, exactRatioShares)
+
+			// exiting the shares again and swapping into the token in must not return more than was put in
+			exitedAmount, err := pool.calcExitSwapShareAmountIn(numShares, tc.tokenIn.Denom, tc.swapFee, sdk.ZeroDec())
+			require.NoError(t, err)
+			require.True(t, exitedAmount.LTE(tc.tokenIn.Amount.ToDec()), "exited %s, joined with %s", exitedAmount, tc.tokenIn.Amount)
+		})
+	}
+}
+
+func TestJoinPoolSwapFeeReducesShares(t *testing.T) {
+	tokenIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 100_000))
+
+	noFeePool := newTestPool(t, defaultTwoAssetLiquidity, sdk.ZeroDec(), sdk.ZeroDec())
+	noFeeShares, _, err := noFeePool.CalcJoinPoolShares(sdk.Context{}, tokenIn, sdk.ZeroDec())
+	require.NoError(t, err)
+
+	feePool := newTestPool(t, defaultTwoAssetLiquidity, defaultSwapFee, sdk.ZeroDec())
+	feeShares, _, err := feePool.CalcJoinPoolShares(sdk.Context{}, tokenIn, defaultSwapFee)
+	require.NoError(t, err)
+
+	require.True(t, feeShares.LT(noFeeShares))
+}
+
+func TestJoinPoolErrors(t *testing.T) {
+	pool := newTestPool(t, defaultTwoAssetLiquidity, defaultSwapFee, sdk.ZeroDec())
+
+	_, err := pool.JoinPool(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("baz", 1_000)), defaultSwapFee)
+	require.Error(t, err)
+
+	_, err = pool.JoinPool(sdk.Context{}, sdk.Coins{}, defaultSwapFee)
+	require.Error(t, err)
+
+	// the failed joins must not have mutated the pool
+	require.Equal(t, defaultTwoAssetLiquidity, pool.GetTotalPoolLiquidity(sdk.Context{}))
+	require.Equal(t, types.InitPoolSharesSupply, pool.GetTotalShares())
+}
+
+func TestExitPool(t *testing.T) {
+	tests := map[string]struct {
+		exitFee       sdk.Dec
+		sharesIn      sdk.Int
+		expectedCoins sdk.Coins
+		expectErr     bool
+	}{
+		"exit 10% without exit fee": {
+			exitFee:  sdk.ZeroDec(),
+			sharesIn: types.InitPoolSharesSupply.QuoRaw(10),
+			expectedCoins: sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 100_000),
+				sdk.NewInt64Coin("foo", 100_000),
+			),
+		},
+		"exit 10% with 1% exit fee": {
+			exitFee:  defaultExitFee,
+			sharesIn: types.InitPoolSharesSupply.QuoRaw(10),
+			expectedCoins: sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 99_000),
+				sdk.NewInt64Coin("foo", 99_000),
+			),
+		},
+		"exit all shares": {
+			exitFee:   sdk.ZeroDec(),
+			sharesIn:  types.InitPoolSharesSupply,
+			expectErr: true,
+		},
+		"exit zero shares": {
+			exitFee:   sdk.ZeroDec(),
+			sharesIn:  sdk.ZeroInt(),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := newTestPool(t, defaultTwoAssetLiquidity, defaultSwapFee, tc.exitFee)
+
+			exitedCoins, err := pool.ExitPool(sdk.Context{}, tc.sharesIn, tc.exitFee)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Equal(t, defaultTwoAssetLiquidity, pool.GetTotalPoolLiquidity(sdk.Context{}))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCoins, exitedCoins)
+			require.Equal(t, defaultTwoAssetLiquidity.Sub(exitedCoins), pool.GetTotalPoolLiquidity(sdk.Context{}))
+			require.Equal(t, types.InitPoolSharesSupply.Sub(tc.sharesIn), pool.GetTotalShares())
+		})
+	}
+}
+
+func TestJoinExitRoundTrip(t *testing.T) {
+	pool := newTestPool(t, defaultTwoAssetLiquidity, defaultSwapFee, sdk.ZeroDec())
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin("bar", 10_000), sdk.NewInt64Coin("foo", 20_000))
+
+	numShares, err := pool.JoinPool(sdk.Context{}, tokensIn, defaultSwapFee)
+	require.NoError(t, err)
+
+	exitedCoins, err := pool.ExitPool(sdk.Context{}, numShares, sdk.ZeroDec())
+	require.NoError(t, err)
+
+	// an imbalanced join pays swap fees, so exiting right after can never return more than was joined with
+	require.True(t, exitedCoins.AmountOf("bar").Add(exitedCoins.AmountOf("foo")).LTE(sdk.NewInt(30_000)))
+}
+
+func TestCalcTokenInShareAmountOut(t *testing.T) {
+	pool := newTestPool(t, defaultTwoAssetLiquidity, defaultSwapFee, sdk.ZeroDec())
+	sharesOut := types.InitPoolSharesSupply.QuoRaw(100)
+
+	tokenInAmount, err := pool.CalcTokenInShareAmountOut(sdk.Context{}, "foo", sharesOut, defaultSwapFee)
+	require.NoError(t, err)
+
+	// joining with the computed amount creates at least sharesOut shares, and one less token does not
+	numShares, _, err := pool.CalcJoinPoolShares(sdk.Context{}, sdk.NewCoins(sdk.NewCoin("foo", tokenInAmount)), defaultSwapFee)
+	require.NoError(t, err)
+	require.True(t, numShares.GTE(sharesOut), "got %s shares, wanted at least %s", numShares, sharesOut)
+
+	numShares, _, err = pool.CalcJoinPoolShares(sdk.Context{}, sdk.NewCoins(sdk.NewCoin("foo", tokenInAmount.SubRaw(1))), defaultSwapFee)
+	require.NoError(t, err)
+	require.True(t, numShares.LT(sharesOut), "got %s shares, wanted less than %s", numShares, sharesOut)
+
+	_, err = pool.JoinPoolTokenInMaxShareAmountOut(sdk.Context{}, "foo", sharesOut)
+	require.NoError(t, err)
+	require.Equal(t, types.InitPoolSharesSupply.Add(sharesOut), pool.GetTotalShares())
+	require.Equal(t, defaultTwoAssetLiquidity.Add(sdk.NewCoin("foo", tokenInAmount)), pool.GetTotalPoolLiquidity(sdk.Context{}))
+}
+
+func TestExitSwapExactAmountOut(t *testing.T) {
+	pool := newTestPool(t, defaultTwoAssetLiquidity, defaultSwapFee, defaultExitFee)
+	tokenOut := sdk.NewInt64Coin("foo", 10_000)
+
+	_, err := pool.ExitSwapExactAmountOut(sdk.Context{}, tokenOut, sdk.OneInt())
+	require.ErrorIs(t, err, types.ErrLimitMaxAmount)
+
+	sharesIn, err := pool.ExitSwapExactAmountOut(sdk.Context{}, tokenOut, types.InitPoolSharesSupply)
+	require.NoError(t, err)
+	require.Equal(t, types.InitPoolSharesSupply.Sub(sharesIn), pool.GetTotalShares())
+	require.Equal(t, defaultTwoAssetLiquidity.Sub(sdk.NewCoins(tokenOut)), pool.GetTotalPoolLiquidity(sdk.Context{}))
+
+	// with swap and exit fees, the shares exited are worth more than the single asset taken out
+	exactRatioShares := types.InitPoolSharesSupply.Mul(tokenOut.Amount).QuoRaw(2).Quo(defaultTwoAssetLiquidity.AmountOf("foo"))
+	require.True(t, sharesIn.GT(exactRatioShares))
+
+	_, err = pool.ExitSwapExactAmountOut(sdk.Context{}, sdk.NewInt64Coin("foo", 2_000_000), types.InitPoolSharesSupply)
+	require.Error(t, err)
+}
+
+func TestSingleAssetSearchesConvergeWithEighteenDecimals(t *testing.T) {
+	liquidity := sdk.NewCoins(
+		sdk.NewCoin("bar", sdk.NewIntWithDecimal(1_000_000_000, 18)),
+		sdk.NewCoin("foo", sdk.NewIntWithDecimal(1_000_000_000, 18)),
+	)
+	pool := newTestPool(t, liquidity, defaultSwapFee, defaultExitFee)
+	amount := sdk.NewCoin("foo", sdk.NewIntWithDecimal(1_000_000, 18))
+
+	// every search converges within maxSearchIterations, to the precision of sdk.Dec
+	numShares, err := pool.calcSingleAssetJoinShares(amount, defaultSwapFee)
+	require.NoError(t, err)
+	require.True(t, numShares.IsPositive())
+
+	tokenInAmount, err := pool.calcSingleAssetInGivenSharesOut("foo", numShares, defaultSwapFee)
+	require.NoError(t, err)
+	// the inverse search recovers the amount joined with, up to the precision of the CFMM
+	require.True(t, tokenInAmount.LTE(amount.Amount), "token in %s for the shares joined with %s", tokenInAmount, amount.Amount)
+	require.True(t, amount.Amount.Sub(tokenInAmount).LTE(amount.Amount.Quo(sdk.NewIntWithDecimal(1, 15))),
+		"token in %s for the shares joined with %s", tokenInAmount, amount.Amount)
+
+	sharesIn, err := pool.calcSharesInGivenSingleAssetOut(amount, defaultSwapFee, defaultExitFee)
+	require.NoError(t, err)
+	require.True(t, sharesIn.IsPositive())
+}
+
+var defaultFourAssetLiquidity = sdk.NewCoins(
+	sdk.NewInt64Coin("dai", 1_000_000),
+	sdk.NewInt64Coin("usdc", 1_000_000),
+	sdk.NewInt64Coin("usdt", 1_000_000),
+	sdk.NewInt64Coin("ust", 1_000_000),
+)
+
+func TestSpotPrice(t *testing.T) {
+	tests := map[string]struct {
+		liquidity      sdk.Coins
+		scalingFactors []uint64
+		base, quote    string
+		expectedPrice  sdk.Dec
+	}{
+		"balanced two asset pool": {
+			liquidity:     defaultTwoAssetLiquidity,
+			base:          "bar",
+			quote:         "foo",
+			expectedPrice: sdk.OneDec(),
+		},
+		"balanced four asset pool": {
+			liquidity:     defaultFourAssetLiquidity,
+			base:          "usdt",
+			quote:         "dai",
+			expectedPrice: sdk.OneDec(),
+		},
+		"balanced four asset pool, with scaling factors": {
+			// 1 dai has 10^12 base units for every base unit of usdc
+			liquidity: sdk.NewCoins(
+				sdk.NewCoin("dai", sdk.NewInt(1_000_000).Mul(sdk.NewInt(1_000_000_000_000))),
+				sdk.NewInt64Coin("usdc", 1_000_000),
+				sdk.NewInt64Coin("usdt", 1_000_000),
+				sdk.NewInt64Coin("ust", 1_000_000),
+			),
+			scalingFactors: []uint64{1_000_000_000_000, 1, 1, 1},
+			base:           "dai",
+			quote:          "usdc",
+			expectedPrice:  sdk.NewDec(1_000_000_000_000),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := newTestPoolWithScalingFactors(t, tc.liquidity, tc.scalingFactors, sdk.ZeroDec(), sdk.ZeroDec())
+			price, err := pool.SpotPrice(sdk.Context{}, tc.base, tc.quote)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice, price)
+		})
+	}
+
+	// an imbalanced pool prices the scarce asset higher, and the reverse pair is the inverse price
+	pool := newTestPool(t, sdk.NewCoins(
+		sdk.NewInt64Coin("dai", 2_000_000),
+		sdk.NewInt64Coin("usdc", 1_000_000),
+		sdk.NewInt64Coin("usdt", 1_000_000),
+	), sdk.ZeroDec(), sdk.ZeroDec())
+	daiPerUsdc, err := pool.SpotPrice(sdk.Context{}, "dai", "usdc")
+	require.NoError(t, err)
+	require.True(t, daiPerUsdc.GT(sdk.OneDec()))
+	usdcPerDai, err := pool.SpotPrice(sdk.Context{}, "usdc", "dai")
+	require.NoError(t, err)
+	decApproxEq(t, sdk.OneDec(), daiPerUsdc.Mul(usdcPerDai), sdk.NewDecWithPrec(1, 12))
+
+	// a small swap executes at about the spot price
+	tokenOut, err := pool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(sdk.NewInt64Coin("usdc", 1_000)), "dai", sdk.ZeroDec())
+	require.NoError(t, err)
+	decApproxEq(t, daiPerUsdc.MulInt64(1_000), tokenOut.Amount.ToDec(), sdk.NewDec(2))
+
+	_, err = pool.SpotPrice(sdk.Context{}, "dai", "foo")
+	require.Error(t, err)
+}
+
+func TestMultiAssetSwaps(t *testing.T) {
+	pairs := [][2]string{{"dai", "usdc"}, {"usdc", "ust"}, {"ust", "dai"}, {"usdt", "usdc"}}
+	for _, pair := range pairs {
+		t.Run(pair[0]+"->"+pair[1], func(t *testing.T) {
+			pool := newTestPool(t, defaultFourAssetLiquidity, defaultSwapFee, sdk.ZeroDec())
+			tokenIn := sdk.NewInt64Coin(pair[0], 100_000)
+
+			tokenOut, err := pool.SwapOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(tokenIn), pair[1], defaultSwapFee)
+			require.NoError(t, err)
+			// stable pools swap close to 1:1, minus the swap fee and some slippage
+			require.True(t, tokenOut.Amount.LT(tokenIn.Amount))
+			require.True(t, tokenOut.Amount.GT(sdk.NewInt(95_000)), "got %s", tokenOut)
+			require.Equal(t, defaultFourAssetLiquidity.Add(tokenIn).Sub(sdk.NewCoins(tokenOut)), pool.GetTotalPoolLiquidity(sdk.Context{}))
+
+			// swapping back for the same amount costs more than was received
+			tokenInBack, err := pool.CalcInAmtGivenOut(sdk.Context{}, sdk.NewCoins(tokenIn), pair[1], defaultSwapFee)
+			require.NoError(t, err)
+			require.True(t, tokenInBack.Amount.GT(tokenOut.Amount))
+		})
+	}
+}
+
+func TestMultiAssetSwapMatchesTwoAssetSwapWithoutOtherAssets(t *testing.T) {
+	// the multi-asset CFMM with one more asset holding next to nothing behaves like the two asset CFMM
+	twoAssetPool := newTestPool(t, defaultTwoAssetLiquidity, sdk.ZeroDec(), sdk.ZeroDec())
+	threeAssetPool := newTestPool(t, defaultTwoAssetLiquidity.Add(sdk.NewInt64Coin("baz", 1)), sdk.ZeroDec(), sdk.ZeroDec())
+
+	tokenIn := sdk.NewCoins(sdk.NewInt64Coin("foo", 500_000))
+	twoAssetOut, err := twoAssetPool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, "bar", sdk.ZeroDec())
+	require.NoError(t, err)
+	threeAssetOut, err := threeAssetPool.CalcOutAmtGivenIn(sdk.Context{}, tokenIn, "bar", sdk.ZeroDec())
+	require.NoError(t, err)
+	decApproxEq(t, twoAssetOut.Amount.ToDec(), threeAssetOut.Amount.ToDec(), sdk.NewDec(2))
+}
+
+func TestMultiAssetJoinAndExit(t *testing.T) {
+	pool := newTestPool(t, defaultFourAssetLiquidity, defaultSwapFee, defaultExitFee)
+
+	// single asset join
+	tokenIn := sdk.NewInt64Coin("usdt", 40_000)
+	numShares, err := pool.JoinPool(sdk.Context{}, sdk.NewCoins(tokenIn), defaultSwapFee)
+	require.NoError(t, err)
+	exactRatioShares := types.InitPoolSharesSupply.Mul(tokenIn.Amount).QuoRaw(4).Quo(defaultFourAssetLiquidity.AmountOf("usdt"))
+	require.True(t, numShares.LTE(exactRatioShares))
+	require.True(t, numShares.GT(exactRatioShares.QuoRaw(100).MulRaw(99)))
+
+	// exits return every asset of the pool
+	exitedCoins, err := pool.ExitPool(sdk.Context{}, numShares, defaultExitFee)
+	require.NoError(t, err)
+	require.Equal(t, 4, exitedCoins.Len())
+
+	// single asset exit through the amount out extension
+	tokenOut := sdk.NewInt64Coin("dai", 10_000)
+	sharesIn, err := pool.ExitSwapExactAmountOut(sdk.Context{}, tokenOut, types.InitPoolSharesSupply)
+	require.NoError(t, err)
+	require.True(t, sharesIn.IsPositive())
+}
+
+func TestSetScalingFactors(t *testing.T) {
+	governor := sdk.AccAddress([]byte("governor____________"))
+	startTime := time.Unix(1_000_000, 0)
+
+	tests := map[string]struct {
+		sender         sdk.AccAddress
+		scalingFactors []uint64
+		duration       time.Duration
+		expectPass     bool
+	}{
+		"minimum duration": {
+			sender:         governor,
+			scalingFactors: []uint64{2, 1},
+			duration:       MinScalingFactorChangeDuration,
+			expectPass:     true,
+		},
+		"ramped change": {
+			sender:         governor,
+			scalingFactors: []uint64{101, 1},
+			duration:       100 * time.Hour,
+			expectPass:     true,
+		},
+		"not the governor": {
+			sender:         sdk.AccAddress([]byte("someone_____________")),
+			scalingFactors: []uint64{2, 1},
+			duration:       time.Hour,
+			expectPass:     false,
+		},
+		"wrong number of scaling factors": {
+			sender:         governor,
+			scalingFactors: []uint64{2, 1, 1},
+			duration:       time.Hour,
+			expectPass:     false,
+		},
+		"zero scaling factor": {
+			sender:         governor,
+			scalingFactors: []uint64{0, 1},
+			duration:       time.Hour,
+			expectPass:     false,
+		},
+		"negative duration": {
+			sender:         governor,
+			scalingFactors: []uint64{2, 1},
+			duration:       -time.Hour,
+			expectPass:     false,
+		},
+		// PokePool divides by the duration in milliseconds
+		"immediate change": {
+			sender:         governor,
+			scalingFactors: []uint64{2, 1},
+			expectPass:     false,
+		},
+		"sub millisecond duration": {
+			sender:         governor,
+			scalingFactors: []uint64{2, 1},
+			duration:       time.Microsecond,
+			expectPass:     false,
+		},
+		"duration under the minimum": {
+			sender:         governor,
+			scalingFactors: []uint64{2, 1},
+			duration:       MinScalingFactorChangeDuration - time.Second,
+			expectPass:     false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool, err := NewStableswapPool(1, PoolParams{SwapFee: defaultSwapFee, ExitFee: defaultExitFee}, defaultTwoAssetLiquidity, nil, governor.String(), startTime)
+			require.NoError(t, err)
+
+			err = pool.SetScalingFactors(startTime, tc.scalingFactors, tc.duration, tc.sender)
+			if !tc.expectPass {
+				require.Error(t, err)
+				require.Equal(t, []uint64{1, 1}, pool.GetScalingFactors())
+				require.Nil(t, pool.ScalingFactorChange)
+				return
+			}
+			require.NoError(t, err)
+
+			target, targetTime := pool.GetTargetScalingFactors()
+			require.Equal(t, tc.scalingFactors, target)
+			require.Equal(t, []uint64{1, 1}, pool.GetScalingFactors())
+			require.Equal(t, startTime.Add(tc.duration), *targetTime)
+		})
+	}
+}
+
+func TestPokePoolRampsScalingFactors(t *testing.T) {
+	governor := sdk.AccAddress([]byte("governor____________"))
+	startTime := time.Unix(1_000_000, 0)
+	pool, err := NewStableswapPool(1, PoolParams{SwapFee: defaultSwapFee, ExitFee: defaultExitFee}, defaultTwoAssetLiquidity, []uint64{1, 100}, governor.String(), startTime)
+	require.NoError(t, err)
+	require.NoError(t, pool.SetScalingFactors(startTime, []uint64{101, 1}, 100*time.Hour, governor))
+
+	tests := []struct {
+		elapsed                time.Duration
+		expectedScalingFactors []uint64
+	}{
+		{0, []uint64{1, 100}},
+		// changes are truncated towards the initial scaling factors
+		{time.Hour, []uint64{2, 100}},
+		{50 * time.Hour, []uint64{51, 51}},
+		{99*time.Hour + 59*time.Minute, []uint64{100, 2}},
+		{100 * time.Hour, []uint64{101, 1}},
+	}
+
+	for _, tc := range tests {
+		// poking is idempotent at any given time, and only moves forward
+		pool.PokePool(startTime.Add(tc.elapsed))
+		pool.PokePool(startTime.Add(tc.elapsed))
+		require.Equal(t, tc.expectedScalingFactors, pool.GetScalingFactors(), "elapsed: %s", tc.elapsed)
+	}
+
+	// the change is over, so it is cleared
+	require.Nil(t, pool.ScalingFactorChange)
+	pool.PokePool(startTime.Add(200 * time.Hour))
+	require.Equal(t, []uint64{101, 1}, pool.GetScalingFactors())
+}
@@ -1,9 +1,11 @@
 package stableswap
 
 import (
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 )
@@ -12,18 +14,67 @@ var _ types.PoolI = &Pool{}
 
 // NewStableswapPool returns a stableswap pool
 // Invariants that are assumed to be satisfied and not checked:
-// * len(initialLiquidity) = 2
+// * 2 <= len(initialLiquidity) <= 8
+// * scalingFactors is empty, or has one positive scaling factor per asset, ordered as initialLiquidity
 // * FutureGovernor is valid
 // * poolID doesn't already exist
-func NewStableswapPool(poolId uint64, stableswapPoolParams PoolParams, initialLiquidity sdk.Coins, futureGovernor string, blockTime time.Time) (Pool, error) {
+func NewStableswapPool(poolId uint64, stableswapPoolParams PoolParams, initialLiquidity sdk.Coins, scalingFactors []uint64, futureGovernor string, blockTime time.Time) (Pool, error) {
+	if len(scalingFactors) == 0 {
+		scalingFactors = defaultScalingFactors(len(initialLiquidity))
+	}
+
 	pool := Pool{
 		Address:            types.NewPoolAddress(poolId).String(),
 		Id:                 poolId,
 		PoolParams:         stableswapPoolParams,
 		TotalShares:        sdk.NewCoin(types.GetPoolShareDenom(poolId), types.InitPoolSharesSupply),
 		PoolLiquidity:      initialLiquidity,
+		ScalingFactor:      scalingFactors,
 		FuturePoolGovernor: futureGovernor,
 	}
 
 	return pool, nil
 }
+
+// defaultScalingFactors returns a scaling factor of 1 for each of the numAssets pool assets.
+func defaultScalingFactors(numAssets int) []uint64 {
+	scalingFactors := make([]uint64, numAssets)
+	for i := range scalingFactors {
+		scalingFactors[i] = 1
+	}
+	return scalingFactors
+}
+
+// validateScalingFactors checks that scalingFactors is either empty,
+// or has one positive scaling factor for each of the numAssets pool assets.
+func validateScalingFactors(scalingFactors []uint64, numAssets int) error {
+	if len(scalingFactors) == 0 {
+		return nil
+	}
+	if len(scalingFactors) != numAssets {
+		return sdkerrors.Wrapf(types.ErrInvalidScalingFactors, "got %d scaling factors for %d assets", len(scalingFactors), numAssets)
+	}
+	for _, scalingFactor := range scalingFactors {
+		if scalingFactor == 0 || scalingFactor > math.MaxInt64 {
+			return sdkerrors.Wrapf(types.ErrInvalidScalingFactors, "scaling factor %d must be positive and fit in an int64", scalingFactor)
+		}
+	}
+	return nil
+}
+
+// interpolateScalingFactors returns the scaling factors that are a fraction t of the way
+// from initial to target, truncated to integers. initial and target must have the same length,
+// and t must be in [0, 1].
+func interpolateScalingFactors(initial, target []uint64, t sdk.Dec) []uint64 {
+	scalingFactors := make([]uint64, len(initial))
+	for i := range initial {
+		initialFactor := sdk.NewIntFromUint64(initial[i])
+		diff := sdk.NewIntFromUint64(target[i]).Sub(initialFactor)
+		scalingFactor := initialFactor.Add(diff.ToDec().Mul(t).TruncateInt())
+		if !scalingFactor.IsPositive() {
+			scalingFactor = sdk.OneInt()
+		}
+		scalingFactors[i] = scalingFactor.Uint64()
+	}
+	return scalingFactors
+}
@@ -10,18 +10,21 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +39,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PoolParams struct {
 	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swapFee" yaml:"swap_fee"`
 	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exitFee" yaml:"exit_fee"`
+	// in-progress change of the scaling factors, applied when the pool is poked
+	ScalingFactorChange *ScalingFactorChange `protobuf:"bytes,8,opt,name=scaling_factor_change,json=scalingFactorChange,proto3" json:"scaling_factor_change,omitempty" yaml:"scaling_factor_change"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -71,6 +76,88 @@ func (m *PoolParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PoolParams proto.InternalMessageInfo
 
+func (m *PoolParams) GetScalingFactorChange() *ScalingFactorChange {
+	if m != nil {
+		return m.ScalingFactorChange
+	}
+	return nil
+}
+
+// ScalingFactorChange describes a linear change of a pool's scaling factors
+// over time, from initial_scaling_factors to target_scaling_factors.
+type ScalingFactorChange struct {
+	// The start time for beginning the scaling factor change.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Duration for the scaling factors to change over
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The scaling factors of the pool at start_time.
+	InitialScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=initial_scaling_factors,json=initialScalingFactors,proto3" json:"initial_scaling_factors,omitempty" yaml:"initial_scaling_factors"`
+	// The scaling factors of the pool at start_time + duration. The scaling
+	// factors change linearly with respect to time in between.
+	TargetScalingFactors []uint64 `protobuf:"varint,4,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+}
+
+func (m *ScalingFactorChange) Reset()         { *m = ScalingFactorChange{} }
+func (m *ScalingFactorChange) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorChange) ProtoMessage()    {}
+func (*ScalingFactorChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{1}
+}
+func (m *ScalingFactorChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorChange.Merge(m, src)
+}
+func (m *ScalingFactorChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorChange proto.InternalMessageInfo
+
+func (m *ScalingFactorChange) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorChange) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ScalingFactorChange) GetInitialScalingFactors() []uint64 {
+	if m != nil {
+		return m.InitialScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorChange) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
 // Pool is the stableswap Pool struct
 type Pool struct {
 	Address    string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
@@ -91,12 +178,14 @@ type Pool struct {
 	PoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=poolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"poolLiquidity"`
 	// for calculation amognst assets with different precisions
 	ScalingFactor []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty" yaml:"stableswap_scaling_factor"`
+	// in-progress change of the scaling factors, applied when the pool is poked
+	ScalingFactorChange *ScalingFactorChange `protobuf:"bytes,8,opt,name=scaling_factor_change,json=scalingFactorChange,proto3" json:"scaling_factor_change,omitempty" yaml:"scaling_factor_change"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
 func (*Pool) ProtoMessage() {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae0f054436f9999a, []int{1}
+	return fileDescriptor_ae0f054436f9999a, []int{2}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -127,6 +216,7 @@ var xxx_messageInfo_Pool proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
+	proto.RegisterType((*ScalingFactorChange)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorChange")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
 }
 
@@ -135,45 +225,58 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x9b, 0xb4, 0x11, 0x57, 0x35, 0x08, 0xd3, 0xc1, 0x6d, 0x85, 0x1d, 0x59, 0x02, 0x45,
-	0x88, 0xf8, 0x28, 0x48, 0x20, 0x3a, 0x41, 0x40, 0x45, 0x48, 0x48, 0x14, 0xb3, 0xa0, 0x32, 0x44,
-	0x67, 0xfb, 0xe2, 0x9c, 0xb0, 0x73, 0xae, 0xef, 0x1c, 0x9a, 0x81, 0x9d, 0x91, 0x91, 0xb1, 0x33,
-	0x33, 0xbf, 0x01, 0x3a, 0x56, 0x4c, 0x88, 0xc1, 0xa0, 0xe4, 0x1f, 0xe4, 0x17, 0xa0, 0x3b, 0x5f,
-	0xd2, 0xa4, 0xa0, 0xaa, 0x12, 0x53, 0xee, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0xdf, 0xcb, 0x4b, 0xc0,
-	0x03, 0xca, 0x12, 0xca, 0x08, 0x83, 0x11, 0x4a, 0x12, 0x98, 0x52, 0x1a, 0xb7, 0x12, 0x1a, 0xe2,
-	0x98, 0x41, 0xc6, 0x91, 0x1f, 0x63, 0xf6, 0x0e, 0xa5, 0x73, 0xcf, 0x8e, 0x40, 0xb8, 0x69, 0x46,
-	0x39, 0x35, 0x6e, 0x2a, 0xaa, 0x2b, 0xa8, 0xae, 0x28, 0x94, 0x4c, 0xf7, 0x14, 0xee, 0x0e, 0xb6,
-	0x7d, 0xcc, 0xd1, 0xf6, 0xe6, 0x46, 0x20, 0xc1, 0x1d, 0xc9, 0x84, 0x65, 0x50, 0xca, 0x6c, 0xae,
-	0x47, 0x34, 0xa2, 0x65, 0x5e, 0xbc, 0x54, 0xd6, 0x8a, 0x28, 0x8d, 0x62, 0x0c, 0x65, 0xe4, 0xe7,
-	0x5d, 0x18, 0xe6, 0x19, 0xe2, 0x84, 0xf6, 0x55, 0xdd, 0x3e, 0x5b, 0xe7, 0x24, 0xc1, 0x8c, 0xa3,
-	0x24, 0x9d, 0x0a, 0x94, 0x4d, 0x20, 0xca, 0x79, 0x0f, 0xaa, 0x31, 0x64, 0x70, 0xa6, 0xee, 0x23,
-	0x86, 0x67, 0xf5, 0x80, 0x12, 0xd5, 0xc0, 0xf9, 0xaa, 0x03, 0xb0, 0x47, 0x69, 0xbc, 0x87, 0x32,
-	0x94, 0x30, 0xe3, 0x0d, 0xa8, 0x09, 0x43, 0xbb, 0x18, 0x9b, 0x7a, 0x43, 0x6f, 0x5e, 0x6a, 0x3f,
-	0x3a, 0x2e, 0x6c, 0xed, 0x67, 0x61, 0xdf, 0x88, 0x08, 0xef, 0xe5, 0xbe, 0x1b, 0xd0, 0x44, 0xf9,
-	0x52, 0x1f, 0x2d, 0x16, 0xbe, 0x85, 0x7c, 0x98, 0x62, 0xe6, 0x3e, 0xc1, 0xc1, 0xa4, 0xb0, 0x2f,
-	0x0f, 0x51, 0x12, 0xef, 0x38, 0x72, 0x8d, 0x5d, 0x8c, 0x1d, 0x6f, 0xaa, 0x28, 0xc4, 0xf1, 0x21,
-	0xe1, 0x42, 0x7c, 0xe9, 0xff, 0xc4, 0x85, 0x8c, 0x12, 0x57, 0x8a, 0xce, 0xb7, 0x2a, 0xa8, 0x0a,
-	0x23, 0xc6, 0x2d, 0x50, 0x43, 0x61, 0x98, 0x61, 0xc6, 0x94, 0x05, 0x63, 0x52, 0xd8, 0xf5, 0x92,
-	0xa7, 0x0a, 0x8e, 0x37, 0x85, 0x18, 0x75, 0xb0, 0x44, 0x42, 0x39, 0x4e, 0xd5, 0x5b, 0x22, 0xa1,
-	0xf1, 0x1e, 0x80, 0x74, 0xb6, 0x0e, 0xb3, 0xd2, 0xd0, 0x9b, 0xab, 0x77, 0xee, 0xb9, 0x17, 0x3f,
-	0x01, 0xf7, 0x74, 0x99, 0xed, 0xeb, 0xc2, 0xde, 0xa4, 0xb0, 0xaf, 0xa9, 0x8d, 0x2c, 0x9e, 0x57,
-	0x27, 0x95, 0x28, 0xc7, 0x9b, 0x6b, 0x68, 0xbc, 0x04, 0xeb, 0xdd, 0x9c, 0xe7, 0x19, 0x2e, 0x21,
-	0x11, 0x1d, 0xe0, 0xac, 0x4f, 0x33, 0xb3, 0x2a, 0x9d, 0xd8, 0x93, 0xc2, 0xde, 0x2a, 0xc5, 0xfe,
-	0x85, 0x72, 0x3c, 0xa3, 0x4c, 0x8b, 0x19, 0x9e, 0xaa, 0xa4, 0xf1, 0x1a, 0xac, 0x72, 0xca, 0x51,
-	0xfc, 0xaa, 0x87, 0x32, 0xcc, 0xcc, 0x65, 0x69, 0x69, 0xc3, 0x55, 0xc7, 0x29, 0xee, 0x62, 0x36,
-	0xfb, 0x63, 0x4a, 0xfa, 0xed, 0x2d, 0x35, 0xf5, 0xd5, 0xb2, 0x91, 0xe4, 0x76, 0x98, 0x24, 0x3b,
-	0xde, 0xbc, 0x94, 0x71, 0x00, 0xd6, 0x44, 0xff, 0xe7, 0xe4, 0x20, 0x27, 0x21, 0xe1, 0x43, 0x73,
-	0xa5, 0x51, 0x39, 0x5f, 0xfb, 0xb6, 0xd0, 0xfe, 0xfc, 0xcb, 0x6e, 0x5e, 0xe0, 0x0b, 0x17, 0x04,
-	0xe6, 0x2d, 0x76, 0x30, 0x5e, 0x80, 0x3a, 0x0b, 0x50, 0x4c, 0xfa, 0x51, 0xa7, 0x8b, 0x02, 0x4e,
-	0x33, 0xb3, 0xd6, 0xa8, 0x34, 0xab, 0xed, 0xa6, 0x1a, 0xba, 0xf1, 0xd7, 0xaa, 0x17, 0xe1, 0x8e,
-	0xb7, 0xa6, 0x12, 0xbb, 0x32, 0xde, 0xb9, 0xf2, 0xe1, 0xc8, 0xd6, 0x3e, 0x1d, 0xd9, 0xda, 0xf7,
-	0x2f, 0xad, 0x65, 0xb1, 0xb7, 0x67, 0xed, 0xfd, 0xe3, 0x91, 0xa5, 0x9f, 0x8c, 0x2c, 0xfd, 0xf7,
-	0xc8, 0xd2, 0x3f, 0x8e, 0x2d, 0xed, 0x64, 0x6c, 0x69, 0x3f, 0xc6, 0x96, 0xb6, 0xff, 0x70, 0x6e,
-	0x6c, 0x75, 0x12, 0xad, 0x18, 0xf9, 0x6c, 0x1a, 0xc0, 0xc1, 0x7d, 0x78, 0x78, 0xde, 0x5f, 0x8c,
-	0xbf, 0x22, 0x7f, 0x75, 0x77, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x82, 0x70, 0xe7, 0x15, 0x90,
-	0x04, 0x00, 0x00,
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xe3, 0x24, 0xdb, 0x6d, 0x27, 0xea, 0xa2, 0xce, 0x6e, 0xc1, 0xdd, 0xb2, 0x9e, 0x30,
+	0x02, 0x14, 0xa1, 0xc6, 0x66, 0x17, 0x09, 0x44, 0x2f, 0x80, 0x5b, 0x15, 0x21, 0x21, 0x51, 0x5c,
+	0x24, 0xaa, 0xe5, 0x60, 0x4d, 0xe2, 0x89, 0x33, 0xc2, 0xce, 0xb8, 0x9e, 0xc9, 0xd2, 0x1c, 0xb8,
+	0x73, 0x42, 0x3d, 0x96, 0x5b, 0xcf, 0x9c, 0xf9, 0x10, 0x7b, 0xac, 0x38, 0x21, 0x0e, 0x2e, 0x4a,
+	0x6e, 0x1c, 0xf3, 0x09, 0xd0, 0x8c, 0xc7, 0xd9, 0xa4, 0x09, 0xab, 0x45, 0x5c, 0x7a, 0x8a, 0x67,
+	0xde, 0xff, 0xfd, 0xde, 0x9b, 0xf7, 0xde, 0x4c, 0xc0, 0xc7, 0x5c, 0xa4, 0x5c, 0x30, 0xe1, 0xc5,
+	0x24, 0x4d, 0xbd, 0x8c, 0xf3, 0xa4, 0x9b, 0xf2, 0x88, 0x26, 0xc2, 0x13, 0x92, 0xf4, 0x12, 0x2a,
+	0x7e, 0x20, 0xd9, 0xd2, 0x67, 0xa8, 0x14, 0x6e, 0x96, 0x73, 0xc9, 0xe1, 0x7b, 0xc6, 0xd5, 0x55,
+	0xae, 0xae, 0x32, 0x94, 0x9e, 0xee, 0x99, 0xdc, 0x3d, 0x39, 0xec, 0x51, 0x49, 0x0e, 0xf7, 0x6f,
+	0xf4, 0xb5, 0x38, 0xd4, 0x9e, 0x5e, 0xb9, 0x28, 0x31, 0xfb, 0x7b, 0x31, 0x8f, 0x79, 0xb9, 0xaf,
+	0xbe, 0xcc, 0xae, 0x13, 0x73, 0x1e, 0x27, 0xd4, 0xd3, 0xab, 0xde, 0x78, 0xe0, 0x45, 0xe3, 0x9c,
+	0x48, 0xc6, 0x47, 0xc6, 0x8e, 0x5e, 0xb6, 0x4b, 0x96, 0x52, 0x21, 0x49, 0x9a, 0x55, 0x80, 0x32,
+	0x88, 0x47, 0xc6, 0x72, 0xe8, 0x99, 0x34, 0xf4, 0xe2, 0x25, 0x7b, 0x8f, 0x08, 0xba, 0xb0, 0xf7,
+	0x39, 0x33, 0x01, 0xf0, 0xb4, 0x0e, 0xc0, 0x7d, 0xce, 0x93, 0xfb, 0x24, 0x27, 0xa9, 0x80, 0xdf,
+	0x81, 0x6d, 0x75, 0xa0, 0x7b, 0x94, 0xda, 0x56, 0xdb, 0xea, 0x5c, 0xf1, 0x3f, 0x3b, 0x2d, 0x50,
+	0xed, 0xcf, 0x02, 0xbd, 0x1b, 0x33, 0x39, 0x1c, 0xf7, 0xdc, 0x3e, 0x4f, 0xcd, 0xb9, 0xcc, 0x4f,
+	0x57, 0x44, 0xdf, 0x7b, 0x72, 0x92, 0x51, 0xe1, 0xde, 0xa5, 0xfd, 0x79, 0x81, 0x5e, 0x9b, 0x90,
+	0x34, 0xb9, 0x8d, 0x75, 0x19, 0x07, 0x94, 0xe2, 0xa0, 0x22, 0x2a, 0x38, 0x7d, 0xcc, 0xa4, 0x82,
+	0xd7, 0xff, 0x1f, 0x5c, 0x61, 0x0c, 0xdc, 0x10, 0xe1, 0x2f, 0x16, 0xb8, 0x2e, 0xfa, 0x24, 0x61,
+	0xa3, 0x38, 0x1c, 0x90, 0xbe, 0xe4, 0x79, 0xd8, 0x1f, 0x92, 0x51, 0x4c, 0xed, 0xcb, 0x6d, 0xab,
+	0xd3, 0x3a, 0xfa, 0xc4, 0xbd, 0x78, 0x1f, 0xdd, 0x07, 0x25, 0xe8, 0x9e, 0xe6, 0xdc, 0xd1, 0x18,
+	0xff, 0xed, 0xd3, 0x02, 0x59, 0xf3, 0x02, 0xbd, 0x69, 0xce, 0xb7, 0x29, 0x16, 0x0e, 0x76, 0xc5,
+	0xba, 0x2b, 0xfe, 0xb9, 0x01, 0x76, 0x37, 0x20, 0xe1, 0x43, 0x00, 0x84, 0x24, 0xb9, 0x0c, 0x55,
+	0x57, 0x75, 0xc1, 0x5b, 0x47, 0xfb, 0x6e, 0xd9, 0x72, 0xb7, 0x6a, 0xb9, 0xfb, 0x4d, 0xd5, 0x72,
+	0xff, 0x40, 0xd5, 0x6b, 0x5e, 0xa0, 0x6b, 0x26, 0x85, 0x85, 0x2f, 0x7e, 0xf2, 0x02, 0x59, 0xc1,
+	0x15, 0xbd, 0xa1, 0xe4, 0x70, 0x08, 0x2e, 0x57, 0x93, 0xa4, 0x6b, 0xdd, 0x3a, 0xba, 0xb1, 0xc6,
+	0xbd, 0x6b, 0x04, 0xfe, 0xa1, 0xc2, 0xfe, 0x5d, 0x20, 0x58, 0xb9, 0xdc, 0xe2, 0x29, 0x93, 0x34,
+	0xcd, 0xe4, 0xe4, 0xac, 0xe4, 0x95, 0x0d, 0x3f, 0x55, 0xa1, 0x16, 0x74, 0x78, 0x0c, 0xde, 0x60,
+	0x23, 0x26, 0x19, 0x49, 0xc2, 0xd5, 0x92, 0x08, 0xbb, 0xd1, 0x6e, 0x74, 0x9a, 0x3e, 0x9e, 0x17,
+	0xc8, 0x29, 0x19, 0xff, 0x22, 0xc4, 0xc1, 0x75, 0x63, 0x59, 0x29, 0x92, 0x80, 0xdf, 0x82, 0xd7,
+	0x25, 0xc9, 0x63, 0x2a, 0xd7, 0xd0, 0x4d, 0x8d, 0x7e, 0x6b, 0x5e, 0xa0, 0x83, 0x12, 0xbd, 0x59,
+	0x87, 0x83, 0xbd, 0xd2, 0xb0, 0x0a, 0xc6, 0xb3, 0x2d, 0xd0, 0x54, 0x53, 0x0f, 0x6f, 0x81, 0x6d,
+	0x12, 0x45, 0x39, 0x15, 0xc2, 0xcc, 0x3b, 0x9c, 0x17, 0x68, 0xa7, 0x44, 0x1a, 0x03, 0x0e, 0x2a,
+	0x09, 0xdc, 0x01, 0x75, 0x16, 0xe9, 0x7a, 0x36, 0x83, 0x3a, 0x8b, 0xe0, 0x8f, 0x00, 0x64, 0x8b,
+	0xbb, 0x63, 0x37, 0x74, 0x9d, 0x3f, 0xfc, 0x2f, 0x73, 0x76, 0x76, 0xf3, 0xfc, 0x77, 0x4c, 0x6f,
+	0x0f, 0x16, 0xbd, 0x5d, 0x7e, 0x8b, 0xc2, 0x4c, 0xab, 0x70, 0xb0, 0x14, 0x10, 0x7e, 0x0d, 0xf6,
+	0x06, 0x63, 0x39, 0xce, 0x69, 0x29, 0x89, 0xf9, 0x09, 0xcd, 0x47, 0x3c, 0xb7, 0x9b, 0xfa, 0x24,
+	0x68, 0x5e, 0xa0, 0x9b, 0x25, 0x6c, 0x93, 0x0a, 0x07, 0xb0, 0xdc, 0x56, 0x39, 0x7c, 0x6e, 0x36,
+	0xe1, 0x43, 0xd0, 0x92, 0x5c, 0x92, 0xe4, 0xc1, 0x90, 0xe4, 0x54, 0xd8, 0x5b, 0x66, 0x74, 0xcc,
+	0x4b, 0xa6, 0x1e, 0x91, 0x45, 0xee, 0x77, 0x38, 0x1b, 0xf9, 0x37, 0x4d, 0xd6, 0xbb, 0xa6, 0x0b,
+	0xca, 0x37, 0x14, 0xda, 0x19, 0x07, 0xcb, 0x28, 0xf8, 0x08, 0x5c, 0x55, 0xf1, 0xbf, 0x64, 0x8f,
+	0xc6, 0x2c, 0x62, 0x72, 0x62, 0x5f, 0x6a, 0x37, 0xce, 0x67, 0xbf, 0xaf, 0xd8, 0xbf, 0xbe, 0x40,
+	0x9d, 0x0b, 0xbc, 0x0e, 0xca, 0x41, 0x04, 0xab, 0x11, 0xe0, 0x57, 0x60, 0x67, 0x75, 0x1e, 0xec,
+	0x6d, 0x3d, 0x36, 0x1d, 0x93, 0x74, 0x7b, 0xad, 0xd4, 0xab, 0x72, 0x1c, 0x5c, 0x5d, 0xb9, 0xcd,
+	0xaf, 0xf2, 0x1b, 0x73, 0xfb, 0xda, 0x4f, 0xcf, 0x50, 0xed, 0xe9, 0x33, 0x54, 0xfb, 0xfd, 0xb7,
+	0xee, 0x96, 0xea, 0xe9, 0x17, 0xfe, 0xf1, 0xe9, 0xd4, 0xb1, 0x9e, 0x4f, 0x1d, 0xeb, 0xaf, 0xa9,
+	0x63, 0x3d, 0x99, 0x39, 0xb5, 0xe7, 0x33, 0xa7, 0xf6, 0xc7, 0xcc, 0xa9, 0x1d, 0x7f, 0xba, 0x54,
+	0x52, 0x93, 0x72, 0x37, 0x21, 0x3d, 0x51, 0x2d, 0xbc, 0x93, 0x8f, 0xbc, 0xc7, 0xe7, 0xfd, 0x57,
+	0xf6, 0x2e, 0xe9, 0x67, 0xe4, 0x83, 0x7f, 0x06, 0x00, 0x6b, 0xf1, 0x1d, 0x98, 0x59, 0x07, 0x00,
+	0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -196,6 +299,18 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScalingFactorChange != nil {
+		{
+			size, err := m.ScalingFactorChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size := m.ExitFee.Size()
 		i -= size
@@ -219,6 +334,81 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA3 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j2 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InitialScalingFactors) > 0 {
+		dAtA5 := make([]byte, len(m.InitialScalingFactors)*10)
+		var j4 int
+		for _, num := range m.InitialScalingFactors {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -239,21 +429,33 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScalingFactorChange != nil {
+		{
+			size, err := m.ScalingFactorChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.ScalingFactor) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactor)*10)
-		var j1 int
+		dAtA10 := make([]byte, len(m.ScalingFactor)*10)
+		var j9 int
 		for _, num := range m.ScalingFactor {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j9++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA10[j9] = uint8(num)
+			j9++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j9))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -334,6 +536,37 @@ func (m *PoolParams) Size() (n int) {
 	n += 1 + l + sovStableswapPool(uint64(l))
 	l = m.ExitFee.Size()
 	n += 1 + l + sovStableswapPool(uint64(l))
+	if m.ScalingFactorChange != nil {
+		l = m.ScalingFactorChange.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	return n
+}
+
+func (m *ScalingFactorChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.InitialScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.InitialScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
 	return n
 }
 
@@ -371,6 +604,10 @@ func (m *Pool) Size() (n int) {
 		}
 		n += 1 + sovStableswapPool(uint64(l)) + l
 	}
+	if m.ScalingFactorChange != nil {
+		l = m.ScalingFactorChange.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
 	return n
 }
 
@@ -477,6 +714,310 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorChange == nil {
+				m.ScalingFactorChange = &ScalingFactorChange{}
+			}
+			if err := m.ScalingFactorChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InitialScalingFactors) == 0 {
+					m.InitialScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScalingFactors", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -786,6 +1327,42 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactor", wireType)
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorChange == nil {
+				m.ScalingFactorChange = &ScalingFactorChange{}
+			}
+			if err := m.ScalingFactorChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +39,9 @@ type MsgCreateStableswapPool struct {
 	PoolParams           *PoolParams                              `protobuf:"bytes,2,opt,name=poolParams,proto3" json:"poolParams,omitempty" yaml:"pool_params"`
 	InitialPoolLiquidity github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=initial_pool_liquidity,json=initialPoolLiquidity,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_pool_liquidity"`
 	FuturePoolGovernor   string                                   `protobuf:"bytes,4,opt,name=future_pool_governor,json=futurePoolGovernor,proto3" json:"future_pool_governor,omitempty" yaml:"future_pool_governor"`
+	// scaling factors of the pool assets, in the same order as the sorted
+	// initial pool liquidity. If empty, every asset has a scaling factor of 1.
+	ScalingFactors []uint64 `protobuf:"varint,5,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
 }
 
 func (m *MsgCreateStableswapPool) Reset()         { *m = MsgCreateStableswapPool{} }
@@ -98,6 +105,13 @@ func (m *MsgCreateStableswapPool) GetFuturePoolGovernor() string {
 	return ""
 }
 
+func (m *MsgCreateStableswapPool) GetScalingFactors() []uint64 {
+	if m != nil {
+		return m.ScalingFactors
+	}
+	return nil
+}
+
 type MsgCreateStableswapPoolResponse struct {
 	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
 }
@@ -142,9 +156,125 @@ func (m *MsgCreateStableswapPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// MsgStableSwapAdjustScalingFactors changes the scaling factors of a stableswap
+// pool. It must be sent by the pool's future_pool_governor.
+type MsgStableSwapAdjustScalingFactors struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// new scaling factors of the pool assets, in the same order as the sorted
+	// pool liquidity.
+	ScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
+	// duration over which the scaling factors change linearly to the new
+	// scaling factors. It must be at least an hour.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+}
+
+func (m *MsgStableSwapAdjustScalingFactors) Reset()         { *m = MsgStableSwapAdjustScalingFactors{} }
+func (m *MsgStableSwapAdjustScalingFactors) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapAdjustScalingFactors) ProtoMessage()    {}
+func (*MsgStableSwapAdjustScalingFactors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{2}
+}
+func (m *MsgStableSwapAdjustScalingFactors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapAdjustScalingFactors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapAdjustScalingFactors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapAdjustScalingFactors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapAdjustScalingFactors.Merge(m, src)
+}
+func (m *MsgStableSwapAdjustScalingFactors) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapAdjustScalingFactors) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapAdjustScalingFactors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapAdjustScalingFactors proto.InternalMessageInfo
+
+func (m *MsgStableSwapAdjustScalingFactors) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapAdjustScalingFactors) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgStableSwapAdjustScalingFactors) GetScalingFactors() []uint64 {
+	if m != nil {
+		return m.ScalingFactors
+	}
+	return nil
+}
+
+func (m *MsgStableSwapAdjustScalingFactors) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgStableSwapAdjustScalingFactorsResponse struct {
+}
+
+func (m *MsgStableSwapAdjustScalingFactorsResponse) Reset() {
+	*m = MsgStableSwapAdjustScalingFactorsResponse{}
+}
+func (m *MsgStableSwapAdjustScalingFactorsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgStableSwapAdjustScalingFactorsResponse) ProtoMessage() {}
+func (*MsgStableSwapAdjustScalingFactorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{3}
+}
+func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse.Merge(m, src)
+}
+func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
+	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
+	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
 }
 
 func init() {
@@ -152,38 +282,49 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0x6b, 0x7a, 0x2a, 0xc2, 0x27, 0x06, 0xac, 0xea, 0x28, 0x45, 0x4a, 0xaa, 0xb0, 0x14,
-	0x44, 0x6d, 0xae, 0x48, 0x20, 0x98, 0x50, 0x8a, 0x40, 0x27, 0x38, 0xa9, 0x84, 0xed, 0x96, 0xca,
-	0x69, 0x4c, 0xb0, 0x48, 0xe2, 0x10, 0xbb, 0xe5, 0x3a, 0x32, 0xb3, 0xf0, 0x31, 0x10, 0x13, 0x1f,
-	0xe3, 0xc6, 0x1b, 0x99, 0x02, 0x6a, 0xbf, 0x41, 0x3f, 0x01, 0xb2, 0x9d, 0xf4, 0x6e, 0xb8, 0x43,
-	0x45, 0x62, 0xea, 0xdb, 0x27, 0xbf, 0xf7, 0x7d, 0xde, 0x3f, 0x86, 0xf7, 0x85, 0x4c, 0x85, 0xe4,
-	0x92, 0xc4, 0x34, 0x4d, 0x49, 0x2e, 0x44, 0x32, 0x48, 0x45, 0xc4, 0x12, 0x49, 0xa4, 0xa2, 0x61,
-	0xc2, 0xe4, 0x27, 0x9a, 0x13, 0x75, 0x8c, 0xf3, 0x42, 0x28, 0x81, 0xee, 0x55, 0x34, 0xd6, 0x34,
-	0xd6, 0xb4, 0x85, 0xf1, 0x19, 0x8c, 0xe7, 0xfb, 0x21, 0x53, 0x74, 0xbf, 0xeb, 0x4c, 0x0d, 0x4c,
-	0x42, 0x2a, 0x19, 0xa9, 0x44, 0x32, 0x15, 0x3c, 0xb3, 0xb5, 0xba, 0xed, 0x58, 0xc4, 0xc2, 0x84,
-	0x44, 0x47, 0x95, 0xfa, 0x64, 0x9b, 0x7e, 0xce, 0xc2, 0x89, 0x26, 0x6c, 0xaa, 0xf7, 0xa5, 0x09,
-	0x6f, 0x1e, 0xca, 0x78, 0x54, 0x30, 0xaa, 0xd8, 0xdb, 0x0d, 0x32, 0x16, 0x22, 0x41, 0x77, 0x61,
-	0x4b, 0xb2, 0x2c, 0x62, 0x45, 0x07, 0xf4, 0x40, 0xff, 0x9a, 0x7f, 0x63, 0x5d, 0xba, 0xd7, 0x17,
-	0x34, 0x4d, 0x9e, 0x7a, 0x56, 0xf7, 0x82, 0x0a, 0x40, 0x19, 0x84, 0xba, 0xe8, 0x98, 0x16, 0x34,
-	0x95, 0x9d, 0x2b, 0x3d, 0xd0, 0xdf, 0x1d, 0x3e, 0xc2, 0xdb, 0x0f, 0x8e, 0xc7, 0x9b, 0x6c, 0x7f,
-	0x6f, 0x5d, 0xba, 0xc8, 0xda, 0xe8, 0x9c, 0x49, 0x6e, 0x64, 0x2f, 0x38, 0xe7, 0x80, 0x3e, 0x03,
-	0xb8, 0xc7, 0x33, 0xae, 0x38, 0x4d, 0xcc, 0x34, 0x93, 0x84, 0x7f, 0x9c, 0xf1, 0x88, 0xab, 0x45,
-	0xa7, 0xd9, 0x6b, 0xf6, 0x77, 0x87, 0xb7, 0xb0, 0xdd, 0x24, 0xd6, 0x9b, 0xdc, 0xb8, 0x8c, 0x04,
-	0xcf, 0xfc, 0x07, 0x27, 0xa5, 0xdb, 0xf8, 0xfe, 0xcb, 0xed, 0xc7, 0x5c, 0xbd, 0x9f, 0x85, 0x78,
-	0x2a, 0x52, 0x52, 0xad, 0xdd, 0xfe, 0x0c, 0x64, 0xf4, 0x81, 0xa8, 0x45, 0xce, 0xa4, 0x49, 0x90,
-	0x41, 0xbb, 0xb2, 0xd2, 0x4d, 0xbe, 0xae, 0x8d, 0xd0, 0x1b, 0xd8, 0x7e, 0x37, 0x53, 0xb3, 0x82,
-	0xd9, 0x0e, 0x62, 0x31, 0x67, 0x45, 0x26, 0x8a, 0xce, 0x8e, 0x59, 0x96, 0xbb, 0x2e, 0xdd, 0xdb,
-	0x76, 0x8a, 0x8b, 0x28, 0x2f, 0x40, 0x56, 0xd6, 0x35, 0x5f, 0xd6, 0xe2, 0x0b, 0xe8, 0x5e, 0x72,
-	0x8c, 0x80, 0xc9, 0x5c, 0x64, 0x92, 0xa1, 0x3b, 0xf0, 0xaa, 0x29, 0xc4, 0x23, 0x73, 0x95, 0x1d,
-	0x1f, 0x2e, 0x4b, 0xb7, 0xa5, 0x91, 0x83, 0xe7, 0x41, 0x4b, 0x7f, 0x3a, 0x88, 0x86, 0x3f, 0x00,
-	0x6c, 0x1e, 0xca, 0x18, 0x7d, 0x03, 0xb0, 0x7d, 0xe1, 0x69, 0x47, 0xff, 0x72, 0x9b, 0x4b, 0x5a,
-	0xea, 0xbe, 0xfa, 0x0f, 0x45, 0xea, 0xb9, 0xfc, 0xa3, 0x93, 0xa5, 0x03, 0x4e, 0x97, 0x0e, 0xf8,
-	0xbd, 0x74, 0xc0, 0xd7, 0x95, 0xd3, 0x38, 0x5d, 0x39, 0x8d, 0x9f, 0x2b, 0xa7, 0x71, 0xf4, 0xec,
-	0xdc, 0x9d, 0x2a, 0xc3, 0x41, 0x42, 0x43, 0x59, 0xff, 0x21, 0xf3, 0xc7, 0xe4, 0xf8, 0x6f, 0x4f,
-	0x3f, 0x6c, 0x99, 0xb7, 0xfe, 0xf0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x53, 0xea, 0x93,
-	0xb8, 0x03, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0x8d, 0x93, 0xfe, 0xf2, 0x83, 0xad, 0x68, 0x85, 0x15, 0x95, 0x10, 0x24, 0x3b, 0x18, 0x0e,
+	0x29, 0x6d, 0xbd, 0xb4, 0x48, 0x20, 0x38, 0x81, 0x5b, 0x15, 0x55, 0x10, 0xa9, 0xb8, 0xe2, 0xd2,
+	0x4b, 0xb4, 0x8e, 0xb7, 0xee, 0x82, 0xed, 0x35, 0xde, 0x75, 0xdb, 0x1c, 0xf9, 0x06, 0x1c, 0xf9,
+	0x08, 0x88, 0x33, 0x9f, 0x01, 0xf5, 0xd8, 0x23, 0xe2, 0xe0, 0xa2, 0xf4, 0xc6, 0x31, 0x37, 0x6e,
+	0x68, 0xbd, 0x76, 0xd2, 0x4a, 0xfd, 0x17, 0xd4, 0x93, 0xd7, 0xe3, 0x37, 0xf3, 0x66, 0xdf, 0x9b,
+	0x31, 0x98, 0xa7, 0x2c, 0xa0, 0x8c, 0x30, 0xe8, 0xa1, 0x20, 0x80, 0x11, 0xa5, 0xfe, 0x42, 0x40,
+	0x5d, 0xec, 0x33, 0xc8, 0x38, 0x72, 0x7c, 0xcc, 0x76, 0x51, 0x04, 0xf9, 0x9e, 0x19, 0xc5, 0x94,
+	0x53, 0xf5, 0x41, 0x8e, 0x36, 0x05, 0xda, 0x14, 0x68, 0x09, 0x36, 0x47, 0x60, 0x73, 0x67, 0xd1,
+	0xc1, 0x1c, 0x2d, 0x36, 0xb4, 0x6e, 0x06, 0x86, 0x0e, 0x62, 0x18, 0xe6, 0x41, 0xd8, 0xa5, 0x24,
+	0x94, 0xb5, 0x1a, 0x35, 0x8f, 0x7a, 0x34, 0x3b, 0x42, 0x71, 0xca, 0xa3, 0x9a, 0x47, 0xa9, 0xe7,
+	0x63, 0x98, 0xbd, 0x39, 0xc9, 0x16, 0x74, 0x93, 0x18, 0x71, 0x42, 0x8b, 0xac, 0xa7, 0x97, 0xe9,
+	0x77, 0x74, 0xec, 0x08, 0x84, 0x4c, 0x35, 0x7e, 0x56, 0xc0, 0xad, 0x36, 0xf3, 0x96, 0x63, 0x8c,
+	0x38, 0xde, 0x18, 0x42, 0xd6, 0x29, 0xf5, 0xd5, 0x59, 0x50, 0x65, 0x38, 0x74, 0x71, 0x5c, 0x57,
+	0x9a, 0x4a, 0xeb, 0xba, 0x75, 0x73, 0x90, 0xea, 0x37, 0x7a, 0x28, 0xf0, 0x9f, 0x19, 0x32, 0x6e,
+	0xd8, 0x39, 0x40, 0x0d, 0x01, 0x10, 0x45, 0xd7, 0x51, 0x8c, 0x02, 0x56, 0x2f, 0x37, 0x95, 0xd6,
+	0xe4, 0xd2, 0x63, 0xf3, 0xf2, 0xc2, 0x98, 0xeb, 0xc3, 0x6c, 0x6b, 0x66, 0x90, 0xea, 0xaa, 0xa4,
+	0x11, 0x39, 0x9d, 0x28, 0x0b, 0x1b, 0xf6, 0x31, 0x06, 0xf5, 0xa3, 0x02, 0x66, 0x48, 0x48, 0x38,
+	0x41, 0x7e, 0x76, 0x9b, 0x8e, 0x4f, 0x3e, 0x24, 0xc4, 0x25, 0xbc, 0x57, 0xaf, 0x34, 0x2b, 0xad,
+	0xc9, 0xa5, 0xdb, 0xa6, 0x54, 0xda, 0x14, 0x4a, 0x0f, 0x59, 0x96, 0x29, 0x09, 0xad, 0x87, 0xfb,
+	0xa9, 0x5e, 0xfa, 0x7a, 0xa8, 0xb7, 0x3c, 0xc2, 0xb7, 0x13, 0xc7, 0xec, 0xd2, 0x00, 0xe6, 0xb6,
+	0xc8, 0xc7, 0x02, 0x73, 0xdf, 0x43, 0xde, 0x8b, 0x30, 0xcb, 0x12, 0x98, 0x5d, 0xcb, 0xa9, 0x44,
+	0x93, 0xaf, 0x0b, 0x22, 0xf5, 0x0d, 0xa8, 0x6d, 0x25, 0x3c, 0x89, 0xb1, 0xec, 0xc0, 0xa3, 0x3b,
+	0x38, 0x0e, 0x69, 0x5c, 0x9f, 0xc8, 0xc4, 0xd2, 0x07, 0xa9, 0x7e, 0x47, 0xde, 0xe2, 0x34, 0x94,
+	0x61, 0xab, 0x32, 0x2c, 0x6a, 0xbe, 0xcc, 0x83, 0x6a, 0x1b, 0x4c, 0xb3, 0x2e, 0xf2, 0x49, 0xe8,
+	0x75, 0xb6, 0x50, 0x97, 0xd3, 0x98, 0xd5, 0xff, 0x6b, 0x56, 0x5a, 0x13, 0xd6, 0xfd, 0x41, 0xaa,
+	0x37, 0x73, 0xe9, 0x47, 0x3e, 0x9e, 0xc4, 0x1a, 0xf6, 0x54, 0x1e, 0x58, 0x95, 0xb9, 0xc6, 0x2a,
+	0xd0, 0xcf, 0xf0, 0xd6, 0xc6, 0x2c, 0xa2, 0x21, 0xc3, 0xea, 0x3d, 0xf0, 0x7f, 0xd6, 0x17, 0x71,
+	0x33, 0x93, 0x27, 0x2c, 0xd0, 0x4f, 0xf5, 0xaa, 0x80, 0xac, 0xad, 0xd8, 0x55, 0xf1, 0x69, 0xcd,
+	0x35, 0xbe, 0x95, 0xc1, 0xdd, 0x36, 0xf3, 0x64, 0x89, 0x8d, 0x5d, 0x14, 0xbd, 0x70, 0xdf, 0x25,
+	0x8c, 0x6f, 0x9c, 0x60, 0x1b, 0x67, 0x5c, 0xe6, 0x46, 0xac, 0xe5, 0x8c, 0x55, 0x1d, 0xa4, 0xfa,
+	0xd4, 0x31, 0xcf, 0x89, 0x6b, 0x14, 0xec, 0xa7, 0x89, 0x52, 0xf9, 0x77, 0x51, 0xd4, 0x6d, 0x70,
+	0xad, 0x58, 0x9f, 0xcc, 0x2a, 0x31, 0x2b, 0x72, 0xbf, 0xcc, 0x62, 0xbf, 0xcc, 0x95, 0x1c, 0x60,
+	0x2d, 0x8a, 0x59, 0xf9, 0x9d, 0xea, 0x6a, 0x91, 0x32, 0x4f, 0x03, 0xc2, 0x71, 0x10, 0xf1, 0xde,
+	0x20, 0xd5, 0xa7, 0x25, 0x79, 0xf1, 0xcd, 0xf8, 0x7c, 0xa8, 0x2b, 0xf6, 0xb0, 0xba, 0x31, 0x07,
+	0x66, 0x2f, 0x54, 0xad, 0x30, 0x62, 0xe9, 0x4f, 0x19, 0x54, 0xda, 0xcc, 0x53, 0xbf, 0x28, 0xa0,
+	0x76, 0xea, 0x36, 0x2e, 0x8f, 0xb3, 0x4e, 0x67, 0xd8, 0xde, 0x78, 0x75, 0x05, 0x45, 0x86, 0xb3,
+	0xf3, 0x5d, 0x01, 0xda, 0x05, 0x33, 0xd1, 0x1e, 0x93, 0xef, 0xfc, 0x72, 0x8d, 0xb7, 0x57, 0x5a,
+	0xae, 0xb8, 0x88, 0xb5, 0xb9, 0xdf, 0xd7, 0x94, 0x83, 0xbe, 0xa6, 0xfc, 0xea, 0x6b, 0xca, 0xa7,
+	0x23, 0xad, 0x74, 0x70, 0xa4, 0x95, 0x7e, 0x1c, 0x69, 0xa5, 0xcd, 0xe7, 0xc7, 0xfe, 0x11, 0x39,
+	0xf5, 0x82, 0x8f, 0x1c, 0x56, 0xbc, 0xc0, 0x9d, 0x27, 0x70, 0xef, 0xbc, 0xdf, 0xae, 0x53, 0xcd,
+	0x86, 0xea, 0xd1, 0xdf, 0x01, 0x00, 0x0f, 0x39, 0xdc, 0xe8, 0x54, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -199,6 +340,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
+	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
 }
 
 type msgClient struct {
@@ -218,9 +360,19 @@ func (c *msgClient) CreateStableswapPool(ctx context.Context, in *MsgCreateStabl
 	return out, nil
 }
 
+func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
+	out := new(MsgStableSwapAdjustScalingFactorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapAdjustScalingFactors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
+	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -230,6 +382,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateStableswapPool not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -253,6 +408,24 @@ func _Msg_CreateStableswapPool_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapAdjustScalingFactors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapAdjustScalingFactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapAdjustScalingFactors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapAdjustScalingFactors(ctx, req.(*MsgStableSwapAdjustScalingFactors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -261,6 +434,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStableswapPool",
 			Handler:    _Msg_CreateStableswapPool_Handler,
 		},
+		{
+			MethodName: "StableSwapAdjustScalingFactors",
+			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -286,6 +463,24 @@ func (m *MsgCreateStableswapPool) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScalingFactors) > 0 {
+		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
+		var j1 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.FuturePoolGovernor) > 0 {
 		i -= len(m.FuturePoolGovernor)
 		copy(dAtA[i:], m.FuturePoolGovernor)
@@ -357,6 +552,90 @@ func (m *MsgCreateStableswapPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapAdjustScalingFactors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapAdjustScalingFactors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapAdjustScalingFactors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ScalingFactors) > 0 {
+		dAtA6 := make([]byte, len(m.ScalingFactors)*10)
+		var j5 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapAdjustScalingFactorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -392,6 +671,13 @@ func (m *MsgCreateStableswapPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -407,6 +693,40 @@ func (m *MsgCreateStableswapPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapAdjustScalingFactors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -576,6 +896,82 @@ func (m *MsgCreateStableswapPool) Unmarshal(dAtA []byte) error {
 			}
 			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -666,6 +1062,266 @@ func (m *MsgCreateStableswapPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgStableSwapAdjustScalingFactors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapAdjustScalingFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
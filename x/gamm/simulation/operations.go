@@ -160,7 +160,8 @@ func SimulateMsgCreateBalancerPool(ak stakingTypes.AccountKeeper, bk stakingType
 
 		// set the pool params to set the pool creation fee to dust amount of denom
 		k.SetParams(ctx, types.Params{
-			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(denoms[0], 1)},
+			PoolCreationFee:               sdk.Coins{sdk.NewInt64Coin(denoms[0], 1)},
+			OsmoMultihopSwapFeeMultiplier: k.GetParams(ctx).OsmoMultihopSwapFeeMultiplier,
 		})
 
 		msg := &balancer.MsgCreateBalancerPool{
@@ -19,6 +19,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJoinSwapShareAmountOut{}, "osmosis/gamm/join-swap-share-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapExternAmountOut{}, "osmosis/gamm/exit-swap-extern-amount-out", nil)
 	cdc.RegisterConcrete(&MsgExitSwapShareAmountIn{}, "osmosis/gamm/exit-swap-share-amount-in", nil)
+	cdc.RegisterConcrete(&MsgSplitRouteSwapExactAmountIn{}, "osmosis/gamm/split-route-swap-exact-amount-in", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -26,6 +27,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		"osmosis.gamm.v1beta1.PoolI",
 		(*PoolI)(nil),
 	)
+	registry.RegisterInterface(
+		"osmosis.gamm.v1beta1.ConcentratedPoolRecordsI",
+		(*ConcentratedPoolRecordsI)(nil),
+	)
 
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
@@ -37,6 +42,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgJoinSwapShareAmountOut{},
 		&MsgExitSwapExternAmountOut{},
 		&MsgExitSwapShareAmountIn{},
+		&MsgSplitRouteSwapExactAmountIn{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -12,6 +12,9 @@ const (
 	// Raise 10 to the power of SigFigsExponent to determine number of significant figures.
 	// i.e. SigFigExponent = 8 is 10^8 which is 100000000. This gives 8 significant figures.
 	SigFigsExponent = 8
+
+	// MaxBestRouteHops is the largest number of pools searched for in a route by the best route query.
+	MaxBestRouteHops = 4
 )
 
 var (
@@ -8,7 +8,7 @@ var (
 	ErrPoolAlreadyExist   = sdkerrors.Register(ModuleName, 2, "pool already exist")
 	ErrPoolLocked         = sdkerrors.Register(ModuleName, 3, "pool is locked")
 	ErrTooFewPoolAssets   = sdkerrors.Register(ModuleName, 4, "pool should have at least 2 assets, as they must be swapping between at least two assets")
-	ErrTooManyPoolAssets  = sdkerrors.Register(ModuleName, 5, "pool has too many assets (currently capped at 8 assets per balancer pool and 2 per stableswap)")
+	ErrTooManyPoolAssets  = sdkerrors.Register(ModuleName, 5, "pool has too many assets (currently capped at 8 assets per pool)")
 	ErrLimitMaxAmount     = sdkerrors.Register(ModuleName, 6, "calculated amount is larger than max amount")
 	ErrLimitMinAmount     = sdkerrors.Register(ModuleName, 7, "calculated amount is lesser than min amount")
 	ErrInvalidMathApprox  = sdkerrors.Register(ModuleName, 8, "invalid calculated result")
@@ -26,9 +26,17 @@ var (
 	ErrNotPositiveCriteria      = sdkerrors.Register(ModuleName, 29, "min out amount or max in amount should be positive")
 	ErrNotPositiveRequireAmount = sdkerrors.Register(ModuleName, 30, "required amount should be positive")
 	ErrTooManyTokensOut         = sdkerrors.Register(ModuleName, 31, "tx is trying to get more tokens out of the pool than exist")
+	ErrNoRouteFound             = sdkerrors.Register(ModuleName, 32, "no route found between the denoms")
+	ErrInvalidMaxHops           = sdkerrors.Register(ModuleName, 33, "max hops is out of range")
+	ErrInvalidSplitRoutes       = sdkerrors.Register(ModuleName, 34, "split routes must all end in the same denom")
 
 	ErrPoolParamsInvalidDenom     = sdkerrors.Register(ModuleName, 50, "pool params' LBP params has an invalid denomination")
 	ErrPoolParamsInvalidNumDenoms = sdkerrors.Register(ModuleName, 51, "pool params' LBP doesn't have same number of params as underlying pool")
+	ErrInvalidScalingFactors      = sdkerrors.Register(ModuleName, 52, "stableswap pool scaling factors are invalid")
+	ErrPoolHasNoShares            = sdkerrors.Register(ModuleName, 53, "pool has no LP shares, its liquidity is provided by positions")
+	ErrInvalidTickSpacing         = sdkerrors.Register(ModuleName, 54, "concentrated pool tick spacing is invalid")
+	ErrInvalidPosition            = sdkerrors.Register(ModuleName, 55, "concentrated pool position is invalid")
+	ErrNotEnoughLiquidity         = sdkerrors.Register(ModuleName, 56, "not enough liquidity in the pool's price range")
 
 	ErrNotImplemented = sdkerrors.Register(ModuleName, 60, "function not implemented")
 )
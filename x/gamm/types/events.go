@@ -12,14 +12,27 @@ const (
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
 
+	TypeEvtSplitRouteTokenSwapped = "split_route_token_swapped"
+
+	TypeEvtScalingFactorsAdjusted = "scaling_factors_adjusted"
+
+	TypeEvtPositionCreated       = "position_created"
+	TypeEvtPositionWithdrawn     = "position_withdrawn"
+	TypeEvtPositionFeesCollected = "position_fees_collected"
+
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeySwapFee    = "swap_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyNumRoutes  = "num_routes"
+	AttributeKeyPositionId = "position_id"
+	AttributeKeyLiquidity  = "liquidity"
+	AttributeKeyFees       = "fees"
 )
 
-func CreateSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) sdk.Event {
+// CreateSwapEvent creates the event of a swap through a single pool, along with the swap fee charged by it.
+func CreateSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins, swapFee sdk.Dec) sdk.Event {
 	return sdk.NewEvent(
 		TypeEvtTokenSwapped,
 		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
@@ -27,6 +40,20 @@ func CreateSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, inpu
 		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
 		sdk.NewAttribute(AttributeKeyTokensIn, input.String()),
 		sdk.NewAttribute(AttributeKeyTokensOut, output.String()),
+		sdk.NewAttribute(AttributeKeySwapFee, swapFee.String()),
+	)
+}
+
+// CreateSplitRouteSwapEvent creates the event with the totals swapped over all routes of a split route swap.
+// The swaps through every pool of the routes emit their own swap events.
+func CreateSplitRouteSwapEvent(ctx sdk.Context, sender sdk.AccAddress, numRoutes int, input sdk.Coins, output sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		TypeEvtSplitRouteTokenSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyNumRoutes, strconv.Itoa(numRoutes)),
+		sdk.NewAttribute(AttributeKeyTokensIn, input.String()),
+		sdk.NewAttribute(AttributeKeyTokensOut, output.String()),
 	)
 }
 
@@ -49,3 +76,41 @@ func CreateRemoveLiquidityEvent(ctx sdk.Context, sender sdk.AccAddress, poolId u
 		sdk.NewAttribute(AttributeKeyTokensOut, liquidity.String()),
 	)
 }
+
+// CreatePositionCreatedEvent creates the event of liquidity being provided to a position of a concentrated liquidity pool.
+func CreatePositionCreatedEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64, liquidity sdk.Dec, tokensIn sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		TypeEvtPositionCreated,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(AttributeKeyLiquidity, liquidity.String()),
+		sdk.NewAttribute(AttributeKeyTokensIn, tokensIn.String()),
+	)
+}
+
+// CreatePositionWithdrawnEvent creates the event of liquidity being withdrawn from a position of a concentrated liquidity pool.
+func CreatePositionWithdrawnEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64, liquidity sdk.Dec, tokensOut sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		TypeEvtPositionWithdrawn,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(AttributeKeyLiquidity, liquidity.String()),
+		sdk.NewAttribute(AttributeKeyTokensOut, tokensOut.String()),
+	)
+}
+
+// CreatePositionFeesCollectedEvent creates the event of the fees of a position of a concentrated liquidity pool being collected.
+func CreatePositionFeesCollectedEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, positionId uint64, fees sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		TypeEvtPositionFeesCollected,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(AttributeKeyFees, fees.String()),
+	)
+}
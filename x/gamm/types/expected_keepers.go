@@ -61,3 +61,8 @@ type BankKeeper interface {
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// StakingKeeper defines the contract needed to be fulfilled for staking keeper.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
@@ -30,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// osmo_multihop_swap_fee_multiplier is multiplied with the swap fee of every
+	// hop of a multihop swap, whose every hop is in a pool containing OSMO.
+	OsmoMultihopSwapFeeMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=osmo_multihop_swap_fee_multiplier,json=osmoMultihopSwapFeeMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"osmo_multihop_swap_fee_multiplier" yaml:"osmo_multihop_swap_fee_multiplier"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -77,6 +80,9 @@ type GenesisState struct {
 	Pools          []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	NextPoolNumber uint64        `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
 	Params         Params        `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// ticks and positions of concentrated liquidity pools, which are stored
+	// apart from the pools
+	ConcentratedPoolRecords []*types1.Any `protobuf:"bytes,4,rep,name=concentrated_pool_records,json=concentratedPoolRecords,proto3" json:"concentrated_pool_records,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +139,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetConcentratedPoolRecords() []*types1.Any {
+	if m != nil {
+		return m.ConcentratedPoolRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
@@ -143,32 +156,39 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x18, 0xc5, 0x33, 0xde, 0x7b, 0x0b, 0xe6, 0x8a, 0x7f, 0x42, 0x17, 0xb9, 0x17, 0x49, 0x4b, 0x56,
-	0xd9, 0x74, 0x86, 0x5b, 0x11, 0xa1, 0x3b, 0x53, 0x50, 0x0a, 0x22, 0x25, 0xee, 0xdc, 0x84, 0x49,
-	0x9c, 0xc6, 0x60, 0x32, 0x5f, 0xc8, 0x4c, 0x4b, 0xf3, 0x16, 0x82, 0x7b, 0x1f, 0x40, 0xb7, 0x3e,
-	0x44, 0x71, 0xd5, 0xa5, 0xab, 0x2a, 0xed, 0x1b, 0xf8, 0x04, 0x32, 0x7f, 0x22, 0x82, 0x77, 0x95,
-	0x7c, 0xf3, 0xfd, 0xce, 0xe1, 0xcc, 0x49, 0xdc, 0x10, 0x44, 0x0d, 0xa2, 0x14, 0xa4, 0xa0, 0x75,
-	0x4d, 0x36, 0x37, 0x19, 0x93, 0xf4, 0x86, 0x14, 0x8c, 0x33, 0x51, 0x0a, 0xdc, 0xb4, 0x20, 0xc1,
-	0x1b, 0x5a, 0x06, 0x2b, 0x06, 0x5b, 0xe6, 0x7a, 0x58, 0x40, 0x01, 0x1a, 0x20, 0xea, 0xcd, 0xb0,
-	0xd7, 0x57, 0x05, 0x40, 0x51, 0x31, 0xa2, 0xa7, 0x6c, 0xbd, 0x22, 0x94, 0x77, 0xfd, 0x2a, 0xd7,
-	0x3e, 0xa9, 0xd1, 0x98, 0xc1, 0xae, 0x02, 0x33, 0x91, 0x8c, 0x0a, 0xf6, 0x37, 0x44, 0x0e, 0x25,
-	0x37, 0xfb, 0xf0, 0x33, 0x72, 0x07, 0x4b, 0xda, 0xd2, 0x5a, 0x78, 0x9f, 0x90, 0xfb, 0xa8, 0x01,
-	0xa8, 0xd2, 0xbc, 0x65, 0x54, 0x96, 0xc0, 0xd3, 0x15, 0x63, 0x3e, 0x1a, 0x9f, 0x45, 0x97, 0xd3,
-	0x2b, 0x6c, 0x5d, 0x95, 0x4f, 0x1f, 0x14, 0xcf, 0xa1, 0xe4, 0xf1, 0xab, 0xdd, 0x61, 0xe4, 0xfc,
-	0x3e, 0x8c, 0xfc, 0x8e, 0xd6, 0xd5, 0x2c, 0xfc, 0xcf, 0x21, 0xfc, 0xf2, 0x73, 0x14, 0x15, 0xa5,
-	0x7c, 0xbf, 0xce, 0x70, 0x0e, 0xb5, 0x8d, 0x67, 0x1f, 0x13, 0xf1, 0xee, 0x03, 0x91, 0x5d, 0xc3,
-	0x84, 0x36, 0x13, 0xc9, 0x03, 0xa5, 0x9f, 0x5b, 0xf9, 0x0b, 0xc6, 0xc2, 0xaf, 0xc8, 0xbd, 0xf7,
-	0xd2, 0x94, 0xf6, 0x46, 0x52, 0xc9, 0xbc, 0xa7, 0xee, 0x85, 0x62, 0x84, 0x4d, 0x36, 0xc4, 0xa6,
-	0x17, 0xdc, 0xf7, 0x82, 0x9f, 0xf3, 0x2e, 0xbe, 0xfb, 0xfd, 0xdb, 0xe4, 0x62, 0x09, 0x50, 0x2d,
-	0x12, 0x43, 0x7b, 0x91, 0xfb, 0x90, 0xb3, 0xad, 0x4c, 0x75, 0x3e, 0xbe, 0xae, 0x33, 0xd6, 0xfa,
-	0x77, 0xc6, 0x28, 0x3a, 0x4f, 0xee, 0xab, 0x73, 0xc5, 0xbe, 0xd6, 0xa7, 0xde, 0xcc, 0x1d, 0x34,
-	0xba, 0x11, 0xff, 0x6c, 0x8c, 0xa2, 0xcb, 0xe9, 0x63, 0x7c, 0xdb, 0x57, 0xc2, 0xa6, 0xb5, 0xf8,
-	0x5c, 0x5d, 0x3f, 0xb1, 0x8a, 0x78, 0xb1, 0x3b, 0x06, 0x68, 0x7f, 0x0c, 0xd0, 0xaf, 0x63, 0x80,
-	0x3e, 0x9e, 0x02, 0x67, 0x7f, 0x0a, 0x9c, 0x1f, 0xa7, 0xc0, 0x79, 0x4b, 0xfe, 0xa9, 0xc0, 0xfa,
-	0x4d, 0x2a, 0x9a, 0x89, 0x7e, 0x20, 0x9b, 0x67, 0x64, 0x6b, 0xfe, 0x15, 0xdd, 0x47, 0x36, 0xd0,
-	0x17, 0x7a, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0xda, 0xf2, 0x18, 0x37, 0x48, 0x02, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6b, 0xdb, 0x40,
+	0x10, 0xb5, 0x1c, 0xc7, 0x10, 0xa5, 0xf4, 0x43, 0x18, 0x2a, 0x87, 0x54, 0x76, 0x7d, 0x28, 0xba,
+	0x78, 0x97, 0xa4, 0x94, 0x42, 0x6e, 0xb5, 0x4b, 0x8a, 0xa1, 0x29, 0x41, 0xb9, 0xe5, 0x22, 0x56,
+	0xf2, 0x44, 0x11, 0x95, 0x76, 0x84, 0x76, 0x9d, 0xc4, 0xff, 0xa2, 0xd0, 0x63, 0xaf, 0x3d, 0xf5,
+	0x9c, 0xbf, 0x50, 0x08, 0x39, 0xe5, 0x58, 0x7a, 0x70, 0x8b, 0xfd, 0x0f, 0xf2, 0x0b, 0xca, 0x6a,
+	0xd7, 0x6d, 0xa0, 0xa1, 0xcd, 0x49, 0x9a, 0x9d, 0xf7, 0xde, 0xbc, 0x9d, 0x27, 0xd9, 0x3d, 0x14,
+	0x39, 0x8a, 0x54, 0xd0, 0x84, 0xe5, 0x39, 0x3d, 0xd9, 0x8a, 0x40, 0xb2, 0x2d, 0x9a, 0x00, 0x07,
+	0x91, 0x0a, 0x52, 0x94, 0x28, 0xd1, 0x69, 0x19, 0x0c, 0x51, 0x18, 0x62, 0x30, 0x1b, 0xad, 0x04,
+	0x13, 0xac, 0x00, 0x54, 0xbd, 0x69, 0xec, 0x46, 0x3b, 0x41, 0x4c, 0x32, 0xa0, 0x55, 0x15, 0x4d,
+	0x8e, 0x28, 0xe3, 0xd3, 0x65, 0x2b, 0xae, 0x74, 0x42, 0xcd, 0xd1, 0x85, 0x69, 0x79, 0xba, 0xa2,
+	0x11, 0x13, 0xf0, 0xdb, 0x44, 0x8c, 0x29, 0xd7, 0xfd, 0xde, 0xd7, 0xba, 0xdd, 0xdc, 0x67, 0x25,
+	0xcb, 0x85, 0xf3, 0xd1, 0xb2, 0x1f, 0x15, 0x88, 0x59, 0x18, 0x97, 0xc0, 0x64, 0x8a, 0x3c, 0x3c,
+	0x02, 0x70, 0xad, 0xee, 0x8a, 0xbf, 0xbe, 0xdd, 0x26, 0x46, 0x55, 0xe9, 0x2c, 0x8d, 0x92, 0x21,
+	0xa6, 0x7c, 0xf0, 0xf6, 0x62, 0xd6, 0xa9, 0x5d, 0xcf, 0x3a, 0xee, 0x94, 0xe5, 0xd9, 0x4e, 0xef,
+	0x2f, 0x85, 0xde, 0x97, 0x1f, 0x1d, 0x3f, 0x49, 0xe5, 0xf1, 0x24, 0x22, 0x31, 0xe6, 0xc6, 0x9e,
+	0x79, 0xf4, 0xc5, 0xf8, 0x3d, 0x95, 0xd3, 0x02, 0x44, 0x25, 0x26, 0x82, 0x07, 0x8a, 0x3f, 0x34,
+	0xf4, 0x5d, 0x00, 0xe7, 0xb3, 0x65, 0x3f, 0x55, 0xd0, 0x30, 0x9f, 0x64, 0x32, 0x3d, 0xc6, 0x22,
+	0x14, 0xa7, 0xac, 0x50, 0xc2, 0xfa, 0xa4, 0xc8, 0x52, 0x28, 0xdd, 0x7a, 0xd7, 0xf2, 0xd7, 0x06,
+	0x87, 0xca, 0xca, 0xf7, 0x59, 0xe7, 0xd9, 0x1d, 0xc6, 0xbd, 0x86, 0xf8, 0x7a, 0xd6, 0xf1, 0xb5,
+	0xe9, 0xff, 0x0e, 0xe8, 0x05, 0x4f, 0x14, 0x66, 0xcf, 0x40, 0x0e, 0x4e, 0x59, 0xb1, 0x0b, 0xb0,
+	0xf7, 0xa7, 0xff, 0xa9, 0x6e, 0xdf, 0x7b, 0xa3, 0xb3, 0x3d, 0x90, 0x4c, 0x82, 0xf3, 0xc2, 0x5e,
+	0x55, 0x57, 0x11, 0x66, 0x81, 0x2d, 0xa2, 0xe3, 0x23, 0xcb, 0xf8, 0xc8, 0x2b, 0x3e, 0x1d, 0xac,
+	0x5d, 0x9e, 0xf7, 0x57, 0xf7, 0x11, 0xb3, 0x51, 0xa0, 0xd1, 0x8e, 0x6f, 0x3f, 0xe4, 0x70, 0x26,
+	0xc3, 0x6a, 0x8d, 0x7c, 0x92, 0x47, 0xe6, 0x72, 0x8d, 0xe0, 0xbe, 0x3a, 0x57, 0xd8, 0x77, 0xd5,
+	0xa9, 0xb3, 0x63, 0x37, 0x8b, 0x2a, 0x38, 0x77, 0xa5, 0x6b, 0xf9, 0xeb, 0xdb, 0x9b, 0xe4, 0xb6,
+	0x8f, 0x89, 0xe8, 0x70, 0x07, 0x0d, 0xb5, 0x9a, 0xc0, 0x30, 0x1c, 0x6e, 0xb7, 0x63, 0xe4, 0x31,
+	0x70, 0x59, 0x32, 0x09, 0x63, 0x3d, 0xad, 0x84, 0x18, 0xcb, 0xb1, 0x70, 0x1b, 0xff, 0x30, 0xbc,
+	0x79, 0x79, 0xde, 0x77, 0x87, 0x37, 0xa8, 0xca, 0x50, 0xa0, 0x89, 0xa3, 0xe0, 0x71, 0x7c, 0x7b,
+	0x67, 0x30, 0xba, 0x98, 0x7b, 0xd6, 0xd5, 0xdc, 0xb3, 0x7e, 0xce, 0x3d, 0xeb, 0xc3, 0xc2, 0xab,
+	0x5d, 0x2d, 0xbc, 0xda, 0xb7, 0x85, 0x57, 0x3b, 0xa4, 0x37, 0xa2, 0x32, 0xfe, 0xfb, 0x19, 0x8b,
+	0xc4, 0xb2, 0xa0, 0x27, 0x2f, 0xe9, 0x99, 0xfe, 0x85, 0xaa, 0xdc, 0xa2, 0x66, 0xe5, 0xe7, 0xf9,
+	0xaf, 0x01, 0x00, 0x75, 0x68, 0x48, 0x3b, 0x5f, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -191,6 +211,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.OsmoMultihopSwapFeeMultiplier.Size()
+		i -= size
+		if _, err := m.OsmoMultihopSwapFeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -228,6 +258,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConcentratedPoolRecords) > 0 {
+		for iNdEx := len(m.ConcentratedPoolRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcentratedPoolRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -283,6 +327,8 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.OsmoMultihopSwapFeeMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -303,6 +349,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConcentratedPoolRecords) > 0 {
+		for _, e := range m.ConcentratedPoolRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -375,6 +427,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoMultihopSwapFeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoMultihopSwapFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -511,6 +597,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcentratedPoolRecords = append(m.ConcentratedPoolRecords, &types1.Any{})
+			if err := m.ConcentratedPoolRecords[len(m.ConcentratedPoolRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
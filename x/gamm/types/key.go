@@ -25,6 +25,10 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixConcentratedTicks defines prefix to store the initialized ticks of concentrated liquidity pools.
+	KeyPrefixConcentratedTicks = []byte{0x04}
+	// KeyPrefixConcentratedPositions defines prefix to store the positions of concentrated liquidity pools.
+	KeyPrefixConcentratedPositions = []byte{0x05}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -56,3 +60,24 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+// GetKeyPrefixConcentratedTicks returns the prefix of the initialized ticks of concentrated liquidity pool #{poolId}.
+func GetKeyPrefixConcentratedTicks(poolId uint64) []byte {
+	return append(KeyPrefixConcentratedTicks, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyConcentratedTick returns the key of tick of concentrated liquidity pool #{poolId}.
+// The sign bit of tick is flipped, so that the keys of a pool's ticks are in the order of the ticks.
+func GetKeyConcentratedTick(poolId uint64, tick int64) []byte {
+	return append(GetKeyPrefixConcentratedTicks(poolId), sdk.Uint64ToBigEndian(uint64(tick)^(1<<63))...)
+}
+
+// GetKeyPrefixConcentratedPositions returns the prefix of the positions of concentrated liquidity pool #{poolId}.
+func GetKeyPrefixConcentratedPositions(poolId uint64) []byte {
+	return append(KeyPrefixConcentratedPositions, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetKeyConcentratedPosition returns the key of position #{positionId} of concentrated liquidity pool #{poolId}.
+func GetKeyConcentratedPosition(poolId uint64, positionId uint64) []byte {
+	return append(GetKeyPrefixConcentratedPositions(poolId), sdk.Uint64ToBigEndian(positionId)...)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"math"
 	"testing"
 
@@ -22,3 +23,12 @@ func TestGetPoolShareDenom(t *testing.T) {
 	require.NoError(t, sdk.ValidateDenom(denom))
 	require.Equal(t, "gamm/pool/18446744073709551615", denom)
 }
+
+func TestGetKeyConcentratedTickOrder(t *testing.T) {
+	ticks := []int64{math.MinInt64, -342000, -1, 0, 1, 342000, math.MaxInt64}
+	for i := 1; i < len(ticks); i++ {
+		require.Equal(t, -1, bytes.Compare(GetKeyConcentratedTick(1, ticks[i-1]), GetKeyConcentratedTick(1, ticks[i])))
+	}
+	// ticks of a pool are sorted before those of the next pool
+	require.Equal(t, -1, bytes.Compare(GetKeyConcentratedTick(1, math.MaxInt64), GetKeyConcentratedTick(2, math.MinInt64)))
+}
@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // SwapMsg defines a simple interface for getting the token denoms on a swap message route.
 type SwapMsgRoute interface {
 	TokenInDenom() string
@@ -7,9 +9,16 @@ type SwapMsgRoute interface {
 	TokenDenomsOnPath() []string
 }
 
+// MultiSwapMsgRoute defines an interface for messages that perform several swaps, such as split route swaps.
+type MultiSwapMsgRoute interface {
+	GetSwapMsgs() []SwapMsgRoute
+}
+
 var (
 	_ SwapMsgRoute = MsgSwapExactAmountOut{}
 	_ SwapMsgRoute = MsgSwapExactAmountIn{}
+
+	_ MultiSwapMsgRoute = MsgSplitRouteSwapExactAmountIn{}
 )
 
 func (msg MsgSwapExactAmountOut) TokenInDenom() string {
@@ -46,3 +55,24 @@ func (msg MsgSwapExactAmountIn) TokenDenomsOnPath() []string {
 	}
 	return denoms
 }
+
+// GetSwapMsgs returns each route of the split route swap as its own MsgSwapExactAmountIn.
+// The minimum amount out of every returned message is one, as only the sum over all routes is bounded.
+func (msg MsgSplitRouteSwapExactAmountIn) GetSwapMsgs() []SwapMsgRoute {
+	swapMsgs := make([]SwapMsgRoute, 0, len(msg.Routes))
+	for _, route := range msg.Routes {
+		swapMsgs = append(swapMsgs, MsgSwapExactAmountIn{
+			Sender:            msg.Sender,
+			Routes:            route.Pools,
+			TokenIn:           sdk.NewCoin(msg.TokenInDenom, route.TokenInAmount),
+			TokenOutMinAmount: sdk.OneInt(),
+		})
+	}
+	return swapMsgs
+}
+
+// TokenOutDenom returns the denom that the first route ends in, which every route ends in for a valid message.
+func (msg MsgSplitRouteSwapExactAmountIn) TokenOutDenom() string {
+	firstRoutePools := msg.Routes[0].Pools
+	return firstRoutePools[len(firstRoutePools)-1].GetTokenOutDenom()
+}
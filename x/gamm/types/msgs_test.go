@@ -141,6 +141,136 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 	}
 }
 
+func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+		properMsg := MsgSplitRouteSwapExactAmountIn{
+			Sender: addr1,
+			Routes: []SwapAmountInSplitRoute{{
+				Pools: []SwapAmountInRoute{{
+					PoolId:        0,
+					TokenOutDenom: "test2",
+				}},
+				TokenInAmount: sdk.NewInt(60),
+			}, {
+				Pools: []SwapAmountInRoute{{
+					PoolId:        1,
+					TokenOutDenom: "test3",
+				}, {
+					PoolId:        2,
+					TokenOutDenom: "test2",
+				}},
+				TokenInAmount: sdk.NewInt(40),
+			}},
+			TokenInDenom:      "test",
+			TokenOutMinAmount: sdk.NewInt(200),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	swapMsgs := msg.GetSwapMsgs()
+	require.Len(t, swapMsgs, 2)
+	for _, swapMsg := range swapMsgs {
+		require.Equal(t, "test", swapMsg.TokenInDenom())
+		require.Equal(t, "test2", swapMsg.TokenOutDenom())
+	}
+
+	tests := []struct {
+		name       string
+		msg        MsgSplitRouteSwapExactAmountIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty pools in route",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes ending in different denoms",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools[1].TokenOutDenom = "test4"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount route",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg MsgSplitRouteSwapExactAmountIn) MsgSplitRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestMsgSwapExactAmountOut(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
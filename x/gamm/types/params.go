@@ -11,7 +11,8 @@ import (
 
 // Parameter store keys.
 var (
-	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyPoolCreationFee               = []byte("PoolCreationFee")
+	KeyOsmoMultihopSwapFeeMultiplier = []byte("OsmoMultihopSwapFeeMultiplier")
 )
 
 // ParamTable for gamm module.
@@ -19,16 +20,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, osmoMultihopSwapFeeMultiplier sdk.Dec) Params {
 	return Params{
-		PoolCreationFee: poolCreationFee,
+		PoolCreationFee:               poolCreationFee,
+		OsmoMultihopSwapFeeMultiplier: osmoMultihopSwapFeeMultiplier,
 	}
 }
 
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		PoolCreationFee:               sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		OsmoMultihopSwapFeeMultiplier: sdk.NewDecWithPrec(5, 1),                                          // 0.5, so that two OSMO routed hops cost as much as one
 	}
 }
 
@@ -37,6 +40,9 @@ func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
+	if err := validateOsmoMultihopSwapFeeMultiplier(p.OsmoMultihopSwapFeeMultiplier); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -45,6 +51,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyOsmoMultihopSwapFeeMultiplier, &p.OsmoMultihopSwapFeeMultiplier, validateOsmoMultihopSwapFeeMultiplier),
 	}
 }
 
@@ -60,3 +67,16 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateOsmoMultihopSwapFeeMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("osmo multihop swap fee multiplier must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
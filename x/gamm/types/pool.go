@@ -116,6 +116,49 @@ type PoolAmountOutExtension interface {
 	IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins)
 }
 
+// ConcentratedPoolExtension is an extension of the PoolI interface
+// for pools whose liquidity is provided by positions over price ranges, instead of by LP shares.
+// Such pools have no LP shares, so GetTotalShares is zero, and they can't be joined or exited.
+// Their initialized ticks and positions are kept in their own prefixes of the gamm store, apart from the pool,
+// so the methods that use them are given the store. Swaps are done through these methods too,
+// as the swap methods of PoolI have no access to the store.
+type ConcentratedPoolExtension interface {
+	PoolI
+
+	// CreateInitialPosition provides the pool's initial liquidity over the full price range,
+	// in a position owned by creator.
+	CreateInitialPosition(store sdk.KVStore, creator sdk.AccAddress) error
+	// CreatePosition opens a position for owner over [lowerTick, upperTick), with the maximal
+	// liquidity that at most tokensDesired can provide at the current price.
+	// Balance transfers are done in the keeper, but this method updates the internal pool state.
+	CreatePosition(store sdk.KVStore, owner sdk.AccAddress, lowerTick, upperTick int64, tokensDesired sdk.Coins) (positionId uint64, liquidity sdk.Dec, tokensIn sdk.Coins, err error)
+	// WithdrawPosition removes liquidity from position positionId of owner, and returns the tokens that it held.
+	// Balance transfers are done in the keeper, but this method updates the internal pool state.
+	WithdrawPosition(store sdk.KVStore, owner sdk.AccAddress, positionId uint64, liquidity sdk.Dec) (tokensOut sdk.Coins, err error)
+	// CollectFees returns the fees accrued by position positionId of owner, and removes them from the position.
+	// Balance transfers are done in the keeper, but this method updates the internal pool state.
+	CollectFees(store sdk.KVStore, owner sdk.AccAddress, positionId uint64) (fees sdk.Coins, err error)
+
+	// CalcOutAmtGivenInWithStore, SwapOutAmtGivenInWithStore, CalcInAmtGivenOutWithStore and
+	// SwapInAmtGivenOutWithStore are the swap methods of PoolI, with access to the pool's ticks.
+	CalcOutAmtGivenInWithStore(store sdk.KVStore, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error)
+	SwapOutAmtGivenInWithStore(store sdk.KVStore, tokenIn sdk.Coins, tokenOutDenom string, swapFee sdk.Dec) (tokenOut sdk.Coin, err error)
+	CalcInAmtGivenOutWithStore(store sdk.KVStore, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error)
+	SwapInAmtGivenOutWithStore(store sdk.KVStore, tokenOut sdk.Coins, tokenInDenom string, swapFee sdk.Dec) (tokenIn sdk.Coin, err error)
+
+	// ExportRecords returns the pool's ticks and positions, for genesis.
+	ExportRecords(store sdk.KVStore) ConcentratedPoolRecordsI
+	// ImportRecords stores the pool's ticks and positions from genesis.
+	ImportRecords(store sdk.KVStore, records ConcentratedPoolRecordsI) error
+}
+
+// ConcentratedPoolRecordsI holds the ticks and positions of a concentrated liquidity pool in genesis.
+type ConcentratedPoolRecordsI interface {
+	proto.Message
+
+	GetPoolId() uint64
+}
+
 func NewPoolAddress(poolId uint64) sdk.AccAddress {
 	key := append([]byte("pool"), sdk.Uint64ToBigEndian(poolId)...)
 	return address.Module(ModuleName, key)
@@ -13,20 +13,24 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -34,7 +38,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-//=============================== Pool
+// =============================== Pool
 type QueryPoolRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
 }
@@ -123,7 +127,7 @@ func (m *QueryPoolResponse) GetPool() *types.Any {
 	return nil
 }
 
-//=============================== Pools
+// =============================== Pools
 type QueryPoolsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -222,7 +226,7 @@ func (m *QueryPoolsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-//=============================== NumPools
+// =============================== NumPools
 type QueryNumPoolsRequest struct {
 }
 
@@ -303,7 +307,7 @@ func (m *QueryNumPoolsResponse) GetNumPools() uint64 {
 	return 0
 }
 
-//=============================== PoolParams
+// =============================== PoolParams
 type QueryPoolParamsRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
 }
@@ -392,7 +396,7 @@ func (m *QueryPoolParamsResponse) GetParams() *types.Any {
 	return nil
 }
 
-//=============================== PoolLiquidity
+// =============================== PoolLiquidity
 type QueryTotalPoolLiquidityRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
 }
@@ -481,7 +485,7 @@ func (m *QueryTotalPoolLiquidityResponse) GetLiquidity() github_com_cosmos_cosmo
 	return nil
 }
 
-//=============================== TotalShares
+// =============================== TotalShares
 type QueryTotalSharesRequest struct {
 	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
 }
@@ -570,6 +574,116 @@ func (m *QueryTotalSharesResponse) GetTotalShares() types1.Coin {
 	return types1.Coin{}
 }
 
+// =============================== ScalingFactors
+type QueryScalingFactorsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryScalingFactorsRequest) Reset()         { *m = QueryScalingFactorsRequest{} }
+func (m *QueryScalingFactorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorsRequest) ProtoMessage()    {}
+func (*QueryScalingFactorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{12}
+}
+func (m *QueryScalingFactorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScalingFactorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScalingFactorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScalingFactorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorsRequest.Merge(m, src)
+}
+func (m *QueryScalingFactorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScalingFactorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScalingFactorsRequest proto.InternalMessageInfo
+
+func (m *QueryScalingFactorsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryScalingFactorsResponse struct {
+	// scaling factors of the pool at the current block time
+	ScalingFactors []uint64 `protobuf:"varint,1,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"scaling_factors"`
+	// scaling factors the pool is changing to. Equal to scaling_factors if no
+	// change is in progress.
+	TargetScalingFactors []uint64 `protobuf:"varint,2,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+	// time at which the pool reaches target_scaling_factors. Unset if no change
+	// is in progress.
+	TargetTime *time.Time `protobuf:"bytes,3,opt,name=target_time,json=targetTime,proto3,stdtime" json:"target_time,omitempty" yaml:"target_time"`
+}
+
+func (m *QueryScalingFactorsResponse) Reset()         { *m = QueryScalingFactorsResponse{} }
+func (m *QueryScalingFactorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorsResponse) ProtoMessage()    {}
+func (*QueryScalingFactorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{13}
+}
+func (m *QueryScalingFactorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScalingFactorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScalingFactorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScalingFactorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorsResponse.Merge(m, src)
+}
+func (m *QueryScalingFactorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScalingFactorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScalingFactorsResponse proto.InternalMessageInfo
+
+func (m *QueryScalingFactorsResponse) GetScalingFactors() []uint64 {
+	if m != nil {
+		return m.ScalingFactors
+	}
+	return nil
+}
+
+func (m *QueryScalingFactorsResponse) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
+func (m *QueryScalingFactorsResponse) GetTargetTime() *time.Time {
+	if m != nil {
+		return m.TargetTime
+	}
+	return nil
+}
+
 // QuerySpotPriceRequest defines the gRPC request structure for a SpotPrice
 // query.
 type QuerySpotPriceRequest struct {
@@ -582,7 +696,7 @@ func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
 func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceRequest) ProtoMessage()    {}
 func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{12}
+	return fileDescriptor_d9a717df9ca609ef, []int{14}
 }
 func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -643,7 +757,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{13}
+	return fileDescriptor_d9a717df9ca609ef, []int{15}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -679,7 +793,7 @@ func (m *QuerySpotPriceResponse) GetSpotPrice() string {
 	return ""
 }
 
-//=============================== EstimateSwapExactAmountIn
+// =============================== EstimateSwapExactAmountIn
 type QuerySwapExactAmountInRequest struct {
 	Sender  string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	PoolId  uint64              `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
@@ -691,7 +805,7 @@ func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmo
 func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{14}
+	return fileDescriptor_d9a717df9ca609ef, []int{16}
 }
 func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +870,7 @@ func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{15}
+	return fileDescriptor_d9a717df9ca609ef, []int{17}
 }
 func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +899,7 @@ func (m *QuerySwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySwapExactAmountInResponse proto.InternalMessageInfo
 
-//=============================== EstimateSwapExactAmountOut
+// =============================== EstimateSwapExactAmountOut
 type QuerySwapExactAmountOutRequest struct {
 	Sender   string               `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	PoolId   uint64               `protobuf:"varint,2,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
@@ -797,7 +911,7 @@ func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{16}
+	return fileDescriptor_d9a717df9ca609ef, []int{18}
 }
 func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +976,7 @@ func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactA
 func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{17}
+	return fileDescriptor_d9a717df9ca609ef, []int{19}
 }
 func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,6 +1005,116 @@ func (m *QuerySwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySwapExactAmountOutResponse proto.InternalMessageInfo
 
+// =============================== BestRoute
+type QueryBestRouteRequest struct {
+	TokenIn       string `protobuf:"bytes,1,opt,name=tokenIn,proto3" json:"tokenIn,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=tokenOutDenom,proto3" json:"tokenOutDenom,omitempty" yaml:"token_out_denom"`
+	// maxHops is the maximum number of pools in the route
+	MaxHops uint64 `protobuf:"varint,3,opt,name=maxHops,proto3" json:"maxHops,omitempty" yaml:"max_hops"`
+}
+
+func (m *QueryBestRouteRequest) Reset()         { *m = QueryBestRouteRequest{} }
+func (m *QueryBestRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBestRouteRequest) ProtoMessage()    {}
+func (*QueryBestRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{20}
+}
+func (m *QueryBestRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBestRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBestRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBestRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBestRouteRequest.Merge(m, src)
+}
+func (m *QueryBestRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBestRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBestRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBestRouteRequest proto.InternalMessageInfo
+
+func (m *QueryBestRouteRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *QueryBestRouteRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *QueryBestRouteRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+type QueryBestRouteResponse struct {
+	Routes         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokenOutAmount" yaml:"token_out_amount"`
+	// priceImpact is the fraction by which tokenOutAmount is less than the
+	// amount out at the spot prices of the route, after swap fees
+	PriceImpact github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"priceImpact" yaml:"price_impact"`
+}
+
+func (m *QueryBestRouteResponse) Reset()         { *m = QueryBestRouteResponse{} }
+func (m *QueryBestRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBestRouteResponse) ProtoMessage()    {}
+func (*QueryBestRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{21}
+}
+func (m *QueryBestRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBestRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBestRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBestRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBestRouteResponse.Merge(m, src)
+}
+func (m *QueryBestRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBestRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBestRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBestRouteResponse proto.InternalMessageInfo
+
+func (m *QueryBestRouteResponse) GetRoutes() []SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 type QueryTotalLiquidityRequest struct {
 }
 
@@ -898,7 +1122,7 @@ func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityR
 func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{18}
+	return fileDescriptor_d9a717df9ca609ef, []int{22}
 }
 func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1159,7 @@ func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidity
 func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{19}
+	return fileDescriptor_d9a717df9ca609ef, []int{23}
 }
 func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -984,12 +1208,16 @@ func init() {
 	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
 	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
 	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
+	proto.RegisterType((*QueryScalingFactorsRequest)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorsRequest")
+	proto.RegisterType((*QueryScalingFactorsResponse)(nil), "osmosis.gamm.v1beta1.QueryScalingFactorsResponse")
 	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
 	proto.RegisterType((*QuerySpotPriceResponse)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceResponse")
 	proto.RegisterType((*QuerySwapExactAmountInRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInRequest")
 	proto.RegisterType((*QuerySwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountInResponse")
 	proto.RegisterType((*QuerySwapExactAmountOutRequest)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutRequest")
 	proto.RegisterType((*QuerySwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.QuerySwapExactAmountOutResponse")
+	proto.RegisterType((*QueryBestRouteRequest)(nil), "osmosis.gamm.v1beta1.QueryBestRouteRequest")
+	proto.RegisterType((*QueryBestRouteResponse)(nil), "osmosis.gamm.v1beta1.QueryBestRouteResponse")
 	proto.RegisterType((*QueryTotalLiquidityRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityRequest")
 	proto.RegisterType((*QueryTotalLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalLiquidityResponse")
 }
@@ -997,90 +1225,109 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xb3, 0xa9, 0x13, 0xea, 0x89, 0x9a, 0x26, 0xd3, 0xb4, 0x75, 0x37, 0xad, 0xb7, 0x0c,
-	0x90, 0x84, 0x36, 0xde, 0x6d, 0x9a, 0x56, 0xa8, 0xfc, 0x28, 0x8a, 0x49, 0xda, 0x1a, 0x15, 0x1a,
-	0xb6, 0x08, 0x10, 0x1c, 0xac, 0x75, 0xbc, 0xb8, 0xab, 0xda, 0x3b, 0x1b, 0xcf, 0x6c, 0x53, 0x0b,
-	0x55, 0x48, 0x95, 0xb8, 0x71, 0x00, 0x95, 0x03, 0x12, 0x08, 0x71, 0x40, 0x42, 0xe2, 0xcc, 0x9f,
-	0xc0, 0xa1, 0x42, 0x1c, 0x2a, 0x71, 0x41, 0x1c, 0x0c, 0x6a, 0x91, 0xb8, 0xfb, 0x8e, 0x84, 0x76,
-	0xe6, 0xed, 0x7a, 0x6d, 0x6f, 0xfc, 0x4b, 0x42, 0xe2, 0xd4, 0x7a, 0xde, 0x9b, 0xef, 0xfb, 0xbc,
-	0xf7, 0x66, 0x76, 0x5e, 0xd0, 0x69, 0xca, 0x6a, 0x94, 0x39, 0xcc, 0xa8, 0x58, 0xb5, 0x9a, 0x71,
-	0x67, 0xad, 0x64, 0x73, 0x6b, 0xcd, 0xd8, 0xf5, 0xed, 0x7a, 0x43, 0xf7, 0xea, 0x94, 0x53, 0xbc,
-	0x00, 0x1e, 0x7a, 0xe0, 0xa1, 0x83, 0x87, 0xba, 0x50, 0xa1, 0x15, 0x2a, 0x1c, 0x8c, 0xe0, 0x7f,
-	0xd2, 0x57, 0x3d, 0x95, 0xa8, 0xc6, 0xef, 0x82, 0x39, 0xbb, 0x23, 0xec, 0x46, 0xc9, 0x62, 0x76,
-	0x64, 0xdd, 0xa1, 0x8e, 0x0b, 0xf6, 0x33, 0x71, 0xbb, 0x60, 0x88, 0xbc, 0x3c, 0xab, 0xe2, 0xb8,
-	0x16, 0x77, 0x68, 0xe8, 0x7b, 0xb2, 0x42, 0x69, 0xa5, 0x6a, 0x1b, 0x96, 0xe7, 0x18, 0x96, 0xeb,
-	0x52, 0x2e, 0x8c, 0x0c, 0xac, 0x27, 0xc0, 0x2a, 0x7e, 0x95, 0xfc, 0x0f, 0x0d, 0xcb, 0x6d, 0x84,
-	0x26, 0x19, 0xa4, 0x28, 0xe1, 0xe5, 0x0f, 0x69, 0x22, 0x97, 0xd1, 0xdc, 0x5b, 0x41, 0xd4, 0x6d,
-	0x4a, 0xab, 0xa6, 0xbd, 0xeb, 0xdb, 0x8c, 0xe3, 0x33, 0x68, 0xda, 0xa3, 0xb4, 0x5a, 0x28, 0x67,
-	0x94, 0xd3, 0xca, 0x4a, 0x2a, 0x8f, 0x5b, 0x4d, 0x6d, 0xb6, 0x61, 0xd5, 0xaa, 0x2f, 0x92, 0x60,
-	0xbd, 0xe8, 0x94, 0x89, 0x09, 0x1e, 0xe4, 0x1a, 0x9a, 0x8f, 0xed, 0x67, 0x1e, 0x75, 0x99, 0x8d,
-	0xd7, 0x51, 0x2a, 0x30, 0x8b, 0xed, 0x33, 0xe7, 0x17, 0x74, 0x49, 0xa6, 0x87, 0x64, 0xfa, 0x86,
-	0xdb, 0xc8, 0xa7, 0x7f, 0xfe, 0x31, 0x37, 0x15, 0xec, 0x2a, 0x98, 0xc2, 0x99, 0x7c, 0x10, 0x53,
-	0x62, 0x21, 0xca, 0x15, 0x84, 0xda, 0x65, 0xc8, 0x4c, 0x0a, 0xbd, 0x25, 0x1d, 0x32, 0x08, 0x6a,
-	0xa6, 0xcb, 0xbe, 0x41, 0xcd, 0xf4, 0x6d, 0xab, 0x62, 0xc3, 0x5e, 0x33, 0xb6, 0x93, 0x7c, 0xa1,
-	0x20, 0x1c, 0x57, 0x07, 0xd0, 0x8b, 0x68, 0x2a, 0x88, 0xcd, 0x32, 0xca, 0xe9, 0x03, 0xc3, 0x90,
-	0x4a, 0x6f, 0x7c, 0x35, 0x81, 0x6a, 0x79, 0x20, 0x95, 0x8c, 0xd9, 0x81, 0x75, 0x0c, 0x2d, 0x08,
-	0xaa, 0x37, 0xfd, 0x5a, 0x3c, 0x6d, 0x52, 0x40, 0x47, 0xbb, 0xd6, 0x01, 0xf8, 0x1c, 0x3a, 0xe8,
-	0xc2, 0x1a, 0x34, 0x67, 0xa1, 0xd5, 0xd4, 0xe6, 0x64, 0x73, 0x5c, 0xbf, 0x56, 0x14, 0x80, 0xc4,
-	0x8c, 0xbc, 0xc8, 0x26, 0x3a, 0x16, 0x25, 0xbe, 0x6d, 0xd5, 0xad, 0x1a, 0x1b, 0xa7, 0xcd, 0x57,
-	0xd1, 0xf1, 0x1e, 0x15, 0x40, 0x5a, 0x45, 0xd3, 0x9e, 0x58, 0xe9, 0xd7, 0x6e, 0x13, 0x7c, 0xc8,
-	0x75, 0x94, 0x15, 0x42, 0x6f, 0x53, 0x6e, 0x55, 0x03, 0xb5, 0xeb, 0xce, 0xae, 0xef, 0x94, 0x1d,
-	0xde, 0x18, 0x07, 0xeb, 0x5b, 0x05, 0x69, 0xfb, 0xca, 0x01, 0xdf, 0x3d, 0x94, 0xae, 0x86, 0x8b,
-	0xd0, 0xe7, 0x13, 0x1d, 0xbd, 0x0a, 0xbb, 0xf4, 0x1a, 0x75, 0xdc, 0xfc, 0xe6, 0xc3, 0xa6, 0x36,
-	0xd1, 0x2e, 0x69, 0xb4, 0x93, 0xfc, 0xf0, 0x87, 0xb6, 0x52, 0x71, 0xf8, 0x2d, 0xbf, 0xa4, 0xef,
-	0xd0, 0x1a, 0x5c, 0x22, 0xf8, 0x27, 0xc7, 0xca, 0xb7, 0x0d, 0xde, 0xf0, 0x6c, 0x26, 0x44, 0x98,
-	0xd9, 0x8e, 0x48, 0xb6, 0xa0, 0x72, 0x82, 0xf0, 0xe6, 0x2d, 0xab, 0x6e, 0x8f, 0xd5, 0x00, 0x8e,
-	0x32, 0xbd, 0x32, 0x90, 0xe1, 0x7b, 0x68, 0x86, 0xb7, 0x97, 0xa1, 0x0d, 0x7d, 0x72, 0x5c, 0x84,
-	0x1c, 0x8f, 0xc8, 0x58, 0x62, 0x6f, 0x91, 0x89, 0xcd, 0xc4, 0x8c, 0x4b, 0x91, 0xbf, 0x15, 0x38,
-	0x88, 0x37, 0x3d, 0xca, 0xb7, 0xeb, 0xce, 0x8e, 0x3d, 0x06, 0x3b, 0xde, 0x42, 0x73, 0x01, 0x44,
-	0xd1, 0x62, 0xcc, 0xe6, 0xc5, 0xb2, 0xed, 0xd2, 0x9a, 0xb8, 0x34, 0xe9, 0xfc, 0x62, 0xab, 0xa9,
-	0x1d, 0x97, 0xbb, 0xba, 0x3d, 0x88, 0x39, 0x1b, 0x2c, 0x6d, 0x04, 0x2b, 0x9b, 0xc1, 0x02, 0xbe,
-	0x86, 0xe6, 0x77, 0x7d, 0xca, 0x3b, 0x75, 0x0e, 0x08, 0x9d, 0x93, 0xad, 0xa6, 0x96, 0x91, 0x3a,
-	0x3d, 0x2e, 0xc4, 0x3c, 0x2c, 0xd6, 0xda, 0x4a, 0xaf, 0xa7, 0x0e, 0xa6, 0xe6, 0xa6, 0xcc, 0x99,
-	0x3d, 0x87, 0xdf, 0xba, 0xb9, 0x67, 0x79, 0x57, 0x6c, 0x9b, 0xbc, 0x01, 0xd7, 0x24, 0x96, 0x68,
-	0xf4, 0x31, 0x4b, 0xb3, 0x70, 0x51, 0x24, 0x9b, 0xce, 0x1f, 0x6d, 0x35, 0xb5, 0x79, 0x19, 0x2e,
-	0x30, 0x15, 0xbd, 0xc0, 0x46, 0xcc, 0xb6, 0x1f, 0xf9, 0x47, 0x41, 0xa7, 0xa4, 0xde, 0x9e, 0xe5,
-	0x6d, 0xdd, 0xb5, 0x76, 0xf8, 0x46, 0x8d, 0xfa, 0x2e, 0x2f, 0xb8, 0x61, 0x01, 0x9f, 0x47, 0xd3,
-	0xcc, 0x76, 0xcb, 0x76, 0x1d, 0x34, 0xe7, 0x5b, 0x4d, 0xed, 0x10, 0x68, 0x8a, 0x75, 0x62, 0x82,
-	0x43, 0xac, 0xd6, 0x93, 0x03, 0x6b, 0x9d, 0x43, 0x4f, 0x71, 0x7a, 0xdb, 0x76, 0x0b, 0x2e, 0x94,
-	0xe6, 0x48, 0xab, 0xa9, 0x1d, 0x0e, 0x1b, 0x7d, 0xdb, 0x76, 0x8b, 0x8e, 0x4b, 0xcc, 0xd0, 0x07,
-	0xbf, 0x83, 0xa6, 0xeb, 0xd4, 0xe7, 0x36, 0xcb, 0xa4, 0xc4, 0xcd, 0x58, 0xd6, 0x93, 0x9e, 0x3e,
-	0x3d, 0xc8, 0x22, 0x4a, 0x20, 0xf0, 0xcf, 0x1f, 0x85, 0x33, 0x04, 0xc8, 0x52, 0x84, 0x98, 0xa0,
-	0x46, 0x1e, 0x28, 0x70, 0xcf, 0x13, 0xf2, 0x87, 0xba, 0xee, 0xa2, 0x59, 0x41, 0x71, 0xc3, 0x07,
-	0x1b, 0x14, 0xa2, 0x10, 0x28, 0xff, 0xde, 0xd4, 0x96, 0x86, 0xb8, 0x6d, 0x05, 0x97, 0xb7, 0x4f,
-	0x90, 0x4c, 0x8f, 0xfa, 0xbc, 0x68, 0x09, 0x3d, 0x62, 0x76, 0x05, 0x20, 0xf7, 0x27, 0x93, 0xa9,
-	0x6e, 0xf8, 0xfc, 0x3f, 0x6e, 0xcb, 0xbb, 0x51, 0x9d, 0x0f, 0x88, 0x3a, 0xaf, 0x0c, 0xaa, 0x73,
-	0x80, 0x34, 0x44, 0xa1, 0x83, 0x07, 0x21, 0x4c, 0x32, 0x93, 0x12, 0xc4, 0xb1, 0x07, 0x21, 0xaa,
-	0x08, 0x31, 0x23, 0x2f, 0xf2, 0x79, 0xf8, 0xcd, 0x4c, 0x2a, 0x02, 0xf4, 0xc6, 0x45, 0x87, 0xe0,
-	0x84, 0x74, 0xb4, 0xe6, 0xda, 0xc8, 0xad, 0x39, 0xd6, 0x79, 0xf2, 0xa2, 0xce, 0x74, 0xca, 0x93,
-	0x93, 0x48, 0x6d, 0x7f, 0xdd, 0xba, 0x5f, 0x04, 0xf2, 0xb5, 0x82, 0x16, 0x13, 0xcd, 0xff, 0x8b,
-	0x2f, 0xfc, 0xf9, 0x2f, 0x0f, 0xa1, 0x29, 0x81, 0x87, 0x3f, 0x46, 0x62, 0x4e, 0x60, 0x78, 0x9f,
-	0x6b, 0xd4, 0x33, 0xdf, 0xa8, 0x2b, 0x83, 0x1d, 0x65, 0x92, 0xe4, 0x99, 0xfb, 0xbf, 0xfe, 0xf5,
-	0x60, 0xf2, 0x14, 0x5e, 0x34, 0x12, 0x07, 0x4e, 0x39, 0x98, 0x7c, 0xaa, 0xa0, 0x83, 0xe1, 0xcc,
-	0x80, 0xcf, 0xf4, 0xd1, 0xee, 0x1a, 0x38, 0xd4, 0xb3, 0x43, 0xf9, 0x02, 0xca, 0xb2, 0x40, 0x79,
-	0x1a, 0x6b, 0xc9, 0x28, 0xd1, 0x18, 0x82, 0xbf, 0x53, 0xd0, 0x6c, 0x67, 0xcf, 0xf0, 0xb9, 0x3e,
-	0x81, 0x12, 0xbb, 0xaf, 0xae, 0x8d, 0xb0, 0x03, 0x00, 0x73, 0x02, 0x70, 0x19, 0x3f, 0x97, 0x0c,
-	0x28, 0x1f, 0xbc, 0xa8, 0x81, 0xf8, 0x13, 0x05, 0xa5, 0x82, 0x0c, 0xf1, 0xd2, 0x80, 0x6e, 0x84,
-	0x48, 0xcb, 0x03, 0xfd, 0x00, 0x64, 0x55, 0x80, 0x2c, 0xe1, 0x67, 0xfb, 0x34, 0xcd, 0xf8, 0x48,
-	0x7e, 0x23, 0xee, 0xe1, 0x6f, 0x14, 0x84, 0xda, 0x03, 0x16, 0x5e, 0x1d, 0x10, 0xa5, 0x63, 0x9a,
-	0x53, 0x73, 0x43, 0x7a, 0x03, 0xd9, 0xba, 0x20, 0xcb, 0xe1, 0xb3, 0xc3, 0x90, 0x19, 0x72, 0x78,
-	0xc3, 0x3f, 0x29, 0x08, 0xf7, 0x4e, 0x5a, 0xf8, 0xc2, 0xa0, 0x0e, 0x25, 0xcd, 0x79, 0xea, 0xc5,
-	0x11, 0x77, 0x01, 0xf8, 0x86, 0x00, 0x7f, 0x09, 0x5f, 0x1a, 0x0a, 0x5c, 0xb6, 0x5a, 0x7c, 0x8e,
-	0xdb, 0xfd, 0xfe, 0x5e, 0x41, 0x33, 0xb1, 0x39, 0x0a, 0xe7, 0x06, 0x91, 0x74, 0x8c, 0x6d, 0xaa,
-	0x3e, 0xac, 0x3b, 0x10, 0x5f, 0x12, 0xc4, 0xeb, 0x78, 0x6d, 0x04, 0x62, 0x39, 0x8d, 0xe1, 0xaf,
-	0x14, 0x94, 0x8e, 0x26, 0x12, 0xdc, 0xef, 0x92, 0x76, 0x0f, 0x68, 0xea, 0xea, 0x70, 0xce, 0xe3,
-	0x1d, 0x87, 0x60, 0x2f, 0xc3, 0xbf, 0x28, 0xe8, 0xc4, 0x16, 0xe3, 0x4e, 0xcd, 0xe2, 0x76, 0xcf,
-	0x3b, 0x8f, 0xd7, 0xfb, 0x01, 0xec, 0x33, 0x15, 0xa9, 0x17, 0x46, 0xdb, 0x04, 0xf4, 0x9b, 0x82,
-	0xfe, 0x32, 0x7e, 0x39, 0x99, 0x3e, 0xe2, 0xb6, 0x01, 0xd6, 0x60, 0x7b, 0x96, 0x57, 0xb4, 0x03,
-	0x2d, 0x78, 0x91, 0x8a, 0x8e, 0x8b, 0x1f, 0x29, 0x48, 0xdd, 0x27, 0x9d, 0x1b, 0x3e, 0xc7, 0x23,
-	0xa0, 0xb5, 0xe7, 0x89, 0xbe, 0xa7, 0x7c, 0xff, 0x07, 0x98, 0x6c, 0x89, 0x8c, 0x5e, 0xc5, 0xaf,
-	0x8c, 0x9f, 0x11, 0xf5, 0x79, 0xbe, 0xf0, 0xf0, 0x71, 0x56, 0x79, 0xf4, 0x38, 0xab, 0xfc, 0xf9,
-	0x38, 0xab, 0x7c, 0xf6, 0x24, 0x3b, 0xf1, 0xe8, 0x49, 0x76, 0xe2, 0xb7, 0x27, 0xd9, 0x89, 0xf7,
-	0x8d, 0xd8, 0x4b, 0x07, 0x21, 0x72, 0x55, 0xab, 0xc4, 0xa2, 0x78, 0x77, 0x5e, 0x30, 0xee, 0xca,
-	0xa0, 0xe2, 0xd9, 0x2b, 0x4d, 0x8b, 0x3f, 0xe7, 0xd6, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x22,
-	0xfc, 0xd6, 0x3e, 0x3e, 0x11, 0x00, 0x00,
+	// 1626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0x1c, 0x45,
+	0x16, 0xc7, 0xdd, 0xe3, 0xb1, 0x37, 0x2e, 0x2b, 0xfe, 0x51, 0x71, 0x9c, 0x49, 0x3b, 0x9e, 0x76,
+	0x6a, 0x77, 0x6d, 0x6f, 0xe2, 0x99, 0x8e, 0xe3, 0x64, 0x57, 0xd9, 0xcd, 0x06, 0x3c, 0xb1, 0x13,
+	0x0f, 0x0a, 0xd8, 0xb4, 0x23, 0x82, 0xc8, 0xa1, 0xd5, 0x1e, 0x77, 0xc6, 0xad, 0x4c, 0x77, 0xb5,
+	0xa7, 0xaa, 0x63, 0x5b, 0x28, 0x42, 0x8a, 0xc4, 0x8d, 0x43, 0x50, 0x38, 0x20, 0x81, 0x10, 0x07,
+	0x24, 0x24, 0xae, 0x20, 0xf1, 0x0f, 0x70, 0x88, 0x50, 0x0e, 0x91, 0xb8, 0x20, 0x0e, 0x13, 0x48,
+	0x90, 0xb8, 0xcf, 0x1d, 0x09, 0x75, 0x55, 0x75, 0x4f, 0x4f, 0xbb, 0x3d, 0x3f, 0x2c, 0x22, 0x71,
+	0xb2, 0xa7, 0xea, 0xbd, 0x6f, 0x7d, 0xde, 0x7b, 0x55, 0xd5, 0xaf, 0xc0, 0x14, 0x26, 0x36, 0x26,
+	0x16, 0x51, 0xcb, 0x86, 0x6d, 0xab, 0xf7, 0xe6, 0x37, 0x4c, 0x6a, 0xcc, 0xab, 0xdb, 0x9e, 0x59,
+	0xdd, 0xcb, 0xbb, 0x55, 0x4c, 0x31, 0x1c, 0x13, 0x16, 0x79, 0xdf, 0x22, 0x2f, 0x2c, 0xe4, 0xb1,
+	0x32, 0x2e, 0x63, 0x66, 0xa0, 0xfa, 0xff, 0x71, 0x5b, 0x79, 0x32, 0x51, 0x8d, 0xee, 0x8a, 0xe9,
+	0x6c, 0x89, 0xcd, 0xab, 0x1b, 0x06, 0x31, 0xc3, 0xd9, 0x12, 0xb6, 0x1c, 0x31, 0x7f, 0x26, 0x3a,
+	0xcf, 0x18, 0x42, 0x2b, 0xd7, 0x28, 0x5b, 0x8e, 0x41, 0x2d, 0x1c, 0xd8, 0x9e, 0x2a, 0x63, 0x5c,
+	0xae, 0x98, 0xaa, 0xe1, 0x5a, 0xaa, 0xe1, 0x38, 0x98, 0xb2, 0x49, 0x22, 0x66, 0x4f, 0x8a, 0x59,
+	0xf6, 0x6b, 0xc3, 0xbb, 0xa3, 0x1a, 0x8e, 0x88, 0x47, 0x56, 0xe2, 0x53, 0xd4, 0xb2, 0x4d, 0x42,
+	0x0d, 0xdb, 0x0d, 0x7c, 0x39, 0x85, 0xce, 0xa3, 0xe3, 0x3f, 0xf8, 0x14, 0xba, 0x02, 0x46, 0xde,
+	0xf4, 0xb1, 0xd6, 0x30, 0xae, 0x68, 0xe6, 0xb6, 0x67, 0x12, 0x0a, 0xcf, 0x80, 0x7e, 0x17, 0xe3,
+	0x4a, 0x71, 0x33, 0x23, 0x4d, 0x49, 0xb3, 0xe9, 0x02, 0xac, 0xd7, 0x94, 0xa1, 0x3d, 0xc3, 0xae,
+	0xfc, 0x17, 0xf9, 0xe3, 0xba, 0xb5, 0x89, 0x34, 0x61, 0x81, 0x56, 0xc0, 0x68, 0xc4, 0x9f, 0xb8,
+	0xd8, 0x21, 0x26, 0x5c, 0x00, 0x69, 0x7f, 0x9a, 0xb9, 0x0f, 0x9e, 0x1f, 0xcb, 0x73, 0xbe, 0x7c,
+	0xc0, 0x97, 0x5f, 0x74, 0xf6, 0x0a, 0x03, 0xdf, 0x7f, 0x93, 0xeb, 0xf3, 0xbd, 0x8a, 0x1a, 0x33,
+	0x46, 0xb7, 0x23, 0x4a, 0x24, 0x40, 0xb9, 0x06, 0x40, 0x23, 0x4f, 0x99, 0x14, 0xd3, 0x9b, 0xce,
+	0x8b, 0x08, 0xfc, 0xa4, 0xe6, 0x79, 0x61, 0x45, 0x52, 0xf3, 0x6b, 0x46, 0xd9, 0x14, 0xbe, 0x5a,
+	0xc4, 0x13, 0x7d, 0x24, 0x01, 0x18, 0x55, 0x17, 0xa0, 0x17, 0x41, 0x9f, 0xbf, 0x36, 0xc9, 0x48,
+	0x53, 0xbd, 0x9d, 0x90, 0x72, 0x6b, 0x78, 0x3d, 0x81, 0x6a, 0xa6, 0x2d, 0x15, 0x5f, 0xb3, 0x09,
+	0x6b, 0x1c, 0x8c, 0x31, 0xaa, 0x37, 0x3c, 0x3b, 0x1a, 0x36, 0x2a, 0x82, 0xe3, 0xb1, 0x71, 0x01,
+	0x7c, 0x0e, 0x1c, 0x71, 0xc4, 0x98, 0x28, 0xce, 0x58, 0xbd, 0xa6, 0x8c, 0xf0, 0xe2, 0x38, 0x9e,
+	0xad, 0x33, 0x40, 0xa4, 0x85, 0x56, 0x68, 0x09, 0x8c, 0x87, 0x81, 0xaf, 0x19, 0x55, 0xc3, 0x26,
+	0x87, 0x29, 0xf3, 0x75, 0x70, 0x62, 0x9f, 0x8a, 0x40, 0x9a, 0x03, 0xfd, 0x2e, 0x1b, 0x69, 0x55,
+	0x6e, 0x4d, 0xd8, 0xa0, 0x1b, 0x20, 0xcb, 0x84, 0x6e, 0x62, 0x6a, 0x54, 0x7c, 0xb5, 0x1b, 0xd6,
+	0xb6, 0x67, 0x6d, 0x5a, 0x74, 0xef, 0x30, 0x58, 0x9f, 0x4b, 0x40, 0x39, 0x50, 0x4e, 0xf0, 0xdd,
+	0x07, 0x03, 0x95, 0x60, 0x50, 0xd4, 0xf9, 0x64, 0x53, 0xad, 0x82, 0x2a, 0x5d, 0xc5, 0x96, 0x53,
+	0x58, 0x7a, 0x5c, 0x53, 0x7a, 0x1a, 0x29, 0x0d, 0x3d, 0xd1, 0x57, 0xcf, 0x94, 0xd9, 0xb2, 0x45,
+	0xb7, 0xbc, 0x8d, 0x7c, 0x09, 0xdb, 0xe2, 0x10, 0x89, 0x3f, 0x39, 0xb2, 0x79, 0x57, 0xa5, 0x7b,
+	0xae, 0x49, 0x98, 0x08, 0xd1, 0x1a, 0x2b, 0xa2, 0x65, 0x70, 0xa2, 0x41, 0xb8, 0xbe, 0x65, 0x54,
+	0xcd, 0x43, 0x15, 0x80, 0x82, 0xcc, 0x7e, 0x19, 0x11, 0xe1, 0xdb, 0x60, 0x90, 0x36, 0x86, 0x45,
+	0x19, 0x5a, 0xc4, 0x38, 0x21, 0x62, 0x3c, 0xc6, 0xd7, 0x62, 0xbe, 0x3a, 0x61, 0xce, 0x48, 0x8b,
+	0x4a, 0xa1, 0x15, 0x20, 0xb3, 0x55, 0xd7, 0x4b, 0x46, 0xc5, 0x72, 0xca, 0xd7, 0x8c, 0x12, 0xc5,
+	0xd5, 0x43, 0xf1, 0x7f, 0x9c, 0x02, 0x13, 0x89, 0x52, 0x22, 0x86, 0xab, 0x60, 0x98, 0xf0, 0x19,
+	0xfd, 0x0e, 0x9f, 0x62, 0xb5, 0x4a, 0x17, 0xe4, 0x7a, 0x4d, 0x19, 0xe7, 0xa2, 0x31, 0x03, 0xa4,
+	0x0d, 0x91, 0x26, 0x31, 0x78, 0x0b, 0x8c, 0x53, 0xa3, 0x5a, 0x36, 0xa9, 0x1e, 0xd7, 0x4a, 0x31,
+	0xad, 0xd3, 0xf5, 0x9a, 0x32, 0x29, 0x82, 0x4e, 0xb4, 0x43, 0xda, 0x18, 0x9f, 0x68, 0xa6, 0x84,
+	0xb7, 0xc1, 0xa0, 0x70, 0xf0, 0xaf, 0xd6, 0x4c, 0x2f, 0xcb, 0xb0, 0xbc, 0x6f, 0xa3, 0xdf, 0x0c,
+	0xee, 0xdd, 0x42, 0xf6, 0x71, 0x4d, 0x91, 0xea, 0x35, 0x05, 0x36, 0xad, 0xe6, 0x3b, 0xa3, 0x87,
+	0xcf, 0x14, 0x49, 0x03, 0x7c, 0xc4, 0x77, 0x40, 0xbf, 0x49, 0xe2, 0xb4, 0xaf, 0xbb, 0x98, 0xae,
+	0x55, 0xad, 0x92, 0x79, 0x88, 0x04, 0xc3, 0x65, 0x30, 0xe2, 0x57, 0x5a, 0x37, 0x08, 0x31, 0xa9,
+	0xbe, 0x69, 0x3a, 0xd8, 0x66, 0x37, 0xd3, 0x40, 0x61, 0xa2, 0x5e, 0x53, 0x4e, 0x70, 0xaf, 0xb8,
+	0x05, 0xd2, 0x86, 0xfc, 0xa1, 0x45, 0x7f, 0x64, 0xc9, 0x1f, 0x80, 0x2b, 0x60, 0x74, 0xdb, 0xc3,
+	0xb4, 0x59, 0xa7, 0x97, 0xe9, 0x9c, 0xaa, 0xd7, 0x94, 0x0c, 0xd7, 0xd9, 0x67, 0x82, 0xb4, 0x61,
+	0x36, 0xd6, 0x50, 0x7a, 0x2d, 0x7d, 0x24, 0x3d, 0xd2, 0xa7, 0x0d, 0xee, 0x58, 0x74, 0x6b, 0x7d,
+	0xc7, 0x70, 0xaf, 0x99, 0x26, 0x7a, 0x1d, 0x8c, 0xc7, 0x03, 0x0d, 0xbf, 0x18, 0x03, 0x24, 0x18,
+	0x64, 0xc1, 0x0e, 0x14, 0x8e, 0xd7, 0x6b, 0xca, 0xa8, 0x28, 0xbc, 0x8b, 0xa9, 0xee, 0xfa, 0x73,
+	0x48, 0x6b, 0xd8, 0xa1, 0xdf, 0x25, 0x30, 0xc9, 0xf5, 0x76, 0x0c, 0x77, 0x79, 0xd7, 0x28, 0xd1,
+	0x45, 0x1b, 0x7b, 0x0e, 0x2d, 0x3a, 0x41, 0x02, 0xff, 0x05, 0xfa, 0x89, 0xe9, 0x6c, 0x9a, 0x55,
+	0xa1, 0x39, 0x5a, 0xaf, 0x29, 0x47, 0x85, 0x26, 0x1b, 0x47, 0x9a, 0x30, 0x88, 0xe4, 0x3a, 0xd5,
+	0x36, 0xd7, 0x39, 0xf0, 0x37, 0x8a, 0xef, 0x9a, 0x4e, 0xd1, 0x11, 0xa9, 0x39, 0x56, 0xaf, 0x29,
+	0xc3, 0xc1, 0x69, 0xba, 0x6b, 0x3a, 0xba, 0xe5, 0x20, 0x2d, 0xb0, 0x81, 0x6f, 0x81, 0xfe, 0x2a,
+	0xf6, 0xa8, 0x49, 0x32, 0x69, 0x76, 0xfd, 0xcc, 0xe4, 0x93, 0x1a, 0x90, 0xbc, 0x1f, 0x45, 0x18,
+	0x80, 0x6f, 0x5f, 0x38, 0x2e, 0x0e, 0xaa, 0x40, 0xe6, 0x22, 0x48, 0x13, 0x6a, 0xe8, 0x91, 0x24,
+	0x2e, 0xd3, 0x84, 0xf8, 0x45, 0x5e, 0xb7, 0xc1, 0x10, 0xa3, 0x58, 0xf5, 0xc4, 0x9c, 0x48, 0x44,
+	0xd1, 0x57, 0xfe, 0xa9, 0xa6, 0x4c, 0x77, 0x70, 0xa5, 0x15, 0x1d, 0xda, 0xd8, 0x41, 0x3c, 0x3c,
+	0xec, 0x51, 0xdd, 0x60, 0x7a, 0x48, 0x8b, 0x2d, 0x80, 0x1e, 0xa4, 0x92, 0xa9, 0x56, 0x3d, 0xfa,
+	0x92, 0xcb, 0x72, 0x2b, 0xcc, 0x73, 0x2f, 0xcb, 0xf3, 0x6c, 0xbb, 0x3c, 0xfb, 0x48, 0x1d, 0x24,
+	0xda, 0xff, 0xea, 0x06, 0x41, 0x66, 0xd2, 0x8c, 0x38, 0xf2, 0xd5, 0x0d, 0x33, 0x82, 0xb4, 0xd0,
+	0x0a, 0x7d, 0x18, 0x7c, 0x98, 0x92, 0x92, 0x20, 0x6a, 0xe3, 0x80, 0xa3, 0x62, 0x87, 0x34, 0x95,
+	0x66, 0xa5, 0xeb, 0xd2, 0x8c, 0x37, 0xef, 0xbc, 0xb0, 0x32, 0xcd, 0xf2, 0xe8, 0xdb, 0xe0, 0x9e,
+	0x29, 0xf8, 0xdd, 0x91, 0x1f, 0x59, 0x50, 0x8f, 0xc8, 0x7e, 0x96, 0x3a, 0xd8, 0xcf, 0xaf, 0x0a,
+	0xf0, 0x55, 0x8f, 0x1f, 0x75, 0x71, 0xcf, 0xc8, 0x71, 0x14, 0xec, 0x85, 0xb7, 0x43, 0xb3, 0x83,
+	0xbf, 0xa0, 0x6d, 0xec, 0xae, 0x60, 0x97, 0xb0, 0x03, 0x94, 0x8e, 0x2e, 0x68, 0x1b, 0xbb, 0xfa,
+	0x16, 0x76, 0x09, 0xd2, 0x02, 0x1b, 0xf4, 0x24, 0x05, 0xc6, 0xe3, 0xe4, 0x22, 0x89, 0x8d, 0xb3,
+	0x25, 0xfd, 0x99, 0x67, 0x2b, 0xe1, 0xe0, 0xa4, 0x5e, 0xf2, 0xc1, 0x81, 0x65, 0x30, 0xc8, 0xee,
+	0xb8, 0xa2, 0xed, 0x1a, 0x25, 0x2a, 0x6e, 0x96, 0xe5, 0x2e, 0xd6, 0x5b, 0x32, 0x4b, 0x8d, 0xaf,
+	0x3a, 0x93, 0xd2, 0x2d, 0xa6, 0x85, 0xb4, 0xa8, 0x32, 0x3a, 0x05, 0xe4, 0x46, 0x2f, 0x11, 0xef,
+	0xbf, 0xd0, 0xa7, 0x12, 0x98, 0x48, 0x9c, 0xfe, 0x4b, 0xf4, 0x53, 0xe7, 0x7f, 0x19, 0x06, 0x7d,
+	0x0c, 0x0f, 0xbe, 0x07, 0x58, 0x57, 0x4e, 0xe0, 0x01, 0x35, 0xdf, 0xf7, 0x9a, 0x90, 0x67, 0xdb,
+	0x1b, 0xf2, 0x20, 0xd1, 0xdf, 0x1f, 0xfc, 0xf0, 0xeb, 0xa3, 0xd4, 0x24, 0x9c, 0x50, 0x13, 0xdf,
+	0x7f, 0xfc, 0x19, 0xf0, 0x81, 0x04, 0x8e, 0x04, 0x1d, 0x3a, 0x3c, 0xd3, 0x42, 0x3b, 0xd6, 0xde,
+	0xcb, 0x67, 0x3b, 0xb2, 0x15, 0x28, 0x33, 0x0c, 0xe5, 0x34, 0x54, 0x92, 0x51, 0xc2, 0xa6, 0x1f,
+	0x7e, 0x21, 0x81, 0xa1, 0xe6, 0x9a, 0xc1, 0x73, 0x2d, 0x16, 0x4a, 0xac, 0xbe, 0x3c, 0xdf, 0x85,
+	0x87, 0x00, 0xcc, 0x31, 0xc0, 0x19, 0xf8, 0xcf, 0x64, 0x40, 0xde, 0x5e, 0x86, 0x05, 0x84, 0xef,
+	0x4b, 0x20, 0xed, 0x47, 0x08, 0xa7, 0xdb, 0x54, 0x23, 0x40, 0x9a, 0x69, 0x6b, 0x27, 0x40, 0xe6,
+	0x18, 0xc8, 0x34, 0xfc, 0x47, 0x8b, 0xa2, 0xa9, 0xef, 0xf2, 0x8f, 0xc5, 0x7d, 0xf8, 0x99, 0x04,
+	0x40, 0xe3, 0x39, 0x03, 0xe7, 0xda, 0xac, 0xd2, 0xf4, 0x76, 0x92, 0x73, 0x1d, 0x5a, 0x0b, 0xb2,
+	0x05, 0x46, 0x96, 0x83, 0x67, 0x3b, 0x21, 0x53, 0xf9, 0x53, 0x09, 0x7e, 0x27, 0x01, 0xb8, 0xff,
+	0x5d, 0x03, 0x2f, 0xb4, 0xab, 0x50, 0xd2, 0xab, 0x4a, 0xbe, 0xd8, 0xa5, 0x97, 0x00, 0x5f, 0x64,
+	0xe0, 0xff, 0x83, 0x97, 0x3a, 0x02, 0xe7, 0xa5, 0xf6, 0x7f, 0x45, 0xea, 0xfd, 0xa5, 0x04, 0x06,
+	0x23, 0xaf, 0x16, 0x98, 0x6b, 0x47, 0xd2, 0xf4, 0x48, 0x92, 0xf3, 0x9d, 0x9a, 0x0b, 0xe2, 0x4b,
+	0x8c, 0x78, 0x01, 0xce, 0x77, 0x41, 0xcc, 0xdf, 0x3e, 0xf0, 0x6b, 0x09, 0x0c, 0xc5, 0x1a, 0xff,
+	0x56, 0x07, 0x28, 0xf1, 0x51, 0x24, 0xcf, 0x77, 0xe1, 0x21, 0x90, 0x2f, 0x33, 0xe4, 0x7f, 0xc3,
+	0x0b, 0x1d, 0x21, 0xc7, 0x9e, 0x2c, 0xf0, 0x13, 0x09, 0x0c, 0x84, 0x0d, 0x35, 0x6c, 0x75, 0xb5,
+	0xc4, 0xdf, 0x17, 0xf2, 0x5c, 0x67, 0xc6, 0x87, 0xdb, 0xc4, 0xbe, 0x2f, 0x81, 0x4f, 0x24, 0x70,
+	0x72, 0x99, 0x50, 0xcb, 0x36, 0xa8, 0xb9, 0xaf, 0x4d, 0x85, 0x0b, 0xad, 0x00, 0x0e, 0x68, 0xea,
+	0xe5, 0x0b, 0xdd, 0x39, 0x09, 0xfa, 0x25, 0x46, 0x7f, 0x05, 0x5e, 0x4e, 0xa6, 0x0f, 0xb9, 0x4d,
+	0x01, 0xab, 0x92, 0x1d, 0xc3, 0xd5, 0x4d, 0x5f, 0x4b, 0x7c, 0xb1, 0x75, 0xcb, 0x81, 0x4f, 0x25,
+	0x20, 0x1f, 0x10, 0xce, 0xaa, 0x47, 0x61, 0x17, 0x68, 0x8d, 0x76, 0x58, 0xbe, 0xd8, 0xa5, 0x97,
+	0x88, 0x68, 0x99, 0x45, 0xf4, 0x0a, 0xfc, 0xff, 0xe1, 0x23, 0xc2, 0x1e, 0x85, 0x0f, 0x25, 0x30,
+	0x10, 0xf6, 0x55, 0x2d, 0xf7, 0x4f, 0xbc, 0x6f, 0x94, 0xe7, 0x3a, 0x33, 0x16, 0xbc, 0xb3, 0x8c,
+	0x17, 0xc1, 0xa9, 0x64, 0xde, 0x0d, 0x93, 0x50, 0x9d, 0x75, 0x5f, 0x85, 0xe2, 0xe3, 0xe7, 0x59,
+	0xe9, 0xe9, 0xf3, 0xac, 0xf4, 0xf3, 0xf3, 0xac, 0xf4, 0xf0, 0x45, 0xb6, 0xe7, 0xe9, 0x8b, 0x6c,
+	0xcf, 0x8f, 0x2f, 0xb2, 0x3d, 0xef, 0xa8, 0x91, 0x96, 0x41, 0xa8, 0xe4, 0x2a, 0xc6, 0x06, 0x09,
+	0x25, 0xef, 0xfd, 0x47, 0xdd, 0xe5, 0xba, 0xac, 0x7f, 0xd8, 0xe8, 0x67, 0x8f, 0xf3, 0x85, 0x3f,
+	0x06, 0x00, 0xd8, 0xcb, 0xb3, 0xe0, 0x16, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1103,12 +1350,18 @@ type QueryClient interface {
 	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
 	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
 	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	// ScalingFactors returns the current scaling factors of a stableswap pool,
+	// and the target scaling factors if they are being changed.
+	ScalingFactors(ctx context.Context, in *QueryScalingFactorsRequest, opts ...grpc.CallOption) (*QueryScalingFactorsResponse, error)
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
 	// Estimate the swap.
 	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
 	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
+	// Find the route through all pools with the largest output for swapping
+	// an exact amount in.
+	BestRoute(ctx context.Context, in *QueryBestRouteRequest, opts ...grpc.CallOption) (*QueryBestRouteResponse, error)
 }
 
 type queryClient struct {
@@ -1182,6 +1435,15 @@ func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesReque
 	return out, nil
 }
 
+func (c *queryClient) ScalingFactors(ctx context.Context, in *QueryScalingFactorsRequest, opts ...grpc.CallOption) (*QueryScalingFactorsResponse, error) {
+	out := new(QueryScalingFactorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ScalingFactors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
 	out := new(QuerySpotPriceResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/SpotPrice", in, out, opts...)
@@ -1209,6 +1471,15 @@ func (c *queryClient) EstimateSwapExactAmountOut(ctx context.Context, in *QueryS
 	return out, nil
 }
 
+func (c *queryClient) BestRoute(ctx context.Context, in *QueryBestRouteRequest, opts ...grpc.CallOption) (*QueryBestRouteResponse, error) {
+	out := new(QueryBestRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/BestRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
@@ -1219,12 +1490,18 @@ type QueryServer interface {
 	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
 	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
 	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
+	// ScalingFactors returns the current scaling factors of a stableswap pool,
+	// and the target scaling factors if they are being changed.
+	ScalingFactors(context.Context, *QueryScalingFactorsRequest) (*QueryScalingFactorsResponse, error)
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
 	// Estimate the swap.
 	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
 	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
+	// Find the route through all pools with the largest output for swapping
+	// an exact amount in.
+	BestRoute(context.Context, *QueryBestRouteRequest) (*QueryBestRouteResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1252,6 +1529,9 @@ func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
 }
+func (*UnimplementedQueryServer) ScalingFactors(ctx context.Context, req *QueryScalingFactorsRequest) (*QueryScalingFactorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScalingFactors not implemented")
+}
 func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
 }
@@ -1261,6 +1541,9 @@ func (*UnimplementedQueryServer) EstimateSwapExactAmountIn(ctx context.Context,
 func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context, req *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateSwapExactAmountOut not implemented")
 }
+func (*UnimplementedQueryServer) BestRoute(ctx context.Context, req *QueryBestRouteRequest) (*QueryBestRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestRoute not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1392,6 +1675,24 @@ func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScalingFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScalingFactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScalingFactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/ScalingFactors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScalingFactors(ctx, req.(*QueryScalingFactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySpotPriceRequest)
 	if err := dec(in); err != nil {
@@ -1446,6 +1747,24 @@ func _Query_EstimateSwapExactAmountOut_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BestRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBestRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/BestRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestRoute(ctx, req.(*QueryBestRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1478,6 +1797,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalShares",
 			Handler:    _Query_TotalShares_Handler,
 		},
+		{
+			MethodName: "ScalingFactors",
+			Handler:    _Query_ScalingFactors_Handler,
+		},
 		{
 			MethodName: "SpotPrice",
 			Handler:    _Query_SpotPrice_Handler,
@@ -1490,6 +1813,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateSwapExactAmountOut",
 			Handler:    _Query_EstimateSwapExactAmountOut_Handler,
 		},
+		{
+			MethodName: "BestRoute",
+			Handler:    _Query_BestRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/query.proto",
@@ -1882,6 +2209,103 @@ func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryScalingFactorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScalingFactorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScalingFactorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScalingFactorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScalingFactorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScalingFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetTime != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TargetTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TargetTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA8 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j7 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintQuery(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScalingFactors) > 0 {
+		dAtA10 := make([]byte, len(m.ScalingFactors)*10)
+		var j9 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2132,7 +2556,7 @@ func (m *QuerySwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBestRouteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2142,24 +2566,123 @@ func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBestRouteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBestRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBestRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBestRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBestRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -2356,6 +2879,45 @@ func (m *QueryTotalSharesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScalingFactorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryScalingFactorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.TargetTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TargetTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QuerySpotPriceRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2463,6 +3025,45 @@ func (m *QuerySwapExactAmountOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBestRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	return n
+}
+
+func (m *QueryBestRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryTotalLiquidityRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3433,7 +4034,7 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScalingFactorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3456,10 +4057,10 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScalingFactorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScalingFactorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3481,70 +4082,6 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3566,7 +4103,7 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScalingFactorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3589,17 +4126,169 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScalingFactorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScalingFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3609,25 +4298,244 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpotPrice = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
+			if m.TargetTime == nil {
+				m.TargetTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TargetTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
@@ -4150,6 +5058,291 @@ func (m *QuerySwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBestRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBestRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBestRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBestRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBestRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBestRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
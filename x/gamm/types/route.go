@@ -19,6 +19,27 @@ func (routes SwapAmountInRoutes) Validate() error {
 	return nil
 }
 
+// IntermediateDenoms returns the denoms swapped out of every hop but the last one,
+// and into the next hop.
+func (routes SwapAmountInRoutes) IntermediateDenoms() []string {
+	if len(routes) < 2 {
+		return nil
+	}
+	denoms := make([]string, len(routes)-1)
+	for i, route := range routes[:len(routes)-1] {
+		denoms[i] = route.TokenOutDenom
+	}
+	return denoms
+}
+
+func (routes SwapAmountInRoutes) PoolIds() []uint64 {
+	poolIds := make([]uint64, len(routes))
+	for i, route := range routes {
+		poolIds[i] = route.PoolId
+	}
+	return poolIds
+}
+
 type SwapAmountOutRoutes []SwapAmountOutRoute
 
 func (routes SwapAmountOutRoutes) Validate() error {
@@ -35,3 +56,24 @@ func (routes SwapAmountOutRoutes) Validate() error {
 
 	return nil
 }
+
+// IntermediateDenoms returns the denoms swapped into every hop but the first one,
+// and out of the previous hop.
+func (routes SwapAmountOutRoutes) IntermediateDenoms() []string {
+	if len(routes) < 2 {
+		return nil
+	}
+	denoms := make([]string, len(routes)-1)
+	for i, route := range routes[1:] {
+		denoms[i] = route.TokenInDenom
+	}
+	return denoms
+}
+
+func (routes SwapAmountOutRoutes) PoolIds() []uint64 {
+	poolIds := make([]uint64, len(routes))
+	for i, route := range routes {
+		poolIds[i] = route.PoolId
+	}
+	return poolIds
+}
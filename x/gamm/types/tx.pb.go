@@ -378,6 +378,157 @@ func (m *MsgSwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapExactAmountInResponse proto.InternalMessageInfo
 
+// ===================== MsgSplitRouteSwapExactAmountIn
+// SwapAmountInSplitRoute is one of the routes of a split route swap, along
+// with the amount swapped through it.
+type SwapAmountInSplitRoute struct {
+	Pools         []SwapAmountInRoute                    `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+	TokenInAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokenInAmount" yaml:"token_in_amount"`
+}
+
+func (m *SwapAmountInSplitRoute) Reset()         { *m = SwapAmountInSplitRoute{} }
+func (m *SwapAmountInSplitRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapAmountInSplitRoute) ProtoMessage()    {}
+func (*SwapAmountInSplitRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{7}
+}
+func (m *SwapAmountInSplitRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAmountInSplitRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAmountInSplitRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAmountInSplitRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAmountInSplitRoute.Merge(m, src)
+}
+func (m *SwapAmountInSplitRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAmountInSplitRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAmountInSplitRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAmountInSplitRoute proto.InternalMessageInfo
+
+func (m *SwapAmountInSplitRoute) GetPools() []SwapAmountInRoute {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// MsgSplitRouteSwapExactAmountIn swaps tokenInDenom through several routes,
+// which must all end in the same denom. tokenOutMinAmount applies to the sum
+// of the amounts out of all routes.
+type MsgSplitRouteSwapExactAmountIn struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Routes            []SwapAmountInSplitRoute               `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenInDenom      string                                 `protobuf:"bytes,3,opt,name=tokenInDenom,proto3" json:"tokenInDenom,omitempty" yaml:"token_in_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokenOutMinAmount" yaml:"token_out_min_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
+func (m *MsgSplitRouteSwapExactAmountIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountIn) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{8}
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountIn proto.InternalMessageInfo
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetRoutes() []SwapAmountInSplitRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+type MsgSplitRouteSwapExactAmountInResponse struct {
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokenOutAmount" yaml:"token_out_amount"`
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Reset() {
+	*m = MsgSplitRouteSwapExactAmountInResponse{}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSplitRouteSwapExactAmountInResponse) ProtoMessage()    {}
+func (*MsgSplitRouteSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{9}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse proto.InternalMessageInfo
+
 // ===================== MsgSwapExactAmountOut
 type SwapAmountOutRoute struct {
 	PoolId       uint64 `protobuf:"varint,1,opt,name=poolId,proto3" json:"poolId,omitempty" yaml:"pool_id"`
@@ -388,7 +539,7 @@ func (m *SwapAmountOutRoute) Reset()         { *m = SwapAmountOutRoute{} }
 func (m *SwapAmountOutRoute) String() string { return proto.CompactTextString(m) }
 func (*SwapAmountOutRoute) ProtoMessage()    {}
 func (*SwapAmountOutRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{7}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
 }
 func (m *SwapAmountOutRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +593,7 @@ func (m *MsgSwapExactAmountOut) Reset()         { *m = MsgSwapExactAmountOut{} }
 func (m *MsgSwapExactAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOut) ProtoMessage()    {}
 func (*MsgSwapExactAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{8}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
 }
 func (m *MsgSwapExactAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,7 +651,7 @@ func (m *MsgSwapExactAmountOutResponse) Reset()         { *m = MsgSwapExactAmoun
 func (m *MsgSwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapExactAmountOutResponse) ProtoMessage()    {}
 func (*MsgSwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{9}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
 }
 func (m *MsgSwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +693,7 @@ func (m *MsgJoinSwapExternAmountIn) Reset()         { *m = MsgJoinSwapExternAmou
 func (m *MsgJoinSwapExternAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountIn) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{10}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
 }
 func (m *MsgJoinSwapExternAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +751,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Reset()         { *m = MsgJoinSwapEx
 func (m *MsgJoinSwapExternAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapExternAmountInResponse) ProtoMessage()    {}
 func (*MsgJoinSwapExternAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{11}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
 }
 func (m *MsgJoinSwapExternAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +793,7 @@ func (m *MsgJoinSwapShareAmountOut) Reset()         { *m = MsgJoinSwapShareAmoun
 func (m *MsgJoinSwapShareAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOut) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{12}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
 }
 func (m *MsgJoinSwapShareAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +851,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Reset()         { *m = MsgJoinSwapSh
 func (m *MsgJoinSwapShareAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgJoinSwapShareAmountOutResponse) ProtoMessage()    {}
 func (*MsgJoinSwapShareAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{13}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
 }
 func (m *MsgJoinSwapShareAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +893,7 @@ func (m *MsgExitSwapShareAmountIn) Reset()         { *m = MsgExitSwapShareAmount
 func (m *MsgExitSwapShareAmountIn) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountIn) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{14}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
 }
 func (m *MsgExitSwapShareAmountIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +951,7 @@ func (m *MsgExitSwapShareAmountInResponse) Reset()         { *m = MsgExitSwapSha
 func (m *MsgExitSwapShareAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapShareAmountInResponse) ProtoMessage()    {}
 func (*MsgExitSwapShareAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{15}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
 }
 func (m *MsgExitSwapShareAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -841,7 +992,7 @@ func (m *MsgExitSwapExternAmountOut) Reset()         { *m = MsgExitSwapExternAmo
 func (m *MsgExitSwapExternAmountOut) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOut) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{16}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
 }
 func (m *MsgExitSwapExternAmountOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +1050,7 @@ func (m *MsgExitSwapExternAmountOutResponse) Reset()         { *m = MsgExitSwapE
 func (m *MsgExitSwapExternAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExitSwapExternAmountOutResponse) ProtoMessage()    {}
 func (*MsgExitSwapExternAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cfc8fd3ac7df3247, []int{17}
+	return fileDescriptor_cfc8fd3ac7df3247, []int{20}
 }
 func (m *MsgExitSwapExternAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -936,6 +1087,9 @@ func init() {
 	proto.RegisterType((*SwapAmountInRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountInRoute")
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountInResponse")
+	proto.RegisterType((*SwapAmountInSplitRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountInSplitRoute")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountIn)(nil), "osmosis.gamm.v1beta1.MsgSplitRouteSwapExactAmountIn")
+	proto.RegisterType((*MsgSplitRouteSwapExactAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgSplitRouteSwapExactAmountInResponse")
 	proto.RegisterType((*SwapAmountOutRoute)(nil), "osmosis.gamm.v1beta1.SwapAmountOutRoute")
 	proto.RegisterType((*MsgSwapExactAmountOut)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountOut")
 	proto.RegisterType((*MsgSwapExactAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgSwapExactAmountOutResponse")
@@ -952,75 +1106,82 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xd8, 0x6e, 0x9a, 0xbe, 0x90, 0xd0, 0x2c, 0x49, 0xe3, 0x6c, 0x5b, 0x3b, 0x1d, 0x10,
-	0xa4, 0x45, 0xdd, 0xa5, 0xa9, 0x44, 0x11, 0x12, 0x08, 0x0c, 0x41, 0x18, 0xd5, 0x32, 0xda, 0x5e,
-	0x2a, 0x2e, 0x61, 0x6d, 0xaf, 0xdc, 0x55, 0xb3, 0x33, 0xc6, 0x33, 0x1b, 0x5c, 0x71, 0xe0, 0x87,
-	0xc4, 0x19, 0x10, 0x3f, 0x8e, 0x88, 0x3f, 0x83, 0x1e, 0xe0, 0xdc, 0x63, 0x25, 0x84, 0x84, 0x38,
-	0x58, 0x28, 0xf9, 0x0f, 0xfc, 0x17, 0xa0, 0xdd, 0x9d, 0x5d, 0xef, 0x4f, 0xdb, 0xdb, 0xc4, 0xed,
-	0x29, 0xb1, 0xf7, 0xcd, 0x7b, 0xdf, 0x7c, 0xef, 0xdb, 0x6f, 0xde, 0x18, 0x2e, 0x53, 0x66, 0x51,
-	0x66, 0x32, 0xb5, 0xab, 0x5b, 0x96, 0x7a, 0x78, 0xa3, 0x65, 0x70, 0xfd, 0x86, 0xca, 0x07, 0x4a,
-	0xaf, 0x4f, 0x39, 0x95, 0xd6, 0xc5, 0x63, 0xc5, 0x79, 0xac, 0x88, 0xc7, 0xf2, 0x7a, 0x97, 0x76,
-	0xa9, 0x1b, 0xa0, 0x3a, 0xff, 0x79, 0xb1, 0x72, 0xa5, 0xed, 0x06, 0xab, 0x2d, 0x9d, 0x19, 0x41,
-	0xa6, 0x36, 0x35, 0x89, 0xf7, 0x1c, 0xff, 0x5e, 0x80, 0xe5, 0x06, 0xeb, 0x7e, 0x44, 0x4d, 0xf2,
-	0x31, 0xa5, 0x07, 0xd2, 0x55, 0x58, 0x64, 0x06, 0xe9, 0x18, 0xfd, 0x32, 0xda, 0x46, 0x3b, 0xe7,
-	0x6a, 0x6b, 0xa3, 0x61, 0x75, 0xe5, 0x81, 0x6e, 0x1d, 0xbc, 0x89, 0xbd, 0xef, 0xb1, 0x26, 0x02,
-	0xa4, 0x6b, 0xb0, 0xd8, 0xa3, 0xf4, 0xa0, 0xde, 0x29, 0x17, 0xb6, 0xd1, 0x4e, 0xa9, 0x26, 0x8d,
-	0x86, 0xd5, 0x55, 0x2f, 0xd4, 0xf9, 0x7e, 0xdf, 0xec, 0x60, 0x4d, 0x44, 0x48, 0x3d, 0x58, 0x65,
-	0xf7, 0xf4, 0xbe, 0xd1, 0xb4, 0xf9, 0xbb, 0x16, 0xb5, 0x09, 0x2f, 0x17, 0xdd, 0xf4, 0x1f, 0x3e,
-	0x1a, 0x56, 0x17, 0xfe, 0x1d, 0x56, 0x5f, 0xee, 0x9a, 0xfc, 0x9e, 0xdd, 0x52, 0xda, 0xd4, 0x52,
-	0x05, 0x62, 0xef, 0xcf, 0x75, 0xd6, 0xb9, 0xaf, 0xf2, 0x07, 0x3d, 0x83, 0x29, 0x75, 0xc2, 0x47,
-	0xc3, 0xea, 0x85, 0x50, 0x05, 0xdd, 0x4d, 0xb5, 0x4f, 0x6d, 0x8e, 0xb5, 0x58, 0x7e, 0xe9, 0x53,
-	0x58, 0xe6, 0xf4, 0xbe, 0x41, 0xea, 0xa4, 0xa1, 0x0f, 0x58, 0xb9, 0xb4, 0x5d, 0xdc, 0x59, 0xde,
-	0xdd, 0x52, 0xbc, 0xac, 0x8a, 0x43, 0x87, 0xcf, 0x9c, 0xf2, 0x1e, 0x35, 0x49, 0xed, 0x45, 0x07,
-	0xc9, 0x68, 0x58, 0xbd, 0xe8, 0xe5, 0x77, 0xd7, 0xee, 0x9b, 0x64, 0xdf, 0xd2, 0x07, 0xa2, 0x0e,
-	0xc3, 0x5a, 0x38, 0x25, 0xde, 0x80, 0x17, 0x42, 0xcc, 0x69, 0x06, 0xeb, 0x51, 0xc2, 0x0c, 0xfc,
-	0xd0, 0x63, 0x74, 0x6f, 0x60, 0xf2, 0x79, 0x32, 0x4a, 0x60, 0xc5, 0xdd, 0x71, 0x9d, 0x9c, 0x0e,
-	0xa1, 0x6e, 0x32, 0x67, 0xc3, 0xde, 0x66, 0xb1, 0x16, 0x4d, 0x2f, 0xb5, 0xe1, 0x39, 0x77, 0xf3,
-	0x4d, 0x9b, 0x37, 0x4c, 0x32, 0x03, 0xa1, 0x2f, 0x09, 0x42, 0x2f, 0x85, 0x09, 0xa5, 0x36, 0xdf,
-	0xb7, 0x82, 0x22, 0x0c, 0x6b, 0x91, 0xa4, 0x82, 0x52, 0x9f, 0xba, 0x80, 0xd2, 0xaf, 0x11, 0xac,
-	0xdd, 0xf9, 0x5c, 0xef, 0x79, 0x50, 0xea, 0x44, 0xa3, 0x36, 0x37, 0x42, 0x6c, 0xa1, 0xa9, 0x6c,
-	0xbd, 0x03, 0x2b, 0x7e, 0xa1, 0xf7, 0x0d, 0x42, 0x2d, 0x97, 0xe0, 0x73, 0x35, 0x79, 0xbc, 0xff,
-	0x31, 0xbe, 0x8e, 0x13, 0x80, 0xb5, 0xe8, 0x02, 0xfc, 0x57, 0x01, 0xd6, 0x1b, 0xac, 0xeb, 0xc0,
-	0xd8, 0x1b, 0xe8, 0x6d, 0xee, 0x63, 0xc9, 0xd3, 0xdf, 0x3d, 0x58, 0xec, 0x3b, 0xd0, 0x59, 0xb9,
-	0xe0, 0xb2, 0xf7, 0x8a, 0x92, 0xf6, 0x26, 0x2b, 0x89, 0xad, 0xd6, 0x4a, 0x0e, 0x97, 0x9a, 0x58,
-	0x2c, 0xdd, 0x86, 0xb3, 0x42, 0x87, 0x6e, 0xd3, 0x27, 0x76, 0x61, 0x53, 0x74, 0xe1, 0xf9, 0xa8,
-	0xac, 0xb1, 0xe6, 0xa7, 0x90, 0xbe, 0x80, 0xb5, 0x50, 0x0f, 0x84, 0x98, 0x4a, 0xee, 0x56, 0x1a,
-	0xb9, 0xc5, 0x74, 0x31, 0xbb, 0xd9, 0x58, 0x4b, 0xd6, 0xc1, 0x3f, 0x20, 0xb8, 0x94, 0xc6, 0xaa,
-	0xdf, 0x7a, 0xe9, 0x33, 0x58, 0xf5, 0x57, 0x09, 0x68, 0x1e, 0xcb, 0xf5, 0xdc, 0xd0, 0x36, 0xe3,
-	0xd0, 0x7c, 0x58, 0xb1, 0x02, 0xf8, 0x2b, 0x04, 0xd2, 0xb8, 0x05, 0x4d, 0x9b, 0xe7, 0x97, 0xdb,
-	0xdb, 0xe2, 0x65, 0xa9, 0x93, 0x59, 0xd5, 0x16, 0x89, 0xc7, 0x7f, 0x17, 0x60, 0x23, 0x49, 0x4b,
-	0xd3, 0xe6, 0x79, 0xd4, 0xf6, 0x41, 0x4c, 0x6d, 0x3b, 0xd3, 0xd4, 0xe6, 0x6f, 0x35, 0x26, 0xb7,
-	0x01, 0x9c, 0x1f, 0xdb, 0x5e, 0xc4, 0x6c, 0x6e, 0xe7, 0x6e, 0x82, 0x9c, 0xe9, 0xae, 0x58, 0x4b,
-	0x54, 0x91, 0x9a, 0xb0, 0xe4, 0xf7, 0xc6, 0x55, 0xe4, 0x44, 0xa5, 0x97, 0x85, 0xd2, 0xcf, 0xc7,
-	0x18, 0xc6, 0x5a, 0x90, 0x04, 0x7f, 0x87, 0xe0, 0x72, 0x2a, 0xaf, 0x81, 0xde, 0x88, 0x30, 0x8a,
-	0xc0, 0x56, 0xd1, 0xc9, 0x6c, 0x35, 0xd8, 0x69, 0x60, 0xab, 0x91, 0xf4, 0xf8, 0x8f, 0x02, 0x6c,
-	0x89, 0x53, 0xc4, 0x43, 0xc5, 0x8d, 0x3e, 0x79, 0x12, 0x6f, 0xc9, 0x73, 0x76, 0x9c, 0xba, 0x81,
-	0xf8, 0x67, 0xef, 0xa9, 0x19, 0x88, 0x77, 0x1a, 0x25, 0x0c, 0x24, 0x51, 0x07, 0xff, 0x82, 0xe0,
-	0x4a, 0x26, 0x7f, 0x61, 0x17, 0x89, 0x8d, 0x1f, 0x27, 0x74, 0x91, 0x31, 0xbe, 0xc0, 0x45, 0xa2,
-	0x05, 0xf0, 0xaf, 0xc5, 0x48, 0x63, 0xef, 0x38, 0x4f, 0x9f, 0xe8, 0x35, 0xce, 0xd3, 0xd8, 0xb7,
-	0x62, 0xbe, 0xe3, 0xbd, 0xa6, 0x5b, 0xa3, 0x61, 0x75, 0x23, 0x26, 0xc7, 0x34, 0xdb, 0x49, 0xa1,
-	0xa9, 0x34, 0x67, 0x9a, 0x52, 0xcd, 0xe5, 0xcc, 0xd3, 0x30, 0x17, 0xfc, 0x63, 0x54, 0x39, 0xd1,
-	0x06, 0x3d, 0x33, 0x3f, 0xf8, 0xad, 0x08, 0x65, 0x31, 0x02, 0xc5, 0x50, 0xcd, 0xcf, 0x0e, 0x12,
-	0xc3, 0x51, 0x31, 0xe7, 0x70, 0x94, 0x1c, 0x46, 0x4b, 0xf3, 0x1d, 0x46, 0x53, 0x67, 0x96, 0x33,
-	0x4f, 0x69, 0x66, 0xf9, 0x19, 0xc1, 0x76, 0x56, 0x8b, 0x9e, 0xe5, 0xdc, 0xf2, 0x67, 0x01, 0xe4,
-	0x10, 0xae, 0xb0, 0x15, 0xce, 0xd1, 0x72, 0xc2, 0x67, 0x74, 0xf1, 0x14, 0xce, 0x68, 0xc7, 0x11,
-	0x44, 0xb3, 0xc7, 0x8e, 0x50, 0x3a, 0x99, 0x23, 0x04, 0x72, 0x8a, 0x38, 0x42, 0xbc, 0x0a, 0xfe,
-	0x09, 0x01, 0xce, 0x26, 0x30, 0x6c, 0x09, 0x51, 0xb1, 0xa3, 0xb9, 0x8a, 0x7d, 0xf7, 0xe1, 0x59,
-	0x28, 0x36, 0x58, 0x57, 0xba, 0x0b, 0x4b, 0xc1, 0x35, 0xfd, 0x4a, 0xfa, 0x2c, 0x17, 0xba, 0x8f,
-	0xca, 0x57, 0xa7, 0x86, 0x04, 0x3b, 0xba, 0x0b, 0x4b, 0xc1, 0x75, 0x35, 0x3b, 0xb3, 0x1f, 0x32,
-	0x21, 0x73, 0xfc, 0xe6, 0x26, 0x31, 0xef, 0xe2, 0x16, 0xbd, 0x31, 0x5d, 0xcb, 0x5c, 0x9f, 0x88,
-	0x95, 0x77, 0x67, 0x8f, 0x0d, 0x8a, 0x1e, 0x7a, 0xf3, 0x7b, 0x6c, 0x72, 0x7e, 0x75, 0xd6, 0x4c,
-	0x4d, 0x9b, 0xcb, 0x37, 0x73, 0x04, 0x07, 0x75, 0xbf, 0x41, 0x70, 0x21, 0x63, 0x90, 0x53, 0x27,
-	0x36, 0x23, 0xb9, 0x40, 0xbe, 0x95, 0x73, 0x41, 0x2a, 0x88, 0xd8, 0xd0, 0x31, 0x1d, 0x44, 0x74,
-	0xc1, 0x0c, 0x20, 0x32, 0x4e, 0xcd, 0x6f, 0x11, 0x6c, 0x66, 0xf9, 0xd0, 0x6b, 0x13, 0xd5, 0x93,
-	0xb2, 0x42, 0x7e, 0x23, 0xef, 0x8a, 0x00, 0xc7, 0x97, 0xb0, 0x91, 0x7e, 0x92, 0x2a, 0x53, 0x53,
-	0x46, 0xe2, 0xe5, 0xd7, 0xf3, 0xc5, 0xfb, 0x00, 0x6a, 0xf5, 0x47, 0x47, 0x15, 0xf4, 0xf8, 0xa8,
-	0x82, 0xfe, 0x3b, 0xaa, 0xa0, 0xef, 0x8f, 0x2b, 0x0b, 0x8f, 0x8f, 0x2b, 0x0b, 0xff, 0x1c, 0x57,
-	0x16, 0x3e, 0x51, 0x43, 0x36, 0x21, 0x72, 0x5f, 0x3f, 0xd0, 0x5b, 0xcc, 0xff, 0xa0, 0x1e, 0xde,
-	0x52, 0x07, 0xde, 0x0f, 0x80, 0xae, 0x67, 0xb4, 0x16, 0xdd, 0x1f, 0xec, 0x6e, 0xfe, 0x1f, 0x00,
-	0x00, 0xff, 0xff, 0x55, 0x14, 0xab, 0x6d, 0x1d, 0x14, 0x00, 0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5d, 0x6f, 0xdb, 0xd4,
+	0x1b, 0xef, 0x49, 0xd2, 0xfe, 0xbb, 0xa7, 0x6b, 0xff, 0xab, 0xe9, 0x4b, 0xea, 0x6e, 0x49, 0x77,
+	0x40, 0xa3, 0x1d, 0xcc, 0x66, 0x1d, 0xa2, 0x08, 0x01, 0x82, 0x40, 0x11, 0x41, 0x8b, 0x82, 0xdc,
+	0x9b, 0x09, 0x2e, 0x8a, 0xdb, 0x5a, 0x99, 0xb5, 0xc4, 0x27, 0xe4, 0x1c, 0x97, 0x4c, 0x5c, 0xf0,
+	0x22, 0x71, 0x0d, 0x13, 0x2f, 0x37, 0x48, 0x88, 0x8f, 0x01, 0x17, 0x70, 0xbd, 0x1b, 0xa4, 0x49,
+	0x08, 0x09, 0x21, 0x11, 0xa1, 0x96, 0x4f, 0x90, 0x4f, 0x80, 0x6c, 0x1f, 0x9f, 0xf8, 0x25, 0x4e,
+	0xea, 0xa6, 0x59, 0xaf, 0xb6, 0xc6, 0xcf, 0xeb, 0xef, 0xf9, 0x9d, 0xdf, 0x79, 0x6c, 0xb8, 0x42,
+	0x68, 0x83, 0x50, 0x93, 0xaa, 0x35, 0xbd, 0xd1, 0x50, 0x0f, 0x6f, 0xee, 0x19, 0x4c, 0xbf, 0xa9,
+	0xb2, 0xb6, 0xd2, 0x6c, 0x11, 0x46, 0xa4, 0x05, 0xfe, 0x58, 0x71, 0x1e, 0x2b, 0xfc, 0xb1, 0xbc,
+	0x50, 0x23, 0x35, 0xe2, 0x1a, 0xa8, 0xce, 0xff, 0x3c, 0x5b, 0xb9, 0xb0, 0xef, 0x1a, 0xab, 0x7b,
+	0x3a, 0x35, 0x44, 0xa4, 0x7d, 0x62, 0x5a, 0xde, 0x73, 0xfc, 0x53, 0x06, 0x66, 0x2a, 0xb4, 0xf6,
+	0x0e, 0x31, 0xad, 0x77, 0x09, 0xa9, 0x4b, 0x1b, 0x30, 0x45, 0x0d, 0xeb, 0xc0, 0x68, 0xe5, 0xd1,
+	0x1a, 0x5a, 0xbf, 0x50, 0x9a, 0xef, 0x76, 0x8a, 0xb3, 0xf7, 0xf5, 0x46, 0xfd, 0x25, 0xec, 0xfd,
+	0x8e, 0x35, 0x6e, 0x20, 0x5d, 0x87, 0xa9, 0x26, 0x21, 0xf5, 0xf2, 0x41, 0x3e, 0xb3, 0x86, 0xd6,
+	0x73, 0x25, 0xa9, 0xdb, 0x29, 0xce, 0x79, 0xa6, 0xce, 0xef, 0xbb, 0xe6, 0x01, 0xd6, 0xb8, 0x85,
+	0xd4, 0x84, 0x39, 0x7a, 0x57, 0x6f, 0x19, 0x55, 0x9b, 0xbd, 0xde, 0x20, 0xb6, 0xc5, 0xf2, 0x59,
+	0x37, 0xfc, 0xdb, 0x0f, 0x3b, 0xc5, 0x89, 0xbf, 0x3a, 0xc5, 0x6b, 0x35, 0x93, 0xdd, 0xb5, 0xf7,
+	0x94, 0x7d, 0xd2, 0x50, 0x79, 0xc5, 0xde, 0x3f, 0x37, 0xe8, 0xc1, 0x3d, 0x95, 0xdd, 0x6f, 0x1a,
+	0x54, 0x29, 0x5b, 0xac, 0xdb, 0x29, 0x2e, 0x05, 0x32, 0xe8, 0x6e, 0xa8, 0x5d, 0x62, 0x33, 0xac,
+	0x45, 0xe2, 0x4b, 0x1f, 0xc0, 0x0c, 0x23, 0xf7, 0x0c, 0xab, 0x6c, 0x55, 0xf4, 0x36, 0xcd, 0xe7,
+	0xd6, 0xb2, 0xeb, 0x33, 0x9b, 0x2b, 0x8a, 0x17, 0x55, 0x71, 0xe0, 0xf0, 0x91, 0x53, 0xde, 0x20,
+	0xa6, 0x55, 0x7a, 0xd2, 0xa9, 0xa4, 0xdb, 0x29, 0xae, 0x7a, 0xf1, 0x5d, 0xdf, 0x5d, 0xd3, 0xda,
+	0x6d, 0xe8, 0x6d, 0x9e, 0x87, 0x62, 0x2d, 0x18, 0x12, 0x2f, 0xc2, 0x13, 0x01, 0xe4, 0x34, 0x83,
+	0x36, 0x89, 0x45, 0x0d, 0xfc, 0xb3, 0x87, 0xe8, 0x76, 0xdb, 0x64, 0xe3, 0x44, 0xd4, 0x82, 0x59,
+	0xb7, 0xe3, 0xb2, 0x75, 0x36, 0x80, 0xba, 0xc1, 0x9c, 0x86, 0xbd, 0x66, 0xb1, 0x16, 0x0e, 0x2f,
+	0xed, 0xc3, 0x45, 0xb7, 0xf9, 0xaa, 0xcd, 0x2a, 0xa6, 0x75, 0x02, 0x40, 0x9f, 0xe2, 0x80, 0x5e,
+	0x0e, 0x02, 0x4a, 0x6c, 0xb6, 0xdb, 0x10, 0x49, 0x28, 0xd6, 0x42, 0x41, 0x39, 0xa4, 0x3e, 0x74,
+	0x02, 0xd2, 0xcf, 0x10, 0xcc, 0xef, 0x7c, 0xa4, 0x37, 0xbd, 0x52, 0xca, 0x96, 0x46, 0x6c, 0x66,
+	0x04, 0xd0, 0x42, 0x43, 0xd1, 0x7a, 0x0d, 0x66, 0xfd, 0x44, 0x6f, 0x1a, 0x16, 0x69, 0xb8, 0x00,
+	0x5f, 0x28, 0xc9, 0xbd, 0xfe, 0x7b, 0xf5, 0x1d, 0x38, 0x06, 0x58, 0x0b, 0x3b, 0xe0, 0xdf, 0x33,
+	0xb0, 0x50, 0xa1, 0x35, 0xa7, 0x8c, 0xed, 0xb6, 0xbe, 0xcf, 0xfc, 0x5a, 0xd2, 0xcc, 0x77, 0x1b,
+	0xa6, 0x5a, 0x4e, 0xe9, 0x34, 0x9f, 0x71, 0xd1, 0x7b, 0x5a, 0xe9, 0x77, 0x92, 0x95, 0x58, 0xab,
+	0xa5, 0x9c, 0x83, 0xa5, 0xc6, 0x9d, 0xa5, 0xdb, 0xf0, 0x3f, 0xce, 0x43, 0x77, 0xe8, 0x03, 0xa7,
+	0xb0, 0xcc, 0xa7, 0xf0, 0xff, 0x30, 0xad, 0xb1, 0xe6, 0x87, 0x90, 0x3e, 0x86, 0xf9, 0xc0, 0x0c,
+	0x38, 0x99, 0x72, 0x6e, 0x2b, 0x95, 0xd4, 0x64, 0x5a, 0x4d, 0x1e, 0x36, 0xd6, 0xe2, 0x79, 0xf0,
+	0x03, 0x04, 0x97, 0xfb, 0xa1, 0xea, 0x8f, 0x5e, 0xfa, 0x10, 0xe6, 0x7c, 0x2f, 0x5e, 0x9a, 0x87,
+	0x72, 0x39, 0x75, 0x69, 0xcb, 0xd1, 0xd2, 0xfc, 0xb2, 0x22, 0x09, 0xf0, 0xdf, 0x08, 0x96, 0x82,
+	0x23, 0xd8, 0x69, 0xd6, 0x4d, 0xe6, 0x51, 0x6e, 0x07, 0x26, 0x1d, 0x42, 0xd1, 0x3c, 0x4a, 0x37,
+	0xbf, 0x05, 0x3e, 0x85, 0x8b, 0x3d, 0x7a, 0x52, 0xac, 0x79, 0xb1, 0x9c, 0x93, 0xcc, 0x67, 0xc1,
+	0x3b, 0xcc, 0x8c, 0x76, 0x92, 0x85, 0x74, 0x89, 0x93, 0x1c, 0x0a, 0x8f, 0xff, 0xcd, 0x40, 0xc1,
+	0xc1, 0x5c, 0xb4, 0x35, 0x12, 0xa7, 0xdf, 0x8f, 0x70, 0xfa, 0xd9, 0xe1, 0x98, 0xf4, 0x32, 0x97,
+	0x16, 0x39, 0x30, 0x3c, 0xb8, 0x17, 0x09, 0x0b, 0xa6, 0xbf, 0xc2, 0x45, 0xa7, 0x6c, 0x79, 0xa7,
+	0xd6, 0xd3, 0xb8, 0x95, 0x6e, 0xa7, 0xb8, 0x18, 0xe9, 0x95, 0x1f, 0xda, 0x90, 0xf9, 0xf9, 0x52,
+	0xfb, 0x7b, 0x04, 0xd7, 0x06, 0xc3, 0x7c, 0x9e, 0x24, 0xff, 0x14, 0x81, 0xd4, 0x9b, 0x49, 0xd5,
+	0x66, 0xe9, 0x35, 0xf5, 0xd5, 0xc8, 0x70, 0x86, 0x4b, 0x6a, 0xc8, 0x1e, 0xff, 0x91, 0x81, 0xc5,
+	0xf8, 0xd9, 0xaf, 0xda, 0x2c, 0x0d, 0xfd, 0xde, 0x8a, 0xd0, 0x6f, 0x7d, 0x18, 0xfd, 0xfc, 0x56,
+	0x23, 0x9a, 0xda, 0x86, 0x4b, 0xbd, 0xbb, 0x3d, 0x74, 0xa3, 0xde, 0x4e, 0x3d, 0x04, 0x39, 0x71,
+	0x85, 0xc0, 0x5a, 0x2c, 0x8b, 0x54, 0x85, 0x69, 0x7f, 0x36, 0xf9, 0xdc, 0x30, 0x39, 0xcf, 0xf3,
+	0xf3, 0x72, 0x29, 0x82, 0x30, 0xd6, 0x44, 0x10, 0xfc, 0x25, 0x82, 0x2b, 0x7d, 0x71, 0x15, 0x7c,
+	0x8b, 0x29, 0x0e, 0x1a, 0xaf, 0xe2, 0xfc, 0x92, 0x81, 0x15, 0xbe, 0x2a, 0x79, 0x55, 0x31, 0xa3,
+	0x65, 0x9d, 0x46, 0x6c, 0xd2, 0x2c, 0x48, 0x67, 0x7e, 0x4b, 0xfa, 0x0b, 0xe6, 0x99, 0x49, 0x89,
+	0xb7, 0x72, 0xc5, 0xa4, 0x24, 0x96, 0x07, 0x7f, 0x87, 0xe0, 0x6a, 0x22, 0x7e, 0x41, 0x15, 0x89,
+	0xec, 0xd8, 0x23, 0xaa, 0x48, 0xaf, 0x3e, 0xa1, 0x22, 0xe1, 0x04, 0xf8, 0x87, 0x6c, 0x68, 0xb0,
+	0x3b, 0xce, 0xd3, 0x53, 0x1d, 0xe3, 0x34, 0x83, 0x1d, 0xf1, 0x52, 0x88, 0xc3, 0x94, 0x1b, 0x33,
+	0x4c, 0x7d, 0xc5, 0x65, 0xf2, 0x71, 0x88, 0x0b, 0xfe, 0x3a, 0xcc, 0x9c, 0xf0, 0x80, 0xce, 0x4d,
+	0x0f, 0x7e, 0xcc, 0x42, 0x9e, 0xef, 0xf9, 0x91, 0xaa, 0xc6, 0x27, 0x07, 0xb1, 0x37, 0x80, 0x6c,
+	0xca, 0x37, 0x80, 0xf8, 0x1b, 0x57, 0x6e, 0xbc, 0x6f, 0x5c, 0x7d, 0xb7, 0x97, 0xc9, 0xc7, 0xb4,
+	0xbd, 0x7c, 0x8b, 0x60, 0x2d, 0x69, 0x44, 0xe7, 0xb9, 0xb7, 0xfc, 0x9a, 0x01, 0x39, 0x50, 0x57,
+	0x50, 0x0a, 0xc7, 0x28, 0x39, 0xc1, 0x3b, 0x3a, 0x7b, 0x06, 0x77, 0xb4, 0xa3, 0x08, 0x7c, 0xd8,
+	0x3d, 0x45, 0xc8, 0x8d, 0xa6, 0x08, 0x82, 0x4e, 0x21, 0x45, 0x88, 0x66, 0xc1, 0xdf, 0x20, 0xc0,
+	0xc9, 0x00, 0x06, 0x25, 0x21, 0x4c, 0x76, 0x34, 0x56, 0xb2, 0x6f, 0xfe, 0x36, 0x0d, 0xd9, 0x0a,
+	0xad, 0x49, 0x77, 0x60, 0x5a, 0x7c, 0x8b, 0xba, 0xda, 0x7f, 0x97, 0x0b, 0x7c, 0x74, 0x91, 0x37,
+	0x86, 0x9a, 0x88, 0x8e, 0xee, 0xc0, 0xb4, 0xf8, 0x26, 0x93, 0x1c, 0xd9, 0x37, 0x91, 0x37, 0x86,
+	0x9a, 0x88, 0xc8, 0x14, 0xe6, 0x23, 0xcb, 0x56, 0xd9, 0x92, 0xae, 0x27, 0xfa, 0xc7, 0x6c, 0xe5,
+	0xcd, 0x93, 0xdb, 0x8a, 0xa4, 0x87, 0x20, 0x45, 0x1e, 0x3a, 0xbc, 0x7a, 0xe6, 0xa4, 0x91, 0xaa,
+	0x36, 0x93, 0x6f, 0xa5, 0x30, 0x16, 0x79, 0x3f, 0x47, 0xb0, 0x94, 0xb0, 0xc8, 0xa9, 0x03, 0x87,
+	0x11, 0x77, 0x90, 0xb7, 0x52, 0x3a, 0xf4, 0x2d, 0x22, 0xb2, 0x74, 0x0c, 0x2f, 0x22, 0xec, 0x20,
+	0x6f, 0xa5, 0x74, 0x10, 0x45, 0x7c, 0x81, 0x60, 0x39, 0x49, 0x87, 0x9e, 0x1b, 0xc8, 0x9e, 0x3e,
+	0x1e, 0xf2, 0x8b, 0x69, 0x3d, 0x44, 0x1d, 0x9f, 0xc0, 0x62, 0xff, 0x9b, 0x54, 0x19, 0x1a, 0x32,
+	0x64, 0x2f, 0xbf, 0x90, 0xce, 0x5e, 0x14, 0xf0, 0x00, 0xc1, 0xea, 0xa0, 0xaf, 0x09, 0xcf, 0x27,
+	0xf3, 0x2c, 0xd9, 0x4b, 0x7e, 0xf9, 0x34, 0x5e, 0x7e, 0x4d, 0xa5, 0xf2, 0xc3, 0xa3, 0x02, 0x7a,
+	0x74, 0x54, 0x40, 0xff, 0x1c, 0x15, 0xd0, 0x57, 0xc7, 0x85, 0x89, 0x47, 0xc7, 0x85, 0x89, 0x3f,
+	0x8f, 0x0b, 0x13, 0xef, 0xa9, 0x01, 0xe9, 0xe2, 0x19, 0x6e, 0xd4, 0xf5, 0x3d, 0xea, 0xff, 0xa1,
+	0x1e, 0x6e, 0xa9, 0x6d, 0xef, 0xcb, 0xbb, 0xab, 0x63, 0x7b, 0x53, 0xee, 0x97, 0xf2, 0x5b, 0xff,
+	0x0d, 0x00, 0x62, 0xd5, 0x72, 0xe7, 0x96, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1043,6 +1204,7 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error)
 }
 
 type msgClient struct {
@@ -1125,6 +1287,15 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) SplitRouteSwapExactAmountIn(ctx context.Context, in *MsgSplitRouteSwapExactAmountIn, opts ...grpc.CallOption) (*MsgSplitRouteSwapExactAmountInResponse, error) {
+	out := new(MsgSplitRouteSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/SplitRouteSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1135,6 +1306,7 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	SplitRouteSwapExactAmountIn(context.Context, *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1165,6 +1337,9 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) SplitRouteSwapExactAmountIn(ctx context.Context, req *MsgSplitRouteSwapExactAmountIn) (*MsgSplitRouteSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitRouteSwapExactAmountIn not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1314,6 +1489,24 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SplitRouteSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitRouteSwapExactAmountIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitRouteSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/SplitRouteSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitRouteSwapExactAmountIn(ctx, req.(*MsgSplitRouteSwapExactAmountIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1350,6 +1543,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "SplitRouteSwapExactAmountIn",
+			Handler:    _Msg_SplitRouteSwapExactAmountIn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -1651,7 +1848,7 @@ func (m *MsgSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *SwapAmountOutRoute) Marshal() (dAtA []byte, err error) {
+func (m *SwapAmountInSplitRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1661,32 +1858,44 @@ func (m *SwapAmountOutRoute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwapAmountOutRoute) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapAmountInSplitRoute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapAmountOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapAmountInSplitRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	i--
+	dAtA[i] = 0x12
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
+func (m *MsgSplitRouteSwapExactAmountIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1696,36 +1905,33 @@ func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountIn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
-	{
-		size := m.TokenInMaxAmount.Size()
-		i -= size
-		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.Routes) > 0 {
 		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1750,7 +1956,7 @@ func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSplitRouteSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1760,20 +1966,20 @@ func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.TokenInAmount.Size()
+		size := m.TokenOutAmount.Size()
 		i -= size
-		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -1783,7 +1989,7 @@ func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinSwapExternAmountIn) Marshal() (dAtA []byte, err error) {
+func (m *SwapAmountOutRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1793,52 +1999,32 @@ func (m *MsgJoinSwapExternAmountIn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinSwapExternAmountIn) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapAmountOutRoute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinSwapExternAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapAmountOutRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ShareOutMinAmount.Size()
-		i -= size
-		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x1a
 	if m.PoolId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinSwapExternAmountInResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSwapExactAmountOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1848,28 +2034,180 @@ func (m *MsgJoinSwapExternAmountInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinSwapExternAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountOut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinSwapExternAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapExactAmountOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.ShareOutAmount.Size()
+		size, err := m.TokenOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
-		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TokenInMaxAmount.Size()
+		i -= size
+		if _, err := m.TokenInMaxAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	dAtA[i] = 0x1a
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinSwapExternAmountIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinSwapExternAmountIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinSwapExternAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinSwapExternAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinSwapExternAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinSwapExternAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
 
 func (m *MsgJoinSwapShareAmountOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -2276,6 +2614,59 @@ func (m *MsgSwapExactAmountInResponse) Size() (n int) {
 	return n
 }
 
+func (m *SwapAmountInSplitRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func (m *SwapAmountOutRoute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3270,6 +3661,390 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SwapAmountInSplitRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountInSplitRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, SwapAmountInRoute{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInSplitRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSplitRouteSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,6 +13,8 @@ const (
 	FlagEpochs    = "epochs"
 	FlagPerpetual = "perpetual"
 
+	FlagWeightByOsmoValue = "weight-by-osmo-value"
+
 	FlagTimestamp = "timestamp"
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
@@ -28,5 +30,6 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.Bool(FlagWeightByOsmoValue, false, "Weight the denoms of a gauge distributing to several denoms by the OSMO value of their locked tokens")
 	return fs
 }
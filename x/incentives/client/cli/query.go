@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -35,6 +36,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdUpcomingGauges(),
 		GetCmdUpcomingGaugesPerDenom(),
 		GetCmdRewardsEst(),
+		GetCmdClaimableRewards(),
+		GetCmdLockRewards(),
+		GetCmdPoolAPR(),
+		GetCmdParams(),
 	)
 
 	return cmd
@@ -419,3 +424,182 @@ $ %s query incentives rewards-estimation
 
 	return cmd
 }
+
+// GetCmdClaimableRewards returns the rewards accrued by the locks of an owner.
+func GetCmdClaimableRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-rewards [owner]",
+		Short: "Query the rewards accrued by the locks of an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards accrued by the locks of an owner, that it can claim.
+
+Example:
+$ %s query incentives claimable-rewards <address>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimableRewards(cmd.Context(), &types.ClaimableRewardsRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdLockRewards returns the rewards a lock received in each epoch of an epoch range.
+func GetCmdLockRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-rewards [lock_id] [from_epoch] [to_epoch]",
+		Short: "Query the rewards a lock received in an epoch range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards a lock received from each gauge in each epoch of an epoch range.
+
+Example:
+$ %s query incentives lock-rewards 1 100 130
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lockID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			fromEpoch, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LockRewards(cmd.Context(), &types.LockRewardsRequest{
+				LockId:    lockID,
+				FromEpoch: fromEpoch,
+				ToEpoch:   toEpoch,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPoolAPR returns the APR of the shares of a pool locked for a duration.
+func GetCmdPoolAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-apr [pool_id] [duration]",
+		Short: "Query the APR of the shares of a pool locked for a duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the APR of the shares of a pool locked for a duration, from the rewards distributed to them in the last epochs.
+
+Example:
+$ %s query incentives pool-apr 1 336h --epochs 7
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+			numEpochs, err := cmd.Flags().GetInt64(FlagEpochs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolAPR(cmd.Context(), &types.PoolAPRRequest{
+				PoolId:    poolID,
+				Duration:  duration,
+				NumEpochs: numEpochs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagEpochs, 7, "the number of last epochs the APR is computed over")
+
+	return cmd
+}
+
+// GetCmdParams returns the incentives module params.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the incentives module params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the incentives module params, including the gauge creation fee and limits.
+
+Example:
+$ %s query incentives params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
@@ -14,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -29,6 +32,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewClaimRewardsCmd(),
+		NewCancelGaugeCmd(),
 	)
 
 	return cmd
@@ -39,7 +44,10 @@ func NewCreateGaugeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-gauge [lockup_denom] [reward] [flags]",
 		Short: "create a gauge to distribute rewards to users",
-		Args:  cobra.ExactArgs(2),
+		Long: `Create a gauge to distribute rewards to users.
+The gauge can distribute to the locks of several denoms, listed as comma separated denom=weight pairs
+instead of a single denom, e.g. gamm/pool/1=2,gamm/pool/2=1. A denom without a weight has a weight of 1.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -88,6 +96,20 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
+			weightByOsmoValue, err := cmd.Flags().GetBool(FlagWeightByOsmoValue)
+			if err != nil {
+				return err
+			}
+
+			var denomWeights []types.DenomWeight
+			if strings.ContainsAny(denom, ",=") {
+				denomWeights, err = parseDenomWeights(denom)
+				if err != nil {
+					return err
+				}
+				denom = ""
+			}
+
 			distributeTo := lockuptypes.QueryCondition{
 				LockQueryType: lockuptypes.ByDuration,
 				Denom:         denom,
@@ -103,6 +125,8 @@ func NewCreateGaugeCmd() *cobra.Command {
 				startTime,
 				epochs,
 			)
+			msg.DistributeToDenoms = denomWeights
+			msg.WeightByOsmoValue = weightByOsmoValue
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
@@ -113,6 +137,25 @@ func NewCreateGaugeCmd() *cobra.Command {
 	return cmd
 }
 
+// parseDenomWeights parses comma separated denom=weight pairs, where the weight defaults to 1.
+func parseDenomWeights(denomWeightsStr string) ([]types.DenomWeight, error) {
+	denomWeights := []types.DenomWeight{}
+	for _, denomWeightStr := range strings.Split(denomWeightsStr, ",") {
+		denomWeight := types.DenomWeight{Weight: sdk.OneDec()}
+		parts := strings.SplitN(denomWeightStr, "=", 2)
+		denomWeight.Denom = strings.TrimSpace(parts[0])
+		if len(parts) == 2 {
+			weight, err := sdk.NewDecFromStr(strings.TrimSpace(parts[1]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight of denom %s: %w", denomWeight.Denom, err)
+			}
+			denomWeight.Weight = weight
+		}
+		denomWeights = append(denomWeights, denomWeight)
+	}
+	return denomWeights, nil
+}
+
 // NewAddToGaugeCmd broadcast MsgAddToGauge.
 func NewAddToGaugeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -151,3 +194,115 @@ func NewAddToGaugeCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewClaimRewardsCmd broadcast MsgClaimRewards.
+func NewClaimRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards",
+		Short: "claim the rewards accrued by all of your locks",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgClaimRewards(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelGaugeCmd broadcast MsgCancelGauge.
+func NewCancelGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-gauge [gauge_id]",
+		Short: "cancel a gauge you created and get its undistributed coins back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelGauge(clientCtx.GetFromAddress(), gaugeId)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdSubmitCancelGaugeProposal implements a command handler for submitting a gauge cancellation proposal transaction.
+func NewCmdSubmitCancelGaugeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-gauge-proposal [gauge_id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a gauge cancellation proposal",
+		Long:  "Submit a proposal to cancel a non perpetual gauge and refund its undistributed coins to its owner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCancelGaugeProposal(title, description, gaugeId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/client/cli"
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/client/rest"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var CancelGaugeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitCancelGaugeProposal, rest.ProposalCancelGaugeRESTHandler)
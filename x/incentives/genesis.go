@@ -18,13 +18,37 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, index := range genState.RewardIndices {
+		k.SetRewardIndex(ctx, index)
+	}
+	for _, checkpoint := range genState.LockCheckpoints {
+		k.SetLockCheckpoint(ctx, checkpoint)
+	}
+	for _, accrued := range genState.AccruedRewards {
+		owner, err := sdk.AccAddressFromBech32(accrued.Owner)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAccruedRewards(ctx, owner, accrued.Rewards)
+	}
+	for _, record := range genState.DistributionRecords {
+		k.SetDistributionRecord(ctx, record)
+	}
+	for _, record := range genState.LockStakesRecords {
+		k.SetLockStakesRecord(ctx, record)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:            k.GetParams(ctx),
-		LockableDurations: k.GetLockableDurations(ctx),
-		Gauges:            k.GetNotFinishedGauges(ctx),
+		Params:              k.GetParams(ctx),
+		LockableDurations:   k.GetLockableDurations(ctx),
+		Gauges:              k.GetNotFinishedGauges(ctx),
+		RewardIndices:       k.GetAllRewardIndices(ctx),
+		LockCheckpoints:     k.GetAllLockCheckpoints(ctx),
+		AccruedRewards:      k.GetAllAccruedRewards(ctx),
+		DistributionRecords: k.GetAllDistributionRecords(ctx),
+		LockStakesRecords:   k.GetAllLockStakesRecords(ctx),
 	}
 }
@@ -55,6 +55,7 @@ func TestIncentivesExportGenesis(t *testing.T) {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins(nil),
 		StartTime:         startTime.UTC(),
+		Owner:             addr.String(),
 	})
 }
 
@@ -83,9 +84,7 @@ func TestIncentivesInitGenesis(t *testing.T) {
 		StartTime:         startTime.UTC(),
 	}
 	incentives.InitGenesis(ctx, *app.IncentivesKeeper, types.GenesisState{
-		Params: types.Params{
-			DistrEpochIdentifier: "week",
-		},
+		Params: types.DefaultParams(),
 		Gauges: []types.Gauge{gauge},
 		LockableDurations: []time.Duration{
 			time.Second,
@@ -99,3 +98,37 @@ func TestIncentivesInitGenesis(t *testing.T) {
 	require.Len(t, gauges, 1)
 	require.Equal(t, gauges[0], gauge)
 }
+
+func TestIncentivesRewardsGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	rewardPerShare := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5)}
+	genesis := incentives.ExportGenesis(ctx, *app.IncentivesKeeper)
+	genesis.RewardIndices = []types.RewardIndex{{
+		Denom:          "lptoken",
+		Duration:       time.Second,
+		RewardPerShare: rewardPerShare,
+	}}
+	genesis.LockCheckpoints = []types.LockCheckpoint{{
+		LockId: 1,
+		Owner:  addr.String(),
+		Stakes: []types.LockStake{{
+			Denom:              "lptoken",
+			Duration:           time.Second,
+			Amount:             sdk.NewInt(100),
+			LastRewardPerShare: rewardPerShare,
+		}},
+	}}
+	genesis.AccruedRewards = []types.AccruedRewards{{
+		Owner:   addr.String(),
+		Rewards: sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("1.5"))},
+	}}
+	incentives.InitGenesis(ctx, *app.IncentivesKeeper, *genesis)
+
+	exported := incentives.ExportGenesis(ctx, *app.IncentivesKeeper)
+	require.Equal(t, genesis.RewardIndices, exported.RewardIndices)
+	require.Equal(t, genesis.LockCheckpoints, exported.LockCheckpoints)
+	require.Equal(t, genesis.AccruedRewards, exported.AccruedRewards)
+}
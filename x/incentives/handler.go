@@ -3,11 +3,15 @@ package incentives
 import (
 	"fmt"
 
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/utils"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/keeper"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler returns msg handler for this module.
@@ -23,9 +27,51 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 		case *types.MsgAddToGauge:
 			res, err := msgServer.AddToGauge(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimRewards:
+			res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelGauge:
+			res, err := msgServer.CancelGauge(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
 		}
 	}
 }
+
+// NewIncentivesProposalHandler returns the handler of the incentives gov proposals.
+func NewIncentivesProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CancelGaugeProposal:
+			return handleCancelGaugeProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized incentives proposal content type: %T", c)
+		}
+	}
+}
+
+func handleCancelGaugeProposal(ctx sdk.Context, k *keeper.Keeper, p *types.CancelGaugeProposal) error {
+	gauge, err := k.GetGaugeByID(ctx, p.GaugeId)
+	if err != nil {
+		return err
+	}
+	refunded, err := k.CancelGauge(ctx, p.GaugeId)
+	if err != nil {
+		return err
+	}
+	receiver := gauge.Owner
+	if receiver == "" {
+		receiver = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtCancelGauge,
+		sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(p.GaugeId)),
+		sdk.NewAttribute(types.AttributeReceiver, receiver),
+		sdk.NewAttribute(types.AttributeAmount, refunded.String()),
+	))
+	return nil
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
-	"time"
+	"strings"
 
 	db "github.com/tendermint/tm-db"
 
+	appparams "github.com/osmosis-labs/osmosis/v7/app/params"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 
@@ -61,15 +63,36 @@ func (k Keeper) moveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gaug
 	if err := k.addGaugeRefByKey(ctx, combineKeys(types.KeyPrefixFinishedGauges, timeKey), gauge.Id); err != nil {
 		return err
 	}
-	if err := k.deleteGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
-		return err
+	for _, denom := range gauge.Denoms() {
+		if err := k.deleteGaugeIDForDenom(ctx, gauge.Id, denom); err != nil {
+			return err
+		}
 	}
 	k.hooks.AfterFinishDistribution(ctx, gauge.Id)
 	return nil
 }
 
-// GetLocksToDistribution get locks that are associated to a condition.
-func (k Keeper) GetLocksToDistribution(ctx sdk.Context, distrTo lockuptypes.QueryCondition) []lockuptypes.PeriodLock {
+// GetLocksToDistribution get locks that are associated to the conditions of a gauge.
+func (k Keeper) GetLocksToDistribution(ctx sdk.Context, gauge types.Gauge) []lockuptypes.PeriodLock {
+	locks := []lockuptypes.PeriodLock{}
+	lockIDSet := map[uint64]bool{}
+	for _, denom := range gauge.Denoms() {
+		distrTo := gauge.DistributeTo
+		distrTo.Denom = denom
+		for _, lock := range k.getLocksToDistribution(ctx, distrTo) {
+			// locks of several coins can be returned for several denoms
+			if lockIDSet[lock.ID] {
+				continue
+			}
+			lockIDSet[lock.ID] = true
+			locks = append(locks, lock)
+		}
+	}
+	return locks
+}
+
+// getLocksToDistribution get locks that are associated to a condition.
+func (k Keeper) getLocksToDistribution(ctx sdk.Context, distrTo lockuptypes.QueryCondition) []lockuptypes.PeriodLock {
 	switch distrTo.LockQueryType {
 	case lockuptypes.ByDuration:
 		return k.lk.GetLocksLongerThanDurationDenom(ctx, distrTo.Denom, distrTo.Duration)
@@ -80,24 +103,104 @@ func (k Keeper) GetLocksToDistribution(ctx sdk.Context, distrTo lockuptypes.Quer
 	return []lockuptypes.PeriodLock{}
 }
 
-// getLocksToDistributionWithMaxDuration get locks that are associated to a condition
-// and if its by duration, then use the min Duration.
-func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo lockuptypes.QueryCondition, minDuration time.Duration) []lockuptypes.PeriodLock {
-	switch distrTo.LockQueryType {
-	case lockuptypes.ByDuration:
-		// TODO: FIX ME!!!!
-		// Confusingly, synthetic lockups aren't indexed by denom as expected.
-		// Thus you have to do this as a hack
-		denom := lockuptypes.NativeDenom(distrTo.Denom)
-		if distrTo.Duration > minDuration {
-			return k.lk.GetLocksLongerThanDurationDenom(ctx, denom, minDuration)
+// denomDistribution is the share of the distributions of a gauge going to the locks of a denom.
+type denomDistribution struct {
+	denom       string
+	totalLocked sdk.Int
+	weight      sdk.Dec
+}
+
+// getDenomDistributions returns how the distributions of a gauge are currently shared between the denoms
+// it distributes to, along with the total weight of the denoms. Denoms without locked tokens are skipped.
+func (k Keeper) getDenomDistributions(ctx sdk.Context, gauge types.Gauge) ([]denomDistribution, sdk.Dec) {
+	distributions := []denomDistribution{}
+	totalWeight := sdk.ZeroDec()
+	if len(gauge.DistributeToDenoms) == 0 {
+		// The accumulation store of synthetic denoms tracks the underlying locks of their synthetic lockups.
+		totalLocked := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+		if totalLocked.IsPositive() {
+			distributions = append(distributions, denomDistribution{gauge.DistributeTo.Denom, totalLocked, sdk.OneDec()})
+			totalWeight = sdk.OneDec()
 		}
-		return k.lk.GetLocksLongerThanDurationDenom(ctx, distrTo.Denom, distrTo.Duration)
-	case lockuptypes.ByTime:
-		panic("Gauge by time is present!?!? Should have been blocked in ValidateBasic")
-	default:
+		return distributions, totalWeight
 	}
-	return []lockuptypes.PeriodLock{}
+
+	for _, denomWeight := range gauge.DistributeToDenoms {
+		distrTo := gauge.DistributeTo
+		distrTo.Denom = denomWeight.Denom
+		totalLocked := k.lk.GetPeriodLocksAccumulation(ctx, distrTo)
+		if !totalLocked.IsPositive() {
+			continue
+		}
+
+		weight := denomWeight.Weight
+		if gauge.WeightByOsmoValue {
+			osmoValue, err := k.osmoValuePerToken(ctx, denomWeight.Denom)
+			if err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("skipping distribution of gauge %d to %s: %s", gauge.Id, denomWeight.Denom, err.Error()))
+				continue
+			}
+			weight = weight.Mul(osmoValue).MulInt(totalLocked)
+		}
+		if !weight.IsPositive() {
+			continue
+		}
+
+		distributions = append(distributions, denomDistribution{denomWeight.Denom, totalLocked, weight})
+		totalWeight = totalWeight.Add(weight)
+	}
+	return distributions, totalWeight
+}
+
+// share returns the share of coins going to the locks of the denom.
+func (distribution denomDistribution) share(coins sdk.Coins, totalWeight sdk.Dec) sdk.Coins {
+	shareCoins := sdk.Coins{}
+	for _, coin := range coins {
+		amt := coin.Amount.ToDec().Mul(distribution.weight).Quo(totalWeight).TruncateInt()
+		if amt.IsPositive() {
+			shareCoins = shareCoins.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+	return shareCoins
+}
+
+// osmoValuePerToken returns the OSMO value of a token of denom, which must be OSMO or the shares of a pool
+// containing OSMO. To resist manipulation within a block, the pool's assets are valued at the arithmetic TWAP
+// of their prices in OSMO over OsmoValueTwapWindow. Fails if a TWAP is unavailable, e.g. when the pool is
+// younger than the window.
+func (k Keeper) osmoValuePerToken(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	if denom == appparams.BaseCoinUnit {
+		return sdk.OneDec(), nil
+	}
+	if !strings.HasPrefix(denom, "gamm/pool/") || gammtypes.ValidatePoolShareDenom(denom) != nil {
+		return sdk.Dec{}, fmt.Errorf("cannot value %s in OSMO, only OSMO and pool shares can be", denom)
+	}
+
+	pool, err := k.gk.GetPoolAndPoke(ctx, gammtypes.MustGetPoolIdFromShareDenom(denom))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	totalShares := pool.GetTotalShares()
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	if !liquidity.AmountOf(appparams.BaseCoinUnit).IsPositive() || !totalShares.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("cannot value %s in OSMO, pool %d has no OSMO", denom, pool.GetId())
+	}
+
+	endTime := ctx.BlockTime()
+	startTime := endTime.Add(-types.OsmoValueTwapWindow)
+	poolValue := sdk.ZeroDec()
+	for _, asset := range liquidity {
+		if asset.Denom == appparams.BaseCoinUnit {
+			poolValue = poolValue.Add(asset.Amount.ToDec())
+			continue
+		}
+		price, err := k.tk.GetArithmeticTwap(ctx, pool.GetId(), appparams.BaseCoinUnit, asset.Denom, startTime, endTime)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		poolValue = poolValue.Add(price.MulInt(asset.Amount))
+	}
+	return poolValue.QuoInt(totalShares), nil
 }
 
 // FilteredLocksDistributionEst estimate distribution amount coins from gauge for fitting conditions
@@ -106,8 +209,8 @@ func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo l
 // It also applies an update for the gauge, handling the sending of the rewards.
 // (Note this update is in-memory, it does not change state.)
 func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge, filteredLocks []lockuptypes.PeriodLock) (types.Gauge, sdk.Coins, error) {
-	TotalAmtLocked := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
-	if TotalAmtLocked.IsZero() {
+	distributions, totalWeight := k.getDenomDistributions(ctx, gauge)
+	if len(distributions) == 0 {
 		return types.Gauge{}, nil, nil
 	}
 
@@ -139,14 +242,17 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 		// distribution in next epoch = gauge_size  / (remain_epochs)
 		filteredDistrCoins = remainCoinsPerEpoch
 	}
-	for _, lock := range filteredLocks {
-		denomLockAmt := lock.Coins.AmountOf(gauge.DistributeTo.Denom)
-
-		for _, coin := range remainCoinsPerEpoch {
-			// distribution amount = gauge_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
-			// distribution amount = gauge_size_per_epoch * denom_lock_amount / total_denom_lock_amount
-			amt := coin.Amount.Mul(denomLockAmt).Quo(TotalAmtLocked)
-			filteredDistrCoins = filteredDistrCoins.Add(sdk.NewCoin(coin.Denom, amt))
+	for _, distribution := range distributions {
+		denomCoinsPerEpoch := distribution.share(remainCoinsPerEpoch, totalWeight)
+		for _, lock := range filteredLocks {
+			denomLockAmt := lock.Coins.AmountOf(distribution.denom)
+
+			for _, coin := range denomCoinsPerEpoch {
+				// distribution amount = gauge_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
+				// distribution amount = gauge_size_per_epoch * denom_lock_amount / total_denom_lock_amount
+				amt := coin.Amount.Mul(denomLockAmt).Quo(distribution.totalLocked)
+				filteredDistrCoins = filteredDistrCoins.Add(sdk.NewCoin(coin.Denom, amt))
+			}
 		}
 	}
 
@@ -157,148 +263,19 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 	return gauge, filteredDistrCoins, nil
 }
 
-// distributionInfo stores all of the information for pent up sends for rewards distributions.
-// This enables us to lower the number of events and calls to back.
-type distributionInfo struct {
-	nextID            int
-	lockOwnerAddrToID map[string]int
-	idToBech32Addr    []string
-	idToDecodedAddr   []sdk.AccAddress
-	idToDistrCoins    []sdk.Coins
-}
-
-func newDistributionInfo() distributionInfo {
-	return distributionInfo{
-		nextID:            0,
-		lockOwnerAddrToID: make(map[string]int),
-		idToBech32Addr:    []string{},
-		idToDecodedAddr:   []sdk.AccAddress{},
-		idToDistrCoins:    []sdk.Coins{},
-	}
-}
-
-func (d *distributionInfo) addLockRewards(owner string, rewards sdk.Coins) error {
-	if id, ok := d.lockOwnerAddrToID[owner]; ok {
-		oldDistrCoins := d.idToDistrCoins[id]
-		d.idToDistrCoins[id] = rewards.Add(oldDistrCoins...)
-	} else {
-		id := d.nextID
-		d.nextID += 1
-		d.lockOwnerAddrToID[owner] = id
-		decodedOwnerAddr, err := sdk.AccAddressFromBech32(owner)
-		if err != nil {
-			return err
-		}
-		d.idToBech32Addr = append(d.idToBech32Addr, owner)
-		d.idToDecodedAddr = append(d.idToDecodedAddr, decodedOwnerAddr)
-		d.idToDistrCoins = append(d.idToDistrCoins, rewards)
-	}
-	return nil
-}
-
-func (k Keeper) doDistributionSends(ctx sdk.Context, distrs *distributionInfo) error {
-	numIDs := len(distrs.idToDecodedAddr)
-	ctx.Logger().Debug(fmt.Sprintf("Beginning distribution to %d users", numIDs))
-	err := k.bk.SendCoinsFromModuleToManyAccounts(
-		ctx,
-		types.ModuleName,
-		distrs.idToDecodedAddr,
-		distrs.idToDistrCoins)
-	if err != nil {
-		return err
-	}
-	ctx.Logger().Debug("Finished sending, now creating liquidity add events")
-	for id := 0; id < numIDs; id++ {
-		ctx.EventManager().EmitEvents(sdk.Events{
-			sdk.NewEvent(
-				types.TypeEvtDistribution,
-				sdk.NewAttribute(types.AttributeReceiver, distrs.idToBech32Addr[id]),
-				sdk.NewAttribute(types.AttributeAmount, distrs.idToDistrCoins[id].String()),
-			),
-		})
-	}
-	ctx.Logger().Debug(fmt.Sprintf("Finished Distributing to %d users", numIDs))
-	return nil
-}
-
-// distributeSyntheticInternal runs the distribution logic for a synthetic rewards distribution gauge, and adds the sends to
-// the distrInfo computed. It also updates the gauge for the distribution.
-// locks is expected to be the correct set of lock recipients for this gauge.
-// TODO: Make this code have way more re-use with distribute internal (post-v7).
-func (k Keeper) distributeSyntheticInternal(
-	ctx sdk.Context, gauge types.Gauge, locks []lockuptypes.PeriodLock, distrInfo *distributionInfo,
-) (sdk.Coins, error) {
-	totalDistrCoins := sdk.NewCoins()
-	denom := gauge.DistributeTo.Denom
-
-	qualifiedLocks := make([]lockuptypes.PeriodLock, 0, len(locks))
-	for _, lock := range locks {
-		// See if this lock has a synthetic lockup. If so, err == nil, and we add to qualifiedLocks
-		// otherwise it does not, and we continue.
-		_, err := k.lk.GetSyntheticLockup(ctx, lock.ID, denom)
-		if err != nil {
-			continue
-		}
-		qualifiedLocks = append(qualifiedLocks, lock)
-	}
-
-	lockSum := lockuptypes.SumLocksByDenom(qualifiedLocks, lockuptypes.NativeDenom(denom))
-
-	if lockSum.IsZero() {
+// distributeInternal runs the distribution logic for a gauge, and updates the gauge for the distribution.
+// Rather than sending the rewards to every lock eligible for the gauge, it adds them to the reward index
+// of the gauge's denom and duration, from which every lock accrues its share. The rewards are sent to the
+// lock owners when they claim them. This makes a distribution independent of the number of locks.
+func (k Keeper) distributeInternal(ctx sdk.Context, gauge types.Gauge) (sdk.Coins, error) {
+	// if gauge is empty, there is nothing to distribute
+	if gauge.Coins.Empty() {
 		return nil, nil
 	}
 
-	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
-	remainEpochs := uint64(1)
-	if !gauge.IsPerpetual { // set remain epochs when it's not perpetual gauge
-		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
-	}
-
-	for _, lock := range qualifiedLocks {
-		distrCoins := sdk.Coins{}
-		for _, coin := range remainCoins {
-			lockedCoin, err := lock.SingleCoin()
-			if err != nil {
-				k.Logger(ctx).Error(err.Error())
-				continue
-			}
-			// distribution amount = gauge_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
-			// check if the synthlock is qualified for GetLocksToDistribution
-			amt := coin.Amount.Mul(lockedCoin.Amount).Quo(lockSum.Mul(sdk.NewIntFromUint64(remainEpochs)))
-			if amt.IsPositive() {
-				newlyDistributedCoin := sdk.Coin{Denom: coin.Denom, Amount: amt}
-				distrCoins = distrCoins.Add(newlyDistributedCoin)
-			}
-		}
-		distrCoins = distrCoins.Sort()
-		if distrCoins.Empty() {
-			continue
-		}
-		// Update the amount for that address
-		err := distrInfo.addLockRewards(lock.Owner, distrCoins)
-		if err != nil {
-			return nil, err
-		}
-
-		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
-	}
-
-	// increase filled epochs after distribution
-	err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
-	return totalDistrCoins, err
-}
-
-// distributeInternal runs the distribution logic for a gauge, and adds the sends to
-// the distrInfo computed. It also updates the gauge for the distribution.
-// locks is expected to be the correct set of lock recipients for this gauge.
-func (k Keeper) distributeInternal(
-	ctx sdk.Context, gauge types.Gauge, locks []lockuptypes.PeriodLock, distrInfo *distributionInfo,
-) (sdk.Coins, error) {
-	totalDistrCoins := sdk.NewCoins()
-	denom := gauge.DistributeTo.Denom
-	lockSum := lockuptypes.SumLocksByDenom(locks, denom)
-
-	if lockSum.IsZero() {
+	// All gauges have a precondition of being ByDuration.
+	distributions, totalWeight := k.getDenomDistributions(ctx, gauge)
+	if len(distributions) == 0 {
 		return nil, nil
 	}
 
@@ -308,32 +285,26 @@ func (k Keeper) distributeInternal(
 		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
 	}
 
-	for _, lock := range locks {
-		distrCoins := sdk.Coins{}
-		for _, coin := range remainCoins {
-			// distribution amount = gauge_size * denom_lock_amount / (total_denom_lock_amount * remain_epochs)
-			denomLockAmt := lock.Coins.AmountOfNoDenomValidation(denom)
-			amt := coin.Amount.Mul(denomLockAmt).Quo(lockSum.Mul(sdk.NewInt(int64(remainEpochs))))
-			if amt.IsPositive() {
-				newlyDistributedCoin := sdk.Coin{Denom: coin.Denom, Amount: amt}
-				distrCoins = distrCoins.Add(newlyDistributedCoin)
-			}
-		}
-		distrCoins = distrCoins.Sort()
-		if distrCoins.Empty() {
-			continue
-		}
-		// Update the amount for that address
-		err := distrInfo.addLockRewards(lock.Owner, distrCoins)
-		if err != nil {
-			return nil, err
+	distrCoins := sdk.Coins{}
+	for _, coin := range remainCoins {
+		// distribution amount = gauge_size / remain_epochs
+		amt := coin.Amount.Quo(sdk.NewIntFromUint64(remainEpochs))
+		if amt.IsPositive() {
+			distrCoins = distrCoins.Add(sdk.Coin{Denom: coin.Denom, Amount: amt})
 		}
+	}
 
-		totalDistrCoins = totalDistrCoins.Add(distrCoins...)
+	// the truncated remainders of the denoms' shares stay in the gauge
+	distributedCoins := sdk.Coins{}
+	for _, distribution := range distributions {
+		denomCoins := distribution.share(distrCoins, totalWeight)
+		rewardPerShare := k.increaseRewardIndex(ctx, distribution.denom, gauge.DistributeTo.Duration, denomCoins, distribution.totalLocked)
+		k.recordDistribution(ctx, gauge, distribution.denom, denomCoins, rewardPerShare)
+		distributedCoins = distributedCoins.Add(denomCoins...)
 	}
 
-	err := k.updateGaugePostDistribute(ctx, gauge, totalDistrCoins)
-	return totalDistrCoins, err
+	err := k.updateGaugePostDistribute(ctx, gauge, distributedCoins)
+	return distributedCoins, err
 }
 
 func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins) error {
@@ -346,51 +317,17 @@ func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, ne
 	return nil
 }
 
-func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cache map[string][]lockuptypes.PeriodLock) []lockuptypes.PeriodLock {
-	// if gauge is empty, don't get the locks
-	if gauge.Coins.Empty() {
-		return []lockuptypes.PeriodLock{}
-	}
-	// TODO: FIXME!!!
-	// Confusingly, there is no way to get all synthetic lockups. Thus we use a separate method `distributeSyntheticInternal` to separately get lockSum for synthetic lockups.
-	// All gauges have a precondition of being ByDuration.
-	distributeBaseDenom := lockuptypes.NativeDenom(gauge.DistributeTo.Denom)
-	if _, ok := cache[distributeBaseDenom]; !ok {
-		cache[distributeBaseDenom] = k.getLocksToDistributionWithMaxDuration(
-			ctx, gauge.DistributeTo, time.Millisecond)
-	}
-	// get this from memory instead of hitting iterators / underlying stores.
-	// due to many details of cacheKVStore, iteration will still cause expensive IAVL reads.
-	allLocks := cache[distributeBaseDenom]
-	return FilterLocksByMinDuration(allLocks, gauge.DistributeTo.Duration)
-}
-
 // Distribute coins from gauge according to its conditions.
 func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, error) {
-	distrInfo := newDistributionInfo()
-
-	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
 	totalDistributedCoins := sdk.Coins{}
 	for _, gauge := range gauges {
-		filteredLocks := k.getDistributeToBaseLocks(ctx, gauge, locksByDenomCache)
-		// send based on synthetic lockup coins if it's distributing to synthetic lockups
-		var gaugeDistributedCoins sdk.Coins
-		var err error
-		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
-			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
-		} else {
-			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, filteredLocks, &distrInfo)
-		}
+		gaugeDistributedCoins, err := k.distributeInternal(ctx, gauge)
 		if err != nil {
 			return nil, err
 		}
 		totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
 	}
 
-	err := k.doDistributionSends(ctx, &distrInfo)
-	if err != nil {
-		return nil, err
-	}
 	k.hooks.AfterEpochDistribution(ctx)
 
 	k.checkFinishDistribution(ctx, gauges)
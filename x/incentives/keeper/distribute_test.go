@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 
@@ -74,6 +76,10 @@ func (suite *KeeperTestSuite) TestDistribute() {
 		suite.Require().NoError(err)
 		// Check expected rewards
 		for i, addr := range addrs {
+			claimable := suite.App.IncentivesKeeper.GetClaimableRewards(suite.Ctx, addr)
+			suite.Require().Equal(tc.expectedRewards[i].String(), claimable.String(), "tcnum %d, person %d", tcIndex, i)
+			_, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr)
+			suite.Require().NoError(err)
 			bal := suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr)
 			suite.Require().Equal(tc.expectedRewards[i].String(), bal.String(), "tcnum %d, person %d", tcIndex, i)
 		}
@@ -185,6 +191,7 @@ func (suite *KeeperTestSuite) TestNoLockPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -230,6 +237,7 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -250,3 +258,107 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 	suite.Require().Len(gauges, 1)
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 }
+
+func (suite *KeeperTestSuite) TestMultiDenomGaugeDistribution() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	addr3 := sdk.AccAddress([]byte("addr3---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("lptoken", 30)}, time.Second)
+	suite.LockTokens(addr3, sdk.Coins{sdk.NewInt64Coin("lptoken2", 5)}, time.Second)
+	// not distributed to, as it is locked for a shorter duration
+	suite.LockTokens(addr3, sdk.Coins{sdk.NewInt64Coin("lptoken2", 5)}, time.Second/2)
+
+	// lptoken locks get 2/3 of the rewards, and lptoken2 locks 1/3
+	denoms := []types.DenomWeight{{Denom: "lptoken", Weight: sdk.NewDec(2)}, {Denom: "lptoken2", Weight: sdk.OneDec()}}
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 3000)}
+	suite.FundAcc(addr1, coins)
+	gaugeID, err := suite.App.IncentivesKeeper.CreateMultiDenomGauge(suite.Ctx, false, addr1, coins, time.Second, denoms, false, suite.Ctx.BlockTime(), 2)
+	suite.Require().NoError(err)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"lptoken", "lptoken2"}, gauge.Denoms())
+	suite.Require().Len(suite.App.IncentivesKeeper.GetLocksToDistribution(suite.Ctx, *gauge), 3)
+
+	// the gauge is referenced by both denoms
+	for _, denom := range gauge.Denoms() {
+		suite.Require().Equal([]uint64{gaugeID}, suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, denom))
+	}
+
+	// estimate the rewards of the first epoch
+	locks := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr3)
+	_, estCoins, err := suite.App.IncentivesKeeper.FilteredLocksDistributionEst(suite.Ctx, *gauge, locks[:1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 500)}, estCoins)
+
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 1500)}, distrCoins)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 250)})
+	suite.requireClaimable(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 750)})
+	suite.requireClaimable(addr3, sdk.Coins{sdk.NewInt64Coin("stake", 500)})
+
+	// the finished gauge is not referenced by its denoms anymore
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	for _, denom := range gauge.Denoms() {
+		suite.Require().Empty(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, denom))
+	}
+}
+
+func (suite *KeeperTestSuite) TestMultiDenomGaugeWeightByOsmoValue() {
+	suite.SetupTest()
+
+	// pool shares are worth 2_000_000uosmo and 6_000_000uosmo per 100 shares
+	poolId1 := suite.PrepareBalancerPoolWithPoolAsset([]balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 1000000)},
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("foo", 1000000)},
+	})
+	poolId2 := suite.PrepareBalancerPoolWithPoolAsset([]balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 3000000)},
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("bar", 1000000)},
+	})
+	shareDenom1 := gammtypes.GetPoolShareDenom(poolId1)
+	shareDenom2 := gammtypes.GetPoolShareDenom(poolId2)
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewCoin(shareDenom1, gammtypes.OneShare)}, time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewCoin(shareDenom2, gammtypes.OneShare)}, time.Second)
+
+	denoms := []types.DenomWeight{{Denom: shareDenom1, Weight: sdk.OneDec()}, {Denom: shareDenom2, Weight: sdk.OneDec()}}
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 4000)}
+	suite.FundAcc(addr1, coins)
+
+	// the shares can't be valued before the pools have a TWAP
+	_, err := suite.App.IncentivesKeeper.CreateMultiDenomGauge(suite.Ctx, true, addr1, coins, time.Second, denoms, true, suite.Ctx.BlockTime(), 1)
+	suite.Require().Error(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.OsmoValueTwapWindow))
+	gaugeID, err := suite.App.IncentivesKeeper.CreateMultiDenomGauge(suite.Ctx, true, addr1, coins, time.Second, denoms, true, suite.Ctx.BlockTime(), 1)
+	suite.Require().NoError(err)
+
+	// swapping 1_000_000uosmo into the first pool in the block of the distribution doubles its spot value,
+	// but its assets are valued at their TWAP prices, so its shares are only worth 2_500_000uosmo per 100 shares
+	swapper := suite.TestAccs[2]
+	suite.FundAcc(swapper, sdk.Coins{sdk.NewInt64Coin("uosmo", 1000000)})
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId1, sdk.NewInt64Coin("uosmo", 1000000), "foo", sdk.OneInt())
+	suite.Require().NoError(err)
+
+	suite.distributeGauge(gaugeID)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 1176)})
+	suite.requireClaimable(addr2, sdk.Coins{sdk.NewInt64Coin("stake", 2823)})
+
+	// only OSMO and pool shares can be valued in OSMO
+	denoms = []types.DenomWeight{{Denom: shareDenom1, Weight: sdk.OneDec()}, {Denom: "lptoken", Weight: sdk.OneDec()}}
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, time.Second)
+	suite.FundAcc(addr1, coins)
+	_, err = suite.App.IncentivesKeeper.CreateMultiDenomGauge(suite.Ctx, true, addr1, coins, time.Second, denoms, true, suite.Ctx.BlockTime(), 1)
+	suite.Require().Error(err)
+}
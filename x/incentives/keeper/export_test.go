@@ -6,6 +6,10 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 )
 
+func (k Keeper) SetGauge(ctx sdk.Context, gauge *types.Gauge) error {
+	return k.setGauge(ctx, gauge)
+}
+
 func (k Keeper) AddGaugeRefByKey(ctx sdk.Context, key []byte, guageID uint64) error {
 	return k.addGaugeRefByKey(ctx, key, guageID)
 }
@@ -29,3 +33,7 @@ func (k Keeper) MoveUpcomingGaugeToActiveGauge(ctx sdk.Context, gauge types.Gaug
 func (k Keeper) MoveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gauge) error {
 	return k.moveActiveGaugeToFinishedGauge(ctx, gauge)
 }
+
+func (k Keeper) GetLockCheckpoint(ctx sdk.Context, lockID uint64) (types.LockCheckpoint, bool) {
+	return k.getLockCheckpoint(ctx, lockID)
+}
@@ -7,12 +7,14 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	appparams "github.com/osmosis-labs/osmosis/v7/app/params"
 	epochtypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 	db "github.com/tendermint/tm-db"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // Iterate over everything in a gauges iterator, until it reaches the end. Return all gauges iterated over.
@@ -66,8 +68,10 @@ func (k Keeper) CreateGaugeRefKeys(ctx sdk.Context, gauge *types.Gauge, combined
 		return err
 	}
 	if activeOrUpcomingGauge {
-		if err := k.addGaugeIDForDenom(ctx, gauge.Id, gauge.DistributeTo.Denom); err != nil {
-			return err
+		for _, denom := range gauge.Denoms() {
+			if err := k.addGaugeIDForDenom(ctx, gauge.Id, denom); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -97,6 +101,40 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 
 // CreateGauge create a gauge and send coins to the gauge.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	return k.createGauge(ctx, owner, types.Gauge{
+		IsPerpetual:       isPerpetual,
+		DistributeTo:      distrTo,
+		Coins:             coins,
+		StartTime:         startTime,
+		NumEpochsPaidOver: numEpochsPaidOver,
+	})
+}
+
+// CreateMultiDenomGauge creates a gauge distributing to the locks of several denoms locked for at least
+// duration, and sends coins to the gauge. The denoms share every distribution by their weights, times the
+// OSMO value of their locked tokens if weightByOsmoValue is set.
+func (k Keeper) CreateMultiDenomGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration, denoms []types.DenomWeight, weightByOsmoValue bool, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	if len(denoms) == 0 {
+		return 0, fmt.Errorf("multi denom gauge should distribute to at least one denom")
+	}
+	return k.createGauge(ctx, owner, types.Gauge{
+		IsPerpetual: isPerpetual,
+		DistributeTo: lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Duration:      duration,
+		},
+		Coins:              coins,
+		StartTime:          startTime,
+		NumEpochsPaidOver:  numEpochsPaidOver,
+		DistributeToDenoms: denoms,
+		WeightByOsmoValue:  weightByOsmoValue,
+	})
+}
+
+// createGauge validates a new gauge, assigns it an ID and sends its coins from owner to the gauge.
+func (k Keeper) createGauge(ctx sdk.Context, owner sdk.AccAddress, gauge types.Gauge) (uint64, error) {
+	distrTo := gauge.DistributeTo
+
 	// Ensure that this gauge's duration is one of the allowed durations on chain
 	durations := k.GetLockableDurations(ctx)
 	if distrTo.LockQueryType == lockuptypes.ByDuration {
@@ -112,20 +150,25 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		}
 	}
 
-	// Ensure that the denom this gauge pays out to exists on-chain
-	if !k.bk.HasSupply(ctx, distrTo.Denom) && !strings.Contains(distrTo.Denom, "osmovaloper") {
-		return 0, fmt.Errorf("denom does not exist: %s", distrTo.Denom)
+	if err := types.ValidateDistributeToDenoms(distrTo, gauge.DistributeToDenoms, gauge.WeightByOsmoValue); err != nil {
+		return 0, err
 	}
 
-	gauge := types.Gauge{
-		Id:                k.GetLastGaugeID(ctx) + 1,
-		IsPerpetual:       isPerpetual,
-		DistributeTo:      distrTo,
-		Coins:             coins,
-		StartTime:         startTime,
-		NumEpochsPaidOver: numEpochsPaidOver,
+	for _, denom := range gauge.Denoms() {
+		// Ensure that the denoms this gauge pays out to exist on-chain
+		if !k.bk.HasSupply(ctx, denom) && !strings.Contains(denom, "osmovaloper") {
+			return 0, fmt.Errorf("denom does not exist: %s", denom)
+		}
+		if gauge.WeightByOsmoValue {
+			if _, err := k.osmoValuePerToken(ctx, denom); err != nil {
+				return 0, err
+			}
+		}
 	}
 
+	gauge.Id = k.GetLastGaugeID(ctx) + 1
+	gauge.Owner = owner.String()
+
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
 		return 0, err
 	}
@@ -148,6 +191,96 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	return gauge.Id, nil
 }
 
+// checkGaugeCreationLimits returns an error if a user created gauge distributing coins to the locks of denoms
+// over numEpochsPaidOver epochs would exceed the max active gauges of one of its denoms, or would distribute
+// less than the min reward value per epoch.
+func (k Keeper) checkGaugeCreationLimits(ctx sdk.Context, denoms []string, coins sdk.Coins, numEpochsPaidOver uint64) error {
+	params := k.GetParams(ctx)
+	if params.MaxActiveGaugesPerDenom > 0 {
+		for _, denom := range denoms {
+			if k.countActiveUserGauges(ctx, denom) >= params.MaxActiveGaugesPerDenom {
+				return fmt.Errorf("denom %s already has the maximum number of active gauges: %d", denom, params.MaxActiveGaugesPerDenom)
+			}
+		}
+	}
+	if params.MinRewardValuePerEpoch.IsPositive() {
+		value := k.rewardValuePerEpoch(ctx, coins, numEpochsPaidOver)
+		if value.LT(params.MinRewardValuePerEpoch) {
+			return fmt.Errorf("gauge rewards are worth %suosmo per epoch, less than the minimum of %suosmo", value, params.MinRewardValuePerEpoch)
+		}
+	}
+	return nil
+}
+
+// countActiveUserGauges returns the number of upcoming and active gauges created by users, perpetual or not, that
+// distribute to the locks of denom. Gauges created by modules, like the pool incentives gauges, aren't counted.
+func (k Keeper) countActiveUserGauges(ctx sdk.Context, denom string) uint64 {
+	count := uint64(0)
+	// gauge IDs are referenced by denom until they finish
+	for _, gaugeID := range k.getAllGaugeIDsByDenom(ctx, denom) {
+		gauge, err := k.GetGaugeByID(ctx, gaugeID)
+		if err != nil {
+			panic(err)
+		}
+		if gauge.UserCreated {
+			count++
+		}
+	}
+	return count
+}
+
+// rewardValuePerEpoch returns the OSMO value, in uosmo, of the coins a gauge distributes per epoch when it
+// distributes coins over numEpochsPaidOver epochs. Fee tokens are valued at the arithmetic TWAP of their
+// price in OSMO over OsmoValueTwapWindow, in the pool the txfees module prices them with, and other coins,
+// or fee tokens without a TWAP, are worth nothing.
+func (k Keeper) rewardValuePerEpoch(ctx sdk.Context, coins sdk.Coins, numEpochsPaidOver uint64) sdk.Int {
+	value := sdk.ZeroInt()
+	if numEpochsPaidOver == 0 {
+		return value
+	}
+	endTime := ctx.BlockTime()
+	startTime := endTime.Add(-types.OsmoValueTwapWindow)
+	for _, coin := range coins {
+		amountPerEpoch := coin.Amount.Quo(sdk.NewIntFromUint64(numEpochsPaidOver))
+		if !amountPerEpoch.IsPositive() {
+			continue
+		}
+		if coin.Denom == appparams.BaseCoinUnit {
+			value = value.Add(amountPerEpoch)
+			continue
+		}
+		feeToken, err := k.tfk.GetFeeToken(ctx, coin.Denom)
+		if err != nil {
+			continue
+		}
+		price, err := k.tk.GetArithmeticTwap(ctx, feeToken.PoolID, appparams.BaseCoinUnit, coin.Denom, startTime, endTime)
+		if err != nil {
+			continue
+		}
+		value = value.Add(price.MulInt(amountPerEpoch).TruncateInt())
+	}
+	return value
+}
+
+// setGaugeUserCreated records that a user created the gauge, so that it counts toward the limits of its denoms.
+func (k Keeper) setGaugeUserCreated(ctx sdk.Context, gaugeID uint64) error {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return err
+	}
+	gauge.UserCreated = true
+	return k.setGauge(ctx, gauge)
+}
+
+// chargeGaugeCreationFee sends the gauge creation fee from owner to the community pool.
+func (k Keeper) chargeGaugeCreationFee(ctx sdk.Context, owner sdk.AccAddress) error {
+	fee := k.GetParams(ctx).GaugeCreationFee
+	if fee.Empty() {
+		return nil
+	}
+	return k.dk.FundCommunityPool(ctx, fee, owner)
+}
+
 // AddToGauge add coins to gauge.
 func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error {
 	gauge, err := k.GetGaugeByID(ctx, gaugeID)
@@ -167,6 +300,63 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	return nil
 }
 
+// CancelGauge finishes a non perpetual upcoming or active gauge before the end of its distribution, and
+// refunds its undistributed coins to its owner, or to the community pool if the gauge was created before
+// owners were recorded. Rewards it already distributed remain claimable.
+func (k Keeper) CancelGauge(ctx sdk.Context, gaugeID uint64) (sdk.Coins, error) {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+	if gauge.IsPerpetual {
+		return nil, fmt.Errorf("gauge %d is perpetual and cannot be canceled", gaugeID)
+	}
+	var owner sdk.AccAddress
+	if gauge.Owner != "" {
+		owner, err = sdk.AccAddressFromBech32(gauge.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	timeKey := getTimeKey(gauge.StartTime)
+	upcomingKey := combineKeys(types.KeyPrefixUpcomingGauges, timeKey)
+	activeKey := combineKeys(types.KeyPrefixActiveGauges, timeKey)
+	switch {
+	case findIndex(k.getGaugeRefs(ctx, upcomingKey), gaugeID) >= 0:
+		if err := k.deleteGaugeRefByKey(ctx, upcomingKey, gaugeID); err != nil {
+			return nil, err
+		}
+		if err := k.addGaugeRefByKey(ctx, activeKey, gaugeID); err != nil {
+			return nil, err
+		}
+	case findIndex(k.getGaugeRefs(ctx, activeKey), gaugeID) >= 0:
+	default:
+		return nil, fmt.Errorf("gauge %d is already finished", gaugeID)
+	}
+
+	refund := gauge.Coins.Sub(gauge.DistributedCoins)
+	gauge.Coins = gauge.DistributedCoins
+	if err := k.setGauge(ctx, gauge); err != nil {
+		return nil, err
+	}
+	if err := k.moveActiveGaugeToFinishedGauge(ctx, *gauge); err != nil {
+		return nil, err
+	}
+	switch {
+	case refund.Empty():
+	case owner.Empty():
+		if err := k.dk.FundCommunityPool(ctx, refund, authtypes.NewModuleAddress(types.ModuleName)); err != nil {
+			return nil, err
+		}
+	default:
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, refund); err != nil {
+			return nil, err
+		}
+	}
+	return refund, nil
+}
+
 // GetGaugeByID Returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
@@ -236,11 +426,16 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 		}
 	}
 	gauges := []types.Gauge{}
+	gaugeIDSet := map[uint64]bool{}
 	// initialize gauges to active and upcomings if not set
 	for s := range denomSet {
 		gaugeIDs := k.getAllGaugeIDsByDenom(ctx, s)
-		// Each gauge only rewards locks to one denom, so no duplicates
 		for _, id := range gaugeIDs {
+			// Gauges distributing to several denoms are referenced by each of them
+			if gaugeIDSet[id] {
+				continue
+			}
+			gaugeIDSet[id] = true
 			gauge, err := k.GetGaugeByID(ctx, id)
 			// Shouldn't happen
 			if err != nil {
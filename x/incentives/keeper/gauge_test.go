@@ -3,8 +3,12 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v7/x/incentives"
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/keeper"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -93,6 +97,7 @@ func (suite *KeeperTestSuite) TestNonPerpetualGaugeOperations() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -173,6 +178,7 @@ func (suite *KeeperTestSuite) TestPerpetualGaugeOperations() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -196,6 +202,7 @@ func (suite *KeeperTestSuite) TestPerpetualGaugeOperations() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -310,3 +317,147 @@ func (suite *KeeperTestSuite) TestGaugesByDenom() {
 	testGaugeByDenom(true)
 	testGaugeByDenom(false)
 }
+
+func (suite *KeeperTestSuite) TestCancelGauge() {
+	suite.SetupTest()
+
+	lockOwners := suite.SetupManyLocks(2, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
+	gaugeID, _, _, startTime := suite.SetupNewGauge(false, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+
+	// distribute the first of the two epochs
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	// only the owner can cancel the gauge
+	_, err = msgServer.CancelGauge(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelGauge(lockOwners[0], gaugeID))
+	suite.Require().Error(err)
+
+	ownerBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, defaultGaugeOwner, "stake")
+	res, err := msgServer.CancelGauge(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCancelGauge(defaultGaugeOwner, gaugeID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, res.Refunded)
+	newOwnerBalance := suite.App.BankKeeper.GetBalance(suite.Ctx, defaultGaugeOwner, "stake")
+	suite.Require().Equal(ownerBalance.Amount.AddRaw(5), newOwnerBalance.Amount)
+
+	// the gauge is finished with the coins it distributed
+	suite.Require().Len(suite.App.IncentivesKeeper.GetActiveGauges(suite.Ctx), 0)
+	suite.Require().Len(suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx), 1)
+	suite.Require().Len(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, defaultLPDenom), 0)
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(gauge.DistributedCoins, gauge.Coins)
+
+	// the distributed rewards can still be claimed
+	rewards, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, lockOwners[0])
+	suite.Require().NoError(err)
+	suite.Require().False(rewards.Empty())
+
+	// a finished gauge cannot be canceled
+	_, err = suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, gaugeID)
+	suite.Require().Error(err)
+
+	// a perpetual gauge cannot be canceled
+	perpetualGaugeID, _, _, _ := suite.SetupNewGauge(true, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	_, err = suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, perpetualGaugeID)
+	suite.Require().Error(err)
+
+	// gov can cancel an upcoming gauge of any owner
+	upcomingGaugeID, _ := suite.CreateGauge(false, defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, gauge.DistributeTo, startTime.Add(time.Hour), 2)
+	handler := incentives.NewIncentivesProposalHandler(suite.App.IncentivesKeeper)
+	err = handler(suite.Ctx, types.NewCancelGaugeProposal("title", "description", upcomingGaugeID))
+	suite.Require().NoError(err)
+	upcomingGauges := suite.App.IncentivesKeeper.GetUpcomingGauges(suite.Ctx)
+	suite.Require().Len(upcomingGauges, 1)
+	suite.Require().Equal(perpetualGaugeID, upcomingGauges[0].Id)
+	suite.Require().Equal(newOwnerBalance.Amount.AddRaw(10), suite.App.BankKeeper.GetBalance(suite.Ctx, defaultGaugeOwner, "stake").Amount)
+
+	// gauges created before owners were recorded are refunded to the community pool
+	noOwnerGaugeID, noOwnerGauge := suite.CreateGauge(false, defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, gauge.DistributeTo, startTime.Add(time.Hour), 2)
+	noOwnerGauge.Owner = ""
+	err = suite.App.IncentivesKeeper.SetGauge(suite.Ctx, noOwnerGauge)
+	suite.Require().NoError(err)
+	communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	err = handler(suite.Ctx, types.NewCancelGaugeProposal("title", "description", noOwnerGaugeID))
+	suite.Require().NoError(err)
+	newCommunityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	suite.Require().Equal(sdk.NewDec(10), newCommunityPool.AmountOf("stake").Sub(communityPool.AmountOf("stake")))
+	suite.Require().Equal(newOwnerBalance.Amount.AddRaw(10), suite.App.BankKeeper.GetBalance(suite.Ctx, defaultGaugeOwner, "stake").Amount)
+}
+
+func (suite *KeeperTestSuite) TestCreateGaugeFeeAndLimits() {
+	suite.SetupTest()
+
+	// foo is a fee token worth as much OSMO, and bar is in a pool with OSMO but isn't a fee token
+	fooPoolID := suite.PrepareBalancerPoolWithPoolAsset([]balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("foo", 1_000_000)},
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 1_000_000)},
+	})
+	suite.PrepareBalancerPoolWithPoolAsset([]balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("bar", 1_000_000)},
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 1_000_000)},
+	})
+	err := suite.App.TxFeesKeeper.SetBaseDenom(suite.Ctx, "uosmo")
+	suite.Require().NoError(err)
+	err = suite.App.TxFeesKeeper.SetFeeTokens(suite.Ctx, []txfeestypes.FeeToken{{Denom: "foo", PoolID: fooPoolID}})
+	suite.Require().NoError(err)
+	// mints the locked denom so its supply exists on chain
+	suite.FundAcc(suite.TestAccs[0], defaultLPTokens)
+
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.GaugeCreationFee = sdk.Coins{sdk.NewInt64Coin("uosmo", 50)}
+	params.MinRewardValuePerEpoch = sdk.NewInt(100)
+	params.MaxActiveGaugesPerDenom = 2
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+	owner := suite.TestAccs[1]
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         defaultLPDenom,
+		Duration:      defaultLockDuration,
+	}
+	createGauge := func(isPerpetual bool, coins sdk.Coins, numEpochsPaidOver uint64) error {
+		suite.FundAcc(owner, coins.Add(params.GaugeCreationFee...))
+		msg := types.NewMsgCreateGauge(isPerpetual, owner, distrTo, coins, suite.Ctx.BlockTime(), numEpochsPaidOver)
+		_, err := msgServer.CreateGauge(sdk.WrapSDKContext(suite.Ctx), msg)
+		return err
+	}
+
+	// 150uosmo over 2 epochs is worth less than the minimum per epoch
+	err = createGauge(false, sdk.Coins{sdk.NewInt64Coin("uosmo", 150)}, 2)
+	suite.Require().Error(err)
+
+	// fee tokens are worth nothing until they have a TWAP
+	err = createGauge(false, sdk.Coins{sdk.NewInt64Coin("foo", 1_000_000)}, 1)
+	suite.Require().Error(err)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.OsmoValueTwapWindow))
+
+	// OSMO and the OSMO value of fee tokens add up
+	communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	err = createGauge(false, sdk.Coins{sdk.NewInt64Coin("foo", 120), sdk.NewInt64Coin("uosmo", 100)}, 2)
+	suite.Require().NoError(err)
+	newCommunityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	suite.Require().Equal(sdk.NewDec(50), newCommunityPool.AmountOf("uosmo").Sub(communityPool.AmountOf("uosmo")))
+
+	// coins that aren't fee tokens are worth nothing, even if they can be swapped for OSMO
+	err = createGauge(false, sdk.Coins{sdk.NewInt64Coin("bar", 1_000_000)}, 1)
+	suite.Require().Error(err)
+
+	// gauges created by modules don't count toward the active gauges of the denom
+	suite.CreateGauge(false, suite.TestAccs[0], sdk.Coins{sdk.NewInt64Coin("uosmo", 100)}, distrTo, suite.Ctx.BlockTime(), 1)
+	err = createGauge(true, sdk.Coins{sdk.NewInt64Coin("uosmo", 100)}, 1)
+	suite.Require().NoError(err)
+
+	// the denom has as many active gauges as allowed, perpetual gauges included
+	err = createGauge(false, sdk.Coins{sdk.NewInt64Coin("uosmo", 100)}, 1)
+	suite.Require().Error(err)
+	err = createGauge(true, sdk.Coins{sdk.NewInt64Coin("uosmo", 100)}, 1)
+	suite.Require().Error(err)
+	suite.Require().Len(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, defaultLPDenom), 3)
+}
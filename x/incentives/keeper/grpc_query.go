@@ -124,7 +124,7 @@ func (q Querier) ActiveGaugesPerDenom(goCtx context.Context, req *types.ActiveGa
 	pageRes, err := query.FilteredPaginate(valStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		activeGauges := q.Keeper.GetActiveGauges(ctx)
 		for _, gauge := range activeGauges {
-			if gauge.DistributeTo.Denom == req.Denom {
+			if gauge.DistributesToDenom(req.Denom) {
 				gauges = append(gauges, gauge)
 			}
 		}
@@ -181,7 +181,7 @@ func (q Querier) UpcomingGaugesPerDenom(goCtx context.Context, req *types.Upcomi
 	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		upcomingGauges := q.Keeper.GetUpcomingGauges(ctx)
 		for _, gauge := range upcomingGauges {
-			if gauge.DistributeTo.Denom == req.Denom {
+			if gauge.DistributesToDenom(req.Denom) {
 				gauges = append(gauges, gauge)
 			}
 		}
@@ -226,12 +226,74 @@ func (q Querier) RewardsEst(goCtx context.Context, req *types.RewardsEstRequest)
 	return &types.RewardsEstResponse{Coins: q.Keeper.GetRewardsEst(ctx, owner, locks, req.EndEpoch)}, nil
 }
 
+// ClaimableRewards returns the rewards accrued by the locks of an owner, that it can claim.
+func (q Querier) ClaimableRewards(goCtx context.Context, req *types.ClaimableRewardsRequest) (*types.ClaimableRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Owner) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty owner")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ClaimableRewardsResponse{Rewards: q.Keeper.GetClaimableRewards(ctx, owner)}, nil
+}
+
+// LockRewards returns the rewards a lock received from each gauge in each epoch of an epoch range.
+func (q Querier) LockRewards(goCtx context.Context, req *types.LockRewardsRequest) (*types.LockRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.FromEpoch > req.ToEpoch {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "from epoch %d is after to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if uint64(req.ToEpoch-req.FromEpoch) >= q.Keeper.GetParams(ctx).RewardHistoryEpochs {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "epoch range is longer than the reward history")
+	}
+
+	rewards, total := q.Keeper.GetLockRewards(ctx, req.LockId, req.FromEpoch, req.ToEpoch)
+	return &types.LockRewardsResponse{Rewards: rewards, Total: total}, nil
+}
+
+// PoolAPR returns the APR of the shares of a pool locked for a duration, from the rewards distributed to them
+// in the last epochs.
+func (q Querier) PoolAPR(goCtx context.Context, req *types.PoolAPRRequest) (*types.PoolAPRResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.NumEpochs <= 0 || uint64(req.NumEpochs) > q.Keeper.GetParams(ctx).RewardHistoryEpochs {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "number of epochs must be between 1 and the reward history length, got %d", req.NumEpochs)
+	}
+
+	apr, rewardPerShare, err := q.Keeper.GetPoolAPR(ctx, req.PoolId, req.Duration, req.NumEpochs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return &types.PoolAPRResponse{Apr: apr, RewardPerShare: rewardPerShare}, nil
+}
+
 func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDurationsRequest) (*types.QueryLockableDurationsResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	return &types.QueryLockableDurationsResponse{LockableDurations: q.Keeper.GetLockableDurations(sdkCtx)}, nil
 }
 
+// Params returns the incentives module params.
+func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from gauge id json bytes.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
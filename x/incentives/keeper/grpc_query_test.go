@@ -38,6 +38,7 @@ func (suite *KeeperTestSuite) TestGRPCGaugeByID() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Gauge.String(), expectedGauge.String())
 }
@@ -70,6 +71,7 @@ func (suite *KeeperTestSuite) TestGRPCGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 }
@@ -105,6 +107,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 }
@@ -138,6 +141,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 }
@@ -170,6 +174,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 }
@@ -201,6 +206,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeOwner.String(),
 	}
 	suite.Require().Equal(res.UpcomingGauges[0].String(), expectedGauge.String())
 
@@ -306,14 +312,14 @@ func (suite *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	// distribute coins to stakers
 	distrCoins, err := suite.querier.Distribute(suite.Ctx, gauges)
 	suite.Require().NoError(err)
-	suite.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 
 	// check gauge changes after distribution
 	gauge, err = suite.querier.GetGaugeByID(suite.Ctx, gaugeID)
 	suite.Require().NoError(err)
 	suite.Require().NotNil(gauge)
 	suite.Require().Equal(gauge.FilledEpochs, uint64(1))
-	suite.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 	gauges = []types.Gauge{*gauge}
 
 	// start distribution
@@ -329,7 +335,7 @@ func (suite *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	// distribute second round to stakers
 	distrCoins, err = suite.querier.Distribute(suite.Ctx, gauges)
 	suite.Require().NoError(err)
-	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, distrCoins)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, distrCoins)
 
 	// final check
 	res, err = suite.querier.ModuleToDistributeCoins(sdk.WrapSDKContext(suite.Ctx), &types.ModuleToDistributeCoinsRequest{})
@@ -371,14 +377,14 @@ func (suite *KeeperTestSuite) TestGRPCDistributedCoins() {
 	// distribute coins to stakers
 	distrCoins, err := suite.querier.Distribute(suite.Ctx, gauges)
 	suite.Require().NoError(err)
-	suite.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Equal(distrCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 
 	// check gauge changes after distribution
 	gauge, err = suite.querier.GetGaugeByID(suite.Ctx, gaugeID)
 	suite.Require().NoError(err)
 	suite.Require().NotNil(gauge)
 	suite.Require().Equal(gauge.FilledEpochs, uint64(1))
-	suite.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Equal(gauge.DistributedCoins, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
 	gauges = []types.Gauge{*gauge}
 
 	// check after distribution
@@ -389,7 +395,7 @@ func (suite *KeeperTestSuite) TestGRPCDistributedCoins() {
 	// distribute second round to stakers
 	distrCoins, err = suite.querier.Distribute(suite.Ctx, gauges)
 	suite.Require().NoError(err)
-	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, distrCoins)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 5)}, distrCoins)
 
 	// final check
 	res, err = suite.querier.ModuleDistributedCoins(sdk.WrapSDKContext(suite.Ctx), &types.ModuleDistributedCoinsRequest{})
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
@@ -42,6 +44,12 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		if err != nil {
 			panic(err)
 		}
+
+		// join the rewards of auto compounding locks, including this epoch's, into their pools
+		k.compoundAutoCompoundLocks(ctx)
+
+		// forget the distributions that fell out of the reward history
+		k.pruneDistributionRecords(ctx)
 	}
 }
 
@@ -52,7 +60,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks  = Hooks{}
+	_ lockuptypes.LockupHooks = Hooks{}
+)
 
 // Return the wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -67,3 +78,65 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 }
+
+// lockup hooks
+// Every change to the coins, duration, synthetic lockups, owner or auto compounding of a lock checkpoints it,
+// so that it accrues its rewards with its new stakes from then on.
+func (h Hooks) AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+// Unlocking locks keep accruing rewards until they are unlocked, but they aren't auto compounding,
+// so starting to unlock, or canceling it, changes where the rewards of the lock go.
+func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+func (h Hooks) OnCancelUnlocking(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration, newDuration time.Duration) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+func (h Hooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	h.k.checkpointLock(ctx, lockID)
+	h.k.checkpointLock(ctx, splitLockID)
+}
+
+// The merged lock is deleted, so checkpointing it accrues its last rewards and removes its checkpoint.
+func (h Hooks) OnLockMerged(ctx sdk.Context, lockID uint64, mergedLockID uint64, amount sdk.Coins) {
+	h.k.checkpointLock(ctx, mergedLockID)
+	h.k.checkpointLock(ctx, lockID)
+}
+
+// The rewards of the lock until the transfer accrue to the previous owner, and later ones to the new owner.
+func (h Hooks) OnLockTransferred(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+// The rewards held for compounding into the lock are accrued by its owner when it stops auto compounding.
+func (h Hooks) OnSetLockAutoCompound(ctx sdk.Context, lockID uint64, autoCompound bool) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+func (h Hooks) OnSyntheticLockupCreated(ctx sdk.Context, lockID uint64, synthDenom string) {
+	h.k.checkpointLock(ctx, lockID)
+}
+
+func (h Hooks) OnSyntheticLockupDeleted(ctx sdk.Context, lockID uint64, synthDenom string) {
+	h.k.checkpointLock(ctx, lockID)
+}
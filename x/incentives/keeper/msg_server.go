@@ -30,10 +30,27 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	gaugeID, err := server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	// limit the gauges users create, as each active gauge adds work to every epoch's distribution
+	denoms := types.Gauge{DistributeTo: msg.DistributeTo, DistributeToDenoms: msg.DistributeToDenoms}.Denoms()
+	if err := server.keeper.checkGaugeCreationLimits(ctx, denoms, msg.Coins, msg.NumEpochsPaidOver); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := server.keeper.chargeGaugeCreationFee(ctx, owner); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	var gaugeID uint64
+	if len(msg.DistributeToDenoms) > 0 {
+		gaugeID, err = server.keeper.CreateMultiDenomGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo.Duration, msg.DistributeToDenoms, msg.WeightByOsmoValue, msg.StartTime, msg.NumEpochsPaidOver)
+	} else {
+		gaugeID, err = server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	if err := server.keeper.setGaugeUserCreated(ctx, gaugeID); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -65,3 +82,45 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+func (server msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := server.keeper.ClaimRewards(ctx, owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
+
+func (server msgServer) CancelGauge(goCtx context.Context, msg *types.MsgCancelGauge) (*types.MsgCancelGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	gauge, err := server.keeper.GetGaugeByID(ctx, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if gauge.Owner != msg.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "msg sender (%s) and gauge owner (%s) are not the same", msg.Owner, gauge.Owner)
+	}
+
+	refunded, err := server.keeper.CancelGauge(ctx, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(msg.GaugeId)),
+			sdk.NewAttribute(types.AttributeReceiver, msg.Owner),
+			sdk.NewAttribute(types.AttributeAmount, refunded.String()),
+		),
+	})
+
+	return &types.MsgCancelGaugeResponse{Refunded: refunded}, nil
+}
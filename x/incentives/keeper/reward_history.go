@@ -0,0 +1,319 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v7/app/params"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+)
+
+// year is the period APRs are computed over.
+const year = 365 * 24 * time.Hour
+
+// spotPriceRouteAmount is the amount of a denom swapped to find the route through which it is valued in OSMO.
+var spotPriceRouteAmount = sdk.NewInt(1_000_000)
+
+// distributionRecordStoreKey returns the store key of the record of the rewards a gauge distributed to the
+// locks of denom in an epoch.
+func distributionRecordStoreKey(epoch int64, denom string, gaugeID uint64) []byte {
+	return combineKeys(types.KeyPrefixDistributionRecord, sdk.Uint64ToBigEndian(uint64(epoch)), []byte(denom), sdk.Uint64ToBigEndian(gaugeID))
+}
+
+// distributionRecordsPrefix returns the prefix of the distribution records, which are ordered by epoch.
+func distributionRecordsPrefix() []byte {
+	return append(append([]byte{}, types.KeyPrefixDistributionRecord...), types.KeyIndexSeparator...)
+}
+
+// distributionRecordEpochDenomPrefix returns the prefix of the distribution records of an epoch and denom,
+// which are ordered by gauge ID.
+func distributionRecordEpochDenomPrefix(epoch int64, denom string) []byte {
+	return append(combineKeys(types.KeyPrefixDistributionRecord, sdk.Uint64ToBigEndian(uint64(epoch)), []byte(denom)), types.KeyIndexSeparator...)
+}
+
+// lockStakesRecordStoreKey returns the store key of the record of the stakes of a lock from an epoch on.
+func lockStakesRecordStoreKey(lockID uint64, epoch int64) []byte {
+	return combineKeys(types.KeyPrefixLockStakesRecord, sdk.Uint64ToBigEndian(lockID), sdk.Uint64ToBigEndian(uint64(epoch)))
+}
+
+// lockStakesRecordLockPrefix returns the prefix of the stakes records of a lock, which are ordered by epoch.
+func lockStakesRecordLockPrefix(lockID uint64) []byte {
+	return append(combineKeys(types.KeyPrefixLockStakesRecord, sdk.Uint64ToBigEndian(lockID)), types.KeyIndexSeparator...)
+}
+
+// SetDistributionRecord stores a distribution record.
+func (k Keeper) SetDistributionRecord(ctx sdk.Context, record types.DistributionRecord) {
+	ctx.KVStore(k.storeKey).Set(distributionRecordStoreKey(record.Epoch, record.Denom, record.GaugeId), k.cdc.MustMarshal(&record))
+}
+
+// GetAllDistributionRecords returns all the distribution records.
+func (k Keeper) GetAllDistributionRecords(ctx sdk.Context) []types.DistributionRecord {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixDistributionRecord)
+	defer iterator.Close()
+
+	records := []types.DistributionRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.DistributionRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// getDistributionRecords returns the records of the rewards gauges distributed to the locks of denom in an epoch.
+func (k Keeper) getDistributionRecords(ctx sdk.Context, epoch int64, denom string) []types.DistributionRecord {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), distributionRecordEpochDenomPrefix(epoch, denom))
+	defer iterator.Close()
+
+	records := []types.DistributionRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.DistributionRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// recordDistribution records that a gauge distributed coins to the locks of denom in the current epoch,
+// increasing the reward index of denom by rewardPerShare.
+func (k Keeper) recordDistribution(ctx sdk.Context, gauge types.Gauge, denom string, coins sdk.Coins, rewardPerShare sdk.DecCoins) {
+	if coins.Empty() {
+		return
+	}
+	record := types.DistributionRecord{
+		Epoch:          k.GetEpochInfo(ctx).CurrentEpoch,
+		GaugeId:        gauge.Id,
+		Denom:          denom,
+		Duration:       gauge.DistributeTo.Duration,
+		Coins:          sdk.Coins{},
+		RewardPerShare: sdk.DecCoins{},
+	}
+	// a gauge distributing several times in an epoch adds to its record
+	bz := ctx.KVStore(k.storeKey).Get(distributionRecordStoreKey(record.Epoch, denom, gauge.Id))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &record)
+	}
+	record.Coins = record.Coins.Add(coins...)
+	record.RewardPerShare = record.RewardPerShare.Add(rewardPerShare...)
+	k.SetDistributionRecord(ctx, record)
+}
+
+// SetLockStakesRecord stores a lock stakes record.
+func (k Keeper) SetLockStakesRecord(ctx sdk.Context, record types.LockStakesRecord) {
+	ctx.KVStore(k.storeKey).Set(lockStakesRecordStoreKey(record.LockId, record.Epoch), k.cdc.MustMarshal(&record))
+}
+
+// GetAllLockStakesRecords returns all the lock stakes records.
+func (k Keeper) GetAllLockStakesRecords(ctx sdk.Context) []types.LockStakesRecord {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixLockStakesRecord)
+	defer iterator.Close()
+
+	records := []types.LockStakesRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.LockStakesRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// getLockStakesAt returns the stakes a lock had in an epoch, i.e. the stakes of its latest record
+// from that epoch or before.
+func (k Keeper) getLockStakesAt(ctx sdk.Context, lockID uint64, epoch int64) []types.LockStake {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), lockStakesRecordLockPrefix(lockID))
+	iterator := store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(epoch)+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+	record := types.LockStakesRecord{}
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record.Stakes
+}
+
+// recordLockStakes records the stakes of a lock from the current epoch on, and deletes its records that
+// are no longer needed to cover the reward history.
+func (k Keeper) recordLockStakes(ctx sdk.Context, lockID uint64, stakes []types.LockStake) {
+	record := types.LockStakesRecord{
+		LockId: lockID,
+		Epoch:  k.GetEpochInfo(ctx).CurrentEpoch,
+		Stakes: make([]types.LockStake, 0, len(stakes)),
+	}
+	for _, stake := range stakes {
+		stake.LastRewardPerShare = nil
+		record.Stakes = append(record.Stakes, stake)
+	}
+	k.SetLockStakesRecord(ctx, record)
+
+	// the latest record before the history start holds the stakes at the history start, the older ones
+	// can be deleted
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), lockStakesRecordLockPrefix(lockID))
+	keys := iteratorKeys(store.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(k.rewardHistoryStartEpoch(ctx)))))
+	for i := 1; i < len(keys); i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// deleteLockStakesRecords deletes all the stakes records of a lock.
+func (k Keeper) deleteLockStakesRecords(ctx sdk.Context, lockID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), lockStakesRecordLockPrefix(lockID))
+	for _, key := range iteratorKeys(store.Iterator(nil, nil)) {
+		store.Delete(key)
+	}
+}
+
+// rewardHistoryStartEpoch returns the first epoch of the reward history, which covers the last
+// RewardHistoryEpochs epochs up to the current one.
+func (k Keeper) rewardHistoryStartEpoch(ctx sdk.Context) int64 {
+	start := k.GetEpochInfo(ctx).CurrentEpoch - int64(k.GetParams(ctx).RewardHistoryEpochs) + 1
+	if start < 0 {
+		return 0
+	}
+	return start
+}
+
+// pruneDistributionRecords deletes the distribution records of the epochs before the reward history start.
+func (k Keeper) pruneDistributionRecords(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), distributionRecordsPrefix())
+	for _, key := range iteratorKeys(store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(k.rewardHistoryStartEpoch(ctx))))) {
+		store.Delete(key)
+	}
+}
+
+// iteratorKeys returns the keys of an iterator, and closes it, so that they can be deleted.
+func iteratorKeys(iterator sdk.Iterator) [][]byte {
+	defer iterator.Close()
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	return keys
+}
+
+// GetLockRewards returns the rewards a lock received from each gauge in each epoch from fromEpoch to toEpoch,
+// ordered by epoch and gauge ID, along with their total.
+// The rewards are computed from the distribution records and the stakes records of the lock, so they only
+// cover the reward history, and may differ from the rewards the lock accrued by rounding.
+func (k Keeper) GetLockRewards(ctx sdk.Context, lockID uint64, fromEpoch, toEpoch int64) ([]types.LockEpochReward, sdk.DecCoins) {
+	rewards := []types.LockEpochReward{}
+	total := sdk.DecCoins{}
+	if fromEpoch < 0 {
+		fromEpoch = 0
+	}
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		gaugeRewards := map[uint64]sdk.DecCoins{}
+		for _, stake := range k.getLockStakesAt(ctx, lockID, epoch) {
+			if stake.Amount.IsZero() {
+				continue
+			}
+			for _, record := range k.getDistributionRecords(ctx, epoch, stake.Denom) {
+				// a stake accrues the rewards of the gauges of its denom with a duration of at most its duration
+				if record.Duration > stake.Duration {
+					continue
+				}
+				reward := record.RewardPerShare.MulDecTruncate(stake.Amount.ToDec()).QuoDecTruncate(types.RewardIndexPrecision.ToDec())
+				gaugeRewards[record.GaugeId] = gaugeRewards[record.GaugeId].Add(reward...)
+			}
+		}
+
+		gaugeIDs := make([]uint64, 0, len(gaugeRewards))
+		for gaugeID := range gaugeRewards {
+			gaugeIDs = append(gaugeIDs, gaugeID)
+		}
+		sort.Slice(gaugeIDs, func(i, j int) bool { return gaugeIDs[i] < gaugeIDs[j] })
+		for _, gaugeID := range gaugeIDs {
+			if gaugeRewards[gaugeID].IsZero() {
+				continue
+			}
+			rewards = append(rewards, types.LockEpochReward{Epoch: epoch, GaugeId: gaugeID, Rewards: gaugeRewards[gaugeID]})
+			total = total.Add(gaugeRewards[gaugeID]...)
+		}
+	}
+	return rewards, total
+}
+
+// GetPoolAPR returns the APR of the shares of a pool locked for duration, from the rewards distributed to them
+// in the numEpochs epochs before the current one, along with these rewards per RewardIndexPrecision shares.
+// Rewards and shares are valued in OSMO at spot prices, and rewards that can't be valued are worth nothing.
+func (k Keeper) GetPoolAPR(ctx sdk.Context, poolID uint64, duration time.Duration, numEpochs int64) (sdk.Dec, sdk.DecCoins, error) {
+	if numEpochs <= 0 {
+		return sdk.Dec{}, nil, fmt.Errorf("number of epochs must be positive, got %d", numEpochs)
+	}
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+	epochInfo := k.GetEpochInfo(ctx)
+	if epochInfo.Duration <= 0 {
+		return sdk.Dec{}, nil, fmt.Errorf("distribution epoch %s has no duration", epochInfo.Identifier)
+	}
+
+	// the locks of a duration accrue the rewards of the gauges with a duration of at most theirs
+	rewardPerShare := sdk.DecCoins{}
+	for epoch := epochInfo.CurrentEpoch - numEpochs; epoch < epochInfo.CurrentEpoch; epoch++ {
+		if epoch < 0 {
+			continue
+		}
+		for _, record := range k.getDistributionRecords(ctx, epoch, shareDenom) {
+			if record.Duration <= duration {
+				rewardPerShare = rewardPerShare.Add(record.RewardPerShare...)
+			}
+		}
+	}
+
+	shareValue, err := k.osmoValuePerToken(ctx, shareDenom)
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+	if !shareValue.IsPositive() {
+		return sdk.Dec{}, nil, fmt.Errorf("shares of pool %d have no OSMO value", poolID)
+	}
+
+	rewardValue := sdk.ZeroDec()
+	for _, coin := range rewardPerShare {
+		price, err := k.osmoSpotPrice(ctx, coin.Denom)
+		if err != nil {
+			continue
+		}
+		rewardValue = rewardValue.Add(price.Mul(coin.Amount))
+	}
+
+	// APR = reward value per share / share value * epochs per year / number of epochs
+	epochsPerYear := sdk.NewDec(int64(year)).QuoInt64(int64(epochInfo.Duration))
+	apr := rewardValue.
+		Quo(shareValue.MulInt(types.RewardIndexPrecision)).
+		Mul(epochsPerYear).
+		QuoInt64(numEpochs)
+	return apr, rewardPerShare, nil
+}
+
+// rewardValueMaxHops is the largest number of pools swapped through to value rewards in OSMO.
+const rewardValueMaxHops = 2
+
+// osmoSpotPrice returns the OSMO value of a token of denom, at the spot prices of the pools of the best route
+// from denom to OSMO.
+func (k Keeper) osmoSpotPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	if denom == appparams.BaseCoinUnit {
+		return sdk.OneDec(), nil
+	}
+	routes, _, _, err := k.gk.BestSwapExactAmountInRoute(ctx, sdk.NewCoin(denom, spotPriceRouteAmount), appparams.BaseCoinUnit, rewardValueMaxHops)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	price := sdk.OneDec()
+	tokenInDenom := denom
+	for _, route := range routes {
+		spotPrice, err := k.gk.CalculateSpotPrice(ctx, route.PoolId, route.TokenOutDenom, tokenInDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		price = price.Mul(spotPrice)
+		tokenInDenom = route.TokenOutDenom
+	}
+	return price, nil
+}
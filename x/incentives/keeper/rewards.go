@@ -0,0 +1,455 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+)
+
+// rewardIndexStoreKey returns the store key of the reward index of a denom and duration.
+func rewardIndexStoreKey(denom string, duration time.Duration) []byte {
+	return combineKeys(types.KeyPrefixRewardIndex, []byte(denom), sdk.Uint64ToBigEndian(uint64(duration)))
+}
+
+// rewardIndexDenomPrefix returns the prefix of the reward indices of a denom, which are ordered by duration.
+func rewardIndexDenomPrefix(denom string) []byte {
+	return append(combineKeys(types.KeyPrefixRewardIndex, []byte(denom)), types.KeyIndexSeparator...)
+}
+
+// lockCheckpointStoreKey returns the store key of the checkpoint of a lock.
+func lockCheckpointStoreKey(lockID uint64) []byte {
+	return combineKeys(types.KeyPrefixLockCheckpoint, sdk.Uint64ToBigEndian(lockID))
+}
+
+// autoCompoundLockStoreKey returns the store key of the auto compounding index entry of a lock.
+func autoCompoundLockStoreKey(lockID uint64) []byte {
+	return combineKeys(types.KeyPrefixAutoCompoundLock, sdk.Uint64ToBigEndian(lockID))
+}
+
+// accruedRewardsStoreKey returns the store key of the accrued rewards of an owner.
+func accruedRewardsStoreKey(owner sdk.AccAddress) []byte {
+	return combineKeys(types.KeyPrefixAccruedRewards, owner)
+}
+
+// GetRewardIndex returns the reward index of the gauges distributing to locks of denom, locked for
+// at least duration.
+func (k Keeper) GetRewardIndex(ctx sdk.Context, denom string, duration time.Duration) types.RewardIndex {
+	index := types.RewardIndex{Denom: denom, Duration: duration, RewardPerShare: sdk.DecCoins{}}
+	bz := ctx.KVStore(k.storeKey).Get(rewardIndexStoreKey(denom, duration))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &index)
+	}
+	return index
+}
+
+// SetRewardIndex stores a reward index.
+func (k Keeper) SetRewardIndex(ctx sdk.Context, index types.RewardIndex) {
+	ctx.KVStore(k.storeKey).Set(rewardIndexStoreKey(index.Denom, index.Duration), k.cdc.MustMarshal(&index))
+}
+
+// GetAllRewardIndices returns all the reward indices.
+func (k Keeper) GetAllRewardIndices(ctx sdk.Context) []types.RewardIndex {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixRewardIndex)
+	defer iterator.Close()
+
+	indices := []types.RewardIndex{}
+	for ; iterator.Valid(); iterator.Next() {
+		index := types.RewardIndex{}
+		k.cdc.MustUnmarshal(iterator.Value(), &index)
+		indices = append(indices, index)
+	}
+	return indices
+}
+
+// increaseRewardIndex distributes rewards to the totalLocked tokens of denom locked for at least duration,
+// by increasing the reward index of denom and duration, and returns the increase.
+func (k Keeper) increaseRewardIndex(ctx sdk.Context, denom string, duration time.Duration, rewards sdk.Coins, totalLocked sdk.Int) sdk.DecCoins {
+	if rewards.Empty() {
+		return sdk.DecCoins{}
+	}
+	index := k.GetRewardIndex(ctx, denom, duration)
+	increase := sdk.NewDecCoinsFromCoins(rewards...).
+		MulDecTruncate(types.RewardIndexPrecision.ToDec()).
+		QuoDecTruncate(totalLocked.ToDec())
+	index.RewardPerShare = index.RewardPerShare.Add(increase...)
+	k.SetRewardIndex(ctx, index)
+	return increase
+}
+
+// rewardPerShare returns the rewards distributed per RewardIndexPrecision tokens of denom locked for duration,
+// i.e. the sum of the reward indices of denom with a duration of at most duration.
+func (k Keeper) rewardPerShare(ctx sdk.Context, denom string, duration time.Duration) sdk.DecCoins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), rewardIndexDenomPrefix(denom))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(duration)+1))
+	defer iterator.Close()
+
+	rewardPerShare := sdk.DecCoins{}
+	for ; iterator.Valid(); iterator.Next() {
+		index := types.RewardIndex{}
+		k.cdc.MustUnmarshal(iterator.Value(), &index)
+		rewardPerShare = rewardPerShare.Add(index.RewardPerShare...)
+	}
+	return rewardPerShare
+}
+
+// getLockCheckpoint returns the checkpoint of a lock, if it has one.
+func (k Keeper) getLockCheckpoint(ctx sdk.Context, lockID uint64) (types.LockCheckpoint, bool) {
+	checkpoint := types.LockCheckpoint{}
+	bz := ctx.KVStore(k.storeKey).Get(lockCheckpointStoreKey(lockID))
+	if bz == nil {
+		return checkpoint, false
+	}
+	k.cdc.MustUnmarshal(bz, &checkpoint)
+	return checkpoint, true
+}
+
+// SetLockCheckpoint stores the checkpoint of a lock, and indexes the lock if it is auto compounding.
+func (k Keeper) SetLockCheckpoint(ctx sdk.Context, checkpoint types.LockCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(lockCheckpointStoreKey(checkpoint.LockId), k.cdc.MustMarshal(&checkpoint))
+	if checkpoint.AutoCompound {
+		store.Set(autoCompoundLockStoreKey(checkpoint.LockId), []byte{})
+	} else {
+		store.Delete(autoCompoundLockStoreKey(checkpoint.LockId))
+	}
+}
+
+// deleteLockCheckpoint deletes the checkpoint of a lock, and its auto compounding index entry.
+func (k Keeper) deleteLockCheckpoint(ctx sdk.Context, lockID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(lockCheckpointStoreKey(lockID))
+	store.Delete(autoCompoundLockStoreKey(lockID))
+}
+
+// getAutoCompoundLockIDs returns the IDs of the locks that were auto compounding when last checkpointed.
+func (k Keeper) getAutoCompoundLockIDs(ctx sdk.Context) []uint64 {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompoundLock)
+	defer iterator.Close()
+
+	lockIDs := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		// the lock ID ends the key
+		key := iterator.Key()
+		lockIDs = append(lockIDs, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+	return lockIDs
+}
+
+// GetAllLockCheckpoints returns the checkpoints of all locks.
+func (k Keeper) GetAllLockCheckpoints(ctx sdk.Context) []types.LockCheckpoint {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixLockCheckpoint)
+	defer iterator.Close()
+
+	checkpoints := []types.LockCheckpoint{}
+	for ; iterator.Valid(); iterator.Next() {
+		checkpoint := types.LockCheckpoint{}
+		k.cdc.MustUnmarshal(iterator.Value(), &checkpoint)
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return checkpoints
+}
+
+// GetAccruedRewards returns the rewards accrued by the locks of owner, that have not been claimed yet.
+// The rewards of locks since they were last checkpointed, and the rewards held for auto compounding locks,
+// are not included.
+func (k Keeper) GetAccruedRewards(ctx sdk.Context, owner sdk.AccAddress) sdk.DecCoins {
+	accrued := types.AccruedRewards{}
+	bz := ctx.KVStore(k.storeKey).Get(accruedRewardsStoreKey(owner))
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+	k.cdc.MustUnmarshal(bz, &accrued)
+	return accrued.Rewards
+}
+
+// SetAccruedRewards stores the unclaimed rewards of owner.
+func (k Keeper) SetAccruedRewards(ctx sdk.Context, owner sdk.AccAddress, rewards sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if rewards.IsZero() {
+		store.Delete(accruedRewardsStoreKey(owner))
+		return
+	}
+	accrued := types.AccruedRewards{Owner: owner.String(), Rewards: rewards}
+	store.Set(accruedRewardsStoreKey(owner), k.cdc.MustMarshal(&accrued))
+}
+
+// GetAllAccruedRewards returns the unclaimed rewards of all owners.
+func (k Keeper) GetAllAccruedRewards(ctx sdk.Context) []types.AccruedRewards {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	defer iterator.Close()
+
+	accrued := []types.AccruedRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		rewards := types.AccruedRewards{}
+		k.cdc.MustUnmarshal(iterator.Value(), &rewards)
+		accrued = append(accrued, rewards)
+	}
+	return accrued
+}
+
+// lockStakes returns the current stakes of a lock in the reward indices: one for each of its coins,
+// and one for each synthetic lockup of its coin.
+func (k Keeper) lockStakes(ctx sdk.Context, lock lockuptypes.PeriodLock) []types.LockStake {
+	stakes := []types.LockStake{}
+	for _, coin := range lock.Coins {
+		stakes = append(stakes, types.LockStake{
+			Denom:              coin.Denom,
+			Duration:           lock.Duration,
+			Amount:             coin.Amount,
+			LastRewardPerShare: k.rewardPerShare(ctx, coin.Denom, lock.Duration),
+		})
+	}
+
+	// CONTRACT: lock will have synthetic lock only if it has a single coin
+	coin, err := lock.SingleCoin()
+	if err != nil {
+		return stakes
+	}
+	for _, synthLock := range k.lk.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		stakes = append(stakes, types.LockStake{
+			Denom:              synthLock.SynthDenom,
+			Duration:           synthLock.Duration,
+			Amount:             coin.Amount,
+			LastRewardPerShare: k.rewardPerShare(ctx, synthLock.SynthDenom, synthLock.Duration),
+		})
+	}
+	return stakes
+}
+
+// checkpointRewards returns the rewards accrued by the stakes of a checkpoint since it was recorded.
+func (k Keeper) checkpointRewards(ctx sdk.Context, checkpoint types.LockCheckpoint) sdk.DecCoins {
+	rewards := sdk.DecCoins{}
+	for _, stake := range checkpoint.Stakes {
+		rewards = rewards.Add(stake.RewardsSince(k.rewardPerShare(ctx, stake.Denom, stake.Duration))...)
+	}
+	return rewards
+}
+
+// checkpointLock records the current stakes of a lock, also in its reward history, and settles the rewards
+// accrued by the lock since its last checkpoint. The rewards of a lock that stays auto compounding are held in
+// its checkpoint, until they are compounded into it at the end of a distribution epoch. Other rewards, along with
+// the rewards held for a lock that stops auto compounding or changes owner, are accrued by its last owner.
+// It must be called whenever the coins, the duration, the synthetic lockups, the owner or the auto compounding
+// of a lock change, so that the lock accrues rewards with its new stakes from the next distribution on.
+func (k Keeper) checkpointLock(ctx sdk.Context, lockID uint64) {
+	owner, rewards := sdk.AccAddress(nil), sdk.DecCoins{}
+	checkpoint, found := k.getLockCheckpoint(ctx, lockID)
+	if found {
+		rewards = k.checkpointRewards(ctx, checkpoint).Add(checkpoint.CompoundRewards...)
+		var err error
+		owner, err = sdk.AccAddressFromBech32(checkpoint.Owner)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		// the lock has been unlocked
+		k.deleteLockCheckpoint(ctx, lockID)
+		k.deleteLockStakesRecords(ctx, lockID)
+		k.accrueRewards(ctx, owner, rewards)
+		return
+	}
+
+	autoCompound := lock.AutoCompound && !lock.IsUnlocking()
+	compoundRewards := sdk.DecCoins{}
+	if found && checkpoint.AutoCompound && autoCompound && checkpoint.Owner == lock.Owner {
+		compoundRewards = rewards
+	} else {
+		k.accrueRewards(ctx, owner, rewards)
+	}
+	stakes := k.lockStakes(ctx, *lock)
+	k.SetLockCheckpoint(ctx, types.LockCheckpoint{
+		LockId:          lock.ID,
+		Owner:           lock.Owner,
+		Stakes:          stakes,
+		AutoCompound:    autoCompound,
+		CompoundRewards: compoundRewards,
+	})
+	k.recordLockStakes(ctx, lock.ID, stakes)
+}
+
+// accrueRewards adds rewards to the accrued rewards of owner.
+func (k Keeper) accrueRewards(ctx sdk.Context, owner sdk.AccAddress, rewards sdk.DecCoins) {
+	if rewards.IsZero() {
+		return
+	}
+	k.SetAccruedRewards(ctx, owner, k.GetAccruedRewards(ctx, owner).Add(rewards...))
+}
+
+// compoundAutoCompoundLocks compounds the rewards held for every auto compounding lock into it.
+func (k Keeper) compoundAutoCompoundLocks(ctx sdk.Context) {
+	for _, lockID := range k.getAutoCompoundLockIDs(ctx) {
+		k.compoundLockRewards(ctx, lockID)
+	}
+}
+
+// compoundLockRewards joins the rewards held for an auto compounding lock, including the ones since its last
+// checkpoint, into the pool of the lock's shares, and adds the resulting shares to the lock.
+// The rewards that can't be joined, e.g. because the pool doesn't contain their denom, are accrued by the owner
+// instead, and the decimal remainders of the rewards stay held for the next compounding.
+func (k Keeper) compoundLockRewards(ctx sdk.Context, lockID uint64) {
+	k.checkpointLock(ctx, lockID)
+	checkpoint, found := k.getLockCheckpoint(ctx, lockID)
+	if !found || !checkpoint.AutoCompound {
+		return
+	}
+	coins, change := checkpoint.CompoundRewards.TruncateDecimal()
+	if coins.Empty() {
+		return
+	}
+	checkpoint.CompoundRewards = change
+	k.SetLockCheckpoint(ctx, checkpoint)
+
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		panic(err)
+	}
+	for _, coin := range coins {
+		// join the pool in a cache context, so that the reward is accrued by the owner as is if it fails
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		shares, err := k.compoundCoin(cacheCtx, *lock, coin)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to compound rewards", "lock_id", lock.ID, "amount", coin, "error", err)
+			k.accrueRewards(ctx, lock.OwnerAddress(), sdk.NewDecCoinsFromCoins(coin))
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtCompoundRewards,
+			sdk.NewAttribute(types.AttributeLockID, strconv.FormatUint(lock.ID, 10)),
+			sdk.NewAttribute(types.AttributeAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeShares, shares.String()),
+		))
+	}
+}
+
+// compoundCoin sends a reward coin to the owner of a lock, joins it into the pool of the lock's shares, and
+// adds the resulting shares to the lock.
+func (k Keeper) compoundCoin(ctx sdk.Context, lock lockuptypes.PeriodLock, coin sdk.Coin) (sdk.Coin, error) {
+	lockedCoin, err := lock.SingleCoin()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := gammtypes.ValidatePoolShareDenom(lockedCoin.Denom); err != nil {
+		return sdk.Coin{}, fmt.Errorf("locked denom %s is not a pool share", lockedCoin.Denom)
+	}
+	poolId := gammtypes.MustGetPoolIdFromShareDenom(lockedCoin.Denom)
+	pool, err := k.gk.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	minSharesOut, err := k.compoundMinSharesOut(ctx, pool, coin)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	owner := lock.OwnerAddress()
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, err
+	}
+	sharesOut, err := k.gk.JoinSwapExactAmountIn(ctx, owner, poolId, sdk.NewCoins(coin), minSharesOut)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	shares := sdk.NewCoin(lockedCoin.Denom, sharesOut)
+	if _, err := k.lk.AddTokensToLockByID(ctx, lock.ID, owner, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+	return shares, nil
+}
+
+// compoundMinSharesOut returns the least shares that compounding coin into pool must create.
+// The pool's liquidity is valued in coin's denom at the arithmetic TWAP of each of its assets over CompoundTwapWindow,
+// and the shares must be worth at least coin, minus MaxCompoundSlippage. This bounds what moving the pool's price
+// within a block, e.g. by sandwiching the compounding, can take from it. Fails if the pool doesn't contain coin's
+// denom, or if a TWAP is unavailable, e.g. when the pool is younger than the window.
+func (k Keeper) compoundMinSharesOut(ctx sdk.Context, pool gammtypes.PoolI, coin sdk.Coin) (sdk.Int, error) {
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	if !liquidity.AmountOf(coin.Denom).IsPositive() {
+		return sdk.Int{}, fmt.Errorf("pool %d does not contain %s", pool.GetId(), coin.Denom)
+	}
+
+	endTime := ctx.BlockTime()
+	startTime := endTime.Add(-types.CompoundTwapWindow)
+	poolValue := sdk.ZeroDec()
+	for _, asset := range liquidity {
+		if asset.Denom == coin.Denom {
+			poolValue = poolValue.Add(asset.Amount.ToDec())
+			continue
+		}
+		price, err := k.tk.GetArithmeticTwap(ctx, pool.GetId(), coin.Denom, asset.Denom, startTime, endTime)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		poolValue = poolValue.Add(price.MulInt(asset.Amount))
+	}
+
+	sharesValue := coin.Amount.ToDec().Mul(sdk.OneDec().Sub(types.MaxCompoundSlippage))
+	return sharesValue.MulInt(pool.GetTotalShares()).Quo(poolValue).TruncateInt(), nil
+}
+
+// CheckpointAllLocks checkpoints every lock that has not been checkpointed yet, so that they accrue
+// rewards from the next distribution on.
+func (k Keeper) CheckpointAllLocks(ctx sdk.Context) error {
+	locks, err := k.lk.GetPeriodLocks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		if _, found := k.getLockCheckpoint(ctx, lock.ID); !found {
+			k.checkpointLock(ctx, lock.ID)
+		}
+	}
+	return nil
+}
+
+// ClaimRewards sends the rewards accrued by the locks of owner to it, and returns them.
+// The rewards held for auto compounding locks are not claimed, they are compounded into the locks at the end
+// of the distribution epoch instead. Reward amounts are truncated, and their decimal remainders are kept for the
+// next claim.
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	for _, lock := range k.lk.GetAccountPeriodLocks(ctx, owner) {
+		k.checkpointLock(ctx, lock.ID)
+	}
+
+	rewards, change := k.GetAccruedRewards(ctx, owner).TruncateDecimal()
+	k.SetAccruedRewards(ctx, owner, change)
+	if rewards.Empty() {
+		return sdk.Coins{}, nil
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, rewards); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtClaimRewards,
+		sdk.NewAttribute(types.AttributeReceiver, owner.String()),
+		sdk.NewAttribute(types.AttributeAmount, rewards.String()),
+	))
+	return rewards, nil
+}
+
+// GetClaimableRewards returns the rewards that owner would receive by claiming its rewards now.
+func (k Keeper) GetClaimableRewards(ctx sdk.Context, owner sdk.AccAddress) sdk.Coins {
+	// checkpoint the locks of owner without persisting it
+	cacheCtx, _ := ctx.CacheContext()
+	for _, lock := range k.lk.GetAccountPeriodLocks(cacheCtx, owner) {
+		k.checkpointLock(cacheCtx, lock.ID)
+	}
+
+	rewards, _ := k.GetAccruedRewards(cacheCtx, owner).TruncateDecimal()
+	return rewards
+}
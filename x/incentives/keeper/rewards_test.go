@@ -0,0 +1,398 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// distributeGauge distributes a gauge once, and returns the distributed coins.
+func (suite *KeeperTestSuite) distributeGauge(gaugeID uint64) sdk.Coins {
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	distrCoins, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+	suite.Require().NoError(err)
+	return distrCoins
+}
+
+func (suite *KeeperTestSuite) requireClaimable(addr sdk.AccAddress, expected sdk.Coins) {
+	claimable := suite.App.IncentivesKeeper.GetClaimableRewards(suite.Ctx, addr)
+	suite.Require().Equal(expected.String(), claimable.String())
+}
+
+func (suite *KeeperTestSuite) TestClaimRewards() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	addr3 := sdk.AccAddress([]byte("addr3---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}, time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 30)}, 2*time.Second)
+
+	// a perpetual gauge for 1s locks, and one for 2s locks
+	gaugeID, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, time.Second)
+	longGaugeID, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, 2*time.Second)
+
+	// distributions only update the reward indices
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, suite.distributeGauge(gaugeID))
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, suite.distributeGauge(longGaugeID))
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1).Empty())
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)})
+	suite.requireClaimable(addr2, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 6000)})
+
+	// a lock created after a distribution doesn't get its rewards
+	suite.LockTokens(addr3, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 40)}, time.Second)
+	suite.requireClaimable(addr3, sdk.Coins{})
+
+	// claiming sends the rewards and resets them
+	rewards, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, rewards)
+	suite.Require().Equal(rewards, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr1))
+	suite.requireClaimable(addr1, sdk.Coins{})
+
+	// refill the gauge, which is now shared with the new lock
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 8000)}, gaugeID)
+	suite.distributeGauge(gaugeID)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)})
+	suite.requireClaimable(addr2, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 9000)})
+	suite.requireClaimable(addr3, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)})
+
+	// rewards accrued before a lock gets unlocked can still be claimed
+	locks := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr3)
+	suite.Require().Len(locks, 1)
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, locks[0].ID, nil)
+	suite.Require().NoError(err)
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second))
+	err = suite.App.LockupKeeper.Unlock(suite.Ctx, locks[0].ID)
+	suite.Require().NoError(err)
+	suite.requireClaimable(addr3, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)})
+
+	rewards, err = suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr3)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, rewards)
+	_, found := suite.App.IncentivesKeeper.GetLockCheckpoint(suite.Ctx, locks[0].ID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestCheckpointOnLockChanges() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}, time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}, time.Second)
+	locks := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1)
+	suite.Require().Len(locks, 1)
+	lockID := locks[0].ID
+
+	gaugeID, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, time.Second)
+	longGaugeID, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{}, 2*time.Second)
+	suite.distributeGauge(gaugeID)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 500)})
+
+	// adding tokens to a lock only increases its share of later distributions
+	suite.FundAcc(addr1, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 30)})
+	_, err := suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, lockID, addr1, sdk.NewInt64Coin(defaultLPDenom, 30))
+	suite.Require().NoError(err)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 500)})
+
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, gaugeID)
+	suite.distributeGauge(gaugeID)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1300)})
+	suite.requireClaimable(addr2, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 700)})
+
+	// extending a lock makes it eligible for the gauges of the longer duration
+	lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockID)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.ExtendLockup(suite.Ctx, *lock, 2*time.Second)
+	suite.Require().NoError(err)
+
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, longGaugeID)
+	suite.distributeGauge(longGaugeID)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2300)})
+	suite.requireClaimable(addr2, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 700)})
+
+	// splitting a lock to partially unlock it keeps its total stake
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lockID, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 20)})
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1), 2)
+
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, gaugeID)
+	suite.distributeGauge(gaugeID)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3100)})
+	suite.requireClaimable(addr2, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 900)})
+
+	// transferring a lock keeps its rewards so far with the previous owner
+	addr3 := sdk.AccAddress([]byte("addr3---------------"))
+	locks = suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr2)
+	suite.Require().Len(locks, 1)
+	_, err = suite.App.LockupKeeper.TransferLock(suite.Ctx, locks[0].ID, addr3, false)
+	suite.Require().NoError(err)
+
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}, gaugeID)
+	suite.distributeGauge(gaugeID)
+	suite.requireClaimable(addr1, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3900)})
+	suite.requireClaimable(addr2, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 900)})
+	suite.requireClaimable(addr3, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 200)})
+}
+
+func (suite *KeeperTestSuite) TestClaimRewardsKeepsRemainders() {
+	suite.SetupTest()
+
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1---------------")),
+		sdk.AccAddress([]byte("addr2---------------")),
+		sdk.AccAddress([]byte("addr3---------------")),
+	}
+	for _, addr := range addrs {
+		suite.LockTokens(addr, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}, time.Second)
+	}
+
+	// 10 coins are split into 3.33 coins per lock, twice
+	gaugeID, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 10)}, time.Second)
+	suite.distributeGauge(gaugeID)
+	rewards, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3)}, rewards)
+
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 10)}, gaugeID)
+	suite.distributeGauge(gaugeID)
+	rewards, err = suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3)}, rewards)
+
+	// the claimed coins never exceed the distributed coins, and the remainders are kept
+	for _, addr := range addrs {
+		_, err = suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, addr)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewInt(6), suite.App.BankKeeper.GetBalance(suite.Ctx, addr, defaultRewardDenom).Amount)
+		remainder := suite.App.IncentivesKeeper.GetAccruedRewards(suite.Ctx, addr).AmountOf(defaultRewardDenom)
+		suite.Require().True(remainder.GT(sdk.MustNewDecFromStr("0.66")))
+		suite.Require().True(remainder.LT(sdk.OneDec()))
+	}
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundRewards() {
+	tests := []struct {
+		name string
+		// twapWindowPassed is whether the pool has existed for the whole compound TWAP window
+		twapWindowPassed bool
+		// swapIn is swapped into the pool in the block of the epoch end, before it
+		swapIn     sdk.Coin
+		compounded bool
+	}{
+		{
+			name:             "compounded at the twap price",
+			twapWindowPassed: true,
+			compounded:       true,
+		},
+		{
+			name:             "compounded after a small swap",
+			twapWindowPassed: true,
+			swapIn:           sdk.NewInt64Coin("foo", 10000),
+			compounded:       true,
+		},
+		{
+			name:             "not compounded after a swap that moves the price",
+			twapWindowPassed: true,
+			swapIn:           sdk.NewInt64Coin("foo", 5000000),
+		},
+		{
+			name: "not compounded without a twap",
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			poolId := suite.PrepareBalancerPool()
+			if tc.twapWindowPassed {
+				suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.CompoundTwapWindow))
+			}
+			shareDenom := gammtypes.GetPoolShareDenom(poolId)
+			owner := sdk.AccAddress([]byte("addr1---------------"))
+			suite.LockTokens(owner, sdk.Coins{sdk.NewCoin(shareDenom, gammtypes.OneShare.MulRaw(10))}, time.Second)
+			locks := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, owner)
+			suite.Require().Len(locks, 1)
+			err := suite.App.LockupKeeper.SetLockAutoCompound(suite.Ctx, locks[0], true)
+			suite.Require().NoError(err)
+
+			// foo is in the pool, and may get compounded, while stake is not and gets accrued by the owner
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         shareDenom,
+				Duration:      time.Second,
+			}
+			rewards := sdk.Coins{sdk.NewInt64Coin("foo", 10000), sdk.NewInt64Coin("stake", 10000)}
+			gaugeID, _ := suite.CreateGauge(true, suite.TestAccs[1], rewards, distrTo, suite.Ctx.BlockTime(), 1)
+			suite.distributeGauge(gaugeID)
+
+			// the rewards are held for compounding, even when the lock changes before the end of the epoch
+			suite.requireClaimable(owner, sdk.Coins{})
+			addedShares := sdk.NewCoin(shareDenom, gammtypes.OneShare)
+			suite.FundAcc(owner, sdk.NewCoins(addedShares))
+			_, err = suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, locks[0].ID, owner, addedShares)
+			suite.Require().NoError(err)
+			suite.requireClaimable(owner, sdk.Coins{})
+			checkpoint, found := suite.App.IncentivesKeeper.GetLockCheckpoint(suite.Ctx, locks[0].ID)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewDecCoinsFromCoins(rewards...), checkpoint.CompoundRewards)
+
+			if !tc.swapIn.IsNil() {
+				swapper := suite.TestAccs[2]
+				suite.FundAcc(swapper, sdk.NewCoins(tc.swapIn))
+				_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId, tc.swapIn, "bar", sdk.OneInt())
+				suite.Require().NoError(err)
+			}
+
+			// the held rewards are compounded at the end of the epoch, without the owner claiming them
+			params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+			epoch := suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).CurrentEpoch
+			suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, params.DistrEpochIdentifier, epoch)
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, locks[0].ID)
+			suite.Require().NoError(err)
+			lockedShares := gammtypes.OneShare.MulRaw(11)
+			if !tc.compounded {
+				suite.requireClaimable(owner, rewards)
+				suite.Require().Equal(lockedShares, lock.Coins.AmountOf(shareDenom))
+				return
+			}
+			suite.requireClaimable(owner, sdk.Coins{sdk.NewInt64Coin("stake", 10000)})
+			suite.Require().True(lock.Coins.AmountOf(shareDenom).GT(lockedShares))
+
+			// the compounded shares accrue rewards from the next distribution on
+			checkpoint, found = suite.App.IncentivesKeeper.GetLockCheckpoint(suite.Ctx, lock.ID)
+			suite.Require().True(found)
+			suite.Require().Equal(lock.Coins.AmountOf(shareDenom), checkpoint.Stakes[0].Amount)
+			suite.Require().True(checkpoint.CompoundRewards.IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundStopped() {
+	suite.SetupTest()
+
+	owner := sdk.AccAddress([]byte("addr1---------------"))
+	suite.LockTokens(owner, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}, time.Second)
+	lock := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, owner)[0]
+	err := suite.App.LockupKeeper.SetLockAutoCompound(suite.Ctx, lock, true)
+	suite.Require().NoError(err)
+
+	gaugeID, _, coins, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, time.Second)
+	suite.distributeGauge(gaugeID)
+	suite.requireClaimable(owner, sdk.Coins{})
+
+	// the held rewards are accrued by the owner once the lock starts unlocking
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+	suite.Require().NoError(err)
+	suite.requireClaimable(owner, coins)
+	checkpoint, found := suite.App.IncentivesKeeper.GetLockCheckpoint(suite.Ctx, lock.ID)
+	suite.Require().True(found)
+	suite.Require().False(checkpoint.AutoCompound)
+}
+
+// advanceDistrEpoch moves the distribution epoch on by one, without running the epoch hooks.
+func (suite *KeeperTestSuite) advanceDistrEpoch() int64 {
+	epochInfo := suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx)
+	epochInfo.CurrentEpoch++
+	suite.App.EpochsKeeper.SetEpochInfo(suite.Ctx, epochInfo)
+	return epochInfo.CurrentEpoch
+}
+
+func (suite *KeeperTestSuite) TestLockRewards() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)}, time.Second)
+	suite.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 30)}, 2*time.Second)
+	lockID1 := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1)[0].ID
+	lockID2 := suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr2)[0].ID
+
+	gaugeID, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, time.Second)
+	longGaugeID, _, _, _ := suite.setupNewGaugeWithDuration(true, sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}, 2*time.Second)
+	firstEpoch := suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).CurrentEpoch
+	suite.distributeGauge(gaugeID)
+	suite.distributeGauge(longGaugeID)
+
+	// the lock gets a bigger share of the next epoch's distribution
+	secondEpoch := suite.advanceDistrEpoch()
+	suite.FundAcc(addr1, sdk.Coins{sdk.NewInt64Coin(defaultLPDenom, 10)})
+	_, err := suite.App.LockupKeeper.AddTokensToLockByID(suite.Ctx, lockID1, addr1, sdk.NewInt64Coin(defaultLPDenom, 10))
+	suite.Require().NoError(err)
+	suite.AddToGauge(sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 4000)}, gaugeID)
+	suite.distributeGauge(gaugeID)
+
+	rewards, total := suite.App.IncentivesKeeper.GetLockRewards(suite.Ctx, lockID1, firstEpoch, secondEpoch)
+	suite.Require().Equal([]types.LockEpochReward{
+		{Epoch: firstEpoch, GaugeId: gaugeID, Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(defaultRewardDenom, 1000))},
+		{Epoch: secondEpoch, GaugeId: gaugeID, Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(defaultRewardDenom, 1600))},
+	}, rewards)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(defaultRewardDenom, 2600)), total)
+
+	// the longer lock gets the rewards of both gauges
+	res, err := suite.querier.LockRewards(sdk.WrapSDKContext(suite.Ctx), &types.LockRewardsRequest{LockId: lockID2, FromEpoch: firstEpoch, ToEpoch: firstEpoch})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.LockEpochReward{
+		{Epoch: firstEpoch, GaugeId: gaugeID, Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(defaultRewardDenom, 3000))},
+		{Epoch: firstEpoch, GaugeId: longGaugeID, Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(defaultRewardDenom, 3000))},
+	}, res.Rewards)
+
+	_, err = suite.querier.LockRewards(sdk.WrapSDKContext(suite.Ctx), &types.LockRewardsRequest{LockId: lockID2, FromEpoch: secondEpoch, ToEpoch: firstEpoch})
+	suite.Require().Error(err)
+
+	// the distributions that fall out of the reward history are forgotten
+	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
+	params.RewardHistoryEpochs = 1
+	suite.App.IncentivesKeeper.SetParams(suite.Ctx, params)
+	suite.App.IncentivesKeeper.AfterEpochEnd(suite.Ctx, params.DistrEpochIdentifier, secondEpoch)
+	rewards, _ = suite.App.IncentivesKeeper.GetLockRewards(suite.Ctx, lockID1, firstEpoch, secondEpoch)
+	suite.Require().Equal([]types.LockEpochReward{
+		{Epoch: secondEpoch, GaugeId: gaugeID, Rewards: sdk.NewDecCoins(sdk.NewInt64DecCoin(defaultRewardDenom, 1600))},
+	}, rewards)
+}
+
+func (suite *KeeperTestSuite) TestPoolAPR() {
+	suite.SetupTest()
+
+	// the pool is worth 2_000_000uosmo, and foo is worth as much OSMO
+	poolID := suite.PrepareBalancerPoolWithPoolAsset([]balancer.PoolAsset{
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("foo", 1_000_000)},
+		{Weight: sdk.NewInt(1), Token: sdk.NewInt64Coin("uosmo", 1_000_000)},
+	})
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.OsmoValueTwapWindow))
+	shareDenom := gammtypes.GetPoolShareDenom(poolID)
+	shares := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[0], shareDenom)
+	_, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, suite.TestAccs[0], sdk.Coins{shares}, 2*time.Second)
+	suite.Require().NoError(err)
+
+	distrTo := lockuptypes.QueryCondition{LockQueryType: lockuptypes.ByDuration, Denom: shareDenom, Duration: time.Second}
+	gaugeID, _ := suite.CreateGauge(true, defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin("foo", 500), sdk.NewInt64Coin("uosmo", 500)}, distrTo, suite.Ctx.BlockTime(), 1)
+	distrTo.Duration = 2 * time.Second
+	longGaugeID, _ := suite.CreateGauge(true, defaultGaugeOwner, sdk.Coins{sdk.NewInt64Coin("uosmo", 1000)}, distrTo, suite.Ctx.BlockTime(), 1)
+	suite.distributeGauge(gaugeID)
+	suite.distributeGauge(longGaugeID)
+	suite.advanceDistrEpoch()
+
+	// 1000uosmo worth of rewards per epoch are 0.05% of the pool value
+	epochsPerYear := sdk.NewDec(int64(365 * 24 * time.Hour)).QuoInt64(int64(suite.App.IncentivesKeeper.GetEpochInfo(suite.Ctx).Duration))
+	apr, _, err := suite.App.IncentivesKeeper.GetPoolAPR(suite.Ctx, poolID, time.Second, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 4).Mul(epochsPerYear).String(), apr.String())
+
+	// locks of the longer duration also get the rewards of the shorter one's gauges
+	res, err := suite.querier.PoolAPR(sdk.WrapSDKContext(suite.Ctx), &types.PoolAPRRequest{PoolId: poolID, Duration: 2 * time.Second, NumEpochs: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 4).Mul(epochsPerYear).String(), res.Apr.String())
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("foo", 5), sdk.NewInt64DecCoin("uosmo", 15)), res.RewardPerShare)
+
+	_, err = suite.querier.PoolAPR(sdk.WrapSDKContext(suite.Ctx), &types.PoolAPRRequest{PoolId: poolID, Duration: time.Second})
+	suite.Require().Error(err)
+}
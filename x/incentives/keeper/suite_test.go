@@ -20,7 +20,8 @@ var (
 		lockDurations: []time.Duration{time.Second},
 		lockAmounts:   []sdk.Coins{defaultLPTokens},
 	}
-	defaultRewardDenom string = "rewardDenom"
+	defaultRewardDenom string         = "rewardDenom"
+	defaultGaugeOwner  sdk.AccAddress = sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
 )
 
 // TODO: Switch more code to use userLocks and perpGaugeDesc
@@ -104,7 +105,7 @@ func (suite *KeeperTestSuite) LockTokens(addr sdk.AccAddress, coins sdk.Coins, d
 func (suite *KeeperTestSuite) setupNewGaugeWithDuration(isPerpetual bool, coins sdk.Coins, duration time.Duration) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	addr := defaultGaugeOwner
 	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
@@ -133,12 +134,12 @@ func (suite *KeeperTestSuite) SetupManyLocks(numLocks int, liquidBalance sdk.Coi
 	lockDuration time.Duration,
 ) []sdk.AccAddress {
 	addrs := make([]sdk.AccAddress, 0, numLocks)
-	randPrefix := make([]byte, 8)
-	_, _ = rand.Read(randPrefix)
+	// hex, so that the prefix is 8 bytes long
+	randPrefix := fmt.Sprintf("%08x", rand.Uint32())
 
 	bal := liquidBalance.Add(coinsPerLock...)
 	for i := 0; i < numLocks; i++ {
-		addr := suite.setupAddr(i, string(randPrefix), bal)
+		addr := suite.setupAddr(i, randPrefix, bal)
 		_, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr, coinsPerLock, lockDuration)
 		suite.Require().NoError(err)
 		addrs = append(addrs, addr)
@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/osmosis-labs/osmosis/v7/x/incentives/types"
@@ -28,9 +29,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 	)
 
 	incentivesGenesis := types.GenesisState{
-		Params: types.Params{
-			DistrEpochIdentifier: distrEpochIdentifier,
-		},
+		// gauge creations are unlimited, as simulated accounts may not afford fees and limits
+		Params: types.NewParams(distrEpochIdentifier, sdk.Coins{}, sdk.ZeroInt(), 0, 365),
 		// Gauges: gauges,
 		LockableDurations: []time.Duration{
 			time.Second,
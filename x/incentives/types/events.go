@@ -2,12 +2,17 @@ package types
 
 // event types.
 const (
-	TypeEvtCreateGauge  = "create_gauge"
-	TypeEvtAddToGauge   = "add_to_gauge"
-	TypeEvtDistribution = "distribution"
+	TypeEvtCreateGauge     = "create_gauge"
+	TypeEvtAddToGauge      = "add_to_gauge"
+	TypeEvtDistribution    = "distribution"
+	TypeEvtClaimRewards    = "claim_rewards"
+	TypeEvtCompoundRewards = "compound_rewards"
+	TypeEvtCancelGauge     = "cancel_gauge"
 
 	AttributeGaugeID     = "gauge_id"
 	AttributeLockedDenom = "denom"
 	AttributeReceiver    = "receiver"
 	AttributeAmount      = "amount"
+	AttributeLockID      = "lock_id"
+	AttributeShares      = "shares"
 )
@@ -4,7 +4,9 @@ import (
 	time "time"
 
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+	txfeestypes "github.com/osmosis-labs/osmosis/v7/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,20 +18,44 @@ type BankKeeper interface {
 	HasSupply(ctx sdk.Context, denom string) bool
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
-	SendCoinsFromModuleToManyAccounts(
-		ctx sdk.Context, senderModule string, recipientAddrs []sdk.AccAddress, amts []sdk.Coins,
-	) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
 
 // LockupKeeper defines the expected interface needed to retrieve locks.
 type LockupKeeper interface {
 	GetSyntheticLockup(ctx sdk.Context, lockID uint64, suffix string) (*lockuptypes.SyntheticLock, error)
+	GetAllSyntheticLockupsByLockup(ctx sdk.Context, lockID uint64) []lockuptypes.SyntheticLock
 	GetLocksPastTimeDenom(ctx sdk.Context, denom string, timestamp time.Time) []lockuptypes.PeriodLock
 	GetLocksLongerThanDurationDenom(ctx sdk.Context, denom string, duration time.Duration) []lockuptypes.PeriodLock
 	GetPeriodLocksAccumulation(ctx sdk.Context, query lockuptypes.QueryCondition) sdk.Int
 	GetAccountPeriodLocks(ctx sdk.Context, addr sdk.AccAddress) []lockuptypes.PeriodLock
 	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
+	GetPeriodLocks(ctx sdk.Context) ([]lockuptypes.PeriodLock, error)
+
+	AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, coin sdk.Coin) (*lockuptypes.PeriodLock, error)
+}
+
+// GAMMKeeper defines the expected interface needed to compound rewards into pools, and value pool shares.
+type GAMMKeeper interface {
+	JoinSwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutMinAmount sdk.Int) (sdk.Int, error)
+	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (sdk.Dec, error)
+	BestSwapExactAmountInRoute(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, maxHops int) (routes []gammtypes.SwapAmountInRoute, tokenOutAmount sdk.Int, priceImpact sdk.Dec, err error)
+}
+
+// TwapKeeper defines the expected interface needed to price rewards and pool shares.
+type TwapKeeper interface {
+	GetArithmeticTwap(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time, endTime time.Time) (sdk.Dec, error)
+}
+
+// TxFeesKeeper defines the expected interface needed to find the pools pricing fee tokens in OSMO.
+type TxFeesKeeper interface {
+	GetFeeToken(ctx sdk.Context, denom string) (txfeestypes.FeeToken, error)
+}
+
+// DistrKeeper defines the expected interface needed to fund the community pool with gauge creation fees.
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 type EpochKeeper interface {
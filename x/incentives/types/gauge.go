@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	time "time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
@@ -35,3 +36,53 @@ func (gauge Gauge) IsActiveGauge(curTime time.Time) bool {
 func (gauge Gauge) IsFinishedGauge(curTime time.Time) bool {
 	return !gauge.IsUpcomingGauge(curTime) && !gauge.IsActiveGauge(curTime)
 }
+
+// Denoms returns the denoms of the locks the gauge distributes to.
+func (gauge Gauge) Denoms() []string {
+	if len(gauge.DistributeToDenoms) == 0 {
+		return []string{gauge.DistributeTo.Denom}
+	}
+	denoms := make([]string, 0, len(gauge.DistributeToDenoms))
+	for _, denomWeight := range gauge.DistributeToDenoms {
+		denoms = append(denoms, denomWeight.Denom)
+	}
+	return denoms
+}
+
+// DistributesToDenom returns whether the gauge distributes to locks of denom.
+func (gauge Gauge) DistributesToDenom(denom string) bool {
+	for _, gaugeDenom := range gauge.Denoms() {
+		if gaugeDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDistributeToDenoms validates the denoms of a gauge distributing to several denoms, if any.
+func ValidateDistributeToDenoms(distrTo lockuptypes.QueryCondition, denoms []DenomWeight, weightByOsmoValue bool) error {
+	if len(denoms) == 0 {
+		if weightByOsmoValue {
+			return fmt.Errorf("weighting by OSMO value requires distribute to denoms")
+		}
+		return nil
+	}
+	if distrTo.Denom != "" {
+		return fmt.Errorf("distribute to denom should be empty when distributing to several denoms")
+	}
+
+	seen := map[string]bool{}
+	for _, denomWeight := range denoms {
+		if err := sdk.ValidateDenom(denomWeight.Denom); err != nil {
+			return err
+		}
+		if seen[denomWeight.Denom] {
+			return fmt.Errorf("duplicate distribute to denom: %s", denomWeight.Denom)
+		}
+		seen[denomWeight.Denom] = true
+		if denomWeight.Weight.IsNil() || !denomWeight.Weight.IsPositive() {
+			return fmt.Errorf("weight of denom %s should be positive", denomWeight.Denom)
+		}
+	}
+	return nil
+}
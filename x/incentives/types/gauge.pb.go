@@ -51,6 +51,22 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// already distributed coins
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// Denoms of the locks the gauge distributes to, when it distributes to
+	// several denoms instead of distribute_to's denom, which is then empty.
+	// Locks of each denom get a share of every distribution proportional to the
+	// denom's weight.
+	DistributeToDenoms []DenomWeight `protobuf:"bytes,9,rep,name=distribute_to_denoms,json=distributeToDenoms,proto3" json:"distribute_to_denoms" yaml:"distribute_to_denoms"`
+	// If set, the share of each of distribute_to_denoms is proportional to its
+	// weight times the OSMO value of its locked tokens instead.
+	WeightByOsmoValue bool `protobuf:"varint,10,opt,name=weight_by_osmo_value,json=weightByOsmoValue,proto3" json:"weight_by_osmo_value,omitempty" yaml:"weight_by_osmo_value"`
+	// address of the gauge's creator, who can cancel it and gets the
+	// undistributed coins back. Empty for gauges created before owners were
+	// recorded.
+	Owner string `protobuf:"bytes,11,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// whether the gauge was created by a user with MsgCreateGauge, rather than by
+	// a module. Only those count toward the max active gauges per denom. False
+	// for gauges created before it was recorded.
+	UserCreated bool `protobuf:"varint,12,opt,name=user_created,json=userCreated,proto3" json:"user_created,omitempty" yaml:"user_created"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -142,6 +158,81 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetDistributeToDenoms() []DenomWeight {
+	if m != nil {
+		return m.DistributeToDenoms
+	}
+	return nil
+}
+
+func (m *Gauge) GetWeightByOsmoValue() bool {
+	if m != nil {
+		return m.WeightByOsmoValue
+	}
+	return false
+}
+
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Gauge) GetUserCreated() bool {
+	if m != nil {
+		return m.UserCreated
+	}
+	return false
+}
+
+// DenomWeight is a denom of the locks a gauge distributes to, along with its
+// weight in the gauge's distributions.
+type DenomWeight struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *DenomWeight) Reset()         { *m = DenomWeight{} }
+func (m *DenomWeight) String() string { return proto.CompactTextString(m) }
+func (*DenomWeight) ProtoMessage()    {}
+func (*DenomWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{1}
+}
+func (m *DenomWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomWeight.Merge(m, src)
+}
+func (m *DenomWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomWeight proto.InternalMessageInfo
+
+func (m *DenomWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type LockableDurationsInfo struct {
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
 }
@@ -150,7 +241,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0304e2bb0159901, []int{1}
+	return fileDescriptor_c0304e2bb0159901, []int{2}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,48 +279,60 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 
 func init() {
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
+	proto.RegisterType((*DenomWeight)(nil), "osmosis.incentives.DenomWeight")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6a, 0xdb, 0x30,
-	0x1c, 0xc7, 0xe3, 0x34, 0xe9, 0x3a, 0x25, 0x1d, 0x8b, 0xe8, 0xc0, 0x09, 0xcc, 0xc9, 0x32, 0x06,
-	0xb9, 0x54, 0x5a, 0x3b, 0xc6, 0x60, 0xc7, 0x74, 0x63, 0x14, 0x06, 0xed, 0x42, 0x0f, 0x63, 0x17,
-	0x23, 0xdb, 0x8a, 0x2b, 0x62, 0xfb, 0x67, 0x2c, 0x39, 0x34, 0x6f, 0xb0, 0x63, 0xd9, 0x69, 0xcf,
-	0xb0, 0x27, 0xe9, 0xb1, 0xc7, 0x9d, 0xda, 0x91, 0xbc, 0xc1, 0x9e, 0x60, 0x58, 0xb2, 0x49, 0xc8,
-	0xae, 0x3d, 0xc9, 0xfa, 0x7d, 0x7f, 0xff, 0xbe, 0x1f, 0x64, 0xe4, 0x80, 0x8c, 0x41, 0x0a, 0x49,
-	0x45, 0xe2, 0xf3, 0x44, 0x89, 0x39, 0x97, 0x34, 0x64, 0x79, 0xc8, 0x49, 0x9a, 0x81, 0x02, 0x8c,
-	0x4b, 0x9d, 0xac, 0xf5, 0xde, 0x41, 0x08, 0x21, 0x68, 0x99, 0x16, 0x5f, 0x26, 0xb3, 0xe7, 0x84,
-	0x00, 0x61, 0xc4, 0xa9, 0xbe, 0x79, 0xf9, 0x94, 0x06, 0x79, 0xc6, 0x94, 0x80, 0xa4, 0xd4, 0xfb,
-	0xdb, 0xba, 0x12, 0x31, 0x97, 0x8a, 0xc5, 0x69, 0xd5, 0xc0, 0xd7, 0xb3, 0xa8, 0xc7, 0x24, 0xa7,
-	0xf3, 0x23, 0x8f, 0x2b, 0x76, 0x44, 0x7d, 0x10, 0x55, 0x83, 0x6e, 0xb5, 0x6a, 0x04, 0xfe, 0x2c,
-	0x4f, 0xf5, 0x61, 0xa4, 0xe1, 0x8f, 0x06, 0x6a, 0x7e, 0x2a, 0xb6, 0xc6, 0x4f, 0x50, 0x5d, 0x04,
-	0xb6, 0x35, 0xb0, 0x46, 0x8d, 0x49, 0x5d, 0x04, 0xf8, 0x05, 0x6a, 0x0b, 0xe9, 0xa6, 0x3c, 0x4b,
-	0xb9, 0xca, 0x59, 0x64, 0xd7, 0x07, 0xd6, 0x68, 0x6f, 0xd2, 0x12, 0xf2, 0xbc, 0x0a, 0xe1, 0x53,
-	0xb4, 0x1f, 0x08, 0xa9, 0x32, 0xe1, 0xe5, 0x8a, 0xbb, 0x0a, 0xec, 0x9d, 0x81, 0x35, 0x6a, 0x1d,
-	0x3b, 0xa4, 0xb2, 0x6e, 0xe6, 0x91, 0x2f, 0x39, 0xcf, 0x16, 0x27, 0x90, 0x04, 0xa2, 0x70, 0x35,
-	0x6e, 0xdc, 0xdc, 0xf5, 0x6b, 0x93, 0xf6, 0xba, 0xf4, 0x02, 0x30, 0x43, 0xcd, 0x62, 0x61, 0x69,
-	0x37, 0x06, 0x3b, 0xa3, 0xd6, 0x71, 0x97, 0x18, 0x4b, 0xa4, 0xb0, 0x44, 0x4a, 0x4b, 0xe4, 0x04,
-	0x44, 0x32, 0x7e, 0x5d, 0x54, 0xff, 0xba, 0xef, 0x8f, 0x42, 0xa1, 0x2e, 0x73, 0x8f, 0xf8, 0x10,
-	0xd3, 0xd2, 0xbf, 0x39, 0x0e, 0x65, 0x30, 0xa3, 0x6a, 0x91, 0x72, 0xa9, 0x0b, 0xe4, 0xc4, 0x74,
-	0xc6, 0x5f, 0x11, 0x92, 0x8a, 0x65, 0xca, 0x2d, 0xf0, 0xd9, 0x4d, 0xbd, 0x6a, 0x8f, 0x18, 0xb6,
-	0xa4, 0x62, 0x4b, 0x2e, 0x2a, 0xb6, 0xe3, 0xe7, 0xc5, 0xa0, 0xbf, 0x77, 0xfd, 0xce, 0x82, 0xc5,
-	0xd1, 0xfb, 0xe1, 0xba, 0x76, 0x78, 0x7d, 0xdf, 0xb7, 0x26, 0x8f, 0x75, 0xa0, 0x48, 0xc7, 0x14,
-	0x1d, 0x24, 0x79, 0xec, 0xf2, 0x14, 0xfc, 0x4b, 0xe9, 0xa6, 0x4c, 0x04, 0x2e, 0xcc, 0x79, 0x66,
-	0xef, 0x6a, 0x98, 0x9d, 0x24, 0x8f, 0x3f, 0x6a, 0xe9, 0x9c, 0x89, 0xe0, 0x6c, 0xce, 0x33, 0xfc,
-	0x12, 0xed, 0x4f, 0x45, 0x14, 0xf1, 0xa0, 0xac, 0xb1, 0x1f, 0xe9, 0xcc, 0xb6, 0x09, 0x9a, 0x64,
-	0x7c, 0x85, 0x3a, 0x6b, 0x44, 0x81, 0x6b, 0xf0, 0xec, 0x3d, 0x3c, 0x9e, 0xa7, 0x1b, 0x53, 0x74,
-	0x64, 0xf8, 0xdd, 0x42, 0xcf, 0x3e, 0x83, 0x3f, 0x63, 0x5e, 0xc4, 0x3f, 0x94, 0x6f, 0x51, 0x9e,
-	0x26, 0x53, 0xc0, 0x80, 0x70, 0x54, 0x0a, 0x6e, 0xf5, 0x4a, 0xa5, 0x6d, 0x95, 0x4b, 0x6d, 0xb3,
-	0xac, 0x6a, 0xc7, 0xaf, 0x4a, 0x94, 0x5d, 0x83, 0xf2, 0xff, 0x16, 0xc3, 0x9f, 0x05, 0xd2, 0x4e,
-	0xb4, 0x3d, 0x74, 0x7c, 0x76, 0xb3, 0x74, 0xac, 0xdb, 0xa5, 0x63, 0xfd, 0x59, 0x3a, 0xd6, 0xf5,
-	0xca, 0xa9, 0xdd, 0xae, 0x9c, 0xda, 0xef, 0x95, 0x53, 0xfb, 0xf6, 0x76, 0xc3, 0x60, 0xf9, 0xde,
-	0x0e, 0x23, 0xe6, 0xc9, 0xea, 0x42, 0xe7, 0xef, 0xe8, 0xd5, 0xe6, 0xcf, 0xa9, 0x3d, 0x7b, 0xbb,
-	0x7a, 0xbb, 0x37, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xeb, 0x47, 0xca, 0xbf, 0x03, 0x00,
-	0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4e, 0xdb, 0x48,
+	0x18, 0x8f, 0x81, 0x64, 0xc9, 0x24, 0xac, 0xc8, 0x6c, 0x56, 0x6b, 0x58, 0xad, 0x9d, 0x35, 0x5a,
+	0x94, 0x0b, 0xf6, 0x42, 0x55, 0x55, 0xe2, 0x68, 0x68, 0x2b, 0xa4, 0x4a, 0x50, 0x0b, 0xb5, 0x55,
+	0x2f, 0xd6, 0xd8, 0x1e, 0xcc, 0x28, 0xb6, 0xc7, 0xf2, 0x8c, 0x03, 0x79, 0x83, 0x1e, 0x39, 0xf6,
+	0x19, 0xfa, 0x14, 0x3d, 0x72, 0xe4, 0x58, 0xf5, 0x10, 0x2a, 0x78, 0x03, 0x9e, 0xa0, 0x9a, 0x19,
+	0x5b, 0x49, 0x81, 0x43, 0x0f, 0x3d, 0xd9, 0xdf, 0xf7, 0xfb, 0xfe, 0xfd, 0x7e, 0xf3, 0xcd, 0x00,
+	0x83, 0xb2, 0x94, 0x32, 0xc2, 0x1c, 0x92, 0x85, 0x38, 0xe3, 0x64, 0x8c, 0x99, 0x13, 0xa3, 0x32,
+	0xc6, 0x76, 0x5e, 0x50, 0x4e, 0x21, 0xac, 0x70, 0x7b, 0x86, 0xaf, 0xf7, 0x63, 0x1a, 0x53, 0x09,
+	0x3b, 0xe2, 0x4f, 0x45, 0xae, 0x1b, 0x31, 0xa5, 0x71, 0x82, 0x1d, 0x69, 0x05, 0xe5, 0x89, 0x13,
+	0x95, 0x05, 0xe2, 0x84, 0x66, 0x15, 0x6e, 0xde, 0xc7, 0x39, 0x49, 0x31, 0xe3, 0x28, 0xcd, 0xeb,
+	0x02, 0xa1, 0xec, 0xe5, 0x04, 0x88, 0x61, 0x67, 0xbc, 0x1d, 0x60, 0x8e, 0xb6, 0x9d, 0x90, 0x92,
+	0xba, 0xc0, 0x5a, 0x3d, 0x6a, 0x42, 0xc3, 0x51, 0x99, 0xcb, 0x8f, 0x82, 0xac, 0xcf, 0x2d, 0xd0,
+	0x7c, 0x29, 0xa6, 0x86, 0xbf, 0x83, 0x05, 0x12, 0xe9, 0xda, 0x40, 0x1b, 0x2e, 0x79, 0x0b, 0x24,
+	0x82, 0xff, 0x82, 0x2e, 0x61, 0x7e, 0x8e, 0x8b, 0x1c, 0xf3, 0x12, 0x25, 0xfa, 0xc2, 0x40, 0x1b,
+	0x2e, 0x7b, 0x1d, 0xc2, 0x8e, 0x6a, 0x17, 0x3c, 0x00, 0x2b, 0x11, 0x61, 0xbc, 0x20, 0x41, 0xc9,
+	0xb1, 0xcf, 0xa9, 0xbe, 0x38, 0xd0, 0x86, 0x9d, 0x1d, 0xc3, 0xae, 0xa9, 0xab, 0x7e, 0xf6, 0xeb,
+	0x12, 0x17, 0x93, 0x3d, 0x9a, 0x45, 0x44, 0xb0, 0x72, 0x97, 0x2e, 0xa7, 0x66, 0xc3, 0xeb, 0xce,
+	0x52, 0x8f, 0x29, 0x44, 0xa0, 0x29, 0x06, 0x66, 0xfa, 0xd2, 0x60, 0x71, 0xd8, 0xd9, 0x59, 0xb3,
+	0x15, 0x25, 0x5b, 0x50, 0xb2, 0x2b, 0x4a, 0xf6, 0x1e, 0x25, 0x99, 0xfb, 0xbf, 0xc8, 0xfe, 0x74,
+	0x6d, 0x0e, 0x63, 0xc2, 0x4f, 0xcb, 0xc0, 0x0e, 0x69, 0xea, 0x54, 0xfc, 0xd5, 0x67, 0x8b, 0x45,
+	0x23, 0x87, 0x4f, 0x72, 0xcc, 0x64, 0x02, 0xf3, 0x54, 0x65, 0xf8, 0x0e, 0x00, 0xc6, 0x51, 0xc1,
+	0x7d, 0x21, 0x9f, 0xde, 0x94, 0xa3, 0xae, 0xdb, 0x4a, 0x5b, 0xbb, 0xd6, 0xd6, 0x3e, 0xae, 0xb5,
+	0x75, 0xff, 0x11, 0x8d, 0xee, 0xa6, 0x66, 0x6f, 0x82, 0xd2, 0x64, 0xd7, 0x9a, 0xe5, 0x5a, 0x17,
+	0xd7, 0xa6, 0xe6, 0xb5, 0xa5, 0x43, 0x84, 0x43, 0x07, 0xf4, 0xb3, 0x32, 0xf5, 0x71, 0x4e, 0xc3,
+	0x53, 0xe6, 0xe7, 0x88, 0x44, 0x3e, 0x1d, 0xe3, 0x42, 0x6f, 0x49, 0x31, 0x7b, 0x59, 0x99, 0x3e,
+	0x97, 0xd0, 0x11, 0x22, 0xd1, 0xe1, 0x18, 0x17, 0x70, 0x03, 0xac, 0x9c, 0x90, 0x24, 0xc1, 0x51,
+	0x95, 0xa3, 0xff, 0x26, 0x23, 0xbb, 0xca, 0xa9, 0x82, 0xe1, 0x39, 0xe8, 0xcd, 0x24, 0x8a, 0x7c,
+	0x25, 0xcf, 0xf2, 0xaf, 0x97, 0x67, 0x75, 0xae, 0x8b, 0xf4, 0xc0, 0x31, 0xe8, 0xff, 0x70, 0xae,
+	0x7e, 0x84, 0x33, 0x9a, 0x32, 0xbd, 0x2d, 0x9b, 0x9b, 0xf6, 0xc3, 0xcd, 0xb6, 0xf7, 0x45, 0xc4,
+	0x5b, 0x4c, 0xe2, 0x53, 0xee, 0x6e, 0x54, 0xc2, 0xfd, 0xad, 0x84, 0x7b, 0xac, 0x94, 0xe5, 0xc1,
+	0xf9, 0xe3, 0x97, 0xd9, 0x0c, 0x1e, 0x81, 0xfe, 0x99, 0x2c, 0xe1, 0x07, 0x13, 0x5f, 0x34, 0xf1,
+	0xc7, 0x28, 0x29, 0xb1, 0x0e, 0xc4, 0xea, 0xb9, 0xe6, 0xac, 0xe4, 0x63, 0x51, 0x96, 0xd7, 0x53,
+	0x6e, 0x77, 0x72, 0xc8, 0x52, 0xfa, 0x46, 0xf8, 0xe0, 0x26, 0x68, 0xd2, 0xb3, 0x0c, 0x17, 0x7a,
+	0x67, 0xa0, 0x0d, 0xdb, 0xee, 0xea, 0xdd, 0xd4, 0xec, 0xaa, 0x12, 0xd2, 0x6d, 0x79, 0x0a, 0x86,
+	0xbb, 0xa0, 0x5b, 0x32, 0x5c, 0xf8, 0x61, 0x81, 0x11, 0xc7, 0x91, 0xde, 0x95, 0x1d, 0xff, 0xba,
+	0x9b, 0x9a, 0x7f, 0xa8, 0xf0, 0x79, 0xd4, 0xf2, 0x3a, 0xc2, 0xdc, 0xab, 0xac, 0x11, 0xe8, 0xcc,
+	0xb1, 0x87, 0x7d, 0xd0, 0x94, 0x1c, 0xe5, 0x55, 0x6a, 0x7b, 0xca, 0x80, 0x2f, 0x40, 0x4b, 0x4d,
+	0x27, 0xef, 0x51, 0xdb, 0xb5, 0x85, 0x46, 0x5f, 0xa7, 0xe6, 0xe6, 0x4f, 0x1c, 0xd3, 0x3e, 0x0e,
+	0xbd, 0x2a, 0xdb, 0xfa, 0xa0, 0x81, 0x3f, 0x5f, 0xd1, 0x70, 0x84, 0x82, 0x04, 0xef, 0x57, 0xcf,
+	0x04, 0x3b, 0xc8, 0x4e, 0x28, 0xa4, 0x00, 0x26, 0x15, 0xe0, 0xd7, 0x0f, 0x08, 0xd3, 0xb5, 0x6a,
+	0x5f, 0xee, 0xaf, 0x79, 0x9d, 0xeb, 0xfe, 0x57, 0x1d, 0xd6, 0x9a, 0xe2, 0xf9, 0xb0, 0x84, 0xf5,
+	0x51, 0x6c, 0x7b, 0x2f, 0xb9, 0xdf, 0xd4, 0x3d, 0xbc, 0xbc, 0x31, 0xb4, 0xab, 0x1b, 0x43, 0xfb,
+	0x76, 0x63, 0x68, 0x17, 0xb7, 0x46, 0xe3, 0xea, 0xd6, 0x68, 0x7c, 0xb9, 0x35, 0x1a, 0xef, 0x9f,
+	0xce, 0x91, 0xaa, 0x76, 0x65, 0x2b, 0x41, 0x01, 0xab, 0x0d, 0x67, 0xfc, 0xcc, 0x39, 0x9f, 0x7f,
+	0x37, 0x25, 0xcf, 0xa0, 0x25, 0xa7, 0x7b, 0xf2, 0x7d, 0x00, 0x65, 0x08, 0x1c, 0xa8, 0x5a, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -252,6 +355,47 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UserCreated {
+		i--
+		if m.UserCreated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.WeightByOsmoValue {
+		i--
+		if m.WeightByOsmoValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DistributeToDenoms) > 0 {
+		for iNdEx := len(m.DistributeToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributeToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -326,6 +470,46 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -406,6 +590,37 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	if len(m.DistributeToDenoms) > 0 {
+		for _, e := range m.DistributeToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	if m.WeightByOsmoValue {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.UserCreated {
+		n += 2
+	}
+	return n
+}
+
+func (m *DenomWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGauge(uint64(l))
 	return n
 }
 
@@ -670,6 +885,228 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeToDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributeToDenoms = append(m.DistributeToDenoms, DenomWeight{})
+			if err := m.DistributeToDenoms[len(m.DistributeToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightByOsmoValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WeightByOsmoValue = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UserCreated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
@@ -30,10 +30,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the incentives module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module
-	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Gauges            []Gauge         `protobuf:"bytes,2,rep,name=gauges,proto3" json:"gauges"`
-	LockableDurations []time.Duration `protobuf:"bytes,3,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
-	LastGaugeId       uint64          `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	Params              Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Gauges              []Gauge              `protobuf:"bytes,2,rep,name=gauges,proto3" json:"gauges"`
+	LockableDurations   []time.Duration      `protobuf:"bytes,3,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
+	LastGaugeId         uint64               `protobuf:"varint,4,opt,name=last_gauge_id,json=lastGaugeId,proto3" json:"last_gauge_id,omitempty"`
+	RewardIndices       []RewardIndex        `protobuf:"bytes,5,rep,name=reward_indices,json=rewardIndices,proto3" json:"reward_indices" yaml:"reward_indices"`
+	LockCheckpoints     []LockCheckpoint     `protobuf:"bytes,6,rep,name=lock_checkpoints,json=lockCheckpoints,proto3" json:"lock_checkpoints" yaml:"lock_checkpoints"`
+	AccruedRewards      []AccruedRewards     `protobuf:"bytes,7,rep,name=accrued_rewards,json=accruedRewards,proto3" json:"accrued_rewards" yaml:"accrued_rewards"`
+	DistributionRecords []DistributionRecord `protobuf:"bytes,8,rep,name=distribution_records,json=distributionRecords,proto3" json:"distribution_records" yaml:"distribution_records"`
+	LockStakesRecords   []LockStakesRecord   `protobuf:"bytes,9,rep,name=lock_stakes_records,json=lockStakesRecords,proto3" json:"lock_stakes_records" yaml:"lock_stakes_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +102,41 @@ func (m *GenesisState) GetLastGaugeId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRewardIndices() []RewardIndex {
+	if m != nil {
+		return m.RewardIndices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockCheckpoints() []LockCheckpoint {
+	if m != nil {
+		return m.LockCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccruedRewards() []AccruedRewards {
+	if m != nil {
+		return m.AccruedRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionRecords() []DistributionRecord {
+	if m != nil {
+		return m.DistributionRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockStakesRecords() []LockStakesRecord {
+	if m != nil {
+		return m.LockStakesRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.incentives.GenesisState")
 }
@@ -104,29 +144,42 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/genesis.proto", fileDescriptor_a288ccc95d977d2d) }
 
 var fileDescriptor_a288ccc95d977d2d = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0x93, 0xb6, 0xea, 0x90, 0xc2, 0x80, 0xc5, 0x90, 0x76, 0x70, 0xa2, 0x48, 0x48, 0x5d,
-	0xb0, 0xa5, 0x22, 0x54, 0xc4, 0x58, 0x21, 0x55, 0x4c, 0xa0, 0xb2, 0xb1, 0x54, 0x4e, 0x6b, 0x8c,
-	0x45, 0x12, 0x57, 0x3d, 0xa7, 0xa2, 0x6f, 0xc1, 0x84, 0x78, 0xa4, 0x8e, 0x1d, 0x99, 0x0a, 0x6a,
-	0xdf, 0x80, 0x27, 0x40, 0x71, 0x62, 0x81, 0xd4, 0x6e, 0x3e, 0xff, 0xdf, 0xfd, 0xf7, 0xdf, 0x79,
-	0xa1, 0x82, 0x54, 0x81, 0x04, 0x2a, 0xb3, 0x09, 0xcf, 0xb4, 0x5c, 0x70, 0xa0, 0x82, 0x67, 0x1c,
-	0x24, 0x90, 0xd9, 0x5c, 0x69, 0x85, 0x50, 0x45, 0x90, 0x3f, 0xa2, 0x73, 0x2a, 0x94, 0x50, 0x46,
-	0xa6, 0xc5, 0xab, 0x24, 0x3b, 0x58, 0x28, 0x25, 0x12, 0x4e, 0x4d, 0x15, 0xe7, 0x4f, 0x74, 0x9a,
-	0xcf, 0x99, 0x96, 0x2a, 0xab, 0xf4, 0xe0, 0xc0, 0xac, 0x19, 0x9b, 0xb3, 0x14, 0xac, 0xc1, 0xa1,
-	0x30, 0x2c, 0x17, 0xbc, 0xd4, 0xa3, 0xf7, 0x9a, 0x77, 0x34, 0x2c, 0xc3, 0x3d, 0x68, 0xa6, 0x39,
-	0xba, 0xf2, 0x9a, 0xa5, 0x81, 0xef, 0x86, 0x6e, 0xb7, 0xd5, 0xeb, 0x90, 0xfd, 0xb0, 0xe4, 0xde,
-	0x10, 0x83, 0xc6, 0x6a, 0x13, 0x38, 0xa3, 0x8a, 0x47, 0x7d, 0xaf, 0x69, 0x9c, 0xc1, 0xaf, 0x85,
-	0xf5, 0x6e, 0xab, 0xd7, 0x3e, 0xd4, 0x39, 0x2c, 0x08, 0xdb, 0x58, 0xe2, 0x48, 0x79, 0x28, 0x51,
-	0x93, 0x17, 0x16, 0x27, 0x7c, 0x6c, 0xf7, 0x03, 0xbf, 0x5e, 0x99, 0x94, 0x17, 0x20, 0xf6, 0x02,
-	0xe4, 0xa6, 0x22, 0x06, 0x67, 0x85, 0xc9, 0xcf, 0x26, 0x68, 0x2f, 0x59, 0x9a, 0x5c, 0x47, 0xfb,
-	0x16, 0xd1, 0xc7, 0x57, 0xe0, 0x8e, 0x4e, 0xac, 0x60, 0x1b, 0x01, 0x45, 0xde, 0x71, 0xc2, 0x40,
-	0x8f, 0xcd, 0xfc, 0xb1, 0x9c, 0xfa, 0x8d, 0xd0, 0xed, 0x36, 0x46, 0xad, 0xe2, 0xd3, 0x04, 0xbc,
-	0x9d, 0x0e, 0xee, 0x56, 0x5b, 0xec, 0xae, 0xb7, 0xd8, 0xfd, 0xde, 0x62, 0xf7, 0x6d, 0x87, 0x9d,
-	0xf5, 0x0e, 0x3b, 0x9f, 0x3b, 0xec, 0x3c, 0x5e, 0x0a, 0xa9, 0x9f, 0xf3, 0x98, 0x4c, 0x54, 0x4a,
-	0xab, 0x0d, 0xcf, 0x13, 0x16, 0x83, 0x2d, 0xe8, 0xa2, 0x4f, 0x5f, 0xff, 0xdf, 0x5b, 0x2f, 0x67,
-	0x1c, 0xe2, 0xa6, 0xd9, 0xe0, 0xe2, 0x37, 0x00, 0x00, 0xff, 0xff, 0x51, 0x99, 0x95, 0x16, 0x1f,
-	0x02, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x36, 0x0a, 0x78, 0x6c, 0x03, 0x6f, 0x40, 0x56, 0x44, 0x52, 0x99, 0x3f, 0xea,
+	0x85, 0x44, 0x1a, 0x42, 0x43, 0xdc, 0x08, 0x93, 0xa6, 0x49, 0x48, 0xa0, 0xec, 0xc6, 0x25, 0x72,
+	0x12, 0x93, 0x59, 0x4d, 0xe3, 0x2a, 0xaf, 0x33, 0xba, 0x0b, 0x9f, 0x81, 0x23, 0x1f, 0x69, 0xc7,
+	0x1d, 0x38, 0x70, 0x1a, 0xa8, 0xfd, 0x06, 0x7c, 0x02, 0x14, 0xdb, 0xd9, 0x5a, 0x9a, 0xdd, 0xea,
+	0xbe, 0xcf, 0xfb, 0xfc, 0x1e, 0x3f, 0x72, 0x50, 0x5f, 0xc0, 0x48, 0x00, 0x07, 0x9f, 0x17, 0x09,
+	0x2b, 0x24, 0x3f, 0x61, 0xe0, 0x67, 0xac, 0x60, 0xc0, 0xc1, 0x1b, 0x97, 0x42, 0x0a, 0x8c, 0x8d,
+	0xc2, 0xbb, 0x52, 0xf4, 0xb6, 0x33, 0x91, 0x09, 0x35, 0xf6, 0xeb, 0x5f, 0x5a, 0xd9, 0x73, 0x32,
+	0x21, 0xb2, 0x9c, 0xf9, 0xea, 0x14, 0x57, 0x5f, 0xfc, 0xb4, 0x2a, 0xa9, 0xe4, 0xa2, 0x30, 0x73,
+	0xb7, 0x85, 0x35, 0xa6, 0x25, 0x1d, 0x41, 0x63, 0xd0, 0x16, 0x86, 0x56, 0x19, 0x33, 0xf3, 0xb6,
+	0xb0, 0x25, 0xfb, 0x4a, 0xcb, 0xd4, 0x38, 0x90, 0x9f, 0x5d, 0x74, 0xf7, 0x40, 0xc7, 0x3f, 0x92,
+	0x54, 0x32, 0xfc, 0x06, 0x75, 0x35, 0xc2, 0xb6, 0xfa, 0xd6, 0x60, 0x6d, 0xb7, 0xe7, 0x2d, 0x5f,
+	0xc7, 0xfb, 0xa4, 0x14, 0xc1, 0xea, 0xd9, 0x85, 0xdb, 0x09, 0x8d, 0x1e, 0xef, 0xa1, 0xae, 0x62,
+	0x83, 0x7d, 0xa3, 0xbf, 0x32, 0x58, 0xdb, 0xdd, 0x69, 0xdb, 0x3c, 0xa8, 0x15, 0xcd, 0xa2, 0x96,
+	0x63, 0x81, 0x70, 0x2e, 0x92, 0x21, 0x8d, 0x73, 0x16, 0x35, 0x0d, 0x80, 0xbd, 0x62, 0x4c, 0x74,
+	0x47, 0x5e, 0xd3, 0x91, 0xb7, 0x6f, 0x14, 0xc1, 0xf3, 0xda, 0xe4, 0xef, 0x85, 0xbb, 0x73, 0x4a,
+	0x47, 0xf9, 0x5b, 0xb2, 0x6c, 0x41, 0x7e, 0xfc, 0x76, 0xad, 0xf0, 0x7e, 0x33, 0x68, 0x16, 0x01,
+	0x13, 0xb4, 0x9e, 0x53, 0x90, 0x91, 0xe2, 0x47, 0x3c, 0xb5, 0x57, 0xfb, 0xd6, 0x60, 0x35, 0x5c,
+	0xab, 0xff, 0x54, 0x01, 0x0f, 0x53, 0xcc, 0xd0, 0x86, 0x6e, 0x2a, 0xe2, 0x45, 0xca, 0x13, 0x06,
+	0xf6, 0x4d, 0x15, 0xc8, 0x6d, 0xbb, 0x55, 0xa8, 0x94, 0x87, 0x45, 0xca, 0x26, 0xc1, 0x13, 0x13,
+	0xeb, 0x81, 0x8e, 0xb5, 0x68, 0x42, 0xc2, 0xf5, 0xb2, 0xd1, 0xd6, 0x67, 0x5c, 0xa0, 0x7b, 0x75,
+	0xbe, 0x28, 0x39, 0x66, 0xc9, 0x70, 0x2c, 0x78, 0x21, 0xc1, 0xee, 0x2a, 0x10, 0x69, 0x03, 0x7d,
+	0x10, 0xc9, 0xf0, 0xfd, 0xa5, 0x34, 0x70, 0x0d, 0xeb, 0xd1, 0x55, 0x05, 0xf3, 0x4e, 0x24, 0xdc,
+	0xcc, 0x17, 0x16, 0x00, 0x0f, 0xd1, 0x26, 0x4d, 0x92, 0xb2, 0x62, 0x69, 0x64, 0x1e, 0x82, 0x7d,
+	0xeb, 0x7a, 0xdc, 0x3b, 0x2d, 0xd5, 0xd7, 0x83, 0xc0, 0x31, 0xb8, 0x87, 0x1a, 0xf7, 0x9f, 0x11,
+	0x09, 0x37, 0xe8, 0x82, 0x1e, 0x7f, 0x43, 0xdb, 0x29, 0x07, 0x59, 0xf2, 0xb8, 0xaa, 0x8b, 0x8f,
+	0x4a, 0x96, 0x88, 0x9a, 0x78, 0x5b, 0x11, 0x5f, 0xb4, 0x11, 0xf7, 0xe7, 0xf4, 0xa1, 0x92, 0x07,
+	0x4f, 0x0d, 0xf5, 0xb1, 0xa6, 0xb6, 0x39, 0x92, 0x70, 0x2b, 0x5d, 0x5a, 0x04, 0x3c, 0x41, 0x5b,
+	0xaa, 0x12, 0x90, 0x74, 0xc8, 0xe0, 0x12, 0x7f, 0x47, 0xe1, 0x9f, 0x5d, 0xd7, 0xef, 0x91, 0x52,
+	0x1b, 0x38, 0x31, 0xf0, 0xde, 0x5c, 0xc3, 0x8b, 0x76, 0x44, 0xbf, 0xb0, 0xf9, 0x2d, 0x08, 0x3e,
+	0x9e, 0x4d, 0x1d, 0xeb, 0x7c, 0xea, 0x58, 0x7f, 0xa6, 0x8e, 0xf5, 0x7d, 0xe6, 0x74, 0xce, 0x67,
+	0x4e, 0xe7, 0xd7, 0xcc, 0xe9, 0x7c, 0x7e, 0x9d, 0x71, 0x79, 0x5c, 0xc5, 0x5e, 0x22, 0x46, 0xbe,
+	0x09, 0xf0, 0x32, 0xa7, 0x31, 0x34, 0x07, 0xff, 0x64, 0xcf, 0x9f, 0xcc, 0x7f, 0xaf, 0xf2, 0x74,
+	0xcc, 0x20, 0xee, 0xaa, 0xf7, 0xff, 0xea, 0xdf, 0x00, 0xbe, 0x18, 0xd6, 0xfd, 0x7f, 0x04, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -149,6 +202,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockStakesRecords) > 0 {
+		for iNdEx := len(m.LockStakesRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockStakesRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DistributionRecords) > 0 {
+		for iNdEx := len(m.DistributionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for iNdEx := len(m.AccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.LockCheckpoints) > 0 {
+		for iNdEx := len(m.LockCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RewardIndices) > 0 {
+		for iNdEx := len(m.RewardIndices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.LastGaugeId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastGaugeId))
 		i--
@@ -227,6 +350,36 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastGaugeId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastGaugeId))
 	}
+	if len(m.RewardIndices) > 0 {
+		for _, e := range m.RewardIndices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockCheckpoints) > 0 {
+		for _, e := range m.LockCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for _, e := range m.AccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionRecords) > 0 {
+		for _, e := range m.DistributionRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockStakesRecords) > 0 {
+		for _, e := range m.LockStakesRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -385,6 +538,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardIndices = append(m.RewardIndices, RewardIndex{})
+			if err := m.RewardIndices[len(m.RewardIndices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockCheckpoints = append(m.LockCheckpoints, LockCheckpoint{})
+			if err := m.LockCheckpoints[len(m.LockCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedRewards = append(m.AccruedRewards, AccruedRewards{})
+			if err := m.AccruedRewards[len(m.AccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionRecords = append(m.DistributionRecords, DistributionRecord{})
+			if err := m.DistributionRecords[len(m.DistributionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockStakesRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockStakesRecords = append(m.LockStakesRecords, LockStakesRecord{})
+			if err := m.LockStakesRecords[len(m.LockStakesRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
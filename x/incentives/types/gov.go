@@ -0,0 +1,57 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeCancelGauge = "CancelGauge"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCancelGauge)
+	govtypes.RegisterProposalTypeCodec(&CancelGaugeProposal{}, "osmosis/CancelGaugeProposal")
+}
+
+var _ govtypes.Content = &CancelGaugeProposal{}
+
+func NewCancelGaugeProposal(title, description string, gaugeID uint64) govtypes.Content {
+	return &CancelGaugeProposal{
+		Title:       title,
+		Description: description,
+		GaugeId:     gaugeID,
+	}
+}
+
+func (p *CancelGaugeProposal) GetTitle() string { return p.Title }
+
+func (p *CancelGaugeProposal) GetDescription() string { return p.Description }
+
+func (p *CancelGaugeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *CancelGaugeProposal) ProposalType() string {
+	return ProposalTypeCancelGauge
+}
+
+func (p *CancelGaugeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.GaugeId == 0 {
+		return errors.New("gauge ID should be set")
+	}
+
+	return nil
+}
+
+func (p CancelGaugeProposal) String() string {
+	return fmt.Sprintf(`Cancel Gauge Proposal:
+  Title:       %s
+  Description: %s
+  GaugeId:     %d
+`, p.Title, p.Description, p.GaugeId)
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 var (
 	// ModuleName defines the module name.
 	ModuleName = "incentives"
@@ -43,10 +49,39 @@ var (
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
 
+	// KeyPrefixRewardIndex defines prefix key for storing the reward indices of locked denoms and durations.
+	KeyPrefixRewardIndex = []byte{0x08}
+
+	// KeyPrefixLockCheckpoint defines prefix key for storing the checkpoints of locks.
+	KeyPrefixLockCheckpoint = []byte{0x09}
+
+	// KeyPrefixAccruedRewards defines prefix key for storing the unclaimed rewards of lock owners.
+	KeyPrefixAccruedRewards = []byte{0x0A}
+
+	// KeyPrefixDistributionRecord defines prefix key for storing the records of the rewards distributed by gauges in each epoch.
+	KeyPrefixDistributionRecord = []byte{0x0B}
+
+	// KeyPrefixLockStakesRecord defines prefix key for storing the records of the stakes of locks over epochs.
+	KeyPrefixLockStakesRecord = []byte{0x0C}
+
+	// KeyPrefixAutoCompoundLock defines prefix key for indexing the IDs of auto compounding locks.
+	KeyPrefixAutoCompoundLock = []byte{0x0D}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 )
 
+// OsmoValueTwapWindow is the window of the arithmetic TWAP used to value pool shares in OSMO.
+const OsmoValueTwapWindow = time.Hour
+
+// CompoundTwapWindow is the window of the arithmetic TWAP used as the reference price
+// when compounding rewards into pools.
+const CompoundTwapWindow = time.Hour
+
+// MaxCompoundSlippage is the largest fraction of the value of a reward at the reference price,
+// that compounding it into a pool may lose to swap fees and price impact.
+var MaxCompoundSlippage = sdk.NewDecWithPrec(5, 2)
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -11,8 +11,10 @@ import (
 
 // constants.
 const (
-	TypeMsgCreateGauge = "create_gauge"
-	TypeMsgAddToGauge  = "add_to_gauge"
+	TypeMsgCreateGauge  = "create_gauge"
+	TypeMsgAddToGauge   = "add_to_gauge"
+	TypeMsgClaimRewards = "claim_rewards"
+	TypeMsgCancelGauge  = "cancel_gauge"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -35,9 +37,12 @@ func (m MsgCreateGauge) ValidateBasic() error {
 	if m.Owner == "" {
 		return errors.New("owner should be set")
 	}
-	if sdk.ValidateDenom(m.DistributeTo.Denom) != nil {
+	if len(m.DistributeToDenoms) == 0 && sdk.ValidateDenom(m.DistributeTo.Denom) != nil {
 		return errors.New("denom should be valid for the condition")
 	}
+	if err := ValidateDistributeToDenoms(m.DistributeTo, m.DistributeToDenoms, m.WeightByOsmoValue); err != nil {
+		return err
+	}
 	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] == "" {
 		return errors.New("lock query type is invalid")
 	}
@@ -99,3 +104,60 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a message to claim the rewards of the locks of an owner.
+func NewMsgClaimRewards(owner sdk.AccAddress) *MsgClaimRewards {
+	return &MsgClaimRewards{
+		Owner: owner.String(),
+	}
+}
+
+func (m MsgClaimRewards) Route() string { return RouterKey }
+func (m MsgClaimRewards) Type() string  { return TypeMsgClaimRewards }
+func (m MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errors.New("owner should be a valid address")
+	}
+
+	return nil
+}
+
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgCancelGauge{}
+
+// NewMsgCancelGauge creates a message to cancel a gauge and refund its undistributed coins to its owner.
+func NewMsgCancelGauge(owner sdk.AccAddress, gaugeID uint64) *MsgCancelGauge {
+	return &MsgCancelGauge{
+		Owner:   owner.String(),
+		GaugeId: gaugeID,
+	}
+}
+
+func (m MsgCancelGauge) Route() string { return RouterKey }
+func (m MsgCancelGauge) Type() string  { return TypeMsgCancelGauge }
+func (m MsgCancelGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errors.New("owner should be a valid address")
+	}
+
+	return nil
+}
+
+func (m MsgCancelGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
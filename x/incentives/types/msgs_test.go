@@ -130,6 +130,50 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "valid distribution to several denoms",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.Denom = ""
+				msg.DistributeToDenoms = []DenomWeight{{"lptoken", sdk.NewDec(2)}, {"lptoken2", sdk.OneDec()}}
+				msg.WeightByOsmoValue = true
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "distribution to several denoms and distribution denom",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeToDenoms = []DenomWeight{{"lptoken", sdk.NewDec(2)}, {"lptoken2", sdk.OneDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "duplicate distribution denoms",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.Denom = ""
+				msg.DistributeToDenoms = []DenomWeight{{"lptoken", sdk.NewDec(2)}, {"lptoken", sdk.OneDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid distribution denom weight",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.DistributeTo.Denom = ""
+				msg.DistributeToDenoms = []DenomWeight{{"lptoken", sdk.NewDec(2)}, {"lptoken2", sdk.ZeroDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "weight by OSMO value without distribution denoms",
+			msg: createMsg(func(msg MsgCreateGauge) MsgCreateGauge {
+				msg.WeightByOsmoValue = true
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -203,3 +247,42 @@ func TestMsgAddToGauge(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgClaimRewards(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	msg := *NewMsgClaimRewards(addr1)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "claim_rewards")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Owner = ""
+	require.Error(t, msg.ValidateBasic())
+
+	msg.Owner = "osmo1invalid"
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgCancelGauge(t *testing.T) {
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	msg := *NewMsgCancelGauge(addr1, 1)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "cancel_gauge")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Owner = ""
+	require.Error(t, msg.ValidateBasic())
+}
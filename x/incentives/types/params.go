@@ -1,14 +1,22 @@
 package types
 
 import (
+	"fmt"
+
+	appparams "github.com/osmosis-labs/osmosis/v7/app/params"
 	epochtypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Parameter store keys.
 var (
-	KeyDistrEpochIdentifier = []byte("DistrEpochIdentifier")
+	KeyDistrEpochIdentifier    = []byte("DistrEpochIdentifier")
+	KeyGaugeCreationFee        = []byte("GaugeCreationFee")
+	KeyMinRewardValuePerEpoch  = []byte("MinRewardValuePerEpoch")
+	KeyMaxActiveGaugesPerDenom = []byte("MaxActiveGaugesPerDenom")
+	KeyRewardHistoryEpochs     = []byte("RewardHistoryEpochs")
 )
 
 // ParamTable for minting module.
@@ -16,16 +24,24 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(distrEpochIdentifier string) Params {
+func NewParams(distrEpochIdentifier string, gaugeCreationFee sdk.Coins, minRewardValuePerEpoch sdk.Int, maxActiveGaugesPerDenom, rewardHistoryEpochs uint64) Params {
 	return Params{
-		DistrEpochIdentifier: distrEpochIdentifier,
+		DistrEpochIdentifier:    distrEpochIdentifier,
+		GaugeCreationFee:        gaugeCreationFee,
+		MinRewardValuePerEpoch:  minRewardValuePerEpoch,
+		MaxActiveGaugesPerDenom: maxActiveGaugesPerDenom,
+		RewardHistoryEpochs:     rewardHistoryEpochs,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		DistrEpochIdentifier: "week",
+		DistrEpochIdentifier:    "week",
+		GaugeCreationFee:        sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 50_000_000)}, // 50 OSMO
+		MinRewardValuePerEpoch:  sdk.NewInt(1_000_000),                                           // 1 OSMO
+		MaxActiveGaugesPerDenom: 20,
+		RewardHistoryEpochs:     365,
 	}
 }
 
@@ -34,6 +50,18 @@ func (p Params) Validate() error {
 	if err := epochtypes.ValidateEpochIdentifierInterface(p.DistrEpochIdentifier); err != nil {
 		return err
 	}
+	if err := validateGaugeCreationFee(p.GaugeCreationFee); err != nil {
+		return err
+	}
+	if err := validateMinRewardValuePerEpoch(p.MinRewardValuePerEpoch); err != nil {
+		return err
+	}
+	if err := validateMaxActiveGaugesPerDenom(p.MaxActiveGaugesPerDenom); err != nil {
+		return err
+	}
+	if err := validateRewardHistoryEpochs(p.RewardHistoryEpochs); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -41,5 +69,57 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDistrEpochIdentifier, &p.DistrEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyGaugeCreationFee, &p.GaugeCreationFee, validateGaugeCreationFee),
+		paramtypes.NewParamSetPair(KeyMinRewardValuePerEpoch, &p.MinRewardValuePerEpoch, validateMinRewardValuePerEpoch),
+		paramtypes.NewParamSetPair(KeyMaxActiveGaugesPerDenom, &p.MaxActiveGaugesPerDenom, validateMaxActiveGaugesPerDenom),
+		paramtypes.NewParamSetPair(KeyRewardHistoryEpochs, &p.RewardHistoryEpochs, validateRewardHistoryEpochs),
 	}
 }
+
+func validateGaugeCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Validate() != nil {
+		return fmt.Errorf("invalid gauge creation fee: %+v", i)
+	}
+
+	return nil
+}
+
+func validateMinRewardValuePerEpoch(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min reward value per epoch must be non negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxActiveGaugesPerDenom(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRewardHistoryEpochs(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("reward history epochs must be positive")
+	}
+
+	return nil
+}
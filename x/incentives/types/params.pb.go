@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -27,6 +29,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// distribution epoch identifier
 	DistrEpochIdentifier string `protobuf:"bytes,1,opt,name=distr_epoch_identifier,json=distrEpochIdentifier,proto3" json:"distr_epoch_identifier,omitempty" yaml:"distr_epoch_identifier"`
+	// fee charged for creating a gauge with MsgCreateGauge, sent to the
+	// community pool
+	GaugeCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=gauge_creation_fee,json=gaugeCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gauge_creation_fee" yaml:"gauge_creation_fee"`
+	// minimum OSMO value, in uosmo, of the rewards a gauge created with
+	// MsgCreateGauge distributes per epoch
+	MinRewardValuePerEpoch github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_reward_value_per_epoch,json=minRewardValuePerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_reward_value_per_epoch" yaml:"min_reward_value_per_epoch"`
+	// maximum number of upcoming and active gauges distributing to a denom,
+	// beyond which MsgCreateGauge can't create gauges for it. 0 means no limit.
+	MaxActiveGaugesPerDenom uint64 `protobuf:"varint,4,opt,name=max_active_gauges_per_denom,json=maxActiveGaugesPerDenom,proto3" json:"max_active_gauges_per_denom,omitempty" yaml:"max_active_gauges_per_denom"`
+	// number of epochs for which the rewards distributed by gauges and received
+	// by locks are recorded
+	RewardHistoryEpochs uint64 `protobuf:"varint,5,opt,name=reward_history_epochs,json=rewardHistoryEpochs,proto3" json:"reward_history_epochs,omitempty" yaml:"reward_history_epochs"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +83,27 @@ func (m *Params) GetDistrEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetGaugeCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GaugeCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxActiveGaugesPerDenom() uint64 {
+	if m != nil {
+		return m.MaxActiveGaugesPerDenom
+	}
+	return 0
+}
+
+func (m *Params) GetRewardHistoryEpochs() uint64 {
+	if m != nil {
+		return m.RewardHistoryEpochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.incentives.Params")
 }
@@ -76,20 +111,37 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/params.proto", fileDescriptor_1cc8b460d089f845) }
 
 var fileDescriptor_1cc8b460d089f845 = []byte{
-	// 206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x2d, 0xd6, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x4a,
-	0x89, 0x5c, 0x6c, 0x01, 0x60, 0x9d, 0x42, 0xe1, 0x5c, 0x62, 0x29, 0x99, 0xc5, 0x25, 0x45, 0xf1,
-	0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xf1, 0x99, 0x29, 0x20, 0x8d, 0x69, 0x99, 0xa9, 0x45, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x8a, 0x9f, 0xee, 0xc9, 0xcb, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29,
-	0x61, 0x57, 0xa7, 0x14, 0x24, 0x02, 0x96, 0x70, 0x05, 0x89, 0x7b, 0xc2, 0x85, 0x9d, 0xfc, 0x4f,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x62, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf,
-	0xcc, 0x5c, 0xbf, 0x02, 0xd9, 0x93, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xa7, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x27, 0x6e, 0x20, 0x07, 0x01, 0x00, 0x00,
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
+	0x14, 0xc6, 0x63, 0x1a, 0x2a, 0x61, 0x36, 0xc8, 0x94, 0xe2, 0x06, 0xb0, 0x53, 0x2f, 0xaa, 0x6c,
+	0xea, 0x51, 0x41, 0x08, 0x89, 0x1d, 0x29, 0xff, 0xba, 0x40, 0x44, 0x06, 0x81, 0xc4, 0x66, 0x34,
+	0xb6, 0x5f, 0x9d, 0x11, 0x99, 0x19, 0x6b, 0x66, 0x12, 0x92, 0x53, 0xc0, 0x8a, 0x43, 0x70, 0x92,
+	0x2c, 0xbb, 0x44, 0x2c, 0x0c, 0x4a, 0x6e, 0xe0, 0x13, 0x20, 0xcf, 0x18, 0xa8, 0x44, 0x23, 0x75,
+	0x65, 0xfb, 0x7d, 0xdf, 0x7c, 0xf3, 0x7b, 0xcf, 0xcf, 0x0d, 0x85, 0x62, 0x42, 0x51, 0x85, 0x28,
+	0xcf, 0x80, 0x6b, 0x3a, 0x03, 0x85, 0x4a, 0x22, 0x09, 0x53, 0x71, 0x29, 0x85, 0x16, 0x9e, 0xd7,
+	0x1a, 0xe2, 0x7f, 0x86, 0xde, 0x4e, 0x21, 0x0a, 0x61, 0x64, 0xd4, 0xbc, 0x59, 0x67, 0x2f, 0xc8,
+	0x8c, 0x15, 0xa5, 0x44, 0x01, 0x9a, 0x1d, 0xa5, 0xa0, 0xc9, 0x11, 0xca, 0x04, 0xe5, 0x56, 0x8f,
+	0x96, 0x5d, 0x77, 0x7b, 0x64, 0xa2, 0xbd, 0xf7, 0xee, 0x6e, 0x4e, 0x95, 0x96, 0x18, 0x4a, 0x91,
+	0x8d, 0x31, 0xcd, 0x9b, 0xe4, 0x53, 0x0a, 0xd2, 0x77, 0xfa, 0xce, 0xe0, 0xda, 0x70, 0xbf, 0xae,
+	0xc2, 0x7b, 0x0b, 0xc2, 0x26, 0x8f, 0xa3, 0x8b, 0x7d, 0x51, 0xb2, 0x63, 0x84, 0x67, 0x4d, 0xfd,
+	0xe4, 0x6f, 0xd9, 0xfb, 0xea, 0xb8, 0x5e, 0x41, 0xa6, 0x05, 0xe0, 0x4c, 0x02, 0xd1, 0x54, 0x70,
+	0x7c, 0x0a, 0xe0, 0x5f, 0xe9, 0x6f, 0x0d, 0xae, 0xdf, 0xdf, 0x8b, 0x2d, 0x61, 0xdc, 0x10, 0xc6,
+	0x2d, 0x61, 0x7c, 0x2c, 0x28, 0x1f, 0xbe, 0x5a, 0x56, 0x61, 0xa7, 0xae, 0xc2, 0x3d, 0x7b, 0xe9,
+	0xff, 0x11, 0xd1, 0xb7, 0x9f, 0xe1, 0xa0, 0xa0, 0x7a, 0x3c, 0x4d, 0xe3, 0x4c, 0x30, 0xd4, 0xf6,
+	0x6a, 0x1f, 0x87, 0x2a, 0xff, 0x88, 0xf4, 0xa2, 0x04, 0x65, 0xd2, 0x54, 0x72, 0xc3, 0x04, 0x1c,
+	0xb7, 0xe7, 0x9f, 0x03, 0x78, 0x9f, 0x1d, 0xb7, 0xc7, 0x28, 0xc7, 0x12, 0x3e, 0x11, 0x99, 0xe3,
+	0x19, 0x99, 0x4c, 0x01, 0x97, 0xd0, 0x76, 0xe6, 0x6f, 0x99, 0xb6, 0xdf, 0x34, 0x14, 0x3f, 0xaa,
+	0xf0, 0xe0, 0x12, 0x17, 0x9d, 0x70, 0x5d, 0x57, 0xe1, 0xbe, 0xe5, 0xdd, 0x9c, 0x1c, 0x25, 0xbb,
+	0x8c, 0xf2, 0xc4, 0x68, 0xef, 0x1a, 0x69, 0x04, 0x76, 0x68, 0x5e, 0xee, 0xde, 0x61, 0x64, 0x8e,
+	0x49, 0xd6, 0xfc, 0x53, 0x6c, 0x80, 0x95, 0x39, 0x97, 0x03, 0x17, 0xcc, 0xef, 0xf6, 0x9d, 0x41,
+	0x77, 0x78, 0x50, 0x57, 0x61, 0xd4, 0xde, 0xb1, 0xd9, 0x1c, 0x25, 0xb7, 0x19, 0x99, 0x3f, 0x31,
+	0xe2, 0x0b, 0xa3, 0x8d, 0x40, 0x3e, 0x6d, 0x14, 0xef, 0xad, 0x7b, 0xab, 0x05, 0x1b, 0x53, 0xa5,
+	0x85, 0x5c, 0x58, 0x2c, 0xe5, 0x5f, 0x35, 0xf9, 0xfd, 0xba, 0x0a, 0xef, 0xda, 0xfc, 0x0b, 0x6d,
+	0x51, 0x72, 0xd3, 0xd6, 0x5f, 0xda, 0xb2, 0x41, 0x57, 0xc3, 0xd7, 0xcb, 0x55, 0xe0, 0x9c, 0xad,
+	0x02, 0xe7, 0xd7, 0x2a, 0x70, 0xbe, 0xac, 0x83, 0xce, 0xd9, 0x3a, 0xe8, 0x7c, 0x5f, 0x07, 0x9d,
+	0x0f, 0x0f, 0xcf, 0x8d, 0xae, 0xdd, 0xdc, 0xc3, 0x09, 0x49, 0xd5, 0x9f, 0x0f, 0x34, 0x7b, 0x84,
+	0xe6, 0xe7, 0x97, 0xdd, 0x4c, 0x33, 0xdd, 0x36, 0x2b, 0xfa, 0xe0, 0xf7, 0x00, 0x6d, 0x9a, 0xf1,
+	0xdf, 0x0f, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +164,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardHistoryEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardHistoryEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxActiveGaugesPerDenom != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveGaugesPerDenom))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinRewardValuePerEpoch.Size()
+		i -= size
+		if _, err := m.MinRewardValuePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.GaugeCreationFee) > 0 {
+		for iNdEx := len(m.GaugeCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.DistrEpochIdentifier) > 0 {
 		i -= len(m.DistrEpochIdentifier)
 		copy(dAtA[i:], m.DistrEpochIdentifier)
@@ -143,6 +229,20 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.GaugeCreationFee) > 0 {
+		for _, e := range m.GaugeCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MinRewardValuePerEpoch.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxActiveGaugesPerDenom != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveGaugesPerDenom))
+	}
+	if m.RewardHistoryEpochs != 0 {
+		n += 1 + sovParams(uint64(m.RewardHistoryEpochs))
+	}
 	return n
 }
 
@@ -213,6 +313,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.DistrEpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeCreationFee = append(m.GaugeCreationFee, types.Coin{})
+			if err := m.GaugeCreationFee[len(m.GaugeCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardValuePerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRewardValuePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveGaugesPerDenom", wireType)
+			}
+			m.MaxActiveGaugesPerDenom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveGaugesPerDenom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistoryEpochs", wireType)
+			}
+			m.RewardHistoryEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardHistoryEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -893,6 +893,314 @@ func (m *RewardsEstResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+type ClaimableRewardsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *ClaimableRewardsRequest) Reset()         { *m = ClaimableRewardsRequest{} }
+func (m *ClaimableRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimableRewardsRequest) ProtoMessage()    {}
+func (*ClaimableRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{18}
+}
+func (m *ClaimableRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimableRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableRewardsRequest.Merge(m, src)
+}
+func (m *ClaimableRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableRewardsRequest proto.InternalMessageInfo
+
+func (m *ClaimableRewardsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type ClaimableRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ClaimableRewardsResponse) Reset()         { *m = ClaimableRewardsResponse{} }
+func (m *ClaimableRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*ClaimableRewardsResponse) ProtoMessage()    {}
+func (*ClaimableRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{19}
+}
+func (m *ClaimableRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimableRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableRewardsResponse.Merge(m, src)
+}
+func (m *ClaimableRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimableRewardsResponse proto.InternalMessageInfo
+
+func (m *ClaimableRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+type LockRewardsRequest struct {
+	LockId    uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	FromEpoch int64  `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty" yaml:"from_epoch"`
+	ToEpoch   int64  `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty" yaml:"to_epoch"`
+}
+
+func (m *LockRewardsRequest) Reset()         { *m = LockRewardsRequest{} }
+func (m *LockRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRewardsRequest) ProtoMessage()    {}
+func (*LockRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{20}
+}
+func (m *LockRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardsRequest.Merge(m, src)
+}
+func (m *LockRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardsRequest proto.InternalMessageInfo
+
+func (m *LockRewardsRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockRewardsRequest) GetFromEpoch() int64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
+}
+
+func (m *LockRewardsRequest) GetToEpoch() int64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
+}
+
+type LockRewardsResponse struct {
+	Rewards []LockEpochReward                           `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	Total   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total"`
+}
+
+func (m *LockRewardsResponse) Reset()         { *m = LockRewardsResponse{} }
+func (m *LockRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*LockRewardsResponse) ProtoMessage()    {}
+func (*LockRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{21}
+}
+func (m *LockRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRewardsResponse.Merge(m, src)
+}
+func (m *LockRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockRewardsResponse proto.InternalMessageInfo
+
+func (m *LockRewardsResponse) GetRewards() []LockEpochReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *LockRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+type PoolAPRRequest struct {
+	PoolId   uint64        `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// number of last epochs the APR is computed over
+	NumEpochs int64 `protobuf:"varint,3,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty" yaml:"num_epochs"`
+}
+
+func (m *PoolAPRRequest) Reset()         { *m = PoolAPRRequest{} }
+func (m *PoolAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolAPRRequest) ProtoMessage()    {}
+func (*PoolAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{22}
+}
+func (m *PoolAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAPRRequest.Merge(m, src)
+}
+func (m *PoolAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAPRRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAPRRequest proto.InternalMessageInfo
+
+func (m *PoolAPRRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolAPRRequest) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *PoolAPRRequest) GetNumEpochs() int64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+type PoolAPRResponse struct {
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr"`
+	// rewards distributed per RewardIndexPrecision locked shares in the last
+	// epochs
+	RewardPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward_per_share,json=rewardPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_share" yaml:"reward_per_share"`
+}
+
+func (m *PoolAPRResponse) Reset()         { *m = PoolAPRResponse{} }
+func (m *PoolAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolAPRResponse) ProtoMessage()    {}
+func (*PoolAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{23}
+}
+func (m *PoolAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolAPRResponse.Merge(m, src)
+}
+func (m *PoolAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolAPRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolAPRResponse proto.InternalMessageInfo
+
+func (m *PoolAPRResponse) GetRewardPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerShare
+	}
+	return nil
+}
+
 type QueryLockableDurationsRequest struct {
 }
 
@@ -900,7 +1208,7 @@ func (m *QueryLockableDurationsRequest) Reset()         { *m = QueryLockableDura
 func (m *QueryLockableDurationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLockableDurationsRequest) ProtoMessage()    {}
 func (*QueryLockableDurationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8124258a89427f98, []int{18}
+	return fileDescriptor_8124258a89427f98, []int{24}
 }
 func (m *QueryLockableDurationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,7 +1245,7 @@ func (m *QueryLockableDurationsResponse) Reset()         { *m = QueryLockableDur
 func (m *QueryLockableDurationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLockableDurationsResponse) ProtoMessage()    {}
 func (*QueryLockableDurationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8124258a89427f98, []int{19}
+	return fileDescriptor_8124258a89427f98, []int{25}
 }
 func (m *QueryLockableDurationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -973,6 +1281,86 @@ func (m *QueryLockableDurationsResponse) GetLockableDurations() []time.Duration
 	return nil
 }
 
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{26}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{27}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
@@ -992,83 +1380,120 @@ func init() {
 	proto.RegisterType((*UpcomingGaugesPerDenomResponse)(nil), "osmosis.incentives.UpcomingGaugesPerDenomResponse")
 	proto.RegisterType((*RewardsEstRequest)(nil), "osmosis.incentives.RewardsEstRequest")
 	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
+	proto.RegisterType((*ClaimableRewardsRequest)(nil), "osmosis.incentives.ClaimableRewardsRequest")
+	proto.RegisterType((*ClaimableRewardsResponse)(nil), "osmosis.incentives.ClaimableRewardsResponse")
+	proto.RegisterType((*LockRewardsRequest)(nil), "osmosis.incentives.LockRewardsRequest")
+	proto.RegisterType((*LockRewardsResponse)(nil), "osmosis.incentives.LockRewardsResponse")
+	proto.RegisterType((*PoolAPRRequest)(nil), "osmosis.incentives.PoolAPRRequest")
+	proto.RegisterType((*PoolAPRResponse)(nil), "osmosis.incentives.PoolAPRResponse")
 	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
 	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.incentives.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.incentives.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
 
 var fileDescriptor_8124258a89427f98 = []byte{
-	// 1103 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xc7, 0x33, 0x4e, 0x52, 0x9a, 0x87, 0x36, 0x34, 0x43, 0x28, 0x89, 0xdb, 0xae, 0xcd, 0xaa,
-	0x4d, 0xdd, 0x94, 0xec, 0xc6, 0x76, 0x93, 0x20, 0xde, 0x24, 0x8c, 0x4b, 0xa9, 0x04, 0xa2, 0xac,
-	0x40, 0x48, 0x48, 0x68, 0xb5, 0xf6, 0x0e, 0xdb, 0x55, 0xec, 0x1d, 0xd7, 0xb3, 0x9b, 0x60, 0x45,
-	0xb9, 0x00, 0xe7, 0x0a, 0x44, 0x54, 0x71, 0xe8, 0x27, 0xe0, 0x08, 0x12, 0x37, 0x38, 0x70, 0xea,
-	0xb1, 0x12, 0x17, 0x4e, 0x29, 0x4a, 0xf8, 0x04, 0xfd, 0x04, 0x68, 0x67, 0x66, 0xfd, 0xba, 0x5e,
-	0x3b, 0x88, 0x46, 0x39, 0x39, 0x93, 0xe7, 0xed, 0xf7, 0x3c, 0x1e, 0xcf, 0xff, 0x01, 0x85, 0xb2,
-	0x3a, 0x65, 0x2e, 0xd3, 0x5d, 0xaf, 0x4a, 0x3c, 0xdf, 0xdd, 0x22, 0x4c, 0xbf, 0x17, 0x90, 0x66,
-	0x4b, 0x6b, 0x34, 0xa9, 0x4f, 0x31, 0x96, 0x76, 0xad, 0x63, 0x4f, 0xcf, 0x3b, 0xd4, 0xa1, 0xdc,
-	0xac, 0x87, 0x7f, 0x09, 0xcf, 0xf4, 0x45, 0x87, 0x52, 0xa7, 0x46, 0x74, 0xab, 0xe1, 0xea, 0x96,
-	0xe7, 0x51, 0xdf, 0xf2, 0x5d, 0xea, 0x31, 0x69, 0x55, 0xa4, 0x95, 0x9f, 0x2a, 0xc1, 0x97, 0xba,
-	0x1d, 0x34, 0xb9, 0x43, 0x64, 0xaf, 0xf2, 0x42, 0x7a, 0xc5, 0x62, 0x44, 0xdf, 0xca, 0x57, 0x88,
-	0x6f, 0xe5, 0xf5, 0x2a, 0x75, 0x23, 0xfb, 0x72, 0xb7, 0x9d, 0x03, 0xb6, 0xbd, 0x1a, 0x96, 0xe3,
-	0x7a, 0x3d, 0xb9, 0x62, 0x7a, 0x72, 0xac, 0xc0, 0x21, 0xd2, 0xbe, 0x18, 0xd9, 0x6b, 0xb4, 0xba,
-	0x19, 0x34, 0xf8, 0x87, 0x30, 0xa9, 0x59, 0x50, 0x3e, 0xa4, 0x76, 0x50, 0x23, 0x9f, 0xd0, 0xb2,
-	0xcb, 0xfc, 0xa6, 0x5b, 0x09, 0x7c, 0xf2, 0x2e, 0x75, 0x3d, 0x66, 0x90, 0x7b, 0x01, 0x61, 0xbe,
-	0xfa, 0x2d, 0x82, 0xcc, 0x50, 0x17, 0xd6, 0xa0, 0x1e, 0x23, 0xd8, 0x82, 0xe9, 0x10, 0x9d, 0x2d,
-	0xa0, 0xec, 0x64, 0xee, 0xf9, 0xc2, 0xa2, 0x26, 0xe0, 0xb5, 0x10, 0x5e, 0x93, 0xd8, 0x5a, 0x18,
-	0x52, 0x5a, 0x7d, 0xb4, 0x9f, 0x99, 0xf8, 0xe9, 0x49, 0x26, 0xe7, 0xb8, 0xfe, 0xdd, 0xa0, 0xa2,
-	0x55, 0x69, 0x5d, 0x97, 0x9d, 0x8a, 0x8f, 0x15, 0x66, 0x6f, 0xea, 0x7e, 0xab, 0x41, 0x98, 0x26,
-	0x6a, 0x88, 0xcc, 0x6a, 0x06, 0x2e, 0x09, 0x8a, 0x0e, 0x83, 0xdd, 0xc3, 0xf9, 0x0d, 0x8a, 0x5a,
-	0x19, 0xf4, 0x38, 0x3e, 0x4c, 0x15, 0xce, 0xdd, 0x0a, 0x27, 0x5f, 0x6a, 0xdd, 0x2e, 0x4b, 0x32,
-	0x3c, 0x0b, 0x29, 0xd7, 0x5e, 0x40, 0x59, 0x94, 0x9b, 0x32, 0x52, 0xae, 0xad, 0x96, 0x61, 0xae,
-	0xcb, 0x47, 0xb2, 0xe9, 0x30, 0xcd, 0xbf, 0x32, 0xee, 0x17, 0xb2, 0x0d, 0xde, 0x43, 0x8d, 0x47,
-	0x19, 0xc2, 0x4f, 0xfd, 0x0c, 0xce, 0xf2, 0x73, 0x34, 0x00, 0xfc, 0x1e, 0x40, 0xe7, 0x66, 0xc8,
-	0x34, 0x4b, 0x3d, 0x2d, 0x8a, 0x7b, 0x1e, 0x35, 0x7a, 0xc7, 0x72, 0x88, 0x8c, 0x35, 0xba, 0x22,
-	0xd5, 0xfb, 0x08, 0x66, 0xa3, 0xcc, 0x12, 0xae, 0x08, 0x53, 0xb6, 0xe5, 0x5b, 0xed, 0xb9, 0x0d,
-	0x63, 0x2b, 0x4d, 0x85, 0x73, 0x33, 0xb8, 0x33, 0xbe, 0xd5, 0xc3, 0x93, 0xe2, 0x3c, 0x57, 0x47,
-	0xf2, 0x88, 0x8a, 0x3d, 0x40, 0x5f, 0xc0, 0x8b, 0xef, 0x54, 0xc3, 0x2a, 0xcf, 0xa6, 0xdf, 0x3d,
-	0x04, 0xf3, 0xbd, 0xf9, 0x4f, 0x44, 0xd7, 0x3b, 0x70, 0xa1, 0x9b, 0xea, 0x0e, 0x69, 0x96, 0x89,
-	0x47, 0xeb, 0x51, 0xf7, 0xf3, 0x30, 0x6d, 0x87, 0x67, 0xde, 0xf8, 0x8c, 0x21, 0x0e, 0x7d, 0x33,
-	0x49, 0xfd, 0xe7, 0x99, 0x3c, 0x44, 0x70, 0x31, 0xbe, 0xfa, 0x89, 0x98, 0x8d, 0x09, 0x2f, 0x7d,
-	0xda, 0xa8, 0xd2, 0xba, 0xeb, 0x39, 0xcf, 0xe6, 0x4e, 0x3c, 0x40, 0x70, 0xbe, 0xbf, 0xc2, 0x89,
-	0xe8, 0x7c, 0x17, 0x2e, 0xf5, 0x72, 0x1d, 0xef, 0xbd, 0xf8, 0x05, 0x81, 0x32, 0xac, 0xbe, 0x9c,
-	0xcf, 0xfb, 0xf0, 0x42, 0x20, 0x3d, 0x4c, 0xfe, 0x52, 0xb1, 0x71, 0x47, 0x35, 0x1b, 0xf4, 0x64,
-	0xfe, 0xff, 0x86, 0xc6, 0x60, 0xce, 0x20, 0xdb, 0x56, 0xd3, 0x66, 0x37, 0x99, 0x1f, 0x0d, 0x6a,
-	0x09, 0xa6, 0xe9, 0xb6, 0x47, 0x9a, 0x62, 0x50, 0xa5, 0x73, 0x4f, 0xf7, 0x33, 0x67, 0x5a, 0x56,
-	0xbd, 0xf6, 0xba, 0xca, 0xff, 0xad, 0x1a, 0xc2, 0x8c, 0x17, 0xe1, 0x74, 0xa8, 0x97, 0xa6, 0x6b,
-	0xb3, 0x85, 0x54, 0x76, 0x32, 0x37, 0x65, 0x3c, 0x17, 0x9e, 0x6f, 0xdb, 0x0c, 0x5f, 0x80, 0x19,
-	0xe2, 0xd9, 0x26, 0x69, 0xd0, 0xea, 0xdd, 0x85, 0xc9, 0x2c, 0xca, 0x4d, 0x1a, 0xa7, 0x89, 0x67,
-	0xdf, 0x0c, 0xcf, 0xea, 0x36, 0xe0, 0xee, 0xa2, 0xc7, 0xaa, 0x94, 0x1f, 0x87, 0x73, 0xf9, 0x80,
-	0x56, 0x37, 0xad, 0x4a, 0x8d, 0x94, 0xe5, 0xe2, 0xd1, 0x56, 0xca, 0xef, 0x11, 0x28, 0xc3, 0x3c,
-	0x24, 0x26, 0x05, 0x5c, 0x93, 0x46, 0x33, 0x5a, 0x5c, 0x3a, 0xcc, 0x62, 0xb5, 0xd1, 0xa2, 0xd5,
-	0x46, 0x8b, 0xe2, 0x4b, 0x57, 0x42, 0xe6, 0xa7, 0xfb, 0x99, 0x45, 0x31, 0xc8, 0xc1, 0x14, 0xea,
-	0x8f, 0x4f, 0x32, 0xc8, 0x98, 0xab, 0xf5, 0x17, 0x2e, 0x3c, 0x38, 0x0b, 0xd3, 0x9c, 0x09, 0xff,
-	0x81, 0xe0, 0xe5, 0x21, 0xfb, 0x06, 0x2e, 0xc4, 0x5d, 0xa1, 0xe4, 0xfd, 0x25, 0x5d, 0x3c, 0x52,
-	0x8c, 0xe8, 0x5f, 0x7d, 0xfb, 0xeb, 0x3f, 0xff, 0xf9, 0x21, 0xf5, 0x1a, 0x5e, 0xd7, 0x63, 0x56,
-	0xab, 0x68, 0x0f, 0xab, 0xf3, 0x24, 0xa6, 0x4f, 0x4d, 0xbb, 0x9d, 0xc6, 0xe4, 0xdf, 0x01, 0xfe,
-	0x0d, 0xc1, 0xf9, 0xf8, 0x65, 0x04, 0xe7, 0x87, 0xf3, 0x0c, 0x59, 0x6d, 0xd2, 0x85, 0xa3, 0x84,
-	0xc8, 0x0e, 0xde, 0xe4, 0x1d, 0xac, 0xe3, 0x1b, 0x63, 0x74, 0xd0, 0xc1, 0xb7, 0x25, 0xff, 0x7d,
-	0x04, 0x33, 0xed, 0x1d, 0x05, 0x5f, 0x1e, 0xfe, 0xcb, 0xed, 0xac, 0x39, 0xe9, 0x2b, 0x23, 0xbc,
-	0x24, 0xd8, 0x0d, 0x0e, 0xa6, 0xe1, 0x57, 0x93, 0xc0, 0xf8, 0xc3, 0x61, 0x56, 0x5a, 0xa6, 0x6b,
-	0xeb, 0x3b, 0xae, 0xbd, 0x8b, 0x77, 0xe0, 0x94, 0x7c, 0x15, 0x5e, 0x19, 0x5a, 0xa6, 0x3d, 0x2f,
-	0x35, 0xc9, 0x45, 0x62, 0x2c, 0x73, 0x8c, 0xcb, 0x58, 0x1d, 0x89, 0xc1, 0xf0, 0x1e, 0x82, 0x33,
-	0xdd, 0x6a, 0x88, 0xaf, 0xc6, 0x15, 0x88, 0xd9, 0x51, 0xd2, 0xb9, 0xd1, 0x8e, 0x92, 0x27, 0xcf,
-	0x79, 0xae, 0xe3, 0x6b, 0x49, 0x3c, 0x16, 0x8f, 0x94, 0xcf, 0x2a, 0xfe, 0xb5, 0x6f, 0x71, 0x89,
-	0x9e, 0x62, 0xac, 0x8f, 0xaa, 0xda, 0x27, 0x1a, 0xe9, 0xd5, 0xf1, 0x03, 0x24, 0xee, 0x1b, 0x1c,
-	0x77, 0x0d, 0x17, 0xc7, 0xc6, 0x35, 0x1b, 0xa4, 0x69, 0x0a, 0x35, 0x7a, 0x88, 0x60, 0xb6, 0x57,
-	0x45, 0xf0, 0xb5, 0x38, 0x82, 0x58, 0x8d, 0x4f, 0x2f, 0x8f, 0xe3, 0x2a, 0x31, 0x8b, 0x1c, 0x73,
-	0x05, 0x5f, 0x4f, 0xc2, 0xec, 0x93, 0x2b, 0xfc, 0xfb, 0x80, 0xf8, 0xb7, 0x27, 0x9b, 0x1f, 0x5d,
-	0xbb, 0x7f, 0xb6, 0x85, 0xa3, 0x84, 0x48, 0xec, 0xb7, 0x38, 0xf6, 0x06, 0x5e, 0x3b, 0x02, 0x76,
-	0xd7, 0x7c, 0xf7, 0x10, 0x40, 0x47, 0x7b, 0x70, 0xec, 0x0f, 0x73, 0x40, 0x10, 0xd3, 0x4b, 0xa3,
-	0xdc, 0x24, 0xdc, 0x06, 0x87, 0xcb, 0x63, 0x3d, 0x09, 0xae, 0x29, 0xe2, 0x4c, 0xc2, 0x7c, 0x7d,
-	0x87, 0x0b, 0xe9, 0x2e, 0xfe, 0x19, 0xc1, 0xdc, 0x80, 0xe4, 0xc4, 0x8f, 0x34, 0x51, 0xc0, 0xe2,
-	0x47, 0x9a, 0xac, 0x68, 0xea, 0x3a, 0xa7, 0x5e, 0xc5, 0x5a, 0x12, 0xf5, 0xa0, 0x60, 0x95, 0x3e,
-	0x7a, 0x74, 0xa0, 0xa0, 0xc7, 0x07, 0x0a, 0xfa, 0xfb, 0x40, 0x41, 0xdf, 0x1d, 0x2a, 0x13, 0x8f,
-	0x0f, 0x95, 0x89, 0xbf, 0x0e, 0x95, 0x89, 0xcf, 0xd7, 0xba, 0x84, 0x59, 0xe6, 0x5c, 0xa9, 0x59,
-	0x15, 0xd6, 0x2e, 0xb0, 0xb5, 0xa1, 0x7f, 0xd5, 0x5d, 0x85, 0x6b, 0x75, 0xe5, 0x14, 0x97, 0xcd,
-	0xe2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x54, 0x04, 0x4d, 0xb2, 0x89, 0x10, 0x00, 0x00,
+	// 1567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x4b, 0x6c, 0xd4, 0x56,
+	0x17, 0xc7, 0x73, 0xf3, 0xce, 0x81, 0x2f, 0x24, 0x37, 0x01, 0x92, 0x01, 0x66, 0xf2, 0x19, 0x48,
+	0x02, 0x01, 0x9b, 0x4c, 0xc2, 0x43, 0xf4, 0xa1, 0x32, 0x04, 0x28, 0x52, 0x5b, 0x52, 0xb7, 0x55,
+	0xa5, 0x4a, 0x95, 0xe5, 0x19, 0x5f, 0x06, 0x8b, 0x19, 0xdf, 0xc1, 0xf6, 0x40, 0xa3, 0x28, 0x8b,
+	0xbe, 0x36, 0x5d, 0xa0, 0x56, 0x45, 0xa8, 0xaa, 0xd8, 0x57, 0xaa, 0xba, 0x6a, 0xa5, 0xee, 0xda,
+	0x45, 0xbb, 0x28, 0x4b, 0xa4, 0x6e, 0xaa, 0x2e, 0x42, 0x05, 0x5d, 0x76, 0x95, 0x4d, 0xb7, 0x95,
+	0xef, 0x3d, 0x9e, 0x19, 0x4f, 0xec, 0x79, 0x54, 0x05, 0xb1, 0x4a, 0x3c, 0xe7, 0x71, 0x7f, 0xe7,
+	0x9c, 0x7b, 0xed, 0xff, 0x85, 0x34, 0xf7, 0xca, 0xdc, 0xb3, 0x3d, 0xcd, 0x76, 0x0a, 0xcc, 0xf1,
+	0xed, 0x9b, 0xcc, 0xd3, 0x6e, 0x54, 0x99, 0xbb, 0xa6, 0x56, 0x5c, 0xee, 0x73, 0x4a, 0xd1, 0xae,
+	0xd6, 0xed, 0xa9, 0xc9, 0x22, 0x2f, 0x72, 0x61, 0xd6, 0x82, 0xff, 0xa4, 0x67, 0x6a, 0x7f, 0x91,
+	0xf3, 0x62, 0x89, 0x69, 0x66, 0xc5, 0xd6, 0x4c, 0xc7, 0xe1, 0xbe, 0xe9, 0xdb, 0xdc, 0xf1, 0xd0,
+	0x9a, 0x46, 0xab, 0x78, 0xca, 0x57, 0xaf, 0x6a, 0x56, 0xd5, 0x15, 0x0e, 0xa1, 0xbd, 0x20, 0x16,
+	0xd2, 0xf2, 0xa6, 0xc7, 0xb4, 0x9b, 0x8b, 0x79, 0xe6, 0x9b, 0x8b, 0x5a, 0x81, 0xdb, 0xa1, 0xfd,
+	0x68, 0xa3, 0x5d, 0x00, 0xd6, 0xbc, 0x2a, 0x66, 0xd1, 0x76, 0x22, 0xb9, 0x62, 0x6a, 0x2a, 0x9a,
+	0xd5, 0x22, 0x43, 0x7b, 0x26, 0xc6, 0x5e, 0x31, 0x5d, 0xb3, 0x1c, 0xc2, 0xce, 0xc4, 0x38, 0xb8,
+	0xec, 0x96, 0xe9, 0x5a, 0xa1, 0xc7, 0x74, 0xe8, 0x51, 0xe2, 0x85, 0xeb, 0xd5, 0x8a, 0xf8, 0x23,
+	0x4d, 0xca, 0x0c, 0xa4, 0x5f, 0xe5, 0x56, 0xb5, 0xc4, 0xde, 0xe4, 0x2b, 0xb6, 0xe7, 0xbb, 0x76,
+	0xbe, 0xea, 0xb3, 0xf3, 0xdc, 0x76, 0x3c, 0x9d, 0xdd, 0xa8, 0x32, 0xcf, 0x57, 0x3e, 0x22, 0x90,
+	0x49, 0x74, 0xf1, 0x2a, 0xdc, 0xf1, 0x18, 0x35, 0x61, 0x20, 0xa8, 0xde, 0x9b, 0x22, 0x33, 0x7d,
+	0xf3, 0x3b, 0xb2, 0xd3, 0xaa, 0xac, 0x5f, 0x0d, 0xea, 0x57, 0xb1, 0x72, 0x35, 0x08, 0xc9, 0x9d,
+	0xb8, 0xbf, 0x99, 0xe9, 0xf9, 0xfa, 0x61, 0x66, 0xbe, 0x68, 0xfb, 0xd7, 0xaa, 0x79, 0xb5, 0xc0,
+	0xcb, 0x1a, 0x36, 0x4b, 0xfe, 0x39, 0xee, 0x59, 0xd7, 0x35, 0x7f, 0xad, 0xc2, 0x3c, 0x55, 0xae,
+	0x21, 0x33, 0x2b, 0x19, 0x38, 0x20, 0x29, 0xea, 0x0c, 0x56, 0x84, 0xf3, 0x43, 0x02, 0xe9, 0x24,
+	0x8f, 0xa7, 0x87, 0xa9, 0xc0, 0xd8, 0xa5, 0x60, 0x78, 0xb9, 0xb5, 0xcb, 0x2b, 0x48, 0x46, 0x47,
+	0xa1, 0xd7, 0xb6, 0xa6, 0xc8, 0x0c, 0x99, 0xef, 0xd7, 0x7b, 0x6d, 0x4b, 0x59, 0x81, 0xf1, 0x06,
+	0x1f, 0x64, 0xd3, 0x60, 0x40, 0x4c, 0x5d, 0xf8, 0x05, 0x6c, 0xdb, 0xb7, 0xb2, 0x2a, 0xa2, 0x74,
+	0xe9, 0xa7, 0xbc, 0x0d, 0xff, 0x13, 0xcf, 0x61, 0x03, 0xe8, 0x45, 0x80, 0xfa, 0xe6, 0xc2, 0x34,
+	0xb3, 0x91, 0x12, 0xe5, 0x51, 0x09, 0x0b, 0x5d, 0x35, 0x8b, 0x0c, 0x63, 0xf5, 0x86, 0x48, 0xe5,
+	0x36, 0x81, 0xd1, 0x30, 0x33, 0xc2, 0x2d, 0x41, 0xbf, 0x65, 0xfa, 0x66, 0xad, 0x6f, 0x49, 0x6c,
+	0xb9, 0xfe, 0xa0, 0x6f, 0xba, 0x70, 0xa6, 0x97, 0x22, 0x3c, 0xbd, 0x82, 0x67, 0xae, 0x2d, 0x8f,
+	0x5c, 0x31, 0x02, 0xf4, 0x2e, 0x4c, 0x9c, 0x2b, 0x04, 0xab, 0x3c, 0x99, 0x7a, 0xef, 0x10, 0x98,
+	0x8c, 0xe6, 0x7f, 0x26, 0xaa, 0x5e, 0x87, 0x7d, 0x8d, 0x54, 0xab, 0xcc, 0x5d, 0x61, 0x0e, 0x2f,
+	0x87, 0xd5, 0x4f, 0xc2, 0x80, 0x15, 0x3c, 0x8b, 0xc2, 0x47, 0x74, 0xf9, 0x40, 0x2f, 0xc6, 0xac,
+	0xfe, 0x6f, 0x7a, 0x72, 0x8f, 0xc0, 0xfe, 0xf8, 0xd5, 0x9f, 0x89, 0xde, 0x18, 0xb0, 0xfb, 0xad,
+	0x4a, 0x81, 0x97, 0x6d, 0xa7, 0xf8, 0x64, 0xf6, 0xc4, 0x5d, 0x02, 0x7b, 0x9a, 0x57, 0x78, 0x26,
+	0x2a, 0xdf, 0x80, 0x03, 0x51, 0xae, 0xa7, 0xbb, 0x2f, 0xbe, 0x23, 0x90, 0x4e, 0x5a, 0x1f, 0xfb,
+	0xf3, 0x32, 0xec, 0xaa, 0xa2, 0x87, 0x21, 0xde, 0x54, 0x5e, 0xa7, 0xad, 0x1a, 0xad, 0x46, 0x32,
+	0xff, 0x77, 0x4d, 0xf3, 0x60, 0x5c, 0x97, 0x1f, 0xc4, 0x0b, 0x9e, 0x1f, 0x36, 0x6a, 0x16, 0x06,
+	0xf8, 0x2d, 0x87, 0xb9, 0xb2, 0x51, 0xb9, 0xb1, 0xad, 0xcd, 0xcc, 0xce, 0x35, 0xb3, 0x5c, 0x3a,
+	0xab, 0x88, 0x9f, 0x15, 0x5d, 0x9a, 0xe9, 0x34, 0x0c, 0x07, 0xdf, 0x4b, 0xc3, 0xb6, 0xbc, 0xa9,
+	0xde, 0x99, 0xbe, 0xf9, 0x7e, 0x7d, 0x28, 0x78, 0xbe, 0x6c, 0x79, 0x74, 0x1f, 0x8c, 0x30, 0xc7,
+	0x32, 0x58, 0x85, 0x17, 0xae, 0x4d, 0xf5, 0xcd, 0x90, 0xf9, 0x3e, 0x7d, 0x98, 0x39, 0xd6, 0x85,
+	0xe0, 0x59, 0xb9, 0x05, 0xb4, 0x71, 0xd1, 0xa7, 0xf7, 0x09, 0x3a, 0x07, 0x7b, 0xcf, 0x97, 0x4c,
+	0xbb, 0x6c, 0xe6, 0x4b, 0x0c, 0x09, 0xba, 0xac, 0x59, 0x79, 0x9f, 0xc0, 0xd4, 0xf6, 0x1c, 0x58,
+	0x02, 0x83, 0x21, 0x94, 0x17, 0x4f, 0xa2, 0x88, 0x30, 0xb7, 0xf2, 0x15, 0x01, 0xfa, 0x0a, 0x2f,
+	0x5c, 0x6f, 0x2a, 0x61, 0x01, 0x86, 0x70, 0x1c, 0xf2, 0x8b, 0x9a, 0xa3, 0x5b, 0x9b, 0x99, 0x51,
+	0x59, 0x04, 0x1a, 0x14, 0x7d, 0x50, 0x4e, 0x88, 0x2e, 0x03, 0x5c, 0x75, 0x79, 0x19, 0x27, 0x14,
+	0xec, 0xa0, 0xbe, 0xdc, 0xee, 0xad, 0xcd, 0xcc, 0xb8, 0xf4, 0xaf, 0xdb, 0x14, 0x7d, 0x24, 0x78,
+	0x10, 0x93, 0xa3, 0x2a, 0x0c, 0xfb, 0xbc, 0x71, 0xaa, 0xb9, 0x89, 0xad, 0xcd, 0xcc, 0x2e, 0x19,
+	0x13, 0x5a, 0x14, 0x7d, 0xc8, 0xe7, 0x72, 0xd2, 0x3f, 0x13, 0x98, 0x88, 0x90, 0x62, 0xa3, 0xce,
+	0x37, 0x37, 0xea, 0x60, 0xdc, 0x09, 0x08, 0x22, 0x45, 0x1e, 0x19, 0x8e, 0x67, 0x21, 0x8c, 0xa4,
+	0x45, 0x18, 0xf0, 0xb9, 0x6f, 0x96, 0xc4, 0xde, 0xdb, 0x91, 0xdd, 0x1f, 0xdb, 0xeb, 0x15, 0x56,
+	0x10, 0xed, 0x5e, 0xc2, 0x76, 0x2f, 0x74, 0xd0, 0x6e, 0x8c, 0xf1, 0x74, 0x99, 0x5f, 0xf9, 0x85,
+	0xc0, 0xe8, 0x2a, 0xe7, 0xa5, 0x73, 0xab, 0x7a, 0x43, 0xaf, 0x2b, 0x9c, 0x97, 0x62, 0x7b, 0x8d,
+	0x06, 0x45, 0x1f, 0x0c, 0xfe, 0xbb, 0x6c, 0x51, 0x1d, 0x86, 0x43, 0x95, 0x8c, 0x67, 0x75, 0x5a,
+	0x95, 0x32, 0x5a, 0x0d, 0x65, 0xb4, 0xba, 0x82, 0x0e, 0xb9, 0x7d, 0x01, 0x68, 0xbd, 0xa9, 0x61,
+	0xa0, 0xf2, 0xc5, 0xc3, 0x0c, 0xd1, 0x6b, 0x79, 0x82, 0xf9, 0x39, 0x55, 0x1c, 0x91, 0x37, 0xd5,
+	0xd7, 0x3c, 0xbf, 0xba, 0x4d, 0xd1, 0x47, 0x9c, 0xaa, 0x1c, 0x9f, 0xa7, 0xfc, 0x45, 0x60, 0x57,
+	0xad, 0x12, 0x9c, 0xc5, 0x4b, 0xd0, 0x67, 0x56, 0xc2, 0x7d, 0xaf, 0x06, 0xab, 0xff, 0xbe, 0x99,
+	0x99, 0xed, 0xac, 0x4d, 0x7a, 0x10, 0x4a, 0xef, 0x12, 0x18, 0x93, 0x43, 0x31, 0x2a, 0xcc, 0x35,
+	0xbc, 0x6b, 0xa6, 0xcb, 0x3a, 0x1a, 0xca, 0x6b, 0x58, 0xeb, 0x5e, 0x09, 0xdd, 0x9c, 0x43, 0xe9,
+	0x76, 0x5e, 0xa3, 0x32, 0xc3, 0x2a, 0x73, 0xdf, 0x10, 0xf1, 0x19, 0x38, 0xf0, 0x7a, 0xf0, 0x1e,
+	0x0c, 0x36, 0x52, 0x70, 0x5e, 0xc3, 0x26, 0xd7, 0x94, 0xf1, 0x67, 0x04, 0xd2, 0x49, 0x1e, 0xd8,
+	0x1e, 0x0e, 0xb4, 0x84, 0x46, 0x23, 0xec, 0x7e, 0xfd, 0x78, 0x27, 0x8e, 0xf1, 0x30, 0x96, 0x36,
+	0x5d, 0x3f, 0x7f, 0xd1, 0x14, 0x72, 0xa0, 0xe3, 0xa5, 0xe6, 0x85, 0x95, 0x49, 0xa0, 0x02, 0x69,
+	0x55, 0xdc, 0x64, 0x42, 0xd2, 0x2b, 0x30, 0x11, 0xf9, 0x15, 0xe9, 0xce, 0xc0, 0xa0, 0xbc, 0xf1,
+	0xe0, 0x17, 0x3d, 0x15, 0x77, 0x8e, 0x64, 0x0c, 0x1e, 0x1f, 0xf4, 0xcf, 0xfe, 0x3d, 0x0e, 0x03,
+	0x22, 0x23, 0xfd, 0x89, 0xc0, 0xde, 0x84, 0x6b, 0x0c, 0xcd, 0xc6, 0xe5, 0x6b, 0x7d, 0x2d, 0x4a,
+	0x2d, 0x75, 0x15, 0x23, 0x0b, 0x51, 0x5e, 0xfc, 0xe0, 0xd7, 0x3f, 0x3f, 0xef, 0x3d, 0x43, 0x4f,
+	0x69, 0x31, 0x77, 0xb6, 0xf0, 0x86, 0x58, 0x16, 0x49, 0x0c, 0x9f, 0x1b, 0x56, 0x2d, 0x8d, 0x21,
+	0x5e, 0xed, 0xf4, 0x07, 0x02, 0x7b, 0xe2, 0xef, 0x38, 0x74, 0x31, 0x99, 0x27, 0xe1, 0xc6, 0x94,
+	0xca, 0x76, 0x13, 0x82, 0x15, 0x3c, 0x2f, 0x2a, 0x38, 0x45, 0x97, 0x3b, 0xa8, 0xa0, 0x8e, 0x6f,
+	0x21, 0xff, 0x6d, 0x02, 0x23, 0xb5, 0xab, 0x0f, 0x3d, 0x94, 0x2c, 0x08, 0xea, 0xb7, 0xa7, 0xd4,
+	0xe1, 0x36, 0x5e, 0x08, 0xb6, 0x2c, 0xc0, 0x54, 0x7a, 0xac, 0x15, 0x98, 0xd0, 0x23, 0x46, 0x7e,
+	0xcd, 0xb0, 0x2d, 0x6d, 0xdd, 0xb6, 0x36, 0xe8, 0x3a, 0x0c, 0xa2, 0xd8, 0xf8, 0x7f, 0xe2, 0x32,
+	0xb5, 0x7e, 0x29, 0xad, 0x5c, 0x10, 0xe3, 0xa8, 0xc0, 0x38, 0x44, 0x95, 0xb6, 0x18, 0x1e, 0xbd,
+	0x43, 0x60, 0x67, 0xa3, 0xc8, 0xa6, 0x73, 0x71, 0x0b, 0xc4, 0x5c, 0x7d, 0x52, 0xf3, 0xed, 0x1d,
+	0x91, 0x67, 0x51, 0xf0, 0x2c, 0xd0, 0x23, 0xad, 0x78, 0x4c, 0x11, 0x89, 0x6a, 0x8d, 0x7e, 0xdf,
+	0x74, 0x1f, 0x0a, 0x15, 0x1e, 0xd5, 0xda, 0xad, 0xda, 0xa4, 0x45, 0x53, 0x27, 0x3a, 0x0f, 0x40,
+	0xdc, 0xe7, 0x04, 0xee, 0x49, 0xba, 0xd4, 0x31, 0xae, 0x78, 0x91, 0x4a, 0x91, 0x7b, 0x8f, 0xc0,
+	0x68, 0x54, 0x9c, 0xd2, 0x23, 0x71, 0x04, 0xb1, 0x57, 0x87, 0xd4, 0xd1, 0x4e, 0x5c, 0x11, 0x73,
+	0x49, 0x60, 0x1e, 0xa7, 0x0b, 0xad, 0x30, 0x9b, 0x54, 0x30, 0xfd, 0x71, 0xdb, 0x9d, 0xa2, 0xd6,
+	0xd9, 0xc5, 0xf6, 0x6b, 0x37, 0xf7, 0x36, 0xdb, 0x4d, 0x08, 0x62, 0xbf, 0x20, 0xb0, 0x4f, 0xd3,
+	0x93, 0x5d, 0x60, 0x37, 0xf4, 0xf7, 0x0e, 0x01, 0xa8, 0x4b, 0x5a, 0x1a, 0x7b, 0x30, 0xb7, 0xe9,
+	0xec, 0xd4, 0x6c, 0x3b, 0x37, 0x84, 0x3b, 0x2d, 0xe0, 0x16, 0xa9, 0xd6, 0x0a, 0x0e, 0x55, 0x91,
+	0xc1, 0x3c, 0x5f, 0x5b, 0x17, 0x5a, 0x75, 0x83, 0x7e, 0x43, 0x60, 0xac, 0x59, 0xac, 0xd2, 0x85,
+	0xb8, 0x55, 0x13, 0x64, 0x71, 0xea, 0x58, 0x67, 0xce, 0xdd, 0x74, 0xb1, 0x10, 0x46, 0x1b, 0x88,
+	0x5c, 0xc3, 0xfd, 0x92, 0xc0, 0x8e, 0x06, 0xb5, 0x48, 0x67, 0x93, 0x44, 0x61, 0x13, 0xe4, 0x5c,
+	0x5b, 0x3f, 0xe4, 0x3b, 0x2b, 0xf8, 0x96, 0x69, 0xb6, 0x15, 0x9f, 0x90, 0xca, 0x35, 0x34, 0x14,
+	0xce, 0x1b, 0xf4, 0x13, 0x02, 0x43, 0x28, 0x9d, 0x68, 0xec, 0xeb, 0x2e, 0xaa, 0x10, 0x53, 0x07,
+	0x5b, 0xfa, 0x20, 0xd0, 0x29, 0x01, 0x74, 0x82, 0xaa, 0xad, 0x80, 0x84, 0x9e, 0x34, 0x2b, 0xae,
+	0xb6, 0x8e, 0xca, 0x72, 0x83, 0x7e, 0x4b, 0x60, 0x7c, 0x9b, 0x64, 0x89, 0x3f, 0x2b, 0x2d, 0x05,
+	0x50, 0x2a, 0xdb, 0x4d, 0x48, 0x37, 0xd0, 0xdb, 0x05, 0x0f, 0xfd, 0x98, 0xc0, 0xa0, 0x94, 0x22,
+	0x74, 0x36, 0x71, 0xd9, 0x88, 0xea, 0x49, 0xcd, 0xb5, 0xf5, 0xeb, 0xe6, 0xe3, 0x22, 0x95, 0x4f,
+	0xee, 0xca, 0xfd, 0x47, 0x69, 0xf2, 0xe0, 0x51, 0x9a, 0xfc, 0xf1, 0x28, 0x4d, 0x3e, 0x7d, 0x9c,
+	0xee, 0x79, 0xf0, 0x38, 0xdd, 0xf3, 0xdb, 0xe3, 0x74, 0xcf, 0x3b, 0x27, 0x1b, 0xc4, 0x26, 0xe6,
+	0x39, 0x5e, 0x32, 0xf3, 0x5e, 0x2d, 0xe9, 0xcd, 0xd3, 0xda, 0x7b, 0x8d, 0x99, 0x85, 0xfe, 0xcc,
+	0x0f, 0x0a, 0xf9, 0xb7, 0xf4, 0xcf, 0x00, 0xd2, 0x3f, 0x97, 0x70, 0x84, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1103,8 +1528,20 @@ type QueryClient interface {
 	// The querier either provides an address or a set of locks
 	// for which they want to find the associated rewards.
 	RewardsEst(ctx context.Context, in *RewardsEstRequest, opts ...grpc.CallOption) (*RewardsEstResponse, error)
+	// ClaimableRewards returns the rewards accrued by the locks of an owner,
+	// that can be claimed with MsgClaimRewards.
+	ClaimableRewards(ctx context.Context, in *ClaimableRewardsRequest, opts ...grpc.CallOption) (*ClaimableRewardsResponse, error)
+	// LockRewards returns the rewards a lock received from each gauge in each
+	// epoch of an epoch range.
+	LockRewards(ctx context.Context, in *LockRewardsRequest, opts ...grpc.CallOption) (*LockRewardsResponse, error)
+	// PoolAPR returns the APR of the shares of a pool locked for a duration,
+	// from the rewards distributed to them in the last epochs, valued in OSMO at
+	// spot prices.
+	PoolAPR(ctx context.Context, in *PoolAPRRequest, opts ...grpc.CallOption) (*PoolAPRResponse, error)
 	// returns lockable durations that are valid to give incentives
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
+	// Params returns the incentives module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1196,6 +1633,33 @@ func (c *queryClient) RewardsEst(ctx context.Context, in *RewardsEstRequest, opt
 	return out, nil
 }
 
+func (c *queryClient) ClaimableRewards(ctx context.Context, in *ClaimableRewardsRequest, opts ...grpc.CallOption) (*ClaimableRewardsResponse, error) {
+	out := new(ClaimableRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/ClaimableRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockRewards(ctx context.Context, in *LockRewardsRequest, opts ...grpc.CallOption) (*LockRewardsResponse, error) {
+	out := new(LockRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/LockRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolAPR(ctx context.Context, in *PoolAPRRequest, opts ...grpc.CallOption) (*PoolAPRResponse, error) {
+	out := new(PoolAPRResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/PoolAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error) {
 	out := new(QueryLockableDurationsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/LockableDurations", in, out, opts...)
@@ -1205,6 +1669,15 @@ func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDu
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// returns coins that is going to be distributed
@@ -1227,8 +1700,20 @@ type QueryServer interface {
 	// The querier either provides an address or a set of locks
 	// for which they want to find the associated rewards.
 	RewardsEst(context.Context, *RewardsEstRequest) (*RewardsEstResponse, error)
+	// ClaimableRewards returns the rewards accrued by the locks of an owner,
+	// that can be claimed with MsgClaimRewards.
+	ClaimableRewards(context.Context, *ClaimableRewardsRequest) (*ClaimableRewardsResponse, error)
+	// LockRewards returns the rewards a lock received from each gauge in each
+	// epoch of an epoch range.
+	LockRewards(context.Context, *LockRewardsRequest) (*LockRewardsResponse, error)
+	// PoolAPR returns the APR of the shares of a pool locked for a duration,
+	// from the rewards distributed to them in the last epochs, valued in OSMO at
+	// spot prices.
+	PoolAPR(context.Context, *PoolAPRRequest) (*PoolAPRResponse, error)
 	// returns lockable durations that are valid to give incentives
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
+	// Params returns the incentives module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1262,9 +1747,21 @@ func (*UnimplementedQueryServer) UpcomingGaugesPerDenom(ctx context.Context, req
 func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEstRequest) (*RewardsEstResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardsEst not implemented")
 }
+func (*UnimplementedQueryServer) ClaimableRewards(ctx context.Context, req *ClaimableRewardsRequest) (*ClaimableRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableRewards not implemented")
+}
+func (*UnimplementedQueryServer) LockRewards(ctx context.Context, req *LockRewardsRequest) (*LockRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockRewards not implemented")
+}
+func (*UnimplementedQueryServer) PoolAPR(ctx context.Context, req *PoolAPRRequest) (*PoolAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAPR not implemented")
+}
 func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1432,6 +1929,60 @@ func _Query_RewardsEst_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClaimableRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/ClaimableRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableRewards(ctx, req.(*ClaimableRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/LockRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockRewards(ctx, req.(*LockRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/PoolAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAPR(ctx, req.(*PoolAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLockableDurationsRequest)
 	if err := dec(in); err != nil {
@@ -1450,6 +2001,24 @@ func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1490,10 +2059,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RewardsEst",
 			Handler:    _Query_RewardsEst_Handler,
 		},
+		{
+			MethodName: "ClaimableRewards",
+			Handler:    _Query_ClaimableRewards_Handler,
+		},
+		{
+			MethodName: "LockRewards",
+			Handler:    _Query_LockRewards_Handler,
+		},
+		{
+			MethodName: "PoolAPR",
+			Handler:    _Query_PoolAPR_Handler,
+		},
 		{
 			MethodName: "LockableDurations",
 			Handler:    _Query_LockableDurations_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/query.proto",
@@ -2206,7 +2791,7 @@ func (m *RewardsEstResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockableDurationsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ClaimableRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2216,20 +2801,27 @@ func (m *QueryLockableDurationsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockableDurationsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClaimableRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockableDurationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClaimableRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLockableDurationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ClaimableRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2239,24 +2831,26 @@ func (m *QueryLockableDurationsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLockableDurationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClaimableRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClaimableRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.LockableDurations) > 0 {
-		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
-			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
-			if err != nil {
-				return 0, err
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= n
-			i = encodeVarintQuery(dAtA, i, uint64(n))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2264,113 +2858,404 @@ func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LockRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ModuleToDistributeCoinsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *LockRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModuleToDistributeCoinsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LockRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ModuleDistributedCoinsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LockRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ModuleDistributedCoinsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LockRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GaugeByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *PoolAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GaugeByIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gauge != nil {
-		l = m.Gauge.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *PoolAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GaugesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PoolAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GaugesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.RewardPerShare) > 0 {
+		for iNdEx := len(m.RewardPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockableDurationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockableDurationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockableDurationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockableDurationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockableDurationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockableDurations) > 0 {
+		for iNdEx := len(m.LockableDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LockableDurations[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LockableDurations[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintQuery(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ModuleToDistributeCoinsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModuleToDistributeCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleDistributedCoinsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModuleDistributedCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GaugeByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gauge != nil {
+		l = m.Gauge.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GaugesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GaugesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	if m.Pagination != nil {
@@ -2554,39 +3439,160 @@ func (m *RewardsEstResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryLockableDurationsRequest) Size() (n int) {
+func (m *ClaimableRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryLockableDurationsResponse) Size() (n int) {
+func (m *ClaimableRewardsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.LockableDurations) > 0 {
-		for _, e := range m.LockableDurations {
-			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *LockRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *LockRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *PoolAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *PoolAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RewardPerShare) > 0 {
+		for _, e := range m.RewardPerShare {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLockableDurationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockableDurationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockableDurations) > 0 {
+		for _, e := range m.LockableDurations {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -2768,7 +3774,896 @@ func (m *ModuleDistributedCoinsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleDistributedCoinsResponse) Unmarshal(dAtA []byte) error {
+func (m *ModuleDistributedCoinsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleDistributedCoinsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleDistributedCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Gauge == nil {
+				m.Gauge = &Gauge{}
+			}
+			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Gauge{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveGaugesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveGaugesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Gauge{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveGaugesPerDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveGaugesPerDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveGaugesPerDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveGaugesPerDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Gauge{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2791,15 +4686,15 @@ func (m *ModuleDistributedCoinsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleDistributedCoinsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingGaugesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleDistributedCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2826,8 +4721,10 @@ func (m *ModuleDistributedCoinsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2852,7 +4749,7 @@ func (m *ModuleDistributedCoinsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2875,17 +4772,17 @@ func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugeByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingGaugesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugeByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2895,11 +4792,62 @@ func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Gauge{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2921,7 +4869,7 @@ func (m *GaugeByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
+func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2944,15 +4892,47 @@ func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugeByIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingGaugesPerDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugeByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingGaugesPerDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gauge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2979,10 +4959,10 @@ func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gauge == nil {
-				m.Gauge = &Gauge{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Gauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3007,7 +4987,7 @@ func (m *GaugeByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3030,13 +5010,47 @@ func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingGaugesPerDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingGaugesPerDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpcomingGauges = append(m.UpcomingGauges, Gauge{})
+			if err := m.UpcomingGauges[len(m.UpcomingGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3066,7 +5080,7 @@ func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3093,7 +5107,7 @@ func (m *GaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3116,17 +5130,17 @@ func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsEstRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsEstRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3136,31 +5150,105 @@ func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, Gauge{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.LockIds = append(m.LockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.LockIds) == 0 {
+					m.LockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.LockIds = append(m.LockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
 			}
-			var msglen int
+			m.EndEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3170,28 +5258,11 @@ func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EndEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3213,7 +5284,7 @@ func (m *GaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
+func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3236,15 +5307,15 @@ func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveGaugesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsEstResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsEstResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3271,10 +5342,8 @@ func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3299,7 +5368,7 @@ func (m *ActiveGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *ClaimableRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3322,51 +5391,17 @@ func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, Gauge{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3376,27 +5411,23 @@ func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3419,7 +5450,7 @@ func (m *ActiveGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *ClaimableRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3442,47 +5473,15 @@ func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveGaugesPerDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveGaugesPerDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3509,10 +5508,8 @@ func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3537,7 +5534,7 @@ func (m *ActiveGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *LockRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3560,17 +5557,17 @@ func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveGaugesPerDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveGaugesPerDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			var msglen int
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3580,117 +5577,35 @@ func (m *ActiveGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, Gauge{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
 			}
-			var msglen int
+			m.FromEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
 				}
 				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingGaugesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingGaugesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
+			}
+			m.ToEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3700,28 +5615,11 @@ func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ToEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3743,7 +5641,7 @@ func (m *UpcomingGaugesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
+func (m *LockRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3766,15 +5664,15 @@ func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingGaugesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LockRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingGaugesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LockRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3801,14 +5699,14 @@ func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, Gauge{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, LockEpochReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3835,10 +5733,8 @@ func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3863,7 +5759,7 @@ func (m *UpcomingGaugesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *PoolAPRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3886,17 +5782,17 @@ func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingGaugesPerDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAPRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingGaugesPerDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3906,27 +5802,14 @@ func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3953,13 +5836,29 @@ func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3981,7 +5880,7 @@ func (m *UpcomingGaugesPerDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *PoolAPRResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4004,17 +5903,17 @@ func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingGaugesPerDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolAPRResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingGaugesPerDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingGauges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4024,29 +5923,29 @@ func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpcomingGauges = append(m.UpcomingGauges, Gauge{})
-			if err := m.UpcomingGauges[len(m.UpcomingGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4073,10 +5972,8 @@ func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardPerShare = append(m.RewardPerShare, types.DecCoin{})
+			if err := m.RewardPerShare[len(m.RewardPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4101,7 +5998,7 @@ func (m *UpcomingGaugesPerDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockableDurationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4124,139 +6021,12 @@ func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsEstRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockableDurationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsEstRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockableDurationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.LockIds = append(m.LockIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.LockIds) == 0 {
-					m.LockIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.LockIds = append(m.LockIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockIds", wireType)
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
-			}
-			m.EndEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndEpoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4278,7 +6048,7 @@ func (m *RewardsEstRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4301,15 +6071,15 @@ func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsEstResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockableDurationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsEstResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockableDurationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4336,8 +6106,8 @@ func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4362,7 +6132,7 @@ func (m *RewardsEstResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockableDurationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4385,10 +6155,10 @@ func (m *QueryLockableDurationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockableDurationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockableDurationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4412,7 +6182,7 @@ func (m *QueryLockableDurationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4435,15 +6205,15 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLockableDurationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLockableDurationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockableDurations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4470,8 +6240,7 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockableDurations = append(m.LockableDurations, time.Duration(0))
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.LockableDurations[len(m.LockableDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
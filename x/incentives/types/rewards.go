@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardIndexPrecision is the number of locked tokens reward indices are expressed per.
+// Locked gamm shares have 18 decimals, so rewards per single locked token would lose most
+// of their precision.
+var RewardIndexPrecision = sdk.NewIntWithDecimal(1, 18)
+
+// RewardsSince returns the rewards accrued by the stake, since its reward index was the
+// stake's last reward per share.
+func (stake LockStake) RewardsSince(rewardPerShare sdk.DecCoins) sdk.DecCoins {
+	if stake.Amount.IsZero() {
+		return sdk.DecCoins{}
+	}
+	delta := rewardPerShare.Sub(stake.LastRewardPerShare)
+	return delta.MulDecTruncate(stake.Amount.ToDec()).QuoDecTruncate(RewardIndexPrecision.ToDec())
+}
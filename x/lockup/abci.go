@@ -29,5 +29,4 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
 
-// TODO: add invariant that no native lockup existent synthetic lockup exists by calling GetAllSyntheticLockups
 // TODO: if superfluid does not delete synthetic lockup before native lockup deletion, it won't be able to be deleted
@@ -9,6 +9,8 @@ const (
 	FlagDuration    = "duration"
 	FlagMinDuration = "min-duration"
 	FlagAmount      = "amount"
+
+	FlagTransferSyntheticLockups = "transfer-synthetic-lockups"
 )
 
 // FlagSetLockTokens returns flags for LockTokens msg builder.
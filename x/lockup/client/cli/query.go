@@ -48,6 +48,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdOutputLocksJson(),
 		GetCmdSyntheticLockupsByLockupID(),
 		GetCmdAccountLockedDuration(),
+		GetCmdInvariants(),
 	)
 
 	return cmd
@@ -793,3 +794,46 @@ $ %s query lockup output-all-locks <max lock ID>
 
 	return cmd
 }
+
+// GetCmdInvariants runs the lockup invariants.
+func GetCmdInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants [route]",
+		Short: "Run lockup invariants",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run the lockup invariants against the queried state, and show whether they are broken.
+Only the invariant of the given route is run, if any.
+
+Example:
+$ %s query lockup invariants
+$ %s query lockup invariants lock-refs
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.InvariantsRequest{}
+			if len(args) == 1 {
+				req.Route = args[0]
+			}
+
+			res, err := queryClient.Invariants(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -29,6 +29,11 @@ func GetTxCmd() *cobra.Command {
 		NewLockTokensCmd(),
 		NewBeginUnlockingCmd(),
 		NewBeginUnlockByIDCmd(),
+		NewSetAutoCompoundCmd(),
+		NewCancelUnlockingCmd(),
+		NewMergeLocksCmd(),
+		NewSplitLockCmd(),
+		NewTransferLockCmd(),
 	)
 
 	return cmd
@@ -154,3 +159,209 @@ func NewBeginUnlockByIDCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetAutoCompoundCmd sets whether the gauge rewards of a period lock are compounded into it.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [id] [true|false]",
+		Short: "set whether the gauge rewards of a period lock are compounded into it",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			autoCompound, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(
+				clientCtx.GetFromAddress(),
+				id,
+				autoCompound,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelUnlockingCmd moves the tokens of an unlocking period lock back to the locked state.
+func NewCancelUnlockingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unlocking [id]",
+		Short: "move the tokens of an unlocking period lock back to the locked state",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins := sdk.Coins(nil)
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			if amountStr != "" {
+				coins, err = sdk.ParseCoinsNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCancelUnlocking(
+				clientCtx.GetFromAddress(),
+				id,
+				coins,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAmount, "", "The amount to stop unlocking. e.g. 1osmo")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMergeLocksCmd merges period locks into the first of them.
+func NewMergeLocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-locks [id] [id]...",
+		Short: "merge period locks of the same denom and duration into the first of them",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			ids := make([]uint64, 0, len(args))
+			for _, arg := range args {
+				id, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, id)
+			}
+
+			msg := types.NewMsgMergeLocks(
+				clientCtx.GetFromAddress(),
+				ids,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSplitLockCmd splits tokens off a period lock into a new lock.
+func NewSplitLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-lock [id] [amount]",
+		Short: "split tokens off a period lock into a new lock",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSplitLock(
+				clientCtx.GetFromAddress(),
+				id,
+				coins,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTransferLockCmd transfers the ownership of a period lock to another account.
+func NewTransferLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock [id] [recipient]",
+		Short: "transfer the ownership of a period lock to another account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			transferSyntheticLockups, err := cmd.Flags().GetBool(FlagTransferSyntheticLockups)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferLock(
+				clientCtx.GetFromAddress(),
+				id,
+				recipient,
+				transferSyntheticLockups,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagTransferSyntheticLockups, false, "Transfer the synthetic lockups of the lock, like superfluid delegations, to the recipient too")
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
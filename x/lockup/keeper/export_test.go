@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/osmosis-labs/osmosis/v7/store"
 	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,3 +26,15 @@ func (k Keeper) SyntheticCoins(coins sdk.Coins, suffix string) sdk.Coins {
 func (k Keeper) GetCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
 	return k.getCoinsFromLocks(locks)
 }
+
+func (k Keeper) AccumulationStore(ctx sdk.Context, denom string) store.Tree {
+	return k.accumulationStore(ctx, denom)
+}
+
+func (k Keeper) SetLock(ctx sdk.Context, lock types.PeriodLock) error {
+	return k.setLock(ctx, lock)
+}
+
+func (k Keeper) DeleteLock(ctx sdk.Context, id uint64) {
+	k.deleteLock(ctx, id)
+}
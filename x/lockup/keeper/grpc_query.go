@@ -280,3 +280,28 @@ func (q Querier) LockedDenom(goCtx context.Context, req *types.LockedDenomReques
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	return &types.LockedDenomResponse{Amount: q.Keeper.GetLockedDenom(ctx, req.Denom, req.Duration)}, nil
 }
+
+// Invariants runs the lockup invariants, or the one of req.Route, and returns whether they are broken.
+func (q Querier) Invariants(goCtx context.Context, req *types.InvariantsRequest) (*types.InvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	invariants := Invariants(q.Keeper)
+
+	routes := InvariantRoutes
+	if req.Route != "" {
+		if _, ok := invariants[req.Route]; !ok {
+			return nil, status.Errorf(codes.NotFound, "invariant route %s not found", req.Route)
+		}
+		routes = []string{req.Route}
+	}
+
+	results := make([]types.InvariantResult, 0, len(routes))
+	for _, route := range routes {
+		msg, broken := invariants[route](ctx)
+		results = append(results, types.InvariantResult{Route: route, Broken: broken, Message: msg})
+	}
+	return &types.InvariantsResponse{Results: results}, nil
+}
@@ -1,36 +1,246 @@
 package keeper
 
-// DONTCOVER
-
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+)
+
+const (
+	moduleBalanceInvariantName     = "module-balance"
+	accumulationStoreInvariantName = "accumulation-store"
+	syntheticLockupInvariantName   = "synthetic-lockup-invariant"
+	lockRefsInvariantName          = "lock-refs"
 )
 
-// RegisterInvariants registers all governance invariants.
+// InvariantRoutes are the routes of the lockup invariants, in the order they are run.
+var InvariantRoutes = []string{
+	moduleBalanceInvariantName,
+	accumulationStoreInvariantName,
+	syntheticLockupInvariantName,
+	lockRefsInvariantName,
+}
+
+// Invariants returns the lockup invariants by route.
+func Invariants(keeper Keeper) map[string]sdk.Invariant {
+	return map[string]sdk.Invariant{
+		moduleBalanceInvariantName:     ModuleBalanceInvariant(keeper),
+		accumulationStoreInvariantName: AccumulationStoreInvariant(keeper),
+		syntheticLockupInvariantName:   SyntheticLockupInvariant(keeper),
+		lockRefsInvariantName:          LockRefsInvariant(keeper),
+	}
+}
+
+// RegisterInvariants registers all lockup invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
-	ir.RegisterRoute(types.ModuleName, "synthetic-lockup-invariant", SyntheticLockupInvariant(keeper))
+	invariants := Invariants(keeper)
+	for _, route := range InvariantRoutes {
+		ir.RegisterRoute(types.ModuleName, route, invariants[route])
+	}
+}
+
+// AllInvariants runs all invariants of the lockup module.
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		invariants := Invariants(keeper)
+		for _, route := range InvariantRoutes {
+			msg, broken := invariants[route](ctx)
+			if broken {
+				return msg, broken
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "all", "All lockup invariants passed"), false
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds at least the coins of all locks,
+// including the ones that finished unlocking but aren't withdrawn yet.
+func ModuleBalanceInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		locks, err := keeper.GetPeriodLocks(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, moduleBalanceInvariantName,
+				fmt.Sprintf("\tInvalid lock: %s\n", err)), true
+		}
+		lockedCoins := keeper.getCoinsFromLocks(locks)
+		balance := keeper.GetModuleBalance(ctx)
+		if !balance.IsAllGTE(lockedCoins) {
+			return sdk.FormatInvariant(types.ModuleName, moduleBalanceInvariantName,
+				fmt.Sprintf("\tModule balance: %s\n\tLocked coins: %s\n", balance, lockedCoins)), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, moduleBalanceInvariantName, "Module balance covers all locked coins"), false
+	}
 }
 
+// AccumulationStoreInvariant checks that the accumulation store of each denom has the amounts locked for each
+// duration, by native locks, and by synthetic lockups for synthetic denoms.
+func AccumulationStoreInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		locks, err := keeper.GetPeriodLocks(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, accumulationStoreInvariantName,
+				fmt.Sprintf("\tInvalid lock: %s\n", err)), true
+		}
+		amounts := make(map[string]map[time.Duration]sdk.Int)
+		addAmount := func(denom string, duration time.Duration, amount sdk.Int) {
+			if _, ok := amounts[denom]; !ok {
+				amounts[denom] = make(map[time.Duration]sdk.Int)
+			}
+			if _, ok := amounts[denom][duration]; !ok {
+				amounts[denom][duration] = sdk.ZeroInt()
+			}
+			amounts[denom][duration] = amounts[denom][duration].Add(amount)
+		}
+
+		locksByID := make(map[uint64]types.PeriodLock, len(locks))
+		for _, lock := range locks {
+			locksByID[lock.ID] = lock
+			for _, coin := range lock.Coins {
+				addAmount(coin.Denom, lock.Duration, coin.Amount)
+			}
+		}
+		for _, synthLock := range keeper.GetAllSyntheticLockups(ctx) {
+			lock, ok := locksByID[synthLock.UnderlyingLockId]
+			if !ok {
+				// reported by the synthetic lockup invariant
+				continue
+			}
+			coin, err := lock.SingleCoin()
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, accumulationStoreInvariantName,
+					fmt.Sprintf("\tSynthetic lock denom %s\n\tUnderlying lock ID %d has coins %s\n",
+						synthLock.SynthDenom, lock.ID, lock.Coins)), true
+			}
+			addAmount(synthLock.SynthDenom, synthLock.Duration, coin.Amount)
+		}
+
+		denoms := make([]string, 0, len(amounts))
+		for denom := range amounts {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+		for _, denom := range denoms {
+			accumulationStore := keeper.accumulationStore(ctx, denom)
+			total := sdk.ZeroInt()
+			for duration, amount := range amounts[denom] {
+				total = total.Add(amount)
+				stored := accumulationStore.Get(accumulationKey(duration))
+				if !stored.Equal(amount) {
+					return sdk.FormatInvariant(types.ModuleName, accumulationStoreInvariantName,
+						fmt.Sprintf("\tDenom %s, duration %s\n\tAccumulation store: %s\n\tLocked amount: %s\n",
+							denom, duration, stored, amount)), true
+				}
+			}
+			storedTotal := accumulationStore.SubsetAccumulation(accumulationKey(0), nil)
+			if !storedTotal.Equal(total) {
+				return sdk.FormatInvariant(types.ModuleName, accumulationStoreInvariantName,
+					fmt.Sprintf("\tDenom %s\n\tAccumulation store total: %s\n\tLocked amount: %s\n",
+						denom, storedTotal, total)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, accumulationStoreInvariantName, "Accumulation stores match the locked amounts"), false
+	}
+}
+
+// SyntheticLockupInvariant checks that every synthetic lockup has an existing underlying lock.
 func SyntheticLockupInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		synthlocks := keeper.GetAllSyntheticLockups(ctx)
 		for _, synthlock := range synthlocks {
 			baselock, err := keeper.GetLockByID(ctx, synthlock.UnderlyingLockId)
 			if err != nil {
-				panic(err)
+				return sdk.FormatInvariant(types.ModuleName, syntheticLockupInvariantName,
+					fmt.Sprintf("\tSynthetic lock denom %s\n\tUnderlying lock ID %d doesn't exist\n",
+						synthlock.SynthDenom, synthlock.UnderlyingLockId,
+					)), true
 			}
 			if baselock.ID != synthlock.UnderlyingLockId {
-				return sdk.FormatInvariant(types.ModuleName, "synthetic-lockup-invariant",
+				return sdk.FormatInvariant(types.ModuleName, syntheticLockupInvariantName,
 					fmt.Sprintf("\tSynthetic lock denom %s\n\tUnderlying lock ID: %d\n\tActual underying lock ID: %d\n",
 						synthlock.SynthDenom, synthlock.UnderlyingLockId, baselock.ID,
 					)), true
 			}
 		}
 
-		return sdk.FormatInvariant(types.ModuleName, "synthetic-lockup-invariant", "All synthetic lockup invariant passed"), false
+		return sdk.FormatInvariant(types.ModuleName, syntheticLockupInvariantName, "All synthetic lockup invariant passed"), false
+	}
+}
+
+// LockRefsInvariant checks that the lock refs in the NotUnlocking and Unlocking queues are exactly the ones
+// of the stored locks and synthetic lockups.
+func LockRefsInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]uint64)
+		addRefs := func(lockRefPrefix []byte, refKeys [][]byte, lockID uint64) {
+			for _, refKey := range refKeys {
+				key := combineKeys(combineKeys(lockRefPrefix, refKey), sdk.Uint64ToBigEndian(lockID))
+				expected[string(key)] = lockID
+			}
+		}
+
+		locks, err := keeper.GetPeriodLocks(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, lockRefsInvariantName,
+				fmt.Sprintf("\tInvalid lock: %s\n", err)), true
+		}
+		locksByID := make(map[uint64]types.PeriodLock, len(locks))
+		for _, lock := range locks {
+			locksByID[lock.ID] = lock
+			refKeys, err := durationLockRefKeys(lock)
+			if lock.IsUnlocking() {
+				refKeys, err = lockRefKeys(lock)
+			}
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, lockRefsInvariantName,
+					fmt.Sprintf("\tLock ID %d has invalid refs: %s\n", lock.ID, err)), true
+			}
+			addRefs(unlockingPrefix(lock.IsUnlocking()), refKeys, lock.ID)
+		}
+		for _, synthLock := range keeper.GetAllSyntheticLockups(ctx) {
+			lock, ok := locksByID[synthLock.UnderlyingLockId]
+			if !ok {
+				// reported by the synthetic lockup invariant
+				continue
+			}
+			refKeys, err := syntheticLockRefKeys(lock, synthLock)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, lockRefsInvariantName,
+					fmt.Sprintf("\tSynthetic lock denom %s of lock ID %d has invalid refs: %s\n", synthLock.SynthDenom, lock.ID, err)), true
+			}
+			addRefs(unlockingPrefix(synthLock.IsUnlocking()), refKeys, lock.ID)
+		}
+
+		store := ctx.KVStore(keeper.storeKey)
+		for _, lockRefPrefix := range [][]byte{types.KeyPrefixNotUnlocking, types.KeyPrefixUnlocking} {
+			iterator := sdk.KVStorePrefixIterator(store, lockRefPrefix)
+			for ; iterator.Valid(); iterator.Next() {
+				if _, ok := expected[string(iterator.Key())]; !ok {
+					lockID := sdk.BigEndianToUint64(iterator.Value())
+					iterator.Close()
+					return sdk.FormatInvariant(types.ModuleName, lockRefsInvariantName,
+						fmt.Sprintf("\tLock ref of lock ID %d doesn't match any lock\n", lockID)), true
+				}
+				delete(expected, string(iterator.Key()))
+			}
+			iterator.Close()
+		}
+
+		if len(expected) != 0 {
+			lockIDs := make([]uint64, 0, len(expected))
+			for _, lockID := range expected {
+				lockIDs = append(lockIDs, lockID)
+			}
+			sort.Slice(lockIDs, func(i, j int) bool { return lockIDs[i] < lockIDs[j] })
+			return sdk.FormatInvariant(types.ModuleName, lockRefsInvariantName,
+				fmt.Sprintf("\tLock ID %d is missing %d lock refs\n", lockIDs[0], len(expected))), true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, lockRefsInvariantName, "Lock refs match the locks"), false
 	}
 }
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) requireInvariants(broken bool) {
+	msg, isBroken := keeper.AllInvariants(*suite.App.LockupKeeper)(suite.Ctx)
+	suite.Require().Equal(broken, isBroken, msg)
+}
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("foo", 10))
+	suite.FundAcc(addr1, coins.Add(coins...).Add(coins...))
+	lock1, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coins, time.Second)
+	suite.Require().NoError(err)
+	lock2, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.Require().NoError(err)
+	lock3, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock2.ID, "synthstakestakedtovalidator1", time.Second, false)
+	suite.Require().NoError(err)
+	suite.requireInvariants(false)
+
+	// the invariants hold through the changes to locks
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock1.ID, sdk.Coins{sdk.NewInt64Coin("foo", 10)})
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, lock2.ID, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.TransferLock(suite.Ctx, lock2.ID, addr2, true)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock3.ID, nil)
+	suite.Require().NoError(err)
+	relock, err := suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, lock3.ID, sdk.Coins{sdk.NewInt64Coin("stake", 5)})
+	suite.Require().NoError(err)
+	suite.requireInvariants(false)
+
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second)))
+	suite.requireInvariants(false)
+
+	// a changed accumulation store breaks the invariants
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	suite.App.LockupKeeper.AccumulationStore(cacheCtx, "stake").Increase(sdk.Uint64ToBigEndian(uint64(time.Hour)), sdk.NewInt(1))
+	_, broken := keeper.AccumulationStoreInvariant(*suite.App.LockupKeeper)(cacheCtx)
+	suite.Require().True(broken)
+
+	// so does a lock with changed coins, or missing refs
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	lock, err := suite.App.LockupKeeper.GetLockByID(cacheCtx, relock.ID)
+	suite.Require().NoError(err)
+	lock.Coins = sdk.Coins{sdk.NewInt64Coin("foo", 100)}
+	err = suite.App.LockupKeeper.SetLock(cacheCtx, *lock)
+	suite.Require().NoError(err)
+	for _, invariant := range []sdk.Invariant{
+		keeper.ModuleBalanceInvariant(*suite.App.LockupKeeper),
+		keeper.AccumulationStoreInvariant(*suite.App.LockupKeeper),
+		keeper.LockRefsInvariant(*suite.App.LockupKeeper),
+	} {
+		_, broken = invariant(cacheCtx)
+		suite.Require().True(broken)
+	}
+
+	// and a synthetic lockup without an underlying lock
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(cacheCtx, relock.ID, "synthstakestakedtovalidator1", time.Second, false)
+	suite.Require().NoError(err)
+	suite.App.LockupKeeper.DeleteLock(cacheCtx, relock.ID)
+	_, broken = keeper.SyntheticLockupInvariant(*suite.App.LockupKeeper)(cacheCtx)
+	suite.Require().True(broken)
+
+	// the invariants can be queried
+	res, err := suite.querier.Invariants(sdk.WrapSDKContext(suite.Ctx), &types.InvariantsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, len(keeper.InvariantRoutes))
+	for _, result := range res.Results {
+		suite.Require().False(result.Broken, result.Message)
+	}
+	_, err = suite.querier.Invariants(sdk.WrapSDKContext(suite.Ctx), &types.InvariantsRequest{Route: "unknown"})
+	suite.Require().Error(err)
+}
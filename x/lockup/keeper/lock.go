@@ -36,7 +36,6 @@ func (k Keeper) accumulationStore(ctx sdk.Context, denom string) store.Tree {
 
 // GetModuleBalance Returns full balance of the module.
 func (k Keeper) GetModuleBalance(ctx sdk.Context) sdk.Coins {
-	// TODO: should add invariant test for module balance and lock items
 	acc := k.ak.GetModuleAccount(ctx, types.ModuleName)
 	return k.bk.GetAllBalances(ctx, acc.GetAddress())
 }
@@ -409,12 +408,18 @@ func (k Keeper) Lock(ctx sdk.Context, lock types.PeriodLock) error {
 }
 
 // splitLock splits a lock with the given amount, and stores split new lock to the state.
+// The split lock has the same duration and end time as the lock, but no lock refs, which the caller must add.
+// The refs of the lock are reset, as it can lose a denom by the split.
+// The synthetic lockups of the lock are copied to the split lock, with their refs.
+// The accumulation stores don't change, as the locked amounts of each denom and duration stay the same.
 func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (types.PeriodLock, error) {
-	if lock.IsUnlocking() {
-		return types.PeriodLock{}, fmt.Errorf("cannot split unlocking lock")
+	err := k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), lock)
+	if err != nil {
+		return types.PeriodLock{}, err
 	}
+
 	lock.Coins = lock.Coins.Sub(coins)
-	err := k.setLock(ctx, lock)
+	err = k.setLockAndResetLockRefs(ctx, lock)
 	if err != nil {
 		return types.PeriodLock{}, err
 	}
@@ -423,8 +428,192 @@ func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coin
 	k.SetLastLockID(ctx, splitLockID)
 
 	splitLock := types.NewPeriodLock(splitLockID, lock.OwnerAddress(), lock.Duration, lock.EndTime, coins)
+	splitLock.AutoCompound = lock.AutoCompound
 	err = k.setLock(ctx, splitLock)
-	return splitLock, err
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		synthLock.UnderlyingLockId = splitLock.ID
+		err = k.setSyntheticLockAndResetRefs(ctx, splitLock, synthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockSplit(ctx, lock.ID, splitLock.ID, coins)
+	}
+	return splitLock, nil
+}
+
+// SplitLock splits coins off a lock into a new lock of the same owner, with the same duration, end time
+// and synthetic lockups. coins must be part of the lock's coins, not all of them. Returns the new lock.
+func (k Keeper) SplitLock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if coins.Empty() || !coins.IsAllLTE(lock.Coins) || coins.IsEqual(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("split amount %s must be a part of the lock's coins %s", coins, lock.Coins)
+	}
+
+	splitLock, err := k.splitLock(ctx, *lock, coins)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	err = k.addLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	return splitLock, nil
+}
+
+// MergeLocks merges the coins of the locks into the first of lockIDs, and deletes the other locks.
+// The locks must not be unlocking, and must have the same owner, denoms, duration and synthetic lockups,
+// none of which may be unlocking. As the locked amounts of each denom and duration stay the same, so do
+// the accumulation stores, and the lock refs of the merged lock. Returns the merged lock.
+func (k Keeper) MergeLocks(ctx sdk.Context, lockIDs []uint64) (types.PeriodLock, error) {
+	if len(lockIDs) < 2 {
+		return types.PeriodLock{}, fmt.Errorf("at least two locks are needed to merge")
+	}
+
+	locks := make([]types.PeriodLock, 0, len(lockIDs))
+	seen := make(map[uint64]bool, len(lockIDs))
+	for _, lockID := range lockIDs {
+		if seen[lockID] {
+			return types.PeriodLock{}, fmt.Errorf("duplicate lock id %d", lockID)
+		}
+		seen[lockID] = true
+		lock, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		if lock.IsUnlocking() {
+			return types.PeriodLock{}, fmt.Errorf("cannot merge unlocking lock %d", lock.ID)
+		}
+		locks = append(locks, *lock)
+	}
+
+	lock := locks[0]
+	synthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)
+	for _, synthLock := range synthLocks {
+		if synthLock.IsUnlocking() {
+			return types.PeriodLock{}, fmt.Errorf("cannot merge lock %d with unlocking synthetic lockup", lock.ID)
+		}
+	}
+	for _, other := range locks[1:] {
+		if other.Owner != lock.Owner {
+			return types.PeriodLock{}, types.ErrNotLockOwner
+		}
+		if other.Duration != lock.Duration {
+			return types.PeriodLock{}, fmt.Errorf("lock %d has duration %s, not %s", other.ID, other.Duration, lock.Duration)
+		}
+		if !other.Coins.DenomsSubsetOf(lock.Coins) || !lock.Coins.DenomsSubsetOf(other.Coins) {
+			return types.PeriodLock{}, fmt.Errorf("lock %d has coins %s, with other denoms than %s", other.ID, other.Coins, lock.Coins)
+		}
+		if !sameSyntheticLockups(synthLocks, k.GetAllSyntheticLockupsByLockup(ctx, other.ID)) {
+			return types.PeriodLock{}, fmt.Errorf("lock %d has other synthetic lockups than lock %d", other.ID, lock.ID)
+		}
+	}
+
+	for _, other := range locks[1:] {
+		err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, other)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+		for _, synthLock := range synthLocks {
+			synthLock.UnderlyingLockId = other.ID
+			err = k.deleteSyntheticLockRefs(ctx, other, synthLock)
+			if err != nil {
+				return types.PeriodLock{}, err
+			}
+			k.deleteSyntheticLockupObject(ctx, other.ID, synthLock.SynthDenom)
+		}
+		k.deleteLock(ctx, other.ID)
+		lock.Coins = lock.Coins.Add(other.Coins...)
+	}
+
+	err := k.setLock(ctx, lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if k.hooks != nil {
+		for _, other := range locks[1:] {
+			k.hooks.OnLockMerged(ctx, lock.ID, other.ID, other.Coins)
+		}
+	}
+	return lock, nil
+}
+
+// sameSyntheticLockups returns whether both lists have synthetic lockups of the same synth denoms and durations.
+func sameSyntheticLockups(synthLocks, others []types.SyntheticLock) bool {
+	if len(synthLocks) != len(others) {
+		return false
+	}
+	durations := make(map[string]time.Duration, len(synthLocks))
+	for _, synthLock := range synthLocks {
+		durations[synthLock.SynthDenom] = synthLock.Duration
+	}
+	for _, other := range others {
+		duration, ok := durations[other.SynthDenom]
+		if !ok || duration != other.Duration || other.IsUnlocking() {
+			return false
+		}
+	}
+	return true
+}
+
+// TransferLock transfers the ownership of a lock that isn't unlocking to the recipient, moving the lock refs
+// under the recipient's account. Locks with synthetic lockups can only be transferred with transferSyntheticLockups,
+// in which case the synthetic lockups move to the recipient with the lock.
+// The accumulation stores don't change, as they aren't kept per account.
+func (k Keeper) TransferLock(ctx sdk.Context, lockID uint64, recipient sdk.AccAddress, transferSyntheticLockups bool) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if lock.IsUnlocking() {
+		return types.PeriodLock{}, fmt.Errorf("cannot transfer unlocking lock %d", lock.ID)
+	}
+	prevOwner := lock.OwnerAddress()
+	if prevOwner.Equals(recipient) {
+		return types.PeriodLock{}, fmt.Errorf("lock %d is already owned by %s", lock.ID, recipient)
+	}
+	synthLocks := k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)
+	if len(synthLocks) != 0 && !transferSyntheticLockups {
+		return types.PeriodLock{}, fmt.Errorf("cannot transfer lock %d with synthetic lockup without transferring it too", lock.ID)
+	}
+
+	err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	for _, synthLock := range synthLocks {
+		err = k.deleteSyntheticLockRefs(ctx, *lock, synthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	lock.Owner = recipient.String()
+	err = k.setLockAndResetLockRefs(ctx, *lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	for _, synthLock := range synthLocks {
+		err = k.addSyntheticLockRefs(ctx, *lock, synthLock)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLockTransferred(ctx, lock.ID, prevOwner, recipient)
+	}
+	return *lock, nil
 }
 
 // BeginUnlock is a utility to start unlocking coins from NotUnlocking queue.
@@ -460,6 +649,9 @@ func (k Keeper) beginForceUnlock(ctx sdk.Context, lock types.PeriodLock, coins s
 	// Otherwise, split the lock into two locks, and fully unlock the newly created lock.
 	// (By virtue, the newly created lock we split into should have the unlock amount)
 	if len(coins) != 0 && !coins.IsEqual(lock.Coins) {
+		if lock.IsUnlocking() {
+			return fmt.Errorf("cannot split unlocking lock")
+		}
 		splitLock, err := k.splitLock(ctx, lock, coins)
 		if err != nil {
 			return err
@@ -493,6 +685,60 @@ func (k Keeper) beginForceUnlock(ctx sdk.Context, lock types.PeriodLock, coins s
 	return nil
 }
 
+// CancelUnlocking moves coins of an unlocking lock back to the locked state, with the lock's duration.
+// If coins is empty or all of the lock's coins, the whole lock stops unlocking. Otherwise the lock is split,
+// and the split lock holding coins stops unlocking while the lock keeps unlocking the rest.
+// Unlocking locks stay in the accumulation store, so it doesn't change. Returns the lock that stopped unlocking.
+// The lock's synthetic lockups must be unlocking, like superfluid unbonding ones, and they keep unlocking
+// until their own end time, so that the lock stays slashable for them until then.
+func (k Keeper) CancelUnlocking(ctx sdk.Context, lockID uint64, coins sdk.Coins) (types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	if !lock.IsUnlocking() {
+		return types.PeriodLock{}, fmt.Errorf("lock %d is not unlocking", lock.ID)
+	}
+	if !ctx.BlockTime().Before(lock.EndTime) {
+		return types.PeriodLock{}, fmt.Errorf("lock %d has already finished unlocking", lock.ID)
+	}
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		if !synthLock.IsUnlocking() {
+			return types.PeriodLock{}, fmt.Errorf("cannot cancel unlocking of a lock with bonded synthetic lockup %s", synthLock.SynthDenom)
+		}
+	}
+	if !coins.IsAllLTE(lock.Coins) {
+		return types.PeriodLock{}, fmt.Errorf("requested amount to cancel unlocking exceeds unlocking tokens")
+	}
+
+	relock := *lock
+	if len(coins) != 0 && !coins.IsEqual(lock.Coins) {
+		relock, err = k.splitLock(ctx, *lock, coins)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	// remove lock refs from unlocking queue if exists
+	err = k.deleteLockRefs(ctx, types.KeyPrefixUnlocking, relock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// store lock with end time unset
+	unlockTime := relock.EndTime
+	relock.EndTime = time.Time{}
+	err = k.setLockAndResetLockRefs(ctx, relock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnCancelUnlocking(ctx, relock.OwnerAddress(), relock.ID, relock.Coins, relock.Duration, unlockTime)
+	}
+	return relock, nil
+}
+
 // Unlock is a utility to unlock coins from module account.
 func (k Keeper) Unlock(ctx sdk.Context, lockID uint64) error {
 	lock, err := k.GetLockByID(ctx, lockID)
@@ -603,3 +849,21 @@ func (k Keeper) ExtendLockup(ctx sdk.Context, lock types.PeriodLock, newDuration
 
 	return nil
 }
+
+// SetLockAutoCompound sets whether the gauge rewards of a lock are joined into the pool of its shares,
+// and added to the lock at the end of each distribution epoch.
+func (k Keeper) SetLockAutoCompound(ctx sdk.Context, lock types.PeriodLock, autoCompound bool) error {
+	if lock.IsUnlocking() {
+		return fmt.Errorf("cannot edit unlocking lockup for lock %d", lock.ID)
+	}
+
+	lock.AutoCompound = autoCompound
+	if err := k.setLock(ctx, lock); err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnSetLockAutoCompound(ctx, lock.ID, autoCompound)
+	}
+	return nil
+}
@@ -265,6 +265,257 @@ func (suite *KeeperTestSuite) TestPartialUnlock() {
 	suite.Require().Equal(locked[0].Amount.Int64(), int64(9))
 }
 
+func (suite *KeeperTestSuite) TestCancelUnlocking() {
+	suite.SetupTest()
+	now := suite.Ctx.BlockTime()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	// lock with balance
+	suite.FundAcc(addr1, coins)
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coins, time.Second)
+	suite.Require().NoError(err)
+
+	// a lock that isn't unlocking can't cancel unlocking
+	_, err = suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, lock.ID, nil)
+	suite.Require().Error(err)
+
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, suite.App.LockupKeeper.GetAccountUnlockingCoins(suite.Ctx, addr1))
+
+	// test exceeding coins
+	_, err = suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, lock.ID, sdk.Coins{sdk.NewInt64Coin("stake", 15)})
+	suite.Require().Error(err)
+
+	// cancel unlocking a partial amount, which is split into a new lock
+	relock, err := suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, lock.ID, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(lock.ID, relock.ID)
+	suite.Require().False(relock.IsUnlocking())
+	suite.Require().Equal(time.Second, relock.Duration)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, suite.App.LockupKeeper.GetAccountUnlockingCoins(suite.Ctx, addr1))
+	suite.Require().Equal([]uint64{relock.ID}, lockIDs(suite.App.LockupKeeper.GetAccountLockedLongerDurationNotUnlockingOnly(suite.Ctx, addr1, time.Second)))
+
+	// the accumulation store still counts all the coins
+	accum := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Second,
+	})
+	suite.Require().Equal(int64(10), accum.Int64())
+
+	// cancel unlocking the rest
+	relock, err = suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, lock.ID, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lock.ID, relock.ID)
+	suite.Require().True(suite.App.LockupKeeper.GetAccountUnlockingCoins(suite.Ctx, addr1).Empty())
+	suite.Require().Len(suite.App.LockupKeeper.GetAccountLockedLongerDurationNotUnlockingOnly(suite.Ctx, addr1, time.Second), 2)
+
+	// nothing gets unlocked after the unlocking period
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx.WithBlockTime(now.Add(time.Second)))
+	suite.Require().Equal(coins, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr1))
+
+	// a lock that finished unlocking can't cancel unlocking
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.CancelUnlocking(suite.Ctx.WithBlockTime(now.Add(time.Second)), lock.ID, nil)
+	suite.Require().Error(err)
+
+	// a lock with a bonded synthetic lockup can't cancel unlocking
+	suite.FundAcc(addr1, coins)
+	synthLock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coins, time.Second)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, synthLock.ID, nil)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, synthLock.ID, "synthstakestakedtovalidator", time.Second, false)
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, synthLock.ID, nil)
+	suite.Require().Error(err)
+
+	// while the unlocking synthetic lockups of a lock keep unlocking until their end time
+	err = suite.App.LockupKeeper.DeleteSyntheticLockup(suite.Ctx, synthLock.ID, "synthstakestakedtovalidator")
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, synthLock.ID, "synthstakeunstakingfromvalidator", time.Second, true)
+	suite.Require().NoError(err)
+	relock, err = suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, synthLock.ID, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().NoError(err)
+	suite.Require().False(relock.IsUnlocking())
+	for _, lockID := range []uint64{synthLock.ID, relock.ID} {
+		synthLocks := suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lockID)
+		suite.Require().Len(synthLocks, 1)
+		suite.Require().Equal(now.Add(time.Second), synthLocks[0].EndTime)
+	}
+	suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx.WithBlockTime(now.Add(time.Second)))
+	suite.Require().False(suite.App.LockupKeeper.HasAnySyntheticLockups(suite.Ctx, relock.ID))
+	storedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, relock.ID)
+	suite.Require().NoError(err)
+	suite.Require().False(storedLock.IsUnlocking())
+}
+
+func (suite *KeeperTestSuite) TestSplitLock() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.FundAcc(addr1, coins)
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coins, time.Second)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock.ID, "synthstakestakedtovalidator1", time.Second, false)
+	suite.Require().NoError(err)
+
+	// the split amount must be a part of the lock's coins
+	for _, amount := range []sdk.Coins{nil, coins, {sdk.NewInt64Coin("stake", 15)}, {sdk.NewInt64Coin("foo", 1)}} {
+		_, err = suite.App.LockupKeeper.SplitLock(suite.Ctx, lock.ID, amount)
+		suite.Require().Error(err)
+	}
+
+	splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, lock.ID, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 4)}, splitLock.Coins)
+	suite.Require().Equal(time.Second, splitLock.Duration)
+	suite.Require().False(splitLock.IsUnlocking())
+
+	storedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, storedLock.Coins)
+	suite.Require().Equal([]uint64{lock.ID, splitLock.ID}, lockIDs(suite.App.LockupKeeper.GetAccountLockedLongerDurationNotUnlockingOnly(suite.Ctx, addr1, time.Second)))
+
+	// the synthetic lockup moves with the split coins
+	synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, splitLock.ID, "synthstakestakedtovalidator1")
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Second, synthLock.Duration)
+	suite.Require().Equal([]uint64{lock.ID, splitLock.ID}, lockIDs(suite.App.LockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, "synthstakestakedtovalidator1", time.Second)))
+
+	// the accumulation stores don't change
+	for _, denom := range []string{"stake", "synthstakestakedtovalidator1"} {
+		accum := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+			LockQueryType: types.ByDuration,
+			Denom:         denom,
+			Duration:      time.Second,
+		})
+		suite.Require().Equal(int64(10), accum.Int64())
+	}
+}
+
+func (suite *KeeperTestSuite) TestMergeLocks() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	createLock := func(addr sdk.AccAddress, coin sdk.Coin, duration time.Duration) types.PeriodLock {
+		suite.FundAcc(addr, sdk.Coins{coin})
+		lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr, sdk.Coins{coin}, duration)
+		suite.Require().NoError(err)
+		return lock
+	}
+	lock1 := createLock(addr1, sdk.NewInt64Coin("stake", 10), time.Second)
+	lock2 := createLock(addr1, sdk.NewInt64Coin("stake", 20), time.Second)
+	lock3 := createLock(addr1, sdk.NewInt64Coin("stake", 30), time.Second)
+	otherOwner := createLock(addr2, sdk.NewInt64Coin("stake", 10), time.Second)
+	otherDuration := createLock(addr1, sdk.NewInt64Coin("stake", 10), time.Minute)
+	otherDenom := createLock(addr1, sdk.NewInt64Coin("foo", 10), time.Second)
+	unlocking := createLock(addr1, sdk.NewInt64Coin("stake", 10), time.Second)
+	err := suite.App.LockupKeeper.BeginUnlock(suite.Ctx, unlocking.ID, nil)
+	suite.Require().NoError(err)
+	synthetic := createLock(addr1, sdk.NewInt64Coin("stake", 10), time.Second)
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, synthetic.ID, "synthstakestakedtovalidator1", time.Second, false)
+	suite.Require().NoError(err)
+
+	for _, other := range []types.PeriodLock{lock1, otherOwner, otherDuration, otherDenom, unlocking, synthetic} {
+		_, err = suite.App.LockupKeeper.MergeLocks(suite.Ctx, []uint64{lock1.ID, lock2.ID, other.ID})
+		suite.Require().Error(err)
+	}
+
+	lock, err := suite.App.LockupKeeper.MergeLocks(suite.Ctx, []uint64{lock2.ID, lock1.ID, lock3.ID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(lock2.ID, lock.ID)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 60)}, lock.Coins)
+
+	for _, lockID := range []uint64{lock1.ID, lock3.ID} {
+		_, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lockID)
+		suite.Require().Error(err)
+	}
+	locks := suite.App.LockupKeeper.GetAccountLockedLongerDurationDenomNotUnlockingOnly(suite.Ctx, addr1, "stake", time.Second)
+	suite.Require().Equal([]uint64{lock2.ID, synthetic.ID, otherDuration.ID}, lockIDs(locks))
+	accum := suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Second,
+	})
+	suite.Require().Equal(int64(100), accum.Int64())
+
+	// locks with the same synthetic lockups merge with them
+	synthetic2 := createLock(addr1, sdk.NewInt64Coin("stake", 5), time.Second)
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, synthetic2.ID, "synthstakestakedtovalidator1", time.Second, false)
+	suite.Require().NoError(err)
+	lock, err = suite.App.LockupKeeper.MergeLocks(suite.Ctx, []uint64{synthetic.ID, synthetic2.ID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 15)}, lock.Coins)
+	suite.Require().Len(suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, synthetic2.ID), 0)
+	suite.Require().Equal([]uint64{synthetic.ID}, lockIDs(suite.App.LockupKeeper.GetLocksLongerThanDurationDenom(suite.Ctx, "synthstakestakedtovalidator1", time.Second)))
+	accum = suite.App.LockupKeeper.GetPeriodLocksAccumulation(suite.Ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "synthstakestakedtovalidator1",
+		Duration:      time.Second,
+	})
+	suite.Require().Equal(int64(15), accum.Int64())
+}
+
+func (suite *KeeperTestSuite) TestTransferLock() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.FundAcc(addr1, coins.Add(coins...))
+	lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coins, time.Second)
+	suite.Require().NoError(err)
+
+	// a lock can't be transferred to its owner
+	_, err = suite.App.LockupKeeper.TransferLock(suite.Ctx, lock.ID, addr1, false)
+	suite.Require().Error(err)
+
+	transferred, err := suite.App.LockupKeeper.TransferLock(suite.Ctx, lock.ID, addr2, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2.String(), transferred.Owner)
+	suite.Require().Empty(suite.App.LockupKeeper.GetAccountPeriodLocks(suite.Ctx, addr1))
+	suite.Require().Equal([]uint64{lock.ID}, lockIDs(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr2, "stake", time.Second)))
+	suite.Require().Equal(coins, suite.App.LockupKeeper.GetAccountLockedCoins(suite.Ctx, addr2))
+
+	// locks with synthetic lockups are only transferred with them
+	synthetic, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addr1, coins, time.Minute)
+	suite.Require().NoError(err)
+	err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, synthetic.ID, "synthstakestakedtovalidator1", time.Second, false)
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.TransferLock(suite.Ctx, synthetic.ID, addr2, false)
+	suite.Require().Error(err)
+	_, err = suite.App.LockupKeeper.TransferLock(suite.Ctx, synthetic.ID, addr2, true)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr1, "synthstakestakedtovalidator1", time.Second))
+	suite.Require().Equal([]uint64{synthetic.ID}, lockIDs(suite.App.LockupKeeper.GetAccountLockedLongerDurationDenom(suite.Ctx, addr2, "synthstakestakedtovalidator1", time.Second)))
+
+	// unlocking locks can't be transferred
+	err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+	suite.Require().NoError(err)
+	_, err = suite.App.LockupKeeper.TransferLock(suite.Ctx, lock.ID, addr1, false)
+	suite.Require().Error(err)
+
+	// the new owner gets the coins when the lock is unlocked
+	err = suite.App.LockupKeeper.Unlock(suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(time.Second)), lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins, suite.App.BankKeeper.GetAllBalances(suite.Ctx, addr2))
+}
+
+func lockIDs(locks []types.PeriodLock) []uint64 {
+	ids := []uint64{}
+	for _, lock := range locks {
+		ids = append(ids, lock.ID)
+	}
+	return ids
+}
+
 func (suite *KeeperTestSuite) TestModuleLockedCoins() {
 	suite.SetupTest()
 
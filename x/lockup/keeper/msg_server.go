@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/osmosis-labs/osmosis/v7/x/gamm/utils"
 	"github.com/osmosis-labs/osmosis/v7/x/lockup/types"
@@ -167,3 +169,164 @@ func (server msgServer) ExtendLockup(goCtx context.Context, msg *types.MsgExtend
 
 	return &types.MsgExtendLockupResponse{}, nil
 }
+
+func (server msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lock, err := server.keeper.GetLockByID(ctx, msg.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Owner != lock.Owner {
+		return nil, sdkerrors.Wrapf(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
+	}
+
+	err = server.keeper.SetLockAutoCompound(ctx, *lock, msg.AutoCompound)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetAutoCompound,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributeAutoCompound, strconv.FormatBool(msg.AutoCompound)),
+		),
+	})
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (server msgServer) CancelUnlocking(goCtx context.Context, msg *types.MsgCancelUnlocking) (*types.MsgCancelUnlockingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lock, err := server.keeper.GetLockByID(ctx, msg.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Owner != lock.Owner {
+		return nil, sdkerrors.Wrapf(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
+	}
+
+	relock, err := server.keeper.CancelUnlocking(ctx, lock.ID, msg.Coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelUnlocking,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(relock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, relock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, relock.Coins.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, relock.Duration.String()),
+		),
+	})
+
+	return &types.MsgCancelUnlockingResponse{ID: relock.ID}, nil
+}
+
+func (server msgServer) MergeLocks(goCtx context.Context, msg *types.MsgMergeLocks) (*types.MsgMergeLocksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, lockID := range msg.LockIds {
+		lock, err := server.keeper.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		if msg.Owner != lock.Owner {
+			return nil, sdkerrors.Wrapf(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
+		}
+	}
+
+	lock, err := server.keeper.MergeLocks(ctx, msg.LockIds)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	mergedLockIDs := make([]string, 0, len(msg.LockIds)-1)
+	for _, lockID := range msg.LockIds[1:] {
+		mergedLockIDs = append(mergedLockIDs, utils.Uint64ToString(lockID))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtMergeLocks,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
+			sdk.NewAttribute(types.AttributeMergedLockIDs, strings.Join(mergedLockIDs, ",")),
+		),
+	})
+
+	return &types.MsgMergeLocksResponse{ID: lock.ID}, nil
+}
+
+func (server msgServer) SplitLock(goCtx context.Context, msg *types.MsgSplitLock) (*types.MsgSplitLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lock, err := server.keeper.GetLockByID(ctx, msg.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Owner != lock.Owner {
+		return nil, sdkerrors.Wrapf(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
+	}
+
+	splitLock, err := server.keeper.SplitLock(ctx, lock.ID, msg.Coins)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSplitLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributeSplitLockID, utils.Uint64ToString(splitLock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockAmount, splitLock.Coins.String()),
+		),
+	})
+
+	return &types.MsgSplitLockResponse{ID: splitLock.ID}, nil
+}
+
+func (server msgServer) TransferLock(goCtx context.Context, msg *types.MsgTransferLock) (*types.MsgTransferLockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lock, err := server.keeper.GetLockByID(ctx, msg.ID)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.Owner != lock.Owner {
+		return nil, sdkerrors.Wrapf(types.ErrNotLockOwner, fmt.Sprintf("msg sender (%s) and lock owner (%s) does not match", msg.Owner, lock.Owner))
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = server.keeper.TransferLock(ctx, lock.ID, recipient, msg.TransferSyntheticLockups)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTransferLock,
+			sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributeRecipient, msg.Recipient),
+		),
+	})
+
+	return &types.MsgTransferLockResponse{}, nil
+}
@@ -327,3 +327,280 @@ func (suite *KeeperTestSuite) TestMsgEditLockup() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgSetAutoCompound() {
+	tests := []struct {
+		name         string
+		sender       sdk.AccAddress
+		isUnlocking  bool
+		autoCompound bool
+		expectPass   bool
+	}{
+		{
+			name:         "enable auto compounding",
+			sender:       sdk.AccAddress([]byte("addr1---------------")),
+			autoCompound: true,
+			expectPass:   true,
+		},
+		{
+			name:         "disable auto compounding",
+			sender:       sdk.AccAddress([]byte("addr1---------------")),
+			autoCompound: false,
+			expectPass:   true,
+		},
+		{
+			name:         "disallow sender other than lock owner",
+			sender:       sdk.AccAddress([]byte("addr2---------------")),
+			autoCompound: true,
+			expectPass:   false,
+		},
+		{
+			name:         "disallow unlocking lock",
+			sender:       sdk.AccAddress([]byte("addr1---------------")),
+			isUnlocking:  true,
+			autoCompound: true,
+			expectPass:   false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		owner := sdk.AccAddress([]byte("addr1---------------"))
+		coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, owner, coins)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(owner, time.Second, coins))
+		suite.Require().NoError(err)
+
+		if test.isUnlocking {
+			_, err = msgServer.BeginUnlocking(c, types.NewMsgBeginUnlocking(owner, resp.ID, nil))
+			suite.Require().NoError(err)
+		}
+
+		_, err = msgServer.SetAutoCompound(c, types.NewMsgSetAutoCompound(test.sender, resp.ID, test.autoCompound))
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(test.autoCompound, lock.AutoCompound, test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCancelUnlocking() {
+	tests := []struct {
+		name          string
+		sender        sdk.AccAddress
+		isUnlocking   bool
+		coins         sdk.Coins
+		expectNewLock bool
+		expectPass    bool
+	}{
+		{
+			name:        "cancel unlocking all coins",
+			sender:      sdk.AccAddress([]byte("addr1---------------")),
+			isUnlocking: true,
+			expectPass:  true,
+		},
+		{
+			name:          "cancel unlocking part of the coins",
+			sender:        sdk.AccAddress([]byte("addr1---------------")),
+			isUnlocking:   true,
+			coins:         sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectNewLock: true,
+			expectPass:    true,
+		},
+		{
+			name:        "disallow sender other than lock owner",
+			sender:      sdk.AccAddress([]byte("addr2---------------")),
+			isUnlocking: true,
+			expectPass:  false,
+		},
+		{
+			name:       "disallow lock that isn't unlocking",
+			sender:     sdk.AccAddress([]byte("addr1---------------")),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		owner := sdk.AccAddress([]byte("addr1---------------"))
+		coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, owner, coins)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(owner, time.Second, coins))
+		suite.Require().NoError(err)
+
+		if test.isUnlocking {
+			_, err = msgServer.BeginUnlocking(c, types.NewMsgBeginUnlocking(owner, resp.ID, nil))
+			suite.Require().NoError(err)
+		}
+
+		cancelResp, err := msgServer.CancelUnlocking(c, types.NewMsgCancelUnlocking(test.sender, resp.ID, test.coins))
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().Equal(test.expectNewLock, cancelResp.ID != resp.ID, test.name)
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, cancelResp.ID)
+			suite.Require().NoError(err)
+			suite.Require().False(lock.IsUnlocking(), test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgMergeLocks() {
+	tests := []struct {
+		name       string
+		sender     sdk.AccAddress
+		expectPass bool
+	}{
+		{
+			name:       "merge locks of the sender",
+			sender:     sdk.AccAddress([]byte("addr1---------------")),
+			expectPass: true,
+		},
+		{
+			name:       "disallow sender other than lock owner",
+			sender:     sdk.AccAddress([]byte("addr2---------------")),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		owner := sdk.AccAddress([]byte("addr1---------------"))
+		coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, owner, coins.Add(coins...))
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		resp1, err := msgServer.LockTokens(c, types.NewMsgLockTokens(owner, time.Second, coins))
+		suite.Require().NoError(err)
+		// locking tokens again would add them to the first lock, so create the second one directly
+		lock2, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, owner, coins, time.Second)
+		suite.Require().NoError(err)
+
+		mergeResp, err := msgServer.MergeLocks(c, types.NewMsgMergeLocks(test.sender, []uint64{resp1.ID, lock2.ID}))
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().Equal(resp1.ID, mergeResp.ID, test.name)
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, mergeResp.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(coins.Add(coins...), lock.Coins, test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSplitLock() {
+	tests := []struct {
+		name       string
+		sender     sdk.AccAddress
+		coins      sdk.Coins
+		expectPass bool
+	}{
+		{
+			name:       "split part of the coins",
+			sender:     sdk.AccAddress([]byte("addr1---------------")),
+			coins:      sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectPass: true,
+		},
+		{
+			name:       "disallow splitting all of the coins",
+			sender:     sdk.AccAddress([]byte("addr1---------------")),
+			coins:      sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+			expectPass: false,
+		},
+		{
+			name:       "disallow sender other than lock owner",
+			sender:     sdk.AccAddress([]byte("addr2---------------")),
+			coins:      sdk.Coins{sdk.NewInt64Coin("stake", 4)},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		owner := sdk.AccAddress([]byte("addr1---------------"))
+		coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, owner, coins)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(owner, time.Second, coins))
+		suite.Require().NoError(err)
+
+		splitResp, err := msgServer.SplitLock(c, types.NewMsgSplitLock(test.sender, resp.ID, test.coins))
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().NotEqual(resp.ID, splitResp.ID, test.name)
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitResp.ID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(test.coins, lock.Coins, test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgTransferLock() {
+	tests := []struct {
+		name       string
+		sender     sdk.AccAddress
+		expectPass bool
+	}{
+		{
+			name:       "transfer lock of the sender",
+			sender:     sdk.AccAddress([]byte("addr1---------------")),
+			expectPass: true,
+		},
+		{
+			name:       "disallow sender other than lock owner",
+			sender:     sdk.AccAddress([]byte("addr2---------------")),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		owner := sdk.AccAddress([]byte("addr1---------------"))
+		recipient := sdk.AccAddress([]byte("addr3---------------"))
+		coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		err := simapp.FundAccount(suite.App.BankKeeper, suite.Ctx, owner, coins)
+		suite.Require().NoError(err)
+
+		msgServer := keeper.NewMsgServerImpl(suite.App.LockupKeeper)
+		c := sdk.WrapSDKContext(suite.Ctx)
+		resp, err := msgServer.LockTokens(c, types.NewMsgLockTokens(owner, time.Second, coins))
+		suite.Require().NoError(err)
+
+		_, err = msgServer.TransferLock(c, types.NewMsgTransferLock(test.sender, resp.ID, recipient, false))
+		lock, lockErr := suite.App.LockupKeeper.GetLockByID(suite.Ctx, resp.ID)
+		suite.Require().NoError(lockErr)
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().Equal(recipient.String(), lock.Owner, test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+			suite.Require().Equal(owner.String(), lock.Owner, test.name)
+		}
+	}
+}
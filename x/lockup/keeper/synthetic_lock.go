@@ -150,6 +150,10 @@ func (k Keeper) CreateSyntheticLockup(ctx sdk.Context, lockID uint64, synthDenom
 	}
 
 	k.accumulationStore(ctx, synthLock.SynthDenom).Increase(accumulationKey(unlockDuration), coin.Amount)
+
+	if k.hooks != nil {
+		k.hooks.OnSyntheticLockupCreated(ctx, lockID, synthDenom)
+	}
 	return nil
 }
 
@@ -181,7 +185,11 @@ func (k Keeper) DeleteSyntheticLockup(ctx sdk.Context, lockID uint64, synthdenom
 	if err != nil {
 		return err
 	}
-	k.accumulationStore(ctx, synthLock.SynthDenom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+	k.accumulationStore(ctx, synthLock.SynthDenom).Decrease(accumulationKey(synthLock.Duration), coin.Amount)
+
+	if k.hooks != nil {
+		k.hooks.OnSyntheticLockupDeleted(ctx, lockID, synthdenom)
+	}
 	return nil
 }
 
@@ -11,6 +11,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLockTokens{}, "osmosis/lockup/lock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlockingAll{}, "osmosis/lockup/begin-unlock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlocking{}, "osmosis/lockup/begin-unlock-period-lock", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "osmosis/lockup/set-auto-compound", nil)
+	cdc.RegisterConcrete(&MsgCancelUnlocking{}, "osmosis/lockup/cancel-unlocking", nil)
+	cdc.RegisterConcrete(&MsgMergeLocks{}, "osmosis/lockup/merge-locks", nil)
+	cdc.RegisterConcrete(&MsgSplitLock{}, "osmosis/lockup/split-lock", nil)
+	cdc.RegisterConcrete(&MsgTransferLock{}, "osmosis/lockup/transfer-lock", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,6 +24,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgLockTokens{},
 		&MsgBeginUnlockingAll{},
 		&MsgBeginUnlocking{},
+		&MsgSetAutoCompound{},
+		&MsgCancelUnlocking{},
+		&MsgMergeLocks{},
+		&MsgSplitLock{},
+		&MsgTransferLock{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
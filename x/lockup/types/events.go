@@ -6,6 +6,11 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtSetAutoCompound = "set_auto_compound"
+	TypeEvtCancelUnlocking = "cancel_unlocking"
+	TypeEvtMergeLocks      = "merge_locks"
+	TypeEvtSplitLock       = "split_lock"
+	TypeEvtTransferLock    = "transfer_lock"
 
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
@@ -13,4 +18,8 @@ const (
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributeAutoCompound         = "auto_compound"
+	AttributeMergedLockIDs        = "merged_lock_ids"
+	AttributeSplitLockID          = "split_lock_id"
+	AttributeRecipient            = "recipient"
 )
@@ -10,9 +10,16 @@ type LockupHooks interface {
 	AfterAddTokensToLock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins)
 	OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
+	OnCancelUnlocking(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 	OnLockupExtend(ctx sdk.Context, lockID uint64, prevDuration time.Duration, newDuration time.Duration)
+	OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins)
+	OnLockMerged(ctx sdk.Context, lockID uint64, mergedLockID uint64, amount sdk.Coins)
+	OnLockTransferred(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress)
+	OnSetLockAutoCompound(ctx sdk.Context, lockID uint64, autoCompound bool)
+	OnSyntheticLockupCreated(ctx sdk.Context, lockID uint64, synthDenom string)
+	OnSyntheticLockupDeleted(ctx sdk.Context, lockID uint64, synthDenom string)
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -42,6 +49,12 @@ func (h MultiLockupHooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress,
 	}
 }
 
+func (h MultiLockupHooks) OnCancelUnlocking(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+	for i := range h {
+		h[i].OnCancelUnlocking(ctx, address, lockID, amount, lockDuration, unlockTime)
+	}
+}
+
 func (h MultiLockupHooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
 	for i := range h {
 		h[i].OnTokenUnlocked(ctx, address, lockID, amount, lockDuration, unlockTime)
@@ -59,3 +72,39 @@ func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, prevDur
 		h[i].OnLockupExtend(ctx, lockID, prevDuration, newDuration)
 	}
 }
+
+func (h MultiLockupHooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockSplit(ctx, lockID, splitLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) OnLockMerged(ctx sdk.Context, lockID uint64, mergedLockID uint64, amount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockMerged(ctx, lockID, mergedLockID, amount)
+	}
+}
+
+func (h MultiLockupHooks) OnLockTransferred(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress) {
+	for i := range h {
+		h[i].OnLockTransferred(ctx, lockID, prevOwner, newOwner)
+	}
+}
+
+func (h MultiLockupHooks) OnSetLockAutoCompound(ctx sdk.Context, lockID uint64, autoCompound bool) {
+	for i := range h {
+		h[i].OnSetLockAutoCompound(ctx, lockID, autoCompound)
+	}
+}
+
+func (h MultiLockupHooks) OnSyntheticLockupCreated(ctx sdk.Context, lockID uint64, synthDenom string) {
+	for i := range h {
+		h[i].OnSyntheticLockupCreated(ctx, lockID, synthDenom)
+	}
+}
+
+func (h MultiLockupHooks) OnSyntheticLockupDeleted(ctx sdk.Context, lockID uint64, synthDenom string) {
+	for i := range h {
+		h[i].OnSyntheticLockupDeleted(ctx, lockID, synthDenom)
+	}
+}
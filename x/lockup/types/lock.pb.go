@@ -66,6 +66,9 @@ type PeriodLock struct {
 	Duration time.Duration                            `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
 	EndTime  time.Time                                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	Coins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// auto_compound is set when the gauge rewards of the lock are joined into
+	// the pool of its shares, and added to the lock.
+	AutoCompound bool `protobuf:"varint,6,opt,name=auto_compound,json=autoCompound,proto3" json:"auto_compound,omitempty" yaml:"auto_compound"`
 }
 
 func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
@@ -136,6 +139,13 @@ func (m *PeriodLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *PeriodLock) GetAutoCompound() bool {
+	if m != nil {
+		return m.AutoCompound
+	}
+	return false
+}
+
 type QueryCondition struct {
 	// type of lock query, ByLockDuration | ByLockTime
 	LockQueryType LockQueryType `protobuf:"varint,1,opt,name=lock_query_type,json=lockQueryType,proto3,enum=osmosis.lockup.LockQueryType" json:"lock_query_type,omitempty"`
@@ -303,45 +313,47 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
+	// 627 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x9d, 0xa4, 0xb4, 0x57, 0x92, 0x46, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
-	0xd4, 0xda, 0xa4, 0x0c, 0x48, 0x8c, 0x6e, 0x18, 0x22, 0x18, 0xc0, 0x54, 0x0c, 0x2c, 0x91, 0x7f,
-	0x1c, 0xce, 0x29, 0xb6, 0xcf, 0xf8, 0x47, 0xc1, 0xff, 0x01, 0x63, 0x47, 0x90, 0xd8, 0xd8, 0xf8,
-	0x4b, 0x3a, 0x76, 0x64, 0x4a, 0x51, 0x22, 0x16, 0xc6, 0xfe, 0x05, 0xe8, 0xee, 0x7c, 0x49, 0x5a,
-	0x84, 0xd4, 0x01, 0xa6, 0xf3, 0xbb, 0xef, 0xbd, 0xef, 0xbd, 0xfb, 0xde, 0x27, 0x83, 0x3d, 0x92,
-	0x45, 0x24, 0xc3, 0x99, 0x19, 0x12, 0x6f, 0x5a, 0x24, 0xec, 0x30, 0x92, 0x94, 0xe4, 0x04, 0xb6,
-	0x2a, 0xc8, 0xe0, 0x50, 0x77, 0x37, 0x20, 0x01, 0x61, 0x90, 0x49, 0xbf, 0x78, 0x56, 0x57, 0x0d,
-	0x08, 0x09, 0x42, 0x64, 0xb2, 0xc8, 0x2d, 0xde, 0x9a, 0x7e, 0x91, 0x3a, 0x39, 0x26, 0x71, 0x85,
-	0x6b, 0xd7, 0xf1, 0x1c, 0x47, 0x28, 0xcb, 0x9d, 0x28, 0x11, 0x04, 0x1e, 0xeb, 0x63, 0xba, 0x4e,
-	0x86, 0xcc, 0x93, 0x81, 0x8b, 0x72, 0x67, 0x60, 0x7a, 0x04, 0x57, 0x04, 0xfa, 0x4f, 0x05, 0x80,
-	0x17, 0x28, 0xc5, 0xc4, 0x7f, 0x4e, 0xbc, 0x29, 0x6c, 0x01, 0x65, 0x34, 0xec, 0xc8, 0x3d, 0xb9,
-	0x5f, 0xb7, 0x95, 0xd1, 0x10, 0xde, 0x07, 0x0d, 0xf2, 0x3e, 0x46, 0x69, 0x47, 0xe9, 0xc9, 0xfd,
-	0x2d, 0xab, 0x7d, 0x39, 0xd3, 0x6e, 0x97, 0x4e, 0x14, 0x3e, 0xd1, 0xd9, 0xb5, 0x6e, 0x73, 0x18,
-	0x4e, 0xc0, 0xa6, 0x98, 0xac, 0x53, 0xeb, 0xc9, 0xfd, 0xed, 0xc3, 0x3d, 0x83, 0x8f, 0x66, 0x88,
-	0xd1, 0x8c, 0x61, 0x95, 0x60, 0x0d, 0xce, 0x66, 0x9a, 0xf4, 0x6b, 0xa6, 0x41, 0x51, 0xb2, 0x4f,
-	0x22, 0x9c, 0xa3, 0x28, 0xc9, 0xcb, 0xcb, 0x99, 0xb6, 0xc3, 0xf9, 0x05, 0xa6, 0x7f, 0xba, 0xd0,
-	0x64, 0x7b, 0xc9, 0x0e, 0x6d, 0xb0, 0x89, 0x62, 0x7f, 0x4c, 0xdf, 0xd9, 0xa9, 0xb3, 0x4e, 0xdd,
-	0x3f, 0x3a, 0x1d, 0x0b, 0x11, 0xac, 0x3b, 0xb4, 0xd5, 0x8a, 0x54, 0x54, 0xea, 0xa7, 0x94, 0xf4,
-	0x16, 0x8a, 0x7d, 0x9a, 0x0a, 0x1d, 0xd0, 0xa0, 0x92, 0x64, 0x9d, 0x46, 0xaf, 0xc6, 0x46, 0xe7,
-	0xa2, 0x19, 0x54, 0x34, 0xa3, 0x12, 0xcd, 0x38, 0x22, 0x38, 0xb6, 0x1e, 0x52, 0xbe, 0x6f, 0x17,
-	0x5a, 0x3f, 0xc0, 0xf9, 0xa4, 0x70, 0x0d, 0x8f, 0x44, 0x66, 0xa5, 0x30, 0x3f, 0x0e, 0x32, 0x7f,
-	0x6a, 0xe6, 0x65, 0x82, 0x32, 0x56, 0x90, 0xd9, 0x9c, 0x59, 0xff, 0xac, 0x80, 0xd6, 0xcb, 0x02,
-	0xa5, 0xe5, 0x11, 0x89, 0x7d, 0xcc, 0x5e, 0xf2, 0x14, 0xec, 0xd0, 0xdd, 0x8f, 0xdf, 0xd1, 0xeb,
-	0x31, 0xad, 0x61, 0xc2, 0xb7, 0x0e, 0xef, 0x19, 0x57, 0xbd, 0x61, 0xd0, 0xd5, 0xb0, 0xe2, 0xe3,
-	0x32, 0x41, 0x76, 0x33, 0x5c, 0x0f, 0xe1, 0x2e, 0x68, 0xf8, 0x28, 0x26, 0x11, 0x5f, 0x91, 0xcd,
-	0x03, 0x2a, 0xd3, 0xcd, 0x17, 0x72, 0x4d, 0xa5, 0xbf, 0x49, 0xff, 0x1a, 0x6c, 0x2d, 0xed, 0x75,
-	0x03, 0xed, 0xef, 0x56, 0xac, 0x6d, 0xce, 0xba, 0x2c, 0xe5, 0xe2, 0xaf, 0xa8, 0xf4, 0x2f, 0x0a,
-	0x68, 0xbe, 0x2a, 0xe3, 0x7c, 0x82, 0x72, 0xec, 0x31, 0x1b, 0xee, 0x03, 0x58, 0xc4, 0x3e, 0x4a,
-	0xc3, 0x12, 0xc7, 0xc1, 0x98, 0xa9, 0x84, 0xfd, 0xca, 0x96, 0xed, 0x15, 0x42, 0x73, 0x47, 0x3e,
-	0xd4, 0xc0, 0x76, 0x46, 0xcb, 0xc7, 0xeb, 0x3a, 0x00, 0x76, 0x35, 0x14, 0x62, 0x2c, 0x3d, 0x53,
-	0xfb, 0x47, 0x9e, 0x59, 0x77, 0x7c, 0xfd, 0x7f, 0x3a, 0xfe, 0xc1, 0x00, 0x34, 0xaf, 0x18, 0x00,
-	0xb6, 0x00, 0xb0, 0x4a, 0xc1, 0xdd, 0x96, 0x20, 0x00, 0x1b, 0x56, 0x49, 0x87, 0x6a, 0xcb, 0xdd,
-	0xfa, 0xc7, 0xaf, 0xaa, 0x64, 0x3d, 0x3b, 0x9b, 0xab, 0xf2, 0xf9, 0x5c, 0x95, 0x7f, 0xcc, 0x55,
-	0xf9, 0x74, 0xa1, 0x4a, 0xe7, 0x0b, 0x55, 0xfa, 0xbe, 0x50, 0xa5, 0x37, 0x83, 0x35, 0xe3, 0x56,
-	0x2e, 0x3b, 0x08, 0x1d, 0x37, 0x13, 0x81, 0x79, 0xf2, 0xd8, 0xfc, 0x20, 0x7e, 0x57, 0xcc, 0xc7,
-	0xee, 0x06, 0x7b, 0xcf, 0xa3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x72, 0x71, 0xec, 0xb5, 0xcd,
-	0x04, 0x00, 0x00,
+	0x14, 0x8e, 0xf3, 0xa3, 0xb4, 0xd7, 0x26, 0x8d, 0x4e, 0x1d, 0xdc, 0x00, 0x76, 0xe4, 0x01, 0x45,
+	0xa8, 0xb5, 0x49, 0x19, 0x90, 0x90, 0x58, 0xdc, 0x30, 0x54, 0x30, 0x80, 0xa9, 0x18, 0x58, 0x2c,
+	0xff, 0x38, 0xdc, 0x53, 0x6d, 0x9f, 0xb1, 0xcf, 0x05, 0xff, 0x07, 0x8c, 0x1d, 0x41, 0x62, 0x83,
+	0x89, 0xbf, 0xa4, 0x63, 0x47, 0xa6, 0x14, 0xb5, 0x1b, 0x63, 0xff, 0x02, 0x74, 0x77, 0xbe, 0x34,
+	0x29, 0x42, 0xea, 0x00, 0x93, 0xf3, 0xee, 0x7b, 0xef, 0x7b, 0xef, 0xbe, 0xf7, 0x5d, 0xc0, 0x26,
+	0x29, 0x12, 0x52, 0xe0, 0xc2, 0x8a, 0x49, 0x70, 0x58, 0x66, 0xfc, 0x63, 0x66, 0x39, 0xa1, 0x04,
+	0xf6, 0x6a, 0xc8, 0x14, 0xd0, 0x60, 0x23, 0x22, 0x11, 0xe1, 0x90, 0xc5, 0x7e, 0x89, 0xac, 0x81,
+	0x16, 0x11, 0x12, 0xc5, 0xc8, 0xe2, 0x91, 0x5f, 0xbe, 0xb5, 0xc2, 0x32, 0xf7, 0x28, 0x26, 0x69,
+	0x8d, 0xeb, 0xd7, 0x71, 0x8a, 0x13, 0x54, 0x50, 0x2f, 0xc9, 0x24, 0x41, 0xc0, 0xfb, 0x58, 0xbe,
+	0x57, 0x20, 0xeb, 0x68, 0xec, 0x23, 0xea, 0x8d, 0xad, 0x80, 0xe0, 0x9a, 0xc0, 0xf8, 0xd6, 0x02,
+	0xe0, 0x05, 0xca, 0x31, 0x09, 0x9f, 0x93, 0xe0, 0x10, 0xf6, 0x40, 0x73, 0x6f, 0xa2, 0x2a, 0x43,
+	0x65, 0xd4, 0x76, 0x9a, 0x7b, 0x13, 0x78, 0x0f, 0x74, 0xc8, 0xfb, 0x14, 0xe5, 0x6a, 0x73, 0xa8,
+	0x8c, 0x56, 0xec, 0xfe, 0xe5, 0x54, 0x5f, 0xab, 0xbc, 0x24, 0x7e, 0x6c, 0xf0, 0x63, 0xc3, 0x11,
+	0x30, 0x3c, 0x00, 0xcb, 0x72, 0x32, 0xb5, 0x35, 0x54, 0x46, 0xab, 0x3b, 0x9b, 0xa6, 0x18, 0xcd,
+	0x94, 0xa3, 0x99, 0x93, 0x3a, 0xc1, 0x1e, 0x9f, 0x4c, 0xf5, 0xc6, 0xaf, 0xa9, 0x0e, 0x65, 0xc9,
+	0x16, 0x49, 0x30, 0x45, 0x49, 0x46, 0xab, 0xcb, 0xa9, 0xbe, 0x2e, 0xf8, 0x25, 0x66, 0x7c, 0x3a,
+	0xd3, 0x15, 0x67, 0xc6, 0x0e, 0x1d, 0xb0, 0x8c, 0xd2, 0xd0, 0x65, 0xf7, 0x54, 0xdb, 0xbc, 0xd3,
+	0xe0, 0x8f, 0x4e, 0xfb, 0x52, 0x04, 0xfb, 0x36, 0x6b, 0x75, 0x45, 0x2a, 0x2b, 0x8d, 0x63, 0x46,
+	0x7a, 0x0b, 0xa5, 0x21, 0x4b, 0x85, 0x1e, 0xe8, 0x30, 0x49, 0x0a, 0xb5, 0x33, 0x6c, 0xf1, 0xd1,
+	0x85, 0x68, 0x26, 0x13, 0xcd, 0xac, 0x45, 0x33, 0x77, 0x09, 0x4e, 0xed, 0x07, 0x8c, 0xef, 0xfb,
+	0x99, 0x3e, 0x8a, 0x30, 0x3d, 0x28, 0x7d, 0x33, 0x20, 0x89, 0x55, 0x2b, 0x2c, 0x3e, 0xdb, 0x45,
+	0x78, 0x68, 0xd1, 0x2a, 0x43, 0x05, 0x2f, 0x28, 0x1c, 0xc1, 0x0c, 0x9f, 0x80, 0xae, 0x57, 0x52,
+	0xe2, 0x06, 0x24, 0xc9, 0x48, 0x99, 0x86, 0xea, 0xd2, 0x50, 0x19, 0x2d, 0xdb, 0xea, 0xe5, 0x54,
+	0xdf, 0x10, 0xb3, 0x2d, 0xc0, 0x86, 0xb3, 0xc6, 0xe2, 0x5d, 0x19, 0x7e, 0x6e, 0x82, 0xde, 0xcb,
+	0x12, 0xe5, 0xd5, 0x2e, 0x49, 0x43, 0xcc, 0x85, 0x78, 0x0a, 0xd6, 0x99, 0x75, 0xdc, 0x77, 0xec,
+	0xd8, 0x65, 0x2d, 0xf9, 0xde, 0x7a, 0x3b, 0x77, 0xcd, 0x45, 0x6b, 0x99, 0x6c, 0xb3, 0xbc, 0x78,
+	0xbf, 0xca, 0x90, 0xd3, 0x8d, 0xe7, 0x43, 0xb8, 0x01, 0x3a, 0x21, 0x4a, 0x49, 0x22, 0x36, 0xec,
+	0x88, 0x80, 0xa9, 0x7c, 0xf3, 0x7d, 0x5e, 0x13, 0xf9, 0x6f, 0x9b, 0x7b, 0x0d, 0x56, 0x66, 0xee,
+	0xbc, 0xc1, 0xea, 0xee, 0xd4, 0xac, 0x7d, 0xc1, 0x3a, 0x2b, 0x15, 0xbb, 0xbb, 0xa2, 0x32, 0xbe,
+	0x34, 0x41, 0xf7, 0x55, 0x95, 0xd2, 0x03, 0x44, 0x71, 0xc0, 0x5d, 0xbc, 0x05, 0x60, 0x99, 0x86,
+	0x28, 0x8f, 0x2b, 0x9c, 0x46, 0x2e, 0x57, 0x09, 0x87, 0xb5, 0xab, 0xfb, 0x57, 0x08, 0xcb, 0xdd,
+	0x0b, 0xa1, 0x0e, 0x56, 0x0b, 0x56, 0xee, 0xce, 0xeb, 0x00, 0xf8, 0xd1, 0x44, 0x8a, 0x31, 0xb3,
+	0x5c, 0xeb, 0x1f, 0x59, 0x6e, 0xfe, 0xc1, 0xb4, 0xff, 0xe7, 0x83, 0xb9, 0x3f, 0x06, 0xdd, 0x05,
+	0x03, 0xc0, 0x1e, 0x00, 0x76, 0x25, 0xb9, 0xfb, 0x0d, 0x08, 0xc0, 0x92, 0x5d, 0xb1, 0xa1, 0xfa,
+	0xca, 0xa0, 0xfd, 0xf1, 0xab, 0xd6, 0xb0, 0x9f, 0x9d, 0x9c, 0x6b, 0xca, 0xe9, 0xb9, 0xa6, 0xfc,
+	0x3c, 0xd7, 0x94, 0xe3, 0x0b, 0xad, 0x71, 0x7a, 0xa1, 0x35, 0x7e, 0x5c, 0x68, 0x8d, 0x37, 0xe3,
+	0x39, 0xdf, 0xd7, 0x2e, 0xdb, 0x8e, 0x3d, 0xbf, 0x90, 0x81, 0x75, 0xf4, 0xc8, 0xfa, 0x20, 0xff,
+	0xed, 0xf8, 0x33, 0xf0, 0x97, 0xf8, 0x7d, 0x1e, 0xfe, 0x1e, 0x00, 0x0b, 0x2b, 0x03, 0x30, 0x0c,
+	0x05, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -364,6 +376,16 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompound {
+		i--
+		if m.AutoCompound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -545,6 +567,9 @@ func (m *PeriodLock) Size() (n int) {
 			n += 1 + l + sovLock(uint64(l))
 		}
 	}
+	if m.AutoCompound {
+		n += 2
+	}
 	return n
 }
 
@@ -774,6 +799,26 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCompound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
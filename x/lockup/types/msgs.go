@@ -13,6 +13,11 @@ const (
 	TypeMsgBeginUnlockingAll = "begin_unlocking_all"
 	TypeMsgBeginUnlocking    = "begin_unlocking"
 	TypeMsgExtendLockup      = "edit_lockup"
+	TypeMsgSetAutoCompound   = "set_auto_compound"
+	TypeMsgCancelUnlocking   = "cancel_unlocking"
+	TypeMsgMergeLocks        = "merge_locks"
+	TypeMsgSplitLock         = "split_lock"
+	TypeMsgTransferLock      = "transfer_lock"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -132,3 +137,185 @@ func (m MsgExtendLockup) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgSetAutoCompound{}
+
+// NewMsgSetAutoCompound creates a message to set whether the gauge rewards of a lock are compounded into it.
+func NewMsgSetAutoCompound(owner sdk.AccAddress, id uint64, autoCompound bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Owner:        owner.String(),
+		ID:           id,
+		AutoCompound: autoCompound,
+	}
+}
+
+func (m MsgSetAutoCompound) Route() string { return RouterKey }
+func (m MsgSetAutoCompound) Type() string  { return TypeMsgSetAutoCompound }
+func (m MsgSetAutoCompound) ValidateBasic() error {
+	if len(m.Owner) == 0 {
+		return fmt.Errorf("owner is empty")
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	return nil
+}
+
+func (m MsgSetAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgCancelUnlocking{}
+
+// NewMsgCancelUnlocking creates a message to move the tokens of an unlocking lock back to the locked state.
+func NewMsgCancelUnlocking(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgCancelUnlocking {
+	return &MsgCancelUnlocking{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgCancelUnlocking) Route() string { return RouterKey }
+func (m MsgCancelUnlocking) Type() string  { return TypeMsgCancelUnlocking }
+func (m MsgCancelUnlocking) ValidateBasic() error {
+	if len(m.Owner) == 0 {
+		return fmt.Errorf("owner is empty")
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if !m.Coins.IsValid() {
+		return fmt.Errorf("invalid coins: %s", m.Coins)
+	}
+	return nil
+}
+
+func (m MsgCancelUnlocking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelUnlocking) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgMergeLocks{}
+
+// NewMsgMergeLocks creates a message to merge locks into the first of them.
+func NewMsgMergeLocks(owner sdk.AccAddress, lockIDs []uint64) *MsgMergeLocks {
+	return &MsgMergeLocks{
+		Owner:   owner.String(),
+		LockIds: lockIDs,
+	}
+}
+
+func (m MsgMergeLocks) Route() string { return RouterKey }
+func (m MsgMergeLocks) Type() string  { return TypeMsgMergeLocks }
+func (m MsgMergeLocks) ValidateBasic() error {
+	if len(m.Owner) == 0 {
+		return fmt.Errorf("owner is empty")
+	}
+	if len(m.LockIds) < 2 {
+		return fmt.Errorf("at least two locks are needed to merge")
+	}
+	seen := make(map[uint64]bool, len(m.LockIds))
+	for _, id := range m.LockIds {
+		if id == 0 {
+			return fmt.Errorf("id is empty")
+		}
+		if seen[id] {
+			return fmt.Errorf("duplicate lock id %d", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+func (m MsgMergeLocks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMergeLocks) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgSplitLock{}
+
+// NewMsgSplitLock creates a message to split coins off a lock into a new lock.
+func NewMsgSplitLock(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgSplitLock {
+	return &MsgSplitLock{
+		Owner: owner.String(),
+		ID:    id,
+		Coins: coins,
+	}
+}
+
+func (m MsgSplitLock) Route() string { return RouterKey }
+func (m MsgSplitLock) Type() string  { return TypeMsgSplitLock }
+func (m MsgSplitLock) ValidateBasic() error {
+	if len(m.Owner) == 0 {
+		return fmt.Errorf("owner is empty")
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if !m.Coins.IsValid() || m.Coins.Empty() {
+		return fmt.Errorf("invalid coins: %s", m.Coins)
+	}
+	return nil
+}
+
+func (m MsgSplitLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSplitLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+// NewMsgTransferLock creates a message to transfer the ownership of a lock to the recipient.
+func NewMsgTransferLock(owner sdk.AccAddress, id uint64, recipient sdk.AccAddress, transferSyntheticLockups bool) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:                    owner.String(),
+		ID:                       id,
+		Recipient:                recipient.String(),
+		TransferSyntheticLockups: transferSyntheticLockups,
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	if len(m.Owner) == 0 {
+		return fmt.Errorf("owner is empty")
+	}
+	if m.ID == 0 {
+		return fmt.Errorf("id is empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient address (%s)", err)
+	}
+	if m.Recipient == m.Owner {
+		return fmt.Errorf("recipient is the owner")
+	}
+	return nil
+}
+
+func (m MsgTransferLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -1529,6 +1529,155 @@ func (m *AccountLockedLongerDurationDenomResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+type InvariantsRequest struct {
+	// Route of the invariant to run. All invariants are run if empty.
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty" yaml:"route"`
+}
+
+func (m *InvariantsRequest) Reset()         { *m = InvariantsRequest{} }
+func (m *InvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*InvariantsRequest) ProtoMessage()    {}
+func (*InvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{32}
+}
+func (m *InvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantsRequest.Merge(m, src)
+}
+func (m *InvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantsRequest proto.InternalMessageInfo
+
+func (m *InvariantsRequest) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+type InvariantResult struct {
+	Route   string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty" yaml:"route"`
+	Broken  bool   `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty" yaml:"broken"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty" yaml:"message"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{33}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type InvariantsResponse struct {
+	Results []InvariantResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *InvariantsResponse) Reset()         { *m = InvariantsResponse{} }
+func (m *InvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*InvariantsResponse) ProtoMessage()    {}
+func (*InvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{34}
+}
+func (m *InvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantsResponse.Merge(m, src)
+}
+func (m *InvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantsResponse proto.InternalMessageInfo
+
+func (m *InvariantsResponse) GetResults() []InvariantResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleBalanceRequest)(nil), "osmosis.lockup.ModuleBalanceRequest")
 	proto.RegisterType((*ModuleBalanceResponse)(nil), "osmosis.lockup.ModuleBalanceResponse")
@@ -1562,102 +1711,112 @@ func init() {
 	proto.RegisterType((*AccountLockedLongerDurationNotUnlockingOnlyResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationNotUnlockingOnlyResponse")
 	proto.RegisterType((*AccountLockedLongerDurationDenomRequest)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomRequest")
 	proto.RegisterType((*AccountLockedLongerDurationDenomResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomResponse")
+	proto.RegisterType((*InvariantsRequest)(nil), "osmosis.lockup.InvariantsRequest")
+	proto.RegisterType((*InvariantResult)(nil), "osmosis.lockup.InvariantResult")
+	proto.RegisterType((*InvariantsResponse)(nil), "osmosis.lockup.InvariantsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0xb4, 0x4d, 0x7f, 0xbf, 0xbe, 0xa5, 0x1f, 0x1a, 0xda, 0x92, 0x6c, 0x5b, 0x3b, 0xdd,
-	0xb6, 0xc1, 0x40, 0xbc, 0xdb, 0x24, 0x55, 0x52, 0xaa, 0xb4, 0x49, 0x1d, 0x13, 0x14, 0x30, 0xd0,
-	0xba, 0x85, 0x8a, 0x2f, 0x59, 0x6b, 0x7b, 0xeb, 0xae, 0x62, 0xef, 0xb8, 0xde, 0x75, 0xc1, 0x54,
-	0xa5, 0xa2, 0xe5, 0xc8, 0xa1, 0x88, 0x0b, 0xe2, 0x80, 0x80, 0x1b, 0x1c, 0x10, 0x17, 0x0e, 0x15,
-	0x77, 0x54, 0x81, 0x84, 0x2a, 0x71, 0x41, 0x1c, 0x52, 0x94, 0xf0, 0x17, 0xe4, 0xc4, 0x11, 0xed,
-	0xcc, 0xec, 0xc6, 0xbb, 0xde, 0x5d, 0xef, 0xda, 0x24, 0xca, 0xc9, 0x1f, 0xf3, 0xce, 0xfb, 0x3e,
-	0xcf, 0x33, 0xef, 0xce, 0xcc, 0xb3, 0x20, 0x10, 0xa3, 0x46, 0x0c, 0xcd, 0x90, 0xab, 0xa4, 0xb4,
-	0xd4, 0xac, 0xcb, 0x37, 0x9a, 0x6a, 0xa3, 0x25, 0xd5, 0x1b, 0xc4, 0x24, 0x78, 0x2f, 0x1f, 0x93,
-	0xd8, 0x98, 0x70, 0xa0, 0x42, 0x2a, 0x84, 0x0e, 0xc9, 0xd6, 0x37, 0x16, 0x25, 0x24, 0x4a, 0x34,
-	0x4c, 0x2e, 0x2a, 0x86, 0x2a, 0xdf, 0x1c, 0x2f, 0xaa, 0xa6, 0x32, 0x2e, 0x97, 0x88, 0xa6, 0xf3,
-	0xf1, 0x23, 0x15, 0x42, 0x2a, 0x55, 0x55, 0x56, 0xea, 0x9a, 0xac, 0xe8, 0x3a, 0x31, 0x15, 0x53,
-	0x23, 0xba, 0xc1, 0x47, 0x93, 0x7c, 0x94, 0xfe, 0x2a, 0x36, 0xaf, 0xc9, 0xa6, 0x56, 0x53, 0x0d,
-	0x53, 0xa9, 0xd5, 0xed, 0xf4, 0xde, 0x80, 0x72, 0xb3, 0x41, 0x33, 0xf0, 0xf1, 0x61, 0x0f, 0x01,
-	0xeb, 0x83, 0x0d, 0x89, 0x87, 0xe0, 0xc0, 0x2b, 0xa4, 0xdc, 0xac, 0xaa, 0x19, 0xa5, 0xaa, 0xe8,
-	0x25, 0x35, 0xaf, 0xde, 0x68, 0xaa, 0x86, 0x29, 0x7e, 0x00, 0x07, 0x3d, 0xff, 0x1b, 0x75, 0xa2,
-	0x1b, 0x2a, 0x56, 0x60, 0xd0, 0x02, 0x6e, 0x0c, 0xa1, 0x91, 0xed, 0xa9, 0xdd, 0x13, 0xc3, 0x12,
-	0xa3, 0x26, 0x59, 0xd4, 0x24, 0x4e, 0x4d, 0x9a, 0x27, 0x9a, 0x9e, 0x39, 0xf5, 0x70, 0x39, 0x39,
-	0xf0, 0xdd, 0xe3, 0x64, 0xaa, 0xa2, 0x99, 0xd7, 0x9b, 0x45, 0xa9, 0x44, 0x6a, 0x32, 0xd7, 0x81,
-	0x7d, 0xa4, 0x8d, 0xf2, 0x92, 0x6c, 0xb6, 0xea, 0xaa, 0x41, 0x27, 0x18, 0x79, 0x96, 0x59, 0x3c,
-	0x0c, 0xc3, 0xac, 0x76, 0x8e, 0x94, 0x96, 0xd4, 0xf2, 0x85, 0x1a, 0x69, 0xea, 0xa6, 0x0d, 0xec,
-	0x0e, 0x08, 0x7e, 0x83, 0x9b, 0x87, 0xee, 0x45, 0x38, 0x7a, 0xa1, 0x54, 0xb2, 0xaa, 0xbe, 0xae,
-	0x5b, 0x42, 0x2a, 0xc5, 0xaa, 0xca, 0x02, 0x18, 0x42, 0x3c, 0x0a, 0x83, 0xe4, 0x3d, 0x5d, 0x6d,
-	0x0c, 0xa1, 0x11, 0x94, 0xda, 0x95, 0xd9, 0xbf, 0xb6, 0x9c, 0x7c, 0xa2, 0xa5, 0xd4, 0xaa, 0x67,
-	0x45, 0xfa, 0xb7, 0x98, 0x67, 0xc3, 0xe2, 0x3d, 0x04, 0x89, 0xa0, 0x4c, 0x9b, 0x47, 0x67, 0x01,
-	0x8e, 0xb8, 0x40, 0x68, 0x7a, 0xa5, 0x27, 0x36, 0x77, 0x91, 0x47, 0x97, 0xf5, 0x44, 0x9b, 0x47,
-	0x66, 0x1e, 0x86, 0x39, 0x06, 0xd6, 0x1d, 0x3d, 0x31, 0xb9, 0x03, 0x82, 0x5f, 0x92, 0xcd, 0x63,
-	0xf1, 0x25, 0x72, 0xd6, 0x84, 0x21, 0xb8, 0xa8, 0x18, 0xe6, 0x15, 0xad, 0xa6, 0xc6, 0x64, 0x82,
-	0xdf, 0x80, 0x5d, 0xce, 0x56, 0x31, 0xb4, 0x6d, 0x04, 0xa5, 0x76, 0x4f, 0x08, 0x12, 0xdb, 0x2b,
-	0x24, 0x7b, 0xaf, 0x90, 0xae, 0xd8, 0x11, 0x99, 0x23, 0x16, 0xe0, 0xb5, 0xe5, 0xe4, 0x7e, 0x96,
-	0xcb, 0x99, 0x2a, 0xde, 0x7f, 0x9c, 0x44, 0xf9, 0xf5, 0x54, 0xe2, 0x55, 0x67, 0xa9, 0xbd, 0xf8,
-	0xb8, 0x48, 0x53, 0x30, 0x68, 0xb5, 0x80, 0x2d, 0x92, 0x20, 0xb9, 0x77, 0x49, 0xe9, 0xa2, 0xda,
-	0xd0, 0x48, 0xd9, 0x9a, 0x9c, 0xd9, 0x61, 0x15, 0xcd, 0xb3, 0x70, 0xf1, 0x7b, 0x04, 0x63, 0xbe,
-	0x99, 0x5f, 0x25, 0xeb, 0x5d, 0xf5, 0x9a, 0x5e, 0x6d, 0x6d, 0x15, 0x25, 0x2a, 0x90, 0x8e, 0x88,
-	0xb7, 0x4f, 0x65, 0xbe, 0x41, 0x30, 0xe2, 0x7a, 0xbc, 0xd4, 0x72, 0x46, 0xbd, 0x46, 0x1a, 0xea,
-	0x56, 0xea, 0x8b, 0xb7, 0xe1, 0x58, 0x08, 0xc6, 0x3e, 0x15, 0x78, 0x80, 0x9c, 0xec, 0x6e, 0xad,
-	0xb3, 0xaa, 0x4e, 0x6a, 0x5b, 0x44, 0x02, 0x7c, 0x00, 0x06, 0xcb, 0x16, 0x9e, 0xa1, 0xed, 0x56,
-	0xfd, 0x3c, 0xfb, 0x21, 0xbe, 0x03, 0x62, 0x18, 0xf4, 0x3e, 0x95, 0xf9, 0x10, 0x30, 0x4b, 0xeb,
-	0x52, 0xc2, 0x41, 0x82, 0xda, 0x90, 0xe0, 0x3c, 0xfc, 0xdf, 0xbe, 0x1c, 0x70, 0xda, 0xc3, 0x1d,
-	0xb4, 0xb3, 0x3c, 0x20, 0x73, 0x98, 0xb3, 0xde, 0xc7, 0x58, 0xdb, 0x13, 0xc5, 0xcf, 0x2d, 0xd2,
-	0x4e, 0x1e, 0x51, 0x87, 0x27, 0x5d, 0xf5, 0x39, 0x9d, 0xab, 0xb0, 0x53, 0xa1, 0xa7, 0x33, 0x5f,
-	0x8b, 0x59, 0x2b, 0xdb, 0x9f, 0xcb, 0xc9, 0xd1, 0x08, 0xfb, 0xe1, 0xa2, 0x6e, 0xae, 0x2d, 0x27,
-	0xf7, 0xb0, 0xba, 0x2c, 0x8b, 0x98, 0xe7, 0xe9, 0xc4, 0x14, 0xec, 0x61, 0xf5, 0x6c, 0xaa, 0x4f,
-	0xc1, 0xff, 0x2c, 0x25, 0x0a, 0x5a, 0x99, 0x96, 0xda, 0x91, 0xdf, 0x69, 0xfd, 0x5c, 0x2c, 0x8b,
-	0x73, 0xb0, 0xd7, 0x8e, 0xe4, 0xa0, 0x24, 0xd8, 0x61, 0x8d, 0xd1, 0xb8, 0x50, 0x89, 0xf3, 0x34,
-	0x4e, 0x9c, 0x81, 0x63, 0x97, 0x5b, 0xba, 0x79, 0x5d, 0x35, 0xb5, 0x52, 0x8e, 0xc6, 0x18, 0x99,
-	0x16, 0xfb, 0xb2, 0x98, 0xed, 0x5a, 0xbf, 0x01, 0x62, 0xd8, 0x6c, 0x8e, 0x29, 0x07, 0xfb, 0x0c,
-	0x3b, 0xaa, 0xd0, 0xde, 0x01, 0x47, 0xbd, 0xf0, 0x5c, 0xc9, 0x78, 0x13, 0xec, 0x35, 0xda, 0xff,
-	0x34, 0xc4, 0xaf, 0x90, 0xa7, 0xd9, 0x72, 0x44, 0xaf, 0xa8, 0x0d, 0x7b, 0x51, 0xe3, 0x3e, 0x28,
-	0x1b, 0xd1, 0x30, 0xef, 0xc2, 0xf1, 0x50, 0x84, 0x7d, 0x3e, 0x0f, 0x5f, 0x78, 0xcf, 0xcf, 0xad,
-	0xc4, 0xdd, 0x7b, 0x76, 0xfe, 0x67, 0xac, 0x7f, 0x40, 0x30, 0x11, 0xa2, 0x6a, 0xbf, 0x27, 0xe8,
-	0x46, 0x68, 0x51, 0x83, 0xc9, 0x58, 0x88, 0xfb, 0x54, 0xe8, 0x27, 0x04, 0x4f, 0x87, 0xd4, 0xeb,
-	0xe9, 0x1c, 0xd9, 0x00, 0x59, 0x02, 0xce, 0x90, 0x22, 0xa4, 0xba, 0x83, 0xef, 0x4f, 0xa1, 0x89,
-	0x8f, 0x86, 0x60, 0xf0, 0x92, 0xe5, 0x6e, 0xf1, 0x27, 0x08, 0xf6, 0xb8, 0x0c, 0x20, 0x3e, 0xe1,
-	0x4d, 0xe2, 0xe7, 0x1b, 0x85, 0x93, 0x5d, 0xa2, 0x18, 0x40, 0x51, 0xba, 0xfb, 0xfb, 0xdf, 0x9f,
-	0x6d, 0x4b, 0xe1, 0x51, 0xd9, 0x63, 0x4d, 0x6d, 0x73, 0x5c, 0xa3, 0xd3, 0x0a, 0x45, 0x5e, 0xfc,
-	0x6b, 0x04, 0xb8, 0xd3, 0xf6, 0xe1, 0x67, 0xfc, 0xab, 0xf9, 0xf8, 0x46, 0xe1, 0xd9, 0x28, 0xa1,
-	0x1c, 0xdd, 0x69, 0x8a, 0x4e, 0xc2, 0x63, 0x5d, 0xd0, 0xb1, 0x3b, 0x4e, 0x81, 0x1d, 0x4b, 0xf8,
-	0x01, 0x82, 0x43, 0xfe, 0x7e, 0x0e, 0xa7, 0xbd, 0xc5, 0x43, 0x1d, 0xa4, 0x20, 0x45, 0x0d, 0xe7,
-	0x78, 0xe7, 0x28, 0xde, 0xb3, 0xf8, 0x4c, 0x10, 0x5e, 0x85, 0xcd, 0x2f, 0x34, 0x9d, 0x04, 0x05,
-	0x6a, 0x35, 0xe4, 0x5b, 0xb4, 0x8b, 0x6f, 0xe3, 0x1f, 0x11, 0x1c, 0xf4, 0x75, 0x6f, 0x78, 0x2c,
-	0x14, 0x8b, 0xc7, 0x2d, 0x0a, 0xe9, 0x88, 0xd1, 0x1c, 0xf8, 0x2c, 0x05, 0xfe, 0x3c, 0x9e, 0x8e,
-	0x06, 0x5c, 0xd3, 0x2b, 0x1e, 0xdc, 0xdf, 0x22, 0xc0, 0x9d, 0x66, 0xad, 0xb3, 0x2f, 0x02, 0x5d,
-	0x61, 0x67, 0x5f, 0x04, 0x7b, 0x3f, 0x71, 0x86, 0xc2, 0x9d, 0xc2, 0xa7, 0xbb, 0xc1, 0xe5, 0x8d,
-	0x11, 0xa8, 0xb1, 0xfb, 0x16, 0x18, 0xa8, 0xb1, 0xaf, 0xfb, 0x0b, 0xd4, 0xd8, 0xdf, 0x8b, 0x45,
-	0xd7, 0x98, 0x83, 0xae, 0x2b, 0x86, 0x69, 0xdd, 0x67, 0x1d, 0xdc, 0xff, 0x20, 0x38, 0x19, 0xc9,
-	0xe4, 0xe0, 0x99, 0x48, 0xc8, 0x02, 0x4e, 0x22, 0xe1, 0x5c, 0x8f, 0xb3, 0x39, 0xcf, 0x3c, 0xe5,
-	0x99, 0xc3, 0x2f, 0xc5, 0xe4, 0x59, 0xd0, 0x49, 0x7b, 0x7f, 0x11, 0xbd, 0xda, 0x72, 0xa8, 0xff,
-	0x8c, 0x9c, 0x17, 0x0a, 0x9d, 0x8e, 0x06, 0x9f, 0x0a, 0x6d, 0x76, 0x1f, 0x83, 0x26, 0x8c, 0xc7,
-	0x98, 0xc1, 0x69, 0x65, 0x29, 0xad, 0xf3, 0x78, 0x26, 0xda, 0x23, 0xa2, 0x96, 0x0b, 0x45, 0x9a,
-	0xa4, 0xe0, 0x5a, 0xc3, 0x5f, 0x90, 0xe7, 0xa5, 0x86, 0xcb, 0x81, 0xe0, 0xf1, 0x48, 0xd2, 0xb7,
-	0x1f, 0x90, 0xc2, 0x44, 0x9c, 0x29, 0x9c, 0xcb, 0x0b, 0x94, 0xcb, 0x2c, 0x3e, 0x17, 0x77, 0x89,
-	0xe8, 0x09, 0xe8, 0x90, 0xf9, 0x18, 0xc1, 0xee, 0x36, 0xc3, 0x81, 0x45, 0x2f, 0x94, 0x4e, 0x37,
-	0x24, 0x1c, 0x0f, 0x8d, 0xe1, 0xf8, 0xc6, 0x28, 0xbe, 0x51, 0x7c, 0x22, 0x08, 0x1f, 0xc7, 0xc5,
-	0xac, 0xd4, 0x3d, 0x04, 0xc0, 0xb2, 0x64, 0x5a, 0x8b, 0x59, 0x7c, 0xd4, 0xbf, 0x82, 0x0d, 0x20,
-	0x11, 0x34, 0xcc, 0x6b, 0x4f, 0xd1, 0xda, 0xa7, 0xb0, 0xd4, 0xa5, 0x76, 0xb1, 0x55, 0xd0, 0xca,
-	0xf2, 0x2d, 0xee, 0x37, 0x6e, 0xe3, 0x5f, 0x11, 0x08, 0xc1, 0x1e, 0xa3, 0x73, 0x65, 0xbb, 0xba,
-	0x99, 0xce, 0x95, 0xed, 0x6e, 0x61, 0xc4, 0x05, 0x8a, 0x7e, 0x0e, 0x9f, 0x0f, 0x42, 0xef, 0x36,
-	0x38, 0xcd, 0xba, 0x61, 0x11, 0xe1, 0x24, 0xda, 0xd8, 0xfc, 0x86, 0xe0, 0x70, 0xc8, 0x2d, 0x07,
-	0x87, 0x77, 0x9d, 0xaf, 0xd3, 0x11, 0x26, 0x63, 0xcd, 0x89, 0x4a, 0xc8, 0xd3, 0xaa, 0x55, 0x9a,
-	0xa6, 0x60, 0xdf, 0xe1, 0x82, 0x37, 0x7d, 0x87, 0x4a, 0xf8, 0xa6, 0xef, 0x25, 0x91, 0x8e, 0x18,
-	0xdd, 0xe3, 0xa6, 0xdf, 0x81, 0xfb, 0xd3, 0x6d, 0xf0, 0x5c, 0x8c, 0xbb, 0x39, 0xce, 0xc4, 0x10,
-	0x39, 0xe8, 0x00, 0x98, 0xef, 0x2b, 0x07, 0x67, 0xfe, 0x26, 0x65, 0x7e, 0x19, 0x5f, 0xea, 0x6d,
-	0xe1, 0xc2, 0x4e, 0x83, 0xd5, 0xf5, 0x77, 0x70, 0x81, 0x57, 0x70, 0x3c, 0x1d, 0x83, 0x84, 0x6b,
-	0x87, 0x3a, 0x13, 0x7f, 0x22, 0xa7, 0x9c, 0xa3, 0x94, 0x17, 0x70, 0xb6, 0x47, 0xca, 0xae, 0xdd,
-	0x35, 0xf3, 0xf2, 0xc3, 0x95, 0x04, 0x7a, 0xb4, 0x92, 0x40, 0x7f, 0xad, 0x24, 0xd0, 0xfd, 0xd5,
-	0xc4, 0xc0, 0xa3, 0xd5, 0xc4, 0xc0, 0x1f, 0xab, 0x89, 0x81, 0xb7, 0xc6, 0xdb, 0x5e, 0xdc, 0xf0,
-	0x4a, 0xe9, 0xaa, 0x52, 0x34, 0x9c, 0xb2, 0x37, 0xa7, 0xe5, 0xf7, 0xed, 0xda, 0xf4, 0x3d, 0x4e,
-	0x71, 0x27, 0x35, 0x41, 0x93, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x62, 0xa3, 0x5a, 0xf4, 0x43,
-	0x1b, 0x00, 0x00,
+	// 1549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x73, 0x14, 0xc5,
+	0x1f, 0x4d, 0x03, 0x09, 0xf0, 0xe1, 0x4b, 0x80, 0xfe, 0x02, 0x26, 0x43, 0xb2, 0x1b, 0x1a, 0x88,
+	0x01, 0x93, 0x19, 0x12, 0x28, 0x40, 0x0c, 0x04, 0x96, 0x88, 0x15, 0x8d, 0x0a, 0x03, 0x48, 0xf9,
+	0xab, 0xb6, 0x66, 0x77, 0x9b, 0x65, 0x2a, 0xbb, 0xd3, 0xcb, 0xce, 0x2c, 0xba, 0x52, 0x48, 0x15,
+	0x78, 0xf4, 0x80, 0x7a, 0xb1, 0x3c, 0x58, 0xea, 0x4d, 0x0f, 0x96, 0x17, 0x0f, 0x94, 0x77, 0x8b,
+	0xd2, 0x2a, 0x8b, 0x2a, 0x2f, 0x96, 0x87, 0x60, 0x11, 0xff, 0x82, 0x9c, 0x3c, 0x5a, 0xd3, 0xdd,
+	0x33, 0xd9, 0x9d, 0xdd, 0x99, 0xcc, 0x64, 0x25, 0x95, 0xd3, 0xee, 0x4c, 0x7f, 0x7e, 0xbc, 0xf7,
+	0xa6, 0xa7, 0xbb, 0xdf, 0x80, 0xc2, 0xec, 0x32, 0xb3, 0x4d, 0x5b, 0x2b, 0xb1, 0xfc, 0x5c, 0xad,
+	0xa2, 0xdd, 0xa8, 0xd1, 0x6a, 0x5d, 0xad, 0x54, 0x99, 0xc3, 0x70, 0xaf, 0x1c, 0x53, 0xc5, 0x98,
+	0xb2, 0xb3, 0xc8, 0x8a, 0x8c, 0x0f, 0x69, 0xee, 0x3f, 0x11, 0xa5, 0xa4, 0xf2, 0x3c, 0x4c, 0xcb,
+	0x19, 0x36, 0xd5, 0x6e, 0x8e, 0xe7, 0xa8, 0x63, 0x8c, 0x6b, 0x79, 0x66, 0x5a, 0x72, 0x7c, 0xa0,
+	0xc8, 0x58, 0xb1, 0x44, 0x35, 0xa3, 0x62, 0x6a, 0x86, 0x65, 0x31, 0xc7, 0x70, 0x4c, 0x66, 0xd9,
+	0x72, 0x34, 0x2d, 0x47, 0xf9, 0x55, 0xae, 0x76, 0x4d, 0x73, 0xcc, 0x32, 0xb5, 0x1d, 0xa3, 0x5c,
+	0xf1, 0xca, 0x07, 0x03, 0x0a, 0xb5, 0x2a, 0xaf, 0x20, 0xc7, 0xfb, 0x03, 0x04, 0xdc, 0x1f, 0x31,
+	0x44, 0x76, 0xc3, 0xce, 0x57, 0x59, 0xa1, 0x56, 0xa2, 0x19, 0xa3, 0x64, 0x58, 0x79, 0xaa, 0xd3,
+	0x1b, 0x35, 0x6a, 0x3b, 0xe4, 0x03, 0xd8, 0x15, 0xb8, 0x6f, 0x57, 0x98, 0x65, 0x53, 0x6c, 0x40,
+	0xb7, 0x0b, 0xdc, 0xee, 0x43, 0x43, 0xeb, 0x47, 0xb6, 0x4c, 0xf4, 0xab, 0x82, 0x9a, 0xea, 0x52,
+	0x53, 0x25, 0x35, 0xf5, 0x1c, 0x33, 0xad, 0xcc, 0xe1, 0x87, 0xf3, 0xe9, 0xae, 0xef, 0x1e, 0xa7,
+	0x47, 0x8a, 0xa6, 0x73, 0xbd, 0x96, 0x53, 0xf3, 0xac, 0xac, 0x49, 0x1d, 0xc4, 0xcf, 0x98, 0x5d,
+	0x98, 0xd3, 0x9c, 0x7a, 0x85, 0xda, 0x3c, 0xc1, 0xd6, 0x45, 0x65, 0xb2, 0x07, 0xfa, 0x45, 0xef,
+	0x59, 0x96, 0x9f, 0xa3, 0x85, 0xb3, 0x65, 0x56, 0xb3, 0x1c, 0x0f, 0xd8, 0x1d, 0x50, 0xda, 0x0d,
+	0xae, 0x1e, 0xba, 0x97, 0x60, 0xf0, 0x6c, 0x3e, 0xef, 0x76, 0xbd, 0x62, 0xb9, 0x42, 0x1a, 0xb9,
+	0x12, 0x15, 0x01, 0x02, 0x21, 0x1e, 0x86, 0x6e, 0xf6, 0x9e, 0x45, 0xab, 0x7d, 0x68, 0x08, 0x8d,
+	0x6c, 0xce, 0x6c, 0x5f, 0x9c, 0x4f, 0xff, 0xaf, 0x6e, 0x94, 0x4b, 0x27, 0x09, 0xbf, 0x4d, 0x74,
+	0x31, 0x4c, 0xee, 0x21, 0x48, 0x85, 0x55, 0x5a, 0x3d, 0x3a, 0xe7, 0x61, 0xa0, 0x09, 0x84, 0x69,
+	0x15, 0x57, 0xc4, 0xe6, 0x2e, 0x82, 0xc1, 0x90, 0x42, 0xab, 0x47, 0xe6, 0x1c, 0xf4, 0x4b, 0x0c,
+	0x62, 0x76, 0xac, 0x88, 0xc9, 0x1d, 0x50, 0xda, 0x15, 0x59, 0x3d, 0x16, 0x5f, 0x22, 0x18, 0x68,
+	0x42, 0x70, 0xc1, 0xb0, 0x9d, 0xcb, 0x66, 0x99, 0x26, 0x64, 0x82, 0xdf, 0x80, 0xcd, 0xfe, 0x52,
+	0xd1, 0xb7, 0x6e, 0x08, 0x8d, 0x6c, 0x99, 0x50, 0x54, 0xb1, 0x56, 0xa8, 0xde, 0x5a, 0xa1, 0x5e,
+	0xf6, 0x22, 0x32, 0x03, 0x2e, 0xe0, 0xc5, 0xf9, 0xf4, 0x76, 0x51, 0xcb, 0x4f, 0x25, 0xf7, 0x1f,
+	0xa7, 0x91, 0xbe, 0x54, 0x8a, 0x5c, 0x85, 0xc1, 0x10, 0x7c, 0x52, 0xa4, 0x63, 0xd0, 0xed, 0x4e,
+	0x01, 0x4f, 0x24, 0x45, 0x6d, 0x5e, 0x25, 0xd5, 0x0b, 0xb4, 0x6a, 0xb2, 0x82, 0x9b, 0x9c, 0xd9,
+	0xe0, 0x36, 0xd5, 0x45, 0x38, 0xf9, 0x1e, 0xc1, 0x68, 0xdb, 0xca, 0xaf, 0xb1, 0xa5, 0x59, 0xf5,
+	0xba, 0x55, 0xaa, 0xaf, 0x15, 0x25, 0x8a, 0x30, 0x16, 0x13, 0x6f, 0x87, 0xca, 0x7c, 0x83, 0x60,
+	0xa8, 0xe9, 0xf5, 0xa2, 0x85, 0x0c, 0xbd, 0xc6, 0xaa, 0x74, 0x2d, 0xcd, 0x8b, 0xb7, 0x61, 0x6f,
+	0x04, 0xc6, 0x0e, 0x15, 0x78, 0x80, 0xfc, 0xea, 0xcd, 0x5a, 0x4f, 0x53, 0x8b, 0x95, 0xd7, 0x88,
+	0x04, 0x78, 0x27, 0x74, 0x17, 0x5c, 0x3c, 0x7d, 0xeb, 0xdd, 0xfe, 0xba, 0xb8, 0x20, 0xef, 0x00,
+	0x89, 0x82, 0xde, 0xa1, 0x32, 0x1f, 0x02, 0x16, 0x65, 0x9b, 0x94, 0xf0, 0x91, 0xa0, 0x06, 0x24,
+	0x58, 0x87, 0x4d, 0xde, 0xe1, 0x40, 0xd2, 0xee, 0x6f, 0xa1, 0x3d, 0x2d, 0x03, 0x32, 0x7b, 0x24,
+	0xeb, 0x6d, 0x82, 0xb5, 0x97, 0x48, 0x3e, 0x77, 0x49, 0xfb, 0x75, 0x88, 0x05, 0xff, 0x6f, 0xea,
+	0x2f, 0xe9, 0x5c, 0x85, 0x1e, 0x83, 0xef, 0xce, 0xf2, 0x59, 0x4c, 0xb9, 0xd5, 0xfe, 0x9c, 0x4f,
+	0x0f, 0xc7, 0x58, 0x0f, 0x67, 0x2c, 0x67, 0x71, 0x3e, 0xbd, 0x55, 0xf4, 0x15, 0x55, 0x88, 0x2e,
+	0xcb, 0x91, 0x11, 0xd8, 0x2a, 0xfa, 0x79, 0x54, 0x9f, 0x81, 0x8d, 0xae, 0x12, 0x59, 0xb3, 0xc0,
+	0x5b, 0x6d, 0xd0, 0x7b, 0xdc, 0xcb, 0x99, 0x02, 0x39, 0x03, 0xbd, 0x5e, 0xa4, 0x04, 0xa5, 0xc2,
+	0x06, 0x77, 0x8c, 0xc7, 0x45, 0x4a, 0xac, 0xf3, 0x38, 0x32, 0x09, 0x7b, 0x2f, 0xd5, 0x2d, 0xe7,
+	0x3a, 0x75, 0xcc, 0xfc, 0x2c, 0x8f, 0xb1, 0x33, 0x75, 0xf1, 0x67, 0x66, 0x7a, 0xd9, 0xfe, 0x55,
+	0x20, 0x51, 0xd9, 0x12, 0xd3, 0x2c, 0x6c, 0xb3, 0xbd, 0xa8, 0x6c, 0xe3, 0x0c, 0x18, 0x0c, 0xc2,
+	0x6b, 0x2a, 0x26, 0x27, 0x41, 0xaf, 0xdd, 0x78, 0xd3, 0x26, 0x5f, 0xa1, 0xc0, 0x64, 0x9b, 0x65,
+	0x56, 0x91, 0x56, 0xbd, 0x87, 0x9a, 0xf4, 0x45, 0x79, 0x1a, 0x13, 0xe6, 0x5d, 0xd8, 0x17, 0x89,
+	0xb0, 0xc3, 0xf7, 0xe1, 0x8b, 0xe0, 0xfe, 0xb9, 0x96, 0xb8, 0x07, 0xf7, 0xce, 0xff, 0x8c, 0xf5,
+	0x0f, 0x08, 0x26, 0x22, 0x54, 0xed, 0x74, 0x07, 0x7d, 0x1a, 0x5a, 0x94, 0xe1, 0x48, 0x22, 0xc4,
+	0x1d, 0x2a, 0xf4, 0x13, 0x82, 0x67, 0x23, 0xfa, 0xad, 0x68, 0x1f, 0x79, 0x0a, 0xb2, 0x84, 0xec,
+	0x21, 0x39, 0x18, 0x59, 0x1e, 0x7c, 0x87, 0x0a, 0xbd, 0x00, 0x3b, 0x66, 0xac, 0x9b, 0x46, 0xd5,
+	0x34, 0x2c, 0xa7, 0xf1, 0xdc, 0x5c, 0x65, 0x35, 0x87, 0xb6, 0x4a, 0xc1, 0x6f, 0x13, 0x5d, 0x0c,
+	0x93, 0x4f, 0x11, 0x6c, 0xf3, 0xb3, 0x75, 0x6a, 0xd7, 0x4a, 0xb1, 0x73, 0xf1, 0x41, 0xe8, 0xc9,
+	0x55, 0xd9, 0x1c, 0x15, 0x22, 0x6e, 0xca, 0xec, 0x58, 0x5a, 0xfd, 0xc5, 0x7d, 0xa2, 0xcb, 0x00,
+	0x3c, 0x0a, 0x1b, 0xcb, 0xd4, 0xb6, 0x8d, 0x22, 0x15, 0xfa, 0x64, 0xf0, 0xe2, 0x7c, 0xba, 0x57,
+	0xc4, 0xca, 0x01, 0xa2, 0x7b, 0x21, 0xe4, 0x0a, 0xe0, 0x46, 0x46, 0x52, 0x9f, 0x29, 0xd8, 0x58,
+	0xe5, 0x00, 0x3d, 0x85, 0xd2, 0x41, 0x85, 0x02, 0x44, 0xa4, 0x4c, 0x5e, 0xd6, 0xc4, 0xdd, 0x7e,
+	0xe8, 0xbe, 0xe8, 0x7e, 0x06, 0xc0, 0x1f, 0x23, 0xd8, 0xda, 0xe4, 0x94, 0xf1, 0xfe, 0x60, 0xad,
+	0x76, 0x06, 0x5b, 0x39, 0xb0, 0x4c, 0x94, 0x40, 0x4a, 0xd4, 0xbb, 0xbf, 0xff, 0xfd, 0xd9, 0xba,
+	0x11, 0x3c, 0xac, 0x05, 0x3c, 0xbc, 0xf7, 0x15, 0xa1, 0xcc, 0xd3, 0xb2, 0x39, 0xd9, 0xfc, 0x6b,
+	0x04, 0xb8, 0xd5, 0x1f, 0xe3, 0x83, 0xed, 0xbb, 0xb5, 0x31, 0xd8, 0xca, 0xa1, 0x38, 0xa1, 0x12,
+	0xdd, 0x51, 0x8e, 0x4e, 0xc5, 0xa3, 0xcb, 0xa0, 0x13, 0x87, 0xc1, 0xac, 0xd8, 0xbf, 0xf1, 0x03,
+	0x04, 0xbb, 0xdb, 0x1b, 0x5f, 0x3c, 0x16, 0x6c, 0x1e, 0x69, 0xb5, 0x15, 0x35, 0x6e, 0xb8, 0xc4,
+	0x7b, 0x86, 0xe3, 0x3d, 0x89, 0x4f, 0x84, 0xe1, 0x35, 0x44, 0x7e, 0xb6, 0xe6, 0x17, 0xc8, 0x72,
+	0x4f, 0xa6, 0xdd, 0xe2, 0xaf, 0xfb, 0x6d, 0xfc, 0x23, 0x82, 0x5d, 0x6d, 0x6d, 0x2e, 0x1e, 0x8d,
+	0xc4, 0x12, 0xb0, 0xd5, 0xca, 0x58, 0xcc, 0x68, 0x09, 0x7c, 0x8a, 0x03, 0x7f, 0x1e, 0x1f, 0x8f,
+	0x07, 0xdc, 0xb4, 0x8a, 0x01, 0xdc, 0xdf, 0x22, 0xc0, 0xad, 0xae, 0xb6, 0x75, 0x5e, 0x84, 0xda,
+	0x67, 0xe5, 0x50, 0x9c, 0x50, 0x09, 0x77, 0x92, 0xc3, 0x3d, 0x86, 0x8f, 0x2e, 0x07, 0x57, 0x4e,
+	0x8c, 0x50, 0x8d, 0x9b, 0x8f, 0xcb, 0xa1, 0x1a, 0xb7, 0xb5, 0xc9, 0xca, 0x58, 0xcc, 0xe8, 0xa4,
+	0x1a, 0x4b, 0xd0, 0x15, 0xc3, 0x76, 0xdc, 0x83, 0xbf, 0x8f, 0xfb, 0x1f, 0x04, 0x07, 0x62, 0xb9,
+	0x41, 0x3c, 0x19, 0x0b, 0x59, 0xc8, 0x96, 0xad, 0x9c, 0x5a, 0x61, 0xb6, 0xe4, 0xa9, 0x73, 0x9e,
+	0xb3, 0xf8, 0xe5, 0x84, 0x3c, 0xb3, 0x16, 0x6b, 0x9c, 0x5f, 0xcc, 0x2a, 0xd5, 0x7d, 0xea, 0x3f,
+	0x23, 0xff, 0xcb, 0x4b, 0xab, 0xf5, 0xc3, 0x87, 0x23, 0x27, 0x7b, 0x1b, 0x27, 0xab, 0x8c, 0x27,
+	0xc8, 0x90, 0xb4, 0xa6, 0x39, 0xad, 0xd3, 0x78, 0x32, 0xde, 0x2b, 0x42, 0x0b, 0xd9, 0x1c, 0x2f,
+	0x92, 0x6d, 0x7a, 0x86, 0xbf, 0x20, 0x50, 0xda, 0xca, 0xc9, 0x37, 0x58, 0x3c, 0x1e, 0x4b, 0xfa,
+	0xc6, 0x93, 0x84, 0x32, 0x91, 0x24, 0x45, 0x72, 0x79, 0x91, 0x73, 0x99, 0xc2, 0xa7, 0x92, 0x3e,
+	0x22, 0x7e, 0x54, 0xf0, 0xc9, 0x7c, 0x84, 0x60, 0x4b, 0x83, 0x33, 0xc3, 0x24, 0x08, 0xa5, 0xd5,
+	0x36, 0x2a, 0xfb, 0x22, 0x63, 0x24, 0xbe, 0x51, 0x8e, 0x6f, 0x18, 0xef, 0x0f, 0xc3, 0x27, 0x71,
+	0x09, 0xcf, 0x79, 0x0f, 0x01, 0x88, 0x2a, 0x99, 0xfa, 0xcc, 0x34, 0x1e, 0x6c, 0xdf, 0xc1, 0x03,
+	0x90, 0x0a, 0x1b, 0x96, 0xbd, 0x8f, 0xf1, 0xde, 0x87, 0xb1, 0xba, 0x4c, 0xef, 0x5c, 0x3d, 0x6b,
+	0x16, 0xb4, 0x5b, 0xd2, 0x98, 0xdd, 0xc6, 0xbf, 0x22, 0x50, 0xc2, 0xcd, 0x58, 0xeb, 0x93, 0x5d,
+	0xd6, 0xf6, 0x29, 0x13, 0x49, 0x52, 0x24, 0xfa, 0xf3, 0x1c, 0xfd, 0x19, 0x7c, 0x3a, 0x0c, 0x7d,
+	0xb3, 0x13, 0xac, 0x55, 0x6c, 0x97, 0x88, 0x24, 0xd1, 0xc0, 0xe6, 0x37, 0x04, 0x7b, 0x22, 0x8e,
+	0x83, 0x38, 0x7a, 0xd6, 0xb5, 0xb5, 0x84, 0xca, 0x91, 0x44, 0x39, 0x71, 0x09, 0x05, 0xa6, 0x6a,
+	0x89, 0x97, 0xc9, 0x7a, 0x87, 0xdd, 0xf0, 0x45, 0xdf, 0xa7, 0x12, 0xbd, 0xe8, 0x07, 0x49, 0x8c,
+	0xc5, 0x8c, 0x5e, 0xe1, 0xa2, 0xdf, 0x82, 0xfb, 0x93, 0x75, 0xf0, 0x5c, 0x02, 0x13, 0x83, 0x33,
+	0x09, 0x44, 0x0e, 0xdb, 0x00, 0xce, 0x75, 0x54, 0x43, 0x32, 0x7f, 0x93, 0x33, 0xbf, 0x84, 0x2f,
+	0xae, 0xec, 0xc1, 0x45, 0xed, 0x06, 0x0b, 0x4b, 0x1f, 0x2b, 0x43, 0xbd, 0x0a, 0x3e, 0x9e, 0x80,
+	0x44, 0xd3, 0x0a, 0x75, 0x22, 0x79, 0xa2, 0xa4, 0x3c, 0xcb, 0x29, 0x9f, 0xc7, 0xd3, 0x2b, 0xa4,
+	0xdc, 0xbc, 0xba, 0xde, 0x01, 0x58, 0xb2, 0x16, 0x78, 0x6f, 0xa8, 0x83, 0xf0, 0x4f, 0x50, 0x24,
+	0x2a, 0x44, 0x42, 0x3c, 0xc4, 0x21, 0xee, 0xc7, 0x24, 0x0c, 0xa2, 0xe9, 0xe7, 0x64, 0x5e, 0x79,
+	0xf8, 0x24, 0x85, 0x1e, 0x3d, 0x49, 0xa1, 0xbf, 0x9e, 0xa4, 0xd0, 0xfd, 0x85, 0x54, 0xd7, 0xa3,
+	0x85, 0x54, 0xd7, 0x1f, 0x0b, 0xa9, 0xae, 0xb7, 0xc6, 0x1b, 0x3e, 0xb1, 0xc9, 0x3a, 0x63, 0x25,
+	0x23, 0x67, 0xfb, 0x45, 0x6f, 0x1e, 0xd7, 0xde, 0xf7, 0x2a, 0xf3, 0x2f, 0x6e, 0xb9, 0x1e, 0x6e,
+	0x57, 0x8f, 0xfc, 0x3b, 0x00, 0x6a, 0x4f, 0x67, 0x20, 0xed, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1706,6 +1865,8 @@ type QueryClient interface {
 	AccountLockedLongerDurationNotUnlockingOnly(ctx context.Context, in *AccountLockedLongerDurationNotUnlockingOnlyRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(ctx context.Context, in *AccountLockedLongerDurationDenomRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationDenomResponse, error)
+	// Runs the lockup invariants, and returns whether they are broken
+	Invariants(ctx context.Context, in *InvariantsRequest, opts ...grpc.CallOption) (*InvariantsResponse, error)
 }
 
 type queryClient struct {
@@ -1860,6 +2021,15 @@ func (c *queryClient) AccountLockedLongerDurationDenom(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryClient) Invariants(ctx context.Context, in *InvariantsRequest, opts ...grpc.CallOption) (*InvariantsResponse, error) {
+	out := new(InvariantsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Return full balance of the module
@@ -1896,6 +2066,8 @@ type QueryServer interface {
 	AccountLockedLongerDurationNotUnlockingOnly(context.Context, *AccountLockedLongerDurationNotUnlockingOnlyRequest) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(context.Context, *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error)
+	// Runs the lockup invariants, and returns whether they are broken
+	Invariants(context.Context, *InvariantsRequest) (*InvariantsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1950,6 +2122,9 @@ func (*UnimplementedQueryServer) AccountLockedLongerDurationNotUnlockingOnly(ctx
 func (*UnimplementedQueryServer) AccountLockedLongerDurationDenom(ctx context.Context, req *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountLockedLongerDurationDenom not implemented")
 }
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *InvariantsRequest) (*InvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2243,6 +2418,24 @@ func _Query_AccountLockedLongerDurationDenom_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*InvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2311,6 +2504,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountLockedLongerDurationDenom",
 			Handler:    _Query_AccountLockedLongerDurationDenom_Handler,
 		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/query.proto",
@@ -3450,6 +3647,120 @@ func (m *AccountLockedLongerDurationDenomResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *InvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3919,6 +4230,54 @@ func (m *AccountLockedLongerDurationDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *InvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *InvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6854,6 +7213,306 @@ func (m *AccountLockedLongerDurationDenomResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *InvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, InvariantResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,7 +16,12 @@ var _ gammtypes.GammHooks = Hooks{}
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
 
 // AfterPoolCreated creates a gauge for each pool’s lockable duration.
+// Pools without LP shares, such as concentrated liquidity pools, have nothing to lock, so get no gauges.
 func (h Hooks) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	if !h.k.bankKeeper.HasSupply(ctx, gammtypes.GetPoolShareDenom(poolId)) {
+		return
+	}
+
 	err := h.k.CreatePoolGauges(ctx, poolId)
 	if err != nil {
 		panic(err)
@@ -20,7 +20,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper) {
 	}
 }
 
-// Called every block to automatically unlock matured locks.
+// EndBlocker is called on every block, before the gov end blocker,
+// to cast the superfluid votes on the first proposal that is about to be tallied.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.BeforeNextProposalTally(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -3,4 +3,15 @@ package cli
 // Proposal flags.
 const (
 	FlagSuperfluidAssets = "superfluid-assets"
+
+	// The osmo pricing of a superfluid asset whose pool has no osmo.
+	FlagPricingDenom            = "pricing-denom"
+	FlagOsmoPricingRoutePoolIds = "osmo-pricing-route-pool-ids"
+	FlagOsmoPricingRouteDenoms  = "osmo-pricing-route-denoms"
+	FlagRiskFactor              = "risk-factor"
+)
+
+// Query flags.
+const (
+	FlagNumEpochs = "num-epochs"
 )
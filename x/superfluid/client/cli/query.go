@@ -29,12 +29,16 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdAllSuperfluidAssets(),
 		GetCmdAssetMultiplier(),
+		GetCmdAssetMultiplierHistory(),
 		GetCmdAllIntermediaryAccounts(),
 		GetCmdConnectedIntermediaryAccount(),
 		GetCmdSuperfluidDelegationAmount(),
 		GetCmdSuperfluidDelegationsByDelegator(),
 		GetCmdSuperfluidUndelegationsByDelegator(),
 		GetCmdTotalSuperfluidDelegations(),
+		GetCmdSuperfluidLockRewards(),
+		GetCmdSuperfluidLockRewardsByDelegator(),
+		GetCmdSuperfluidVoteBreakdown(),
 	)
 
 	return cmd
@@ -146,6 +150,51 @@ $ %s query superfluid asset-multiplier gamm/pool/1
 	return cmd
 }
 
+// GetCmdAssetMultiplierHistory returns the multipliers of an asset set at each epoch.
+func GetCmdAssetMultiplierHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset-multiplier-history [denom]",
+		Short: "Query the multipliers of an asset set at each epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the multipliers of an asset set at each epoch.
+
+Example:
+$ %s query superfluid asset-multiplier-history gamm/pool/1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AssetMultiplierHistory(cmd.Context(), &types.AssetMultiplierHistoryRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "asset-multiplier-history")
+
+	return cmd
+}
+
 // GetCmdAllIntermediaryAccounts returns all superfluid intermediary accounts.
 func GetCmdAllIntermediaryAccounts() *cobra.Command {
 	cmd := &cobra.Command{
@@ -349,3 +398,136 @@ func GetCmdTotalSuperfluidDelegations() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSuperfluidLockRewards returns the pending and realized staking rewards of a superfluid delegated lock.
+func GetCmdSuperfluidLockRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "superfluid-lock-rewards [lock_id]",
+		Short: "Query the delegation and the pending and realized staking rewards of a superfluid delegated lock",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the intermediary account, the osmo equivalent delegation and the pending and realized staking rewards of a superfluid delegated lock.
+The realized rewards cover the last --num-epochs epochs, or the whole incentives reward history by default.
+
+Example:
+$ %s query superfluid superfluid-lock-rewards 1 --num-epochs 7
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			numEpochs, err := cmd.Flags().GetInt64(FlagNumEpochs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SuperfluidLockRewards(cmd.Context(), &types.SuperfluidLockRewardsRequest{
+				LockId:    lockId,
+				NumEpochs: numEpochs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagNumEpochs, 0, "Number of past epochs to return the realized rewards of")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSuperfluidLockRewardsByDelegator returns the pending and realized staking rewards of the superfluid
+// delegated locks of the specified delegator.
+func GetCmdSuperfluidLockRewardsByDelegator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "superfluid-lock-rewards-by-delegator [delegator_address]",
+		Short: "Query the delegations and the pending and realized staking rewards of the superfluid delegated locks of the specified delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numEpochs, err := cmd.Flags().GetInt64(FlagNumEpochs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SuperfluidLockRewardsByDelegator(cmd.Context(), &types.SuperfluidLockRewardsByDelegatorRequest{
+				DelegatorAddress: args[0],
+				NumEpochs:        numEpochs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagNumEpochs, 0, "Number of past epochs to return the realized rewards of")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSuperfluidVoteBreakdown returns how the votes of the intermediary accounts on a proposal break down.
+func GetCmdSuperfluidVoteBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "superfluid-vote-breakdown [proposal_id]",
+		Short: "Query how the votes of the intermediary accounts on a proposal break down into superfluid and validator votes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query, for each intermediary account, its delegation, the vote of its validator, the superfluid delegators
+overriding it with the osmo equivalent of their locks, the amount inheriting the vote of the validator,
+and the weighted vote cast for the intermediary account when the voting period ends.
+
+Example:
+$ %s query superfluid superfluid-vote-breakdown 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SuperfluidVoteBreakdown(cmd.Context(), &types.SuperfluidVoteBreakdownRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
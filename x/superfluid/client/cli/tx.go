@@ -1,18 +1,22 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -30,7 +34,9 @@ func GetTxCmd() *cobra.Command {
 		NewSuperfluidDelegateCmd(),
 		NewSuperfluidUndelegateCmd(),
 		NewSuperfluidUnbondLockCmd(),
-		// NewSuperfluidRedelegateCmd(),
+		NewSuperfluidRedelegateCmd(),
+		NewSuperfluidUndelegatePartialCmd(),
+		NewSuperfluidVoteCmd(),
 		NewCmdSubmitSetSuperfluidAssetsProposal(),
 		NewCmdSubmitRemoveSuperfluidAssetsProposal(),
 		NewCmdLockAndSuperfluidDelegate(),
@@ -109,6 +115,93 @@ func NewSuperfluidUndelegateCmd() *cobra.Command {
 	return cmd
 }
 
+// NewSuperfluidUndelegatePartialCmd broadcast MsgSuperfluidUndelegatePartial.
+func NewSuperfluidUndelegatePartialCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-partial [lock_id] [amount] [flags]",
+		Short: "superfluid undelegate a part of a lock from a validator, split off into a new lock",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidUndelegatePartial(
+				clientCtx.GetFromAddress(),
+				uint64(lockId),
+				coin,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSuperfluidVoteCmd broadcast MsgSuperfluidVote.
+func NewSuperfluidVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal_id] [options] [flags]",
+		Short: "vote on a proposal with superfluid delegated locks, overriding the vote of their validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal in the voting period with the osmo equivalent of your superfluid delegated locks,
+instead of the validators they are delegated to. The options are yes/no/no_with_veto/abstain, optionally weighted.
+
+Example:
+$ %s tx superfluid vote 1 yes --from mykey
+$ %s tx superfluid vote 1 yes=0.6,no=0.4 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			options, err := govtypes.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidVote(
+				clientCtx.GetFromAddress(),
+				proposalID,
+				options,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewSuperfluidUnbondLock broadcast MsgSuperfluidUndelegate and.
 func NewSuperfluidUnbondLockCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -141,43 +234,43 @@ func NewSuperfluidUnbondLockCmd() *cobra.Command {
 	return cmd
 }
 
-// NewSuperfluidRedelegateCmd broadcast MsgSuperfluidRedelegate
-// func NewSuperfluidRedelegateCmd() *cobra.Command {
-// 	cmd := &cobra.Command{
-// 		Use:   "redelegate [lock_id] [val_addr] [flags]",
-// 		Short: "superfluid redelegate a lock to a new validator",
-// 		Args:  cobra.ExactArgs(2),
-// 		RunE: func(cmd *cobra.Command, args []string) error {
-// 			clientCtx, err := client.GetClientTxContext(cmd)
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
-
-// 			lockId, err := strconv.Atoi(args[0])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			valAddr, err := sdk.ValAddressFromBech32(args[1])
-// 			if err != nil {
-// 				return err
-// 			}
-
-// 			msg := types.NewMsgSuperfluidRedelegate(
-// 				clientCtx.GetFromAddress(),
-// 				uint64(lockId),
-// 				valAddr,
-// 			)
-
-// 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
-// 		},
-// 	}
-
-// 	flags.AddTxFlagsToCmd(cmd)
-// 	return cmd
-// }
+// NewSuperfluidRedelegateCmd broadcast MsgSuperfluidRedelegate.
+func NewSuperfluidRedelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate [lock_id] [val_addr] [flags]",
+		Short: "superfluid redelegate a lock to a new validator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			lockId, err := strconv.Atoi(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSuperfluidRedelegate(
+				clientCtx.GetFromAddress(),
+				uint64(lockId),
+				valAddr,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
 // NewCmdSubmitSetSuperfluidAssetsProposal implements a command handler for submitting a superfluid asset set proposal transaction.
 func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
@@ -224,6 +317,10 @@ func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagSuperfluidAssets, "", "The superfluid asset array")
+	cmd.Flags().String(FlagPricingDenom, "", "The pool asset priced in osmo through the osmo pricing route, for a single asset whose pool has no osmo")
+	cmd.Flags().StringArray(FlagOsmoPricingRoutePoolIds, []string{""}, "osmo pricing route pool ids")
+	cmd.Flags().StringArray(FlagOsmoPricingRouteDenoms, []string{""}, "osmo pricing route token out denoms")
+	cmd.Flags().String(FlagRiskFactor, "", "The risk factor of the assets, the minimum risk factor param applies if it is higher")
 
 	return cmd
 }
@@ -295,11 +392,40 @@ func parseSetSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content
 
 	assets := strings.Split(assetsStr, ",")
 
+	pricingDenom, err := cmd.Flags().GetString(FlagPricingDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	osmoPricingRoute, err := osmoPricingRoute(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+	if len(osmoPricingRoute) != 0 && len(assets) != 1 {
+		return nil, errors.New("an osmo pricing route can only be set for a single superfluid asset")
+	}
+
+	var riskFactor *sdk.Dec
+	riskFactorStr, err := cmd.Flags().GetString(FlagRiskFactor)
+	if err != nil {
+		return nil, err
+	}
+	if riskFactorStr != "" {
+		dec, err := sdk.NewDecFromStr(riskFactorStr)
+		if err != nil {
+			return nil, err
+		}
+		riskFactor = &dec
+	}
+
 	superfluidAssets := []types.SuperfluidAsset{}
 	for _, asset := range assets {
 		superfluidAssets = append(superfluidAssets, types.SuperfluidAsset{
-			Denom:     asset,
-			AssetType: types.SuperfluidAssetTypeLPShare,
+			Denom:            asset,
+			AssetType:        types.SuperfluidAssetTypeLPShare,
+			PricingDenom:     pricingDenom,
+			OsmoPricingRoute: osmoPricingRoute,
+			RiskFactor:       riskFactor,
 		})
 	}
 
@@ -311,6 +437,38 @@ func parseSetSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content
 	return content, nil
 }
 
+func osmoPricingRoute(fs *flag.FlagSet) ([]types.PricingRouteHop, error) {
+	routePoolIds, err := fs.GetStringArray(FlagOsmoPricingRoutePoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	routeDenoms, err := fs.GetStringArray(FlagOsmoPricingRouteDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(routePoolIds) != len(routeDenoms) {
+		return nil, errors.New("osmo pricing route pool ids and denoms mismatch")
+	}
+
+	route := []types.PricingRouteHop{}
+	for index, poolIDStr := range routePoolIds {
+		if poolIDStr == "" {
+			continue
+		}
+		pID, err := strconv.Atoi(poolIDStr)
+		if err != nil {
+			return nil, err
+		}
+		route = append(route, types.PricingRouteHop{
+			PoolId:        uint64(pID),
+			TokenOutDenom: routeDenoms[index],
+		})
+	}
+	return route, nil
+}
+
 func parseRemoveSuperfluidAssetsArgsToContent(cmd *cobra.Command) (govtypes.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -38,6 +38,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	for _, vote := range genState.SuperfluidVotes {
+		k.SetSuperfluidVote(ctx, vote)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -48,5 +52,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		SuperfluidVotes:               k.GetAllSuperfluidVotes(ctx),
 	}
 }
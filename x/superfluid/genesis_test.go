@@ -12,13 +12,15 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var now = time.Now().UTC()
 
 var testGenesis = types.GenesisState{
 	Params: types.Params{
-		MinimumRiskFactor: sdk.NewDecWithPrec(5, 1), // 50%
+		MinimumRiskFactor:        sdk.NewDecWithPrec(5, 1), // 50%
+		OsmoMultiplierTwapWindow: time.Hour,
 	},
 	SuperfluidAssets: []types.SuperfluidAsset{
 		{
@@ -46,6 +48,13 @@ var testGenesis = types.GenesisState{
 			IntermediaryAccount: "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
 		},
 	},
+	SuperfluidVotes: []types.SuperfluidVote{
+		{
+			ProposalId: 1,
+			Voter:      "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
+			Options:    govtypes.NewNonSplitVoteOption(govtypes.OptionYes),
+		},
+	},
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -91,6 +100,9 @@ func TestInitGenesis(t *testing.T) {
 
 	connections := app.SuperfluidKeeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 	require.Equal(t, connections, genesis.IntemediaryAccountConnections)
+
+	votes := app.SuperfluidKeeper.GetAllSuperfluidVotes(ctx)
+	require.Equal(t, votes, genesis.SuperfluidVotes)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -114,4 +126,5 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesis.OsmoEquivalentMultipliers, genesis.OsmoEquivalentMultipliers)
 	require.Equal(t, genesis.IntermediaryAccounts, genesis.IntermediaryAccounts)
 	require.Equal(t, genesis.IntemediaryAccountConnections, genesis.IntemediaryAccountConnections)
+	require.Equal(t, genesisExported.SuperfluidVotes, genesis.SuperfluidVotes)
 }
@@ -23,15 +23,21 @@ func NewHandler(k *keeper.Keeper) sdk.Handler {
 		case *types.MsgSuperfluidUndelegate:
 			res, err := msgServer.SuperfluidUndelegate(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSuperfluidRedelegate:
+			res, err := msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSuperfluidUndelegatePartial:
+			res, err := msgServer.SuperfluidUndelegatePartial(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSuperfluidVote:
+			res, err := msgServer.SuperfluidVote(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgSuperfluidUnbondLock:
 			res, err := msgServer.SuperfluidUnbondLock(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgLockAndSuperfluidDelegate:
 			res, err := msgServer.LockAndSuperfluidDelegate(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
-		// case *types.MsgSuperfluidRedelegate:
-		// 	res, err := msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(ctx), msg)
-		// return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v7/osmoutils"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
@@ -97,27 +99,39 @@ func (k Keeper) distributeSuperfluidGauges(ctx sdk.Context) {
 
 func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.SuperfluidAsset, newEpochNumber int64) error {
 	if asset.AssetType == types.SuperfluidAssetTypeLPShare {
-		// LP_token_Osmo_equivalent = OSMO_amount_on_pool / LP_token_supply
+		// LP_token_Osmo_equivalent = OSMO_amount_on_pool / LP_token_supply,
+		// using the fair OSMO amount of the pool at TWAP prices over the OsmoMultiplierTwapWindow param.
+		// For pools without OSMO, the OSMO amount is the pool's pricing denom amount valued through the asset's osmo pricing route.
 		poolId := gammtypes.MustGetPoolIdFromShareDenom(asset.Denom)
-		pool, err := k.gk.GetPoolAndPoke(ctx, poolId)
+		poolI, err := k.gk.GetPoolAndPoke(ctx, poolId)
 		if err != nil {
 			// Pool has been unexpectedly deleted
 			k.Logger(ctx).Error(err.Error())
 			k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
 			return err
 		}
-
-		// get OSMO amount
-		bondDenom := k.sk.BondDenom(ctx)
-		osmoPoolAsset := pool.GetTotalPoolLiquidity(ctx).AmountOf(bondDenom)
-		if osmoPoolAsset.IsZero() {
-			// Pool has unexpectedly removed Osmo from its assets.
+		pool, ok := poolI.(*balancer.Pool)
+		if !ok {
+			// Pool has been unexpectedly added as a superfluid asset without being a balancer pool
+			err = fmt.Errorf("pool %d is not a balancer pool", poolId)
 			k.Logger(ctx).Error(err.Error())
 			k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
 			return err
 		}
 
-		multiplier := k.calculateOsmoBackingPerShare(pool, osmoPoolAsset)
+		// The multiplier is computed in a cache context, so that a panic of the pricing math unwinds the asset
+		// instead of halting the chain.
+		var multiplier sdk.Dec
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			var err error
+			multiplier, err = k.calculateOsmoEquivalentMultiplier(cacheCtx, pool, asset)
+			return err
+		})
+		if err != nil {
+			k.Logger(ctx).Error(err.Error())
+			k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
+			return err
+		}
 		k.SetOsmoEquivalentMultiplier(ctx, newEpochNumber, asset.Denom, multiplier)
 	} else if asset.AssetType == types.SuperfluidAssetTypeNative {
 		// TODO: Consider deleting superfluid asset type native
@@ -126,3 +140,21 @@ func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.Sup
 	}
 	return nil
 }
+
+// calculateOsmoEquivalentMultiplier returns the osmo equivalent worth of an LP share of pool, for the superfluid asset
+// of its shares.
+func (k Keeper) calculateOsmoEquivalentMultiplier(ctx sdk.Context, pool *balancer.Pool, asset types.SuperfluidAsset) (sdk.Dec, error) {
+	bondDenom := k.sk.BondDenom(ctx)
+	osmoPoolAsset := pool.GetTotalPoolLiquidity(ctx).AmountOf(bondDenom)
+	switch {
+	case !osmoPoolAsset.IsZero():
+		return k.calculateOsmoBackingPerShare(ctx, pool)
+	case len(asset.OsmoPricingRoute) != 0:
+		// Pools without Osmo are valued in Osmo through the asset's pricing route.
+		// This fails if a pool of the route has been unexpectedly deleted, or has removed a denom of the route.
+		return k.calculateRoutedOsmoBackingPerShare(ctx, pool, asset)
+	default:
+		// Pool has unexpectedly removed Osmo from its assets.
+		return sdk.Dec{}, fmt.Errorf("pool %d has no %s and asset %s has no osmo pricing route", pool.GetId(), bondDenom, asset.Denom)
+	}
+}
@@ -1,6 +1,14 @@
 package keeper
 
+import "github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
+
 var (
 	StakingSyntheticDenom   = stakingSyntheticDenom
 	UnstakingSyntheticDenom = unstakingSyntheticDenom
 )
+
+// WithTwapKeeper returns a copy of the keeper that gets TWAP prices from tk.
+func (k Keeper) WithTwapKeeper(tk types.TwapKeeper) Keeper {
+	k.tk = tk
+	return k
+}
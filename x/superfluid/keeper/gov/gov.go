@@ -10,6 +10,11 @@ import (
 )
 
 func HandleSetSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek types.EpochKeeper, p *types.SetSuperfluidAssetsProposal) error {
+	for _, asset := range p.Assets {
+		if err := k.ValidateSuperfluidAsset(ctx, asset); err != nil {
+			return err
+		}
+	}
 	for _, asset := range p.Assets {
 		k.AddNewSuperfluidAsset(ctx, asset)
 		event := sdk.NewEvent(
@@ -25,8 +30,7 @@ func HandleSetSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, ek type
 func HandleRemoveSuperfluidAssetsProposal(ctx sdk.Context, k keeper.Keeper, p *types.RemoveSuperfluidAssetsProposal) error {
 	for _, denom := range p.SuperfluidAssetDenoms {
 		asset := k.GetSuperfluidAsset(ctx, denom)
-		dummyAsset := types.SuperfluidAsset{}
-		if asset == dummyAsset {
+		if asset.Denom == "" {
 			return fmt.Errorf("superfluid asset %s doesn't exist", denom)
 		}
 		k.BeginUnwindSuperfluidAsset(ctx, 0, asset)
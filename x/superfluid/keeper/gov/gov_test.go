@@ -150,3 +150,77 @@ func (suite *KeeperTestSuite) TestHandleSetSuperfluidAssetsProposal() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestHandleSetSuperfluidAssetsProposalWithPricingRoute() {
+	suite.SetupTest()
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	osmoPoolId := suite.createGammPool([]string{bondDenom, "foo"})
+	poolId := suite.createGammPool([]string{"foo", "bar"})
+	otherPoolId := suite.createGammPool([]string{"bar", "baz"})
+	riskFactor := sdk.NewDecWithPrec(6, 1)
+
+	validAsset := types.SuperfluidAsset{
+		Denom:            fmt.Sprintf("gamm/pool/%d", poolId),
+		AssetType:        types.SuperfluidAssetTypeLPShare,
+		PricingDenom:     "foo",
+		OsmoPricingRoute: []types.PricingRouteHop{{PoolId: osmoPoolId, TokenOutDenom: bondDenom}},
+		RiskFactor:       &riskFactor,
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(asset *types.SuperfluidAsset)
+		expectErr bool
+	}{
+		{"valid pricing route", func(asset *types.SuperfluidAsset) {}, false},
+		{"no pricing route for a pool without osmo", func(asset *types.SuperfluidAsset) {
+			asset.PricingDenom = ""
+			asset.OsmoPricingRoute = nil
+		}, true},
+		{"pricing route for a pool with osmo", func(asset *types.SuperfluidAsset) {
+			asset.Denom = fmt.Sprintf("gamm/pool/%d", osmoPoolId)
+		}, true},
+		{"pricing denom not in the pool", func(asset *types.SuperfluidAsset) {
+			asset.PricingDenom = "baz"
+		}, true},
+		{"route pool without the pricing denom", func(asset *types.SuperfluidAsset) {
+			asset.OsmoPricingRoute = []types.PricingRouteHop{{PoolId: otherPoolId, TokenOutDenom: bondDenom}}
+		}, true},
+		{"route not ending in osmo", func(asset *types.SuperfluidAsset) {
+			asset.OsmoPricingRoute = []types.PricingRouteHop{{PoolId: poolId, TokenOutDenom: "bar"}}
+		}, true},
+		{"route pool does not exist", func(asset *types.SuperfluidAsset) {
+			asset.OsmoPricingRoute = []types.PricingRouteHop{{PoolId: 100, TokenOutDenom: bondDenom}}
+		}, true},
+		{"risk factor above one", func(asset *types.SuperfluidAsset) {
+			riskFactor := sdk.NewDec(2)
+			asset.RiskFactor = &riskFactor
+		}, true},
+		{"risk factor of one", func(asset *types.SuperfluidAsset) {
+			riskFactor := sdk.OneDec()
+			asset.RiskFactor = &riskFactor
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := suite.ctx.CacheContext()
+			asset := validAsset
+			tc.malleate(&asset)
+
+			err := gov.HandleSetSuperfluidAssetsProposal(cacheCtx, *suite.app.SuperfluidKeeper, *suite.app.EpochsKeeper, &types.SetSuperfluidAssetsProposal{
+				Title:       "title",
+				Description: "description",
+				Assets:      []types.SuperfluidAsset{asset},
+			})
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Equal("", suite.app.SuperfluidKeeper.GetSuperfluidAsset(cacheCtx, asset.Denom).Denom)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(asset, suite.app.SuperfluidKeeper.GetSuperfluidAsset(cacheCtx, asset.Denom))
+			suite.Require().Equal(riskFactor, suite.app.SuperfluidKeeper.GetRiskFactor(cacheCtx, asset))
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -83,6 +85,37 @@ func (q Querier) AssetMultiplier(goCtx context.Context, req *types.AssetMultipli
 	}, nil
 }
 
+// AssetMultiplierHistory returns the multipliers of an asset set at each epoch.
+func (q Querier) AssetMultiplierHistory(goCtx context.Context, req *types.AssetMultiplierHistoryRequest) (*types.AssetMultiplierHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(q.Keeper.storeKey), types.GetKeyPrefixTokenMultiplierHistory(req.Denom))
+
+	records := []types.OsmoEquivalentMultiplierRecord{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		record := types.OsmoEquivalentMultiplierRecord{}
+		if err := q.Keeper.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.AssetMultiplierHistoryResponse{
+		OsmoEquivalentMultipliers: records,
+		Pagination:                pageRes,
+	}, nil
+}
+
 // AllIntermediaryAccounts returns all superfluid intermediary accounts.
 func (q Querier) AllIntermediaryAccounts(goCtx context.Context, _ *types.AllIntermediaryAccountsRequest) (*types.AllIntermediaryAccountsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -348,7 +381,8 @@ func (q Querier) EstimateSuperfluidDelegatedAmountByValidatorDenom(goCtx context
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if q.Keeper.GetSuperfluidAsset(ctx, req.Denom).Denom == "" {
+	asset := q.Keeper.GetSuperfluidAsset(ctx, req.Denom)
+	if asset.Denom == "" {
 		return nil, types.ErrNonSuperfluidAsset
 	}
 
@@ -374,7 +408,7 @@ func (q Querier) EstimateSuperfluidDelegatedAmountByValidatorDenom(goCtx context
 	}
 
 	syntheticOsmoAmt := delegation.Shares.Quo(val.DelegatorShares).MulInt(val.Tokens)
-	baseAmount := q.Keeper.UnriskAdjustOsmoValue(ctx, syntheticOsmoAmt).Quo(q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)).RoundInt()
+	baseAmount := q.Keeper.UnriskAdjustOsmoValue(ctx, asset, syntheticOsmoAmt).Quo(q.Keeper.GetOsmoEquivalentMultiplier(ctx, req.Denom)).RoundInt()
 
 	return &types.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse{
 		TotalDelegatedCoins: sdk.NewCoins(sdk.NewCoin(req.Denom, baseAmount)),
@@ -412,3 +446,66 @@ func (q Querier) TotalSuperfluidDelegations(goCtx context.Context, _ *types.Tota
 		TotalDelegations: totalSuperfluidDelegated,
 	}, nil
 }
+
+// SuperfluidLockRewards returns the intermediary account, the osmo equivalent delegation and the pending and
+// realized staking rewards of a superfluid delegated lock.
+func (q Querier) SuperfluidLockRewards(goCtx context.Context, req *types.SuperfluidLockRewardsRequest) (*types.SuperfluidLockRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	lockRewards, err := q.Keeper.GetSuperfluidLockRewards(ctx, req.LockId, req.NumEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SuperfluidLockRewardsResponse{LockRewards: lockRewards}, nil
+}
+
+// SuperfluidLockRewardsByDelegator returns the superfluid lock rewards of all the superfluid delegated locks
+// of a delegator.
+func (q Querier) SuperfluidLockRewardsByDelegator(goCtx context.Context, req *types.SuperfluidLockRewardsByDelegatorRequest) (*types.SuperfluidLockRewardsByDelegatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.DelegatorAddress) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.SuperfluidLockRewardsByDelegatorResponse{
+		LockRewards: []types.SuperfluidLockRewards{},
+	}
+	for _, lock := range q.Keeper.lk.GetAccountPeriodLocks(ctx, delAddr) {
+		if _, found := q.Keeper.GetIntermediaryAccountFromLockId(ctx, lock.ID); !found {
+			continue
+		}
+		lockRewards, err := q.Keeper.GetSuperfluidLockRewards(ctx, lock.ID, req.NumEpochs)
+		if err != nil {
+			return nil, err
+		}
+		res.LockRewards = append(res.LockRewards, lockRewards)
+	}
+
+	return &res, nil
+}
+
+// SuperfluidVoteBreakdown returns how the votes of the intermediary accounts on a proposal break down into
+// the votes of superfluid delegators and the votes inherited from their validators.
+func (q Querier) SuperfluidVoteBreakdown(goCtx context.Context, req *types.SuperfluidVoteBreakdownRequest) (*types.SuperfluidVoteBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.SuperfluidVoteBreakdownResponse{
+		Breakdowns: q.Keeper.GetSuperfluidVoteBreakdown(ctx, req.ProposalId),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 )
 
@@ -190,3 +191,79 @@ func (suite *KeeperTestSuite) TestGRPCQuerySuperfluidDelegationsDontIncludeUnbon
 	suite.Require().NoError(err)
 	suite.Require().Equal(totalSuperfluidDelegationsRes.TotalDelegations, sdk.NewInt(30000000))
 }
+
+func (suite *KeeperTestSuite) TestGRPCQuerySuperfluidLockRewards() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(2)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+
+	// the second lock has three times the first lock's share of the superfluid gauge
+	superfluidDelegations := []superfluidDelegation{
+		{0, 0, 0, 1000000},
+		{1, 0, 0, 3000000},
+	}
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, superfluidDelegations, denoms)
+	acc := intermediaryAccs[0]
+
+	queryLockRewards := func(lockId uint64) types.SuperfluidLockRewards {
+		res, err := suite.querier.SuperfluidLockRewards(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidLockRewardsRequest{LockId: lockId})
+		suite.Require().NoError(err)
+		return res.LockRewards
+	}
+
+	lockRewards := queryLockRewards(locks[0].ID)
+	suite.Require().Equal(locks[0].ID, lockRewards.LockId)
+	suite.Require().Equal(types.SuperfluidIntermediaryAccountInfo{
+		Denom:   acc.Denom,
+		ValAddr: acc.ValAddr,
+		GaugeId: acc.GaugeId,
+		Address: acc.GetAccAddress().String(),
+	}, lockRewards.IntermediaryAccount)
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(1000000))), lockRewards.DelegationAmount)
+	suite.Require().True(lockRewards.PendingRewards.IsZero())
+	suite.Require().Empty(lockRewards.RealizedRewards)
+
+	// the pending staking rewards are split by the locks' shares of the superfluid gauge
+	suite.AllocateRewardsToValidator(valAddrs[0], sdk.NewInt(20000))
+	pendingRewards := queryLockRewards(locks[0].ID).PendingRewards
+	suite.Require().True(pendingRewards.AmountOf(sdk.DefaultBondDenom).IsPositive())
+	otherPendingRewards := queryLockRewards(locks[1].ID).PendingRewards
+	suite.Require().True(otherPendingRewards.AmountOf(sdk.DefaultBondDenom).Sub(pendingRewards.AmountOf(sdk.DefaultBondDenom).MulInt64(3)).Abs().LTE(sdk.OneDec()))
+
+	// querying pending rewards doesn't withdraw them
+	suite.Require().Equal(pendingRewards, queryLockRewards(locks[0].ID).PendingRewards)
+
+	// once the gauge distributed them, the pending rewards are realized
+	suite.App.SuperfluidKeeper.MoveSuperfluidDelegationRewardToGauges(suite.Ctx)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, acc.GaugeId)
+	suite.Require().NoError(err)
+	_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []incentivestypes.Gauge{*gauge})
+	suite.Require().NoError(err)
+
+	lockRewards = queryLockRewards(locks[0].ID)
+	suite.Require().True(lockRewards.PendingRewards.IsZero())
+	suite.Require().Len(lockRewards.RealizedRewards, 1)
+	suite.Require().Equal(acc.GaugeId, lockRewards.RealizedRewards[0].GaugeId)
+	suite.Require().Equal(lockRewards.RealizedRewards[0].Rewards, lockRewards.TotalRealizedRewards)
+	suite.Require().True(lockRewards.TotalRealizedRewards.AmountOf(sdk.DefaultBondDenom).Sub(pendingRewards.AmountOf(sdk.DefaultBondDenom)).Abs().LTE(sdk.OneDec()))
+
+	// the delegator query returns the rewards of the delegator's superfluid delegated locks
+	delegatorRes, err := suite.querier.SuperfluidLockRewardsByDelegator(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidLockRewardsByDelegatorRequest{
+		DelegatorAddress: delAddrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SuperfluidLockRewards{lockRewards}, delegatorRes.LockRewards)
+
+	// undelegated locks have no superfluid lock rewards
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, locks[0].Owner, locks[0].ID)
+	suite.Require().NoError(err)
+	_, err = suite.querier.SuperfluidLockRewards(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidLockRewardsRequest{LockId: locks[0].ID})
+	suite.Require().Error(err)
+	delegatorRes, err = suite.querier.SuperfluidLockRewardsByDelegator(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidLockRewardsByDelegatorRequest{
+		DelegatorAddress: delAddrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(delegatorRes.LockRewards)
+}
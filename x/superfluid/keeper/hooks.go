@@ -8,6 +8,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // Hooks wrapper struct for incentives keeper.
@@ -15,7 +16,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooks = Hooks{}
+	_ govtypes.GovHooks      = Hooks{}
+)
 
 // Return the wrapper struct.
 func (k Keeper) Hooks() Hooks {
@@ -58,6 +62,11 @@ func (h Hooks) OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uin
 func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
 }
 
+// Only locks whose synthetic lockups are unlocking can cancel unlocking. Superfluid unbonding synthetic lockups keep
+// unbonding, so that the lock stays slashable for their validator, and superfluid stakes don't change.
+func (h Hooks) OnCancelUnlocking(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
+}
+
 func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
 }
 
@@ -67,6 +76,34 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, oldDuration, newDuration time.Duration) {
 }
 
+// The split lock gets copies of the synthetic lockups of the lock, so if the lock is superfluid delegated,
+// the split lock is delegated through the same intermediary account. The delegation amount doesn't change.
+func (h Hooks) OnLockSplit(ctx sdk.Context, lockID uint64, splitLockID uint64, amount sdk.Coins) {
+	if acc, found := h.k.GetIntermediaryAccountFromLockId(ctx, lockID); found {
+		h.k.SetLockIdIntermediaryAccountConnection(ctx, splitLockID, acc)
+	}
+}
+
+// Merged locks have the same synthetic lockups as the lock they are merged into, so only the
+// intermediary account connection of the deleted lock has to be removed.
+func (h Hooks) OnLockMerged(ctx sdk.Context, lockID uint64, mergedLockID uint64, amount sdk.Coins) {
+	h.k.DeleteLockIdIntermediaryAccountConnection(ctx, mergedLockID)
+}
+
+// Superfluid delegations are tracked by lock ID, so the new owner of a superfluid delegated lock
+// takes over its delegation, and its synthetic lockups, without further changes.
+func (h Hooks) OnLockTransferred(ctx sdk.Context, lockID uint64, prevOwner sdk.AccAddress, newOwner sdk.AccAddress) {
+}
+
+func (h Hooks) OnSetLockAutoCompound(ctx sdk.Context, lockID uint64, autoCompound bool) {
+}
+
+func (h Hooks) OnSyntheticLockupCreated(ctx sdk.Context, lockID uint64, synthDenom string) {
+}
+
+func (h Hooks) OnSyntheticLockupDeleted(ctx sdk.Context, lockID uint64, synthDenom string) {
+}
+
 // staking hooks.
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
@@ -104,3 +141,23 @@ func (h Hooks) AfterValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, in
 	}
 	h.k.RefreshIntermediaryDelegationAmounts(ctx)
 }
+
+// governance hooks
+func (h Hooks) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
+}
+
+func (h Hooks) AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) {
+}
+
+func (h Hooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
+}
+
+func (h Hooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+}
+
+// The superfluid votes have been cast before the proposal was tallied, so they are deleted like the votes of the gov
+// module, and the superfluid votes on the next proposal tallied in this block are cast.
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.k.AfterProposalTally(ctx, proposalID)
+	h.k.BeforeNextProposalTally(ctx)
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	lockupkeeper "github.com/osmosis-labs/osmosis/v7/x/lockup/keeper"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -90,7 +92,11 @@ func (suite *KeeperTestSuite) TestSuperfluidAfterEpochEnd() {
 				suite.Require().Equal(sdk.NewDec(7500000), delegation.Shares)
 			}
 
+			// gauge rewards are accrued by the locks, and sent to delegators when claimed
 			for index, delAddr := range delAddrs {
+				rewards, err := suite.App.IncentivesKeeper.ClaimRewards(suite.Ctx, delAddr)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRewards[index], rewards)
 				balance := suite.App.BankKeeper.GetAllBalances(suite.Ctx, delAddr)
 				suite.Require().Equal(tc.expRewards[index], balance)
 			}
@@ -307,3 +313,67 @@ func (suite *KeeperTestSuite) TestBeforeSlashingUnbondingDelegationHook() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSplitAndMergeSuperfluidLock() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	delAddrs := CreateRandomAccounts(1)
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+	delegation := func() sdk.Dec {
+		del, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAccs[0].GetAccAddress(), valAddrs[0])
+		if !found {
+			return sdk.ZeroDec()
+		}
+		return del.Shares
+	}
+	delegated := delegation()
+
+	// the split lock is superfluid delegated through the same intermediary account
+	splitLock, err := suite.App.LockupKeeper.SplitLock(suite.Ctx, lock.ID, sdk.Coins{sdk.NewInt64Coin(denoms[0], 400000)})
+	suite.Require().NoError(err)
+	acc, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, splitLock.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(intermediaryAccs[0], acc)
+	suite.Require().Equal(delegated, delegation())
+
+	// merging it back removes its connection
+	mergedLock, err := suite.App.LockupKeeper.MergeLocks(suite.Ctx, []uint64{lock.ID, splitLock.ID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(lock.Coins, mergedLock.Coins)
+	_, found = suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, splitLock.ID)
+	suite.Require().False(found)
+	suite.Require().Equal(delegated, delegation())
+
+	// undelegating the merged lock undelegates all of it
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(delegation().IsZero())
+
+	reason, broken := lockupkeeper.AllInvariants(*suite.App.LockupKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+}
+
+func (suite *KeeperTestSuite) TestTransferSuperfluidLock() {
+	suite.SetupTest()
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	delAddrs := CreateRandomAccounts(2)
+	intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+
+	// the recipient takes over the delegation of the lock
+	_, err := suite.App.LockupKeeper.TransferLock(suite.Ctx, lock.ID, delAddrs[1], false)
+	suite.Require().Error(err)
+	_, err = suite.App.LockupKeeper.TransferLock(suite.Ctx, lock.ID, delAddrs[1], true)
+	suite.Require().NoError(err)
+	acc, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, lock.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(intermediaryAccs[0], acc)
+
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, delAddrs[0].String(), lock.ID)
+	suite.Require().Error(err)
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, delAddrs[1].String(), lock.ID)
+	suite.Require().NoError(err)
+}
@@ -25,13 +25,16 @@ type Keeper struct {
 	ek types.EpochKeeper
 	lk types.LockupKeeper
 	gk types.GammKeeper
+	tk types.TwapKeeper
 	ik types.IncentivesKeeper
+	// govk is set after the gov keeper is created, as the gov keeper needs the superfluid proposal handler.
+	govk types.GovKeeper
 
 	lms types.LockupMsgServer
 }
 
 // NewKeeper returns an instance of Keeper.
-func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistrKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GammKeeper, ik types.IncentivesKeeper, lms types.LockupMsgServer) *Keeper {
+func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, ak authkeeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, dk types.DistrKeeper, ek types.EpochKeeper, lk types.LockupKeeper, gk types.GammKeeper, tk types.TwapKeeper, ik types.IncentivesKeeper, lms types.LockupMsgServer) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -48,12 +51,24 @@ func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, paramSpace paramtypes.Sub
 		ek:         ek,
 		lk:         lk,
 		gk:         gk,
+		tk:         tk,
 		ik:         ik,
 
 		lms: lms,
 	}
 }
 
+// SetGovKeeper sets the gov keeper that superfluid votes are cast to.
+func (k *Keeper) SetGovKeeper(govk types.GovKeeper) *Keeper {
+	if k.govk != nil {
+		panic("cannot set superfluid gov keeper twice")
+	}
+
+	k.govk = govk
+
+	return k
+}
+
 // Logger returns a logger instance.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
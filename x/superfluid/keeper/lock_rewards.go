@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"github.com/osmosis-labs/osmosis/v7/osmoutils"
+	incentivestypes "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetSuperfluidLockRewards returns the intermediary account of a superfluid delegated lock, the osmo
+// delegated on its behalf, its share of the pending staking rewards of the intermediary account, and the
+// rewards it received from superfluid gauges in the last numEpochs epochs of the incentives reward history.
+// If numEpochs is not positive or longer than the reward history, the whole reward history is returned.
+func (k Keeper) GetSuperfluidLockRewards(ctx sdk.Context, lockID uint64, numEpochs int64) (types.SuperfluidLockRewards, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return types.SuperfluidLockRewards{}, err
+	}
+	acc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return types.SuperfluidLockRewards{}, types.ErrNotSuperfluidUsedLockup
+	}
+	lockAmount := lock.Coins.AmountOf(acc.Denom)
+
+	pendingRewards, err := k.getPendingLockRewards(ctx, acc, lockAmount)
+	if err != nil {
+		return types.SuperfluidLockRewards{}, err
+	}
+	realizedRewards, totalRealizedRewards := k.getRealizedLockRewards(ctx, lockID, numEpochs)
+
+	return types.SuperfluidLockRewards{
+		LockId: lockID,
+		IntermediaryAccount: types.SuperfluidIntermediaryAccountInfo{
+			Denom:   acc.Denom,
+			ValAddr: acc.ValAddr,
+			GaugeId: acc.GaugeId,
+			Address: acc.GetAccAddress().String(),
+		},
+		DelegationAmount:     sdk.NewCoin(k.sk.BondDenom(ctx), k.GetSuperfluidOSMOTokens(ctx, acc.Denom, lockAmount)),
+		PendingRewards:       pendingRewards,
+		RealizedRewards:      realizedRewards,
+		TotalRealizedRewards: totalRealizedRewards,
+	}, nil
+}
+
+// getPendingLockRewards returns the share of a lock in the rewards that the gauge of its intermediary account
+// will distribute at the next epoch: its undistributed coins, and the OSMO staking rewards of the intermediary
+// account that will be moved to it, as in MoveSuperfluidDelegationRewardToGauges.
+func (k Keeper) getPendingLockRewards(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, lockAmount sdk.Int) (sdk.DecCoins, error) {
+	gauge, err := k.ik.GetGaugeByID(ctx, acc.GaugeId)
+	if err != nil {
+		return nil, err
+	}
+	totalLocked := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+	if !totalLocked.IsPositive() {
+		return sdk.DecCoins{}, nil
+	}
+
+	// withdraw the delegation rewards without persisting it
+	cacheCtx, _ := ctx.CacheContext()
+	valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		return nil, err
+	}
+	_ = osmoutils.ApplyFuncIfNoError(cacheCtx, func(cacheCtx sdk.Context) error {
+		_, err := k.dk.WithdrawDelegationRewards(cacheCtx, acc.GetAccAddress(), valAddr)
+		return err
+	})
+	stakingRewards := k.bk.GetBalance(cacheCtx, acc.GetAccAddress(), k.sk.BondDenom(ctx))
+
+	gaugeRewards := gauge.Coins.Sub(gauge.DistributedCoins).Add(stakingRewards)
+	return sdk.NewDecCoinsFromCoins(gaugeRewards...).MulDecTruncate(lockAmount.ToDec()).QuoDecTruncate(totalLocked.ToDec()), nil
+}
+
+// getRealizedLockRewards returns the rewards a lock received from superfluid gauges in the last numEpochs epochs,
+// along with their total.
+func (k Keeper) getRealizedLockRewards(ctx sdk.Context, lockID uint64, numEpochs int64) ([]incentivestypes.LockEpochReward, sdk.DecCoins) {
+	rewardHistoryEpochs := int64(k.ik.GetParams(ctx).RewardHistoryEpochs)
+	if numEpochs <= 0 || numEpochs > rewardHistoryEpochs {
+		numEpochs = rewardHistoryEpochs
+	}
+	currentEpoch := k.ik.GetEpochInfo(ctx).CurrentEpoch
+	lockRewards, _ := k.ik.GetLockRewards(ctx, lockID, currentEpoch-numEpochs+1, currentEpoch)
+
+	// a lock can have received rewards from several superfluid gauges if it has been redelegated
+	isSuperfluidGauge := map[uint64]bool{}
+	rewards := []incentivestypes.LockEpochReward{}
+	total := sdk.DecCoins{}
+	for _, reward := range lockRewards {
+		superfluidGauge, ok := isSuperfluidGauge[reward.GaugeId]
+		if !ok {
+			gauge, err := k.ik.GetGaugeByID(ctx, reward.GaugeId)
+			superfluidGauge = err == nil && gauge.IsPerpetual && lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom)
+			isSuperfluidGauge[reward.GaugeId] = superfluidGauge
+		}
+		if !superfluidGauge {
+			continue
+		}
+		rewards = append(rewards, reward)
+		total = total.Add(reward.Rewards...)
+	}
+	return rewards, total
+}
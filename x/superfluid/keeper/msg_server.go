@@ -50,12 +50,36 @@ func (server msgServer) SuperfluidUndelegate(goCtx context.Context, msg *types.M
 	return &types.MsgSuperfluidUndelegateResponse{}, err
 }
 
-// func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
-// 	ctx := sdk.UnwrapSDKContext(goCtx)
+func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.MsgSuperfluidRedelegate) (*types.MsgSuperfluidRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
+	if err == nil {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSuperfluidRedelegate,
+			sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", msg.LockId)),
+			sdk.NewAttribute(types.AttributeValidator, msg.NewValAddr),
+		))
+	}
+	return &types.MsgSuperfluidRedelegateResponse{}, err
+}
+
+func (server msgServer) SuperfluidUndelegatePartial(goCtx context.Context, msg *types.MsgSuperfluidUndelegatePartial) (*types.MsgSuperfluidUndelegatePartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	splitLockID, err := server.keeper.SuperfluidUndelegatePartial(ctx, msg.Sender, msg.LockId, msg.Coin)
+	if err != nil {
+		return nil, err
+	}
 
-// 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
-// 	return &types.MsgSuperfluidRedelegateResponse{}, err
-// }
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSuperfluidUndelegatePartial,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", msg.LockId)),
+		sdk.NewAttribute(types.AttributeSplitLockId, fmt.Sprintf("%d", splitLockID)),
+		sdk.NewAttribute(types.AttributeAmount, msg.Coin.String()),
+	))
+	return &types.MsgSuperfluidUndelegatePartialResponse{SplitLockId: splitLockID}, nil
+}
 
 func (server msgServer) SuperfluidUnbondLock(goCtx context.Context, msg *types.MsgSuperfluidUnbondLock) (
 	*types.MsgSuperfluidUnbondLockResponse, error,
@@ -97,3 +121,20 @@ func (server msgServer) LockAndSuperfluidDelegate(goCtx context.Context, msg *ty
 		ID: lockupRes.ID,
 	}, err
 }
+
+func (server msgServer) SuperfluidVote(goCtx context.Context, msg *types.MsgSuperfluidVote) (*types.MsgSuperfluidVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SuperfluidVote(ctx, msg.Sender, msg.ProposalId, msg.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSuperfluidVote,
+		sdk.NewAttribute(types.AttributeProposalId, fmt.Sprintf("%d", msg.ProposalId)),
+		sdk.NewAttribute(types.AttributeVoter, msg.Sender),
+		sdk.NewAttribute(types.AttributeOption, msg.Options.String()),
+	))
+	return &types.MsgSuperfluidVoteResponse{}, nil
+}
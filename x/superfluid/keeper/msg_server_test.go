@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	lockupkeeper "github.com/osmosis-labs/osmosis/v7/x/lockup/keeper"
@@ -104,6 +105,172 @@ func (suite *KeeperTestSuite) TestMsgSuperfluidUndelegate() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgSuperfluidRedelegate() {
+	tests := []struct {
+		name         string
+		lockID       uint64
+		newValIndex  int64
+		expectPass   bool
+		expectEvents int
+	}{
+		{
+			name:         "superfluid redelegation to another validator",
+			lockID:       1,
+			newValIndex:  1,
+			expectPass:   true,
+			expectEvents: 1,
+		},
+		{
+			name:        "superfluid redelegation to the same validator",
+			lockID:      1,
+			newValIndex: 0,
+			expectPass:  false,
+		},
+		{
+			name:        "superfluid redelegation of not existing lockup",
+			lockID:      2,
+			newValIndex: 1,
+			expectPass:  false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		delAddrs := CreateRandomAccounts(1)
+		valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+		denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+		suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+		ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+		msgServer := keeper.NewMsgServerImpl(suite.App.SuperfluidKeeper)
+		_, err := msgServer.SuperfluidRedelegate(sdk.WrapSDKContext(ctx), types.NewMsgSuperfluidRedelegate(delAddrs[0], test.lockID, valAddrs[test.newValIndex]))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+		}
+		redelegateEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.TypeEvtSuperfluidRedelegate {
+				redelegateEvents++
+			}
+		}
+		suite.Require().Equal(test.expectEvents, redelegateEvents, test.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSuperfluidUndelegatePartial() {
+	tests := []struct {
+		name         string
+		lockID       uint64
+		amount       int64
+		expectPass   bool
+		expectEvents int
+	}{
+		{
+			name:         "superfluid undelegation of a part of a lockup",
+			lockID:       1,
+			amount:       400000,
+			expectPass:   true,
+			expectEvents: 1,
+		},
+		{
+			name:       "superfluid undelegation of a whole lockup",
+			lockID:     1,
+			amount:     1000000,
+			expectPass: false,
+		},
+		{
+			name:       "superfluid undelegation of not existing lockup",
+			lockID:     2,
+			amount:     400000,
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		delAddrs := CreateRandomAccounts(1)
+		valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+		denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+		suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+		ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+		msgServer := keeper.NewMsgServerImpl(suite.App.SuperfluidKeeper)
+		res, err := msgServer.SuperfluidUndelegatePartial(sdk.WrapSDKContext(ctx),
+			types.NewMsgSuperfluidUndelegatePartial(delAddrs[0], test.lockID, sdk.NewInt64Coin(denoms[0], test.amount)))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+			suite.Require().Equal(uint64(2), res.SplitLockId, test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+		}
+		undelegatePartialEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.TypeEvtSuperfluidUndelegatePartial {
+				undelegatePartialEvents++
+			}
+		}
+		suite.Require().Equal(test.expectEvents, undelegatePartialEvents, test.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSuperfluidVote() {
+	tests := []struct {
+		name         string
+		voterIndex   int
+		options      govtypes.WeightedVoteOptions
+		expectPass   bool
+		expectEvents int
+	}{
+		{
+			name:         "superfluid vote of a superfluid delegator",
+			voterIndex:   0,
+			options:      govtypes.NewNonSplitVoteOption(govtypes.OptionYes),
+			expectPass:   true,
+			expectEvents: 1,
+		},
+		{
+			name:       "superfluid vote of an account without superfluid delegation",
+			voterIndex: 1,
+			options:    govtypes.NewNonSplitVoteOption(govtypes.OptionYes),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		delAddrs := CreateRandomAccounts(2)
+		valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+		denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+		suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+		proposal := suite.submitProposal(true)
+
+		ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+		msgServer := keeper.NewMsgServerImpl(suite.App.SuperfluidKeeper)
+		_, err := msgServer.SuperfluidVote(sdk.WrapSDKContext(ctx),
+			types.NewMsgSuperfluidVote(delAddrs[test.voterIndex], proposal.ProposalId, test.options))
+
+		if test.expectPass {
+			suite.Require().NoError(err, test.name)
+		} else {
+			suite.Require().Error(err, test.name)
+		}
+		voteEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.TypeEvtSuperfluidVote {
+				voteEvents++
+			}
+		}
+		suite.Require().Equal(test.expectEvents, voteEvents, test.name)
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgSuperfluidUnbondLock() {
 	type param struct {
 		coinsToLock         sdk.Coins
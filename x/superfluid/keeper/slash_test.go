@@ -188,3 +188,37 @@ func (suite *KeeperTestSuite) TestSlashLockupsForUnbondingDelegationSlash() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSlashLockupsForRedelegationSlash() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+	// setup superfluid delegation, and redelegate it to the second validator
+	_, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+	err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+
+	// slashes of the old validator still apply to the redelegated lock
+	slashFactor := sdk.NewDecWithPrec(5, 2)
+	suite.App.SuperfluidKeeper.SlashLockupsForValidatorSlash(suite.Ctx, valAddrs[0], suite.Ctx.BlockHeight(), slashFactor)
+
+	gotLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(950000).String(), gotLock.Coins[0].Amount.String())
+
+	// and so do the slashes of the new validator
+	suite.App.SuperfluidKeeper.SlashLockupsForValidatorSlash(suite.Ctx, valAddrs[1], suite.Ctx.BlockHeight(), slashFactor)
+
+	gotLock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(902500).String(), gotLock.Coins[0].Amount.String())
+
+	// check invariant is fine once the delegations are refreshed, as after validator slashes
+	suite.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(suite.Ctx)
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+}
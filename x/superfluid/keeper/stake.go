@@ -121,8 +121,7 @@ func (k Keeper) validateLockForSFDelegate(ctx sdk.Context, lock *lockuptypes.Per
 	if err != nil {
 		return err
 	}
-	defaultSuperfluidAsset := types.SuperfluidAsset{}
-	if k.GetSuperfluidAsset(ctx, lock.Coins[0].Denom) == defaultSuperfluidAsset {
+	if k.GetSuperfluidAsset(ctx, lock.Coins[0].Denom).Denom == "" {
 		return types.ErrNonSuperfluidAsset
 	}
 
@@ -235,6 +234,101 @@ func (k Keeper) SuperfluidUndelegate(ctx sdk.Context, sender string, lockID uint
 	return k.createSyntheticLockup(ctx, lockID, intermediaryAcc, unlockingStatus)
 }
 
+// SuperfluidUndelegatePartial splits coin off a superfluid delegated lock into a new lock, and superfluid undelegates
+// the new lock, so that only the OSMO equivalent of coin is undelegated and burned, and the rest of the lock stays
+// superfluid delegated. The new lock starts superfluid unbonding, like a lock on SuperfluidUndelegate.
+// Either the split and the undelegation both succeed or nothing changes. Returns the ID of the new lock.
+func (k Keeper) SuperfluidUndelegatePartial(ctx sdk.Context, sender string, lockID uint64, coin sdk.Coin) (uint64, error) {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return 0, err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return 0, err
+	}
+	if _, found := k.GetIntermediaryAccountFromLockId(ctx, lockID); !found {
+		return 0, types.ErrNotSuperfluidUsedLockup
+	}
+
+	var splitLockID uint64
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		// the split lock gets the synthetic lockups and the intermediary account connection of the lock
+		splitLock, err := k.lk.SplitLock(cacheCtx, lockID, sdk.Coins{coin})
+		if err != nil {
+			return err
+		}
+		splitLockID = splitLock.ID
+		return k.SuperfluidUndelegate(cacheCtx, sender, splitLock.ID)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return splitLockID, nil
+}
+
+// SuperfluidRedelegate moves the superfluid delegation of a lock to newValAddr.
+// The lock's minted OSMO is undelegated from the old validator, and delegated to newValAddr from the intermediary
+// account of the new validator. Like on undelegation, an unbonding synthetic lockup is created for the old validator,
+// so that the lock stays slashable for it during the unbonding period, and can't be redelegated again until then.
+// Either the whole redelegation, lock to intermediary account connection included, succeeds or nothing changes.
+func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint64, newValAddr string) error {
+	lock, err := k.lk.GetLockByID(ctx, lockID)
+	if err != nil {
+		return err
+	}
+	err = k.validateLockForSF(ctx, lock, sender)
+	if err != nil {
+		return err
+	}
+	lockedCoin := lock.Coins[0]
+
+	oldAcc, found := k.GetIntermediaryAccountFromLockId(ctx, lockID)
+	if !found {
+		return types.ErrNotSuperfluidUsedLockup
+	}
+	if oldAcc.ValAddr == newValAddr {
+		return types.ErrSameValidatorRedelegation
+	}
+	// A bonded lock only has an unbonding synthetic lockup if it was redelegated in the last unbonding period,
+	// so redelegating it again would be a transitive redelegation.
+	for _, synthLock := range k.lk.GetAllSyntheticLockupsByLockup(ctx, lockID) {
+		if synthLock.IsUnlocking() {
+			return types.ErrUnbondingSyntheticLockupExists
+		}
+	}
+	_, err = k.validateValAddrForDelegate(ctx, newValAddr)
+	if err != nil {
+		return err
+	}
+
+	return osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		newAcc, err := k.GetOrCreateIntermediaryAccount(cacheCtx, lockedCoin.Denom, newValAddr)
+		if err != nil {
+			return err
+		}
+		k.SetLockIdIntermediaryAccountConnection(cacheCtx, lockID, newAcc)
+
+		// Replace the bonded synthetic lockup of the old validator by an unbonding one,
+		// and create the bonded synthetic lockup of the new validator.
+		err = k.lk.DeleteSyntheticLockup(cacheCtx, lockID, stakingSyntheticDenom(lockedCoin.Denom, oldAcc.ValAddr))
+		if err != nil {
+			return err
+		}
+		err = k.createSyntheticLockup(cacheCtx, lockID, oldAcc, unlockingStatus)
+		if err != nil {
+			return err
+		}
+		err = k.createSyntheticLockup(cacheCtx, lockID, newAcc, bondedStatus)
+		if err != nil {
+			return err
+		}
+
+		amount := k.GetSuperfluidOSMOTokens(cacheCtx, lockedCoin.Denom, lockedCoin.Amount)
+		return k.redelegateOsmoTokens(cacheCtx, amount, oldAcc, newAcc)
+	})
+}
+
 func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, sender string) error {
 	lock, err := k.lk.GetLockByID(ctx, underlyingLockId)
 	if err != nil {
@@ -244,12 +338,16 @@ func (k Keeper) SuperfluidUnbondLock(ctx sdk.Context, underlyingLockId uint64, s
 	if err != nil {
 		return err
 	}
+	// A lock undelegated after a redelegation has an unbonding synthetic lockup for each validator,
+	// and can start unlocking with all of them.
 	synthLocks := k.lk.GetAllSyntheticLockupsByLockup(ctx, underlyingLockId)
-	if len(synthLocks) != 1 {
+	if len(synthLocks) == 0 {
 		return types.ErrNotSuperfluidUsedLockup
 	}
-	if !synthLocks[0].IsUnlocking() {
-		return types.ErrBondingLockupNotSupported
+	for _, synthLock := range synthLocks {
+		if !synthLock.IsUnlocking() {
+			return types.ErrBondingLockupNotSupported
+		}
 	}
 	return k.lk.BeginForceUnlock(ctx, underlyingLockId, sdk.Coins{})
 }
@@ -272,7 +370,12 @@ func (k Keeper) alreadySuperfluidStaking(ctx sdk.Context, lockID uint64) bool {
 
 // mint osmoAmount of OSMO tokens, and immediately delegate them to validator on behalf of intermediary account.
 func (k Keeper) mintOsmoTokensAndDelegate(ctx sdk.Context, osmoAmount sdk.Int, intermediaryAccount types.SuperfluidIntermediaryAccount) error {
-	validator, err := k.validateValAddrForDelegate(ctx, intermediaryAccount.ValAddr)
+	return k.mintOsmoTokensAndDelegateFrom(ctx, osmoAmount, intermediaryAccount.GetAccAddress(), intermediaryAccount.ValAddr)
+}
+
+// mint osmoAmount of OSMO tokens, and immediately delegate them to valAddr on behalf of delAddr.
+func (k Keeper) mintOsmoTokensAndDelegateFrom(ctx sdk.Context, osmoAmount sdk.Int, delAddr sdk.AccAddress, valAddr string) error {
+	validator, err := k.validateValAddrForDelegate(ctx, valAddr)
 	if err != nil {
 		return err
 	}
@@ -285,7 +388,7 @@ func (k Keeper) mintOsmoTokensAndDelegate(ctx sdk.Context, osmoAmount sdk.Int, i
 			return err
 		}
 		k.bk.AddSupplyOffset(cacheCtx, bondDenom, osmoAmount.Neg())
-		err = k.bk.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, intermediaryAccount.GetAccAddress(), coins)
+		err = k.bk.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, delAddr, coins)
 		if err != nil {
 			return err
 		}
@@ -294,7 +397,7 @@ func (k Keeper) mintOsmoTokensAndDelegate(ctx sdk.Context, osmoAmount sdk.Int, i
 		// TODO: What happens here if validator is jailed, tombstoned, or unbonding
 		// For now, we don't worry since worst case it errors, in which case we revert mint.
 		_, err = k.sk.Delegate(cacheCtx,
-			intermediaryAccount.GetAccAddress(),
+			delAddr,
 			osmoAmount, stakingtypes.Unbonded, validator, true)
 		return err
 	})
@@ -306,7 +409,15 @@ func (k Keeper) mintOsmoTokensAndDelegate(ctx sdk.Context, osmoAmount sdk.Int, i
 func (k Keeper) forceUndelegateAndBurnOsmoTokens(ctx sdk.Context,
 	osmoAmount sdk.Int, intermediaryAcc types.SuperfluidIntermediaryAccount,
 ) error {
-	valAddr, err := sdk.ValAddressFromBech32(intermediaryAcc.ValAddr)
+	return k.forceUndelegateAndBurnOsmoTokensFrom(ctx, osmoAmount, intermediaryAcc.GetAccAddress(), intermediaryAcc.ValAddr)
+}
+
+// force undelegate osmoAmount worth of delegation shares from delegations between delAddr and valAddr,
+// and burn the returned tokens.
+func (k Keeper) forceUndelegateAndBurnOsmoTokensFrom(ctx sdk.Context,
+	osmoAmount sdk.Int, delAddr sdk.AccAddress, valAddrStr string,
+) error {
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
 	if err != nil {
 		return err
 	}
@@ -314,7 +425,7 @@ func (k Keeper) forceUndelegateAndBurnOsmoTokens(ctx sdk.Context,
 	// briefly looked into it, did not understand whats correct.
 	// TODO: ensure that intermediate account has at least osmoAmount staked.
 	shares, err := k.sk.ValidateUnbondAmount(
-		ctx, intermediaryAcc.GetAccAddress(), valAddr, osmoAmount,
+		ctx, delAddr, valAddr, osmoAmount,
 	)
 	if err == stakingtypes.ErrNoDelegation {
 		return nil
@@ -322,13 +433,13 @@ func (k Keeper) forceUndelegateAndBurnOsmoTokens(ctx sdk.Context,
 		return err
 	}
 	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-		undelegatedCoins, err := k.sk.InstantUndelegate(cacheCtx, intermediaryAcc.GetAccAddress(), valAddr, shares)
+		undelegatedCoins, err := k.sk.InstantUndelegate(cacheCtx, delAddr, valAddr, shares)
 		if err != nil {
 			return err
 		}
 
 		// TODO: Should we compare undelegatedCoins vs osmoAmount?
-		err = k.bk.SendCoinsFromAccountToModule(cacheCtx, intermediaryAcc.GetAccAddress(), types.ModuleName, undelegatedCoins)
+		err = k.bk.SendCoinsFromAccountToModule(cacheCtx, delAddr, types.ModuleName, undelegatedCoins)
 		if err != nil {
 			return err
 		}
@@ -350,3 +461,48 @@ func (k Keeper) forceUndelegateAndBurnOsmoTokens(ctx sdk.Context,
 // Eugen’s point: Only rewards message needs to be updated. Rest of messages are fine
 // Queries need to be updated
 // We can do this at the very end though, since it just relates to queries.
+
+// redelegate osmoAmount worth of delegation shares of oldAcc from its validator to the validator of newAcc.
+// The delegation can't be transferred between delegators, so the tokens are instantly undelegated from the old
+// validator, sent to newAcc and delegated again. No staking redelegation is recorded, as its entries would be shared
+// by every lock redelegating between the same validators, and capped by the staking module's MaxEntries for all of them.
+// Redelegations are tracked per lock instead: the lock's unbonding synthetic lockup keeps it slashable for the old
+// validator, and prevents redelegating it again, until the end of the unbonding period.
+func (k Keeper) redelegateOsmoTokens(ctx sdk.Context,
+	osmoAmount sdk.Int, oldAcc, newAcc types.SuperfluidIntermediaryAccount,
+) error {
+	if osmoAmount.IsZero() {
+		return nil
+	}
+	oldValAddr, err := sdk.ValAddressFromBech32(oldAcc.ValAddr)
+	if err != nil {
+		return err
+	}
+	newValidator, err := k.validateValAddrForDelegate(ctx, newAcc.ValAddr)
+	if err != nil {
+		return err
+	}
+
+	shares, err := k.sk.ValidateUnbondAmount(ctx, oldAcc.GetAccAddress(), oldValAddr, osmoAmount)
+	if err == stakingtypes.ErrNoDelegation {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	redelegatedCoins, err := k.sk.InstantUndelegate(ctx, oldAcc.GetAccAddress(), oldValAddr, shares)
+	if err != nil {
+		return err
+	}
+	err = k.bk.SendCoinsFromAccountToModule(ctx, oldAcc.GetAccAddress(), types.ModuleName, redelegatedCoins)
+	if err != nil {
+		return err
+	}
+	err = k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, newAcc.GetAccAddress(), redelegatedCoins)
+	if err != nil {
+		return err
+	}
+	_, err = k.sk.Delegate(ctx,
+		newAcc.GetAccAddress(),
+		redelegatedCoins.AmountOf(k.sk.BondDenom(ctx)), stakingtypes.Unbonded, newValidator, true)
+	return err
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	lockupkeeper "github.com/osmosis-labs/osmosis/v7/x/lockup/keeper"
 	lockuptypes "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 	minttypes "github.com/osmosis-labs/osmosis/v7/x/mint/types"
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/keeper"
@@ -420,6 +421,112 @@ func (suite *KeeperTestSuite) TestSuperfluidUndelegate() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSuperfluidUndelegatePartial() {
+	testCases := []struct {
+		name              string
+		lockId            uint64
+		undelegateAmount  int64
+		undelegateDenom   string
+		sender            int
+		expUndelegatedErr bool
+	}{
+		{"undelegate a part of a lock", 1, 400000, "gamm/pool/1", 0, false},
+		{"undelegate a small part of a lock", 1, 1, "gamm/pool/1", 0, false},
+		{"undelegate the whole lock", 1, 1000000, "gamm/pool/1", 0, true},
+		{"undelegate more than the lock", 1, 2000000, "gamm/pool/1", 0, true},
+		{"undelegate another denom", 1, 400000, "gamm/pool/2", 0, true},
+		{"undelegate a lock of another owner", 1, 400000, "gamm/pool/1", 1, true},
+		{"undelegate a not superfluid delegated lock", 3, 400000, "gamm/pool/1", 0, true},
+		{"undelegate a not existing lock", 10, 400000, "gamm/pool/1", 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			bondDenom := suite.App.StakingKeeper.GetParams(suite.Ctx).BondDenom
+			delAddrs := CreateRandomAccounts(2)
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			// lock 1 and 2 are superfluid delegated, lock 3 is not
+			intermediaryAccs, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs,
+				[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+			acc := intermediaryAccs[0]
+			unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+			coin := sdk.NewInt64Coin(denoms[0], 1000000)
+			suite.FundAcc(delAddrs[0], sdk.NewCoins(coin))
+			_, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, delAddrs[0], sdk.NewCoins(coin), unbondingDuration)
+			suite.Require().NoError(err)
+
+			presupply := suite.App.BankKeeper.GetSupply(suite.Ctx, bondDenom)
+			presupplyWithOffset := suite.App.BankKeeper.GetSupplyWithOffset(suite.Ctx, bondDenom)
+			undelegateCoin := sdk.NewInt64Coin(tc.undelegateDenom, tc.undelegateAmount)
+
+			splitLockId, err := suite.App.SuperfluidKeeper.SuperfluidUndelegatePartial(suite.Ctx, delAddrs[tc.sender].String(), tc.lockId, undelegateCoin)
+			if tc.expUndelegatedErr {
+				suite.Require().Error(err)
+
+				// nothing changes
+				suite.Require().Equal(uint64(3), suite.App.LockupKeeper.GetLastLockID(suite.Ctx))
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, locks[0].ID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(locks[0].Coins, lock.Coins)
+				suite.Require().Equal(presupply, suite.App.BankKeeper.GetSupply(suite.Ctx, bondDenom))
+				suite.Require().Equal(acc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, locks[0].ID))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(4), splitLockId)
+
+			// only the osmo of the split part is undelegated and burned
+			undelegatedOsmo := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], undelegateCoin.Amount)
+			postsupply := suite.App.BankKeeper.GetSupply(suite.Ctx, bondDenom)
+			suite.Require().Equal(presupply.Amount.Sub(undelegatedOsmo), postsupply.Amount)
+			suite.Require().Equal(presupplyWithOffset, suite.App.BankKeeper.GetSupplyWithOffset(suite.Ctx, bondDenom))
+
+			// the rest of the lock stays superfluid delegated
+			lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, tc.lockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(coin.Sub(undelegateCoin)), lock.Coins)
+			suite.Require().Equal(acc.GetAccAddress(), suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, tc.lockId))
+			synthLocks := suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, tc.lockId)
+			suite.Require().Len(synthLocks, 1)
+			suite.Require().Equal(keeper.StakingSyntheticDenom(denoms[0], acc.ValAddr), synthLocks[0].SynthDenom)
+
+			// the split lock is superfluid unbonding
+			splitLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, splitLockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(lock.Owner, splitLock.Owner)
+			suite.Require().Equal(lock.Duration, splitLock.Duration)
+			suite.Require().False(splitLock.IsUnlocking())
+			suite.Require().Equal(sdk.NewCoins(undelegateCoin), splitLock.Coins)
+			suite.Require().True(suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, splitLockId).Empty())
+			synthLocks = suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, splitLockId)
+			suite.Require().Len(synthLocks, 1)
+			suite.Require().Equal(keeper.UnstakingSyntheticDenom(denoms[0], acc.ValAddr), synthLocks[0].SynthDenom)
+			suite.Require().Equal(suite.Ctx.BlockTime().Add(unbondingDuration), synthLocks[0].EndTime)
+
+			// the intermediary account delegates the osmo of the remaining locks
+			valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+			suite.Require().NoError(err)
+			delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, acc.GetAccAddress(), valAddr)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.App.SuperfluidKeeper.GetExpectedDelegationAmount(suite.Ctx, acc).ToDec(), delegation.Shares)
+
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+			reason, broken = lockupkeeper.AllInvariants(*suite.App.LockupKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// the split lock can then be unbonded
+			err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, splitLockId, lock.Owner)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
 // TestSuperfluidUnbondLock tests the following.
 // 		1. test SuperfluidUnbondLock does not work before undelegation
 // 		2. test SuperfluidUnbondLock makes underlying lock start unlocking
@@ -510,155 +617,279 @@ func (suite *KeeperTestSuite) TestSuperfluidUnbondLock() {
 	}
 }
 
-// func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
-// 	testCases := []struct {
-// 		name                    string
-// 		validatorStats          []stakingtypes.BondStatus
-// 		superDelegations        []superfluidDelegation
-// 		superRedelegations      []superfluidRedelegation
-// 		expSuperRedelegationErr []bool
-// 	}{
-// 		{
-// 			"with single validator and single superfluid delegation with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with single redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{false},
-// 		},
-// 		{
-// 			"with multiple superfluid delegations with multiple redelegations",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
-// 			[]bool{false, false},
-// 		},
-// 		{
-// 			"try redelegating back from new validator to original validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}, {0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
-// 			[]bool{false, true},
-// 		},
-// 		{
-// 			"not available lock id redelegation",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{2, 0, 1}}, // lock1 => val0 -> val1
-// 			[]bool{true},
-// 		},
-// 		{
-// 			"redelegation for same validator",
-// 			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
-// 			[]superfluidDelegation{{0, "gamm/pool/1", 1000000}},
-// 			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
-// 			[]bool{true},
-// 		},
-// 	}
-
-// 	for _, tc := range testCases {
-// 		tc := tc
-// 		suite.Run(tc.name, func() {
-// 			suite.SetupTest()
-
-// 			poolId := suite.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
-// 			suite.Require().Equal(poolId, uint64(1))
-
-// 			// setup validators
-// 			valAddrs := suite.SetupValidators(tc.validatorStats)
-
-// 			// setup superfluid delegations
-// 			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(valAddrs, tc.superDelegations)
-// 			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
-
-// 			// execute redelegation and check changes on store
-// 			for index, srd := range tc.superRedelegations {
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				if err != nil {
-// 					lock = &lockuptypes.PeriodLock{}
-// 				}
-
-// 				// superfluid redelegate
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				if tc.expSuperRedelegationErr[index] {
-// 					suite.Require().Error(err)
-// 					continue
-// 				}
-// 				suite.Require().NoError(err)
-
-// 				// check previous validator bonding synthetic lockup deletion
-// 				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Error(err)
-
-// 				// check unbonding synthetic lockup creation
-// 				params := suite.App.SuperfluidKeeper.GetParams(suite.Ctx)
-// 				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock.Suffix, keeper.UnstakingSuffix(valAddrs[srd.oldValIndex].String()))
-// 				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(params.UnbondingDuration))
-
-// 				// check synthetic lockup creation
-// 				synthLock2, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(synthLock2.UnderlyingLockId, srd.lockId)
-// 				suite.Require().Equal(synthLock2.Suffix, keeper.StakingSuffix(valAddrs[srd.newValIndex].String()))
-// 				suite.Require().Equal(synthLock2.EndTime, time.Time{})
-
-// 				// check intermediary account creation
-// 				lock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-
-// 				expAcc := types.NewSuperfluidIntermediaryAccount(lock.Coins[0].Denom, valAddrs[srd.newValIndex].String(), 1)
-// 				gotAcc := suite.App.SuperfluidKeeper.GetIntermediaryAccount(suite.Ctx, expAcc.GetAccAddress())
-// 				suite.Require().Equal(gotAcc.Denom, expAcc.Denom)
-// 				suite.Require().Equal(gotAcc.ValAddr, expAcc.ValAddr)
-
-// 				// check gauge creation
-// 				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gotAcc.GaugeId)
-// 				suite.Require().NoError(err)
-// 				suite.Require().Equal(gauge.Id, gotAcc.GaugeId)
-// 				suite.Require().Equal(gauge.IsPerpetual, true)
-// 				suite.Require().Equal(gauge.DistributeTo, lockuptypes.QueryCondition{
-// 					LockQueryType: lockuptypes.ByDuration,
-// 					Denom:         expAcc.Denom + keeper.StakingSuffix(valAddrs[srd.newValIndex].String()),
-// 					Duration:      params.UnbondingDuration,
-// 				})
-// 				suite.Require().Equal(gauge.Coins, sdk.Coins(nil))
-// 				suite.Require().Equal(gauge.StartTime, suite.Ctx.BlockTime())
-// 				suite.Require().Equal(gauge.NumEpochsPaidOver, uint64(1))
-// 				suite.Require().Equal(gauge.FilledEpochs, uint64(0))
-// 				suite.Require().Equal(gauge.DistributedCoins, sdk.Coins(nil))
-
-// 				// Check lockID connection with intermediary account
-// 				intAcc := suite.App.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.Ctx, srd.lockId)
-// 				suite.Require().Equal(intAcc.String(), expAcc.GetAccAddress().String())
-
-// 				// check delegation from intermediary account to validator
-// 				_, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, expAcc.GetAccAddress(), valAddrs[srd.newValIndex])
-// 				suite.Require().True(found)
-// 			}
-
-// 			// try redelegating twice
-// 			for index, srd := range tc.superRedelegations {
-// 				if tc.expSuperRedelegationErr[index] {
-// 					continue
-// 				}
-// 				cacheCtx, _ := suite.Ctx.CacheContext()
-// 				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
-// 				suite.Require().NoError(err)
-// 				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
-// 				suite.Require().Error(err)
-// 			}
-// 		})
-// 	}
-// }
+// TestSuperfluidUnbondLockCancelUnlocking tests that the unlocking of a superfluid unbonding lock can be canceled,
+// while its unbonding synthetic lockup keeps unbonding until the end of the unbonding period.
+func (suite *KeeperTestSuite) TestSuperfluidUnbondLockCancelUnlocking() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	_, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+	valAddr := valAddrs[0].String()
+	unstakingDenom := keeper.UnstakingSyntheticDenom(lock.Coins[0].Denom, valAddr)
+	unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+	startTime := suite.Ctx.BlockTime()
+
+	err := suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+	suite.Require().NoError(err)
+	err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, lock.ID, lock.Owner)
+	suite.Require().NoError(err)
+
+	// the lock stops unlocking, but stays slashable for the validator until the end of the unbonding period
+	_, err = suite.App.LockupKeeper.CancelUnlocking(suite.Ctx, lock.ID, nil)
+	suite.Require().NoError(err)
+	updatedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().False(updatedLock.IsUnlocking())
+	synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, unstakingDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(startTime.Add(unbondingDuration), synthLock.EndTime)
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+
+	// it can't be superfluid delegated again until then
+	err = suite.App.SuperfluidKeeper.SuperfluidDelegate(suite.Ctx, lock.Owner, lock.ID, valAddr)
+	suite.Require().Error(err)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(startTime.Add(unbondingDuration))
+	suite.App.LockupKeeper.DeleteAllMaturedSyntheticLocks(suite.Ctx)
+	suite.App.LockupKeeper.WithdrawAllMaturedLocks(suite.Ctx)
+	_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, unstakingDenom)
+	suite.Require().Error(err)
+	updatedLock, err = suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().False(updatedLock.IsUnlocking())
+
+	err = suite.App.SuperfluidKeeper.SuperfluidDelegate(suite.Ctx, lock.Owner, lock.ID, valAddr)
+	suite.Require().NoError(err)
+}
+
+// TestSuperfluidUnbondLockAfterRedelegation tests that a lock undelegated after a redelegation starts unlocking
+// with the unbonding synthetic lockups of both validators.
+func (suite *KeeperTestSuite) TestSuperfluidUnbondLockAfterRedelegation() {
+	suite.SetupTest()
+
+	delAddrs := CreateRandomAccounts(1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+	_, locks := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lock := locks[0]
+
+	err := suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, lock.ID, valAddrs[1].String())
+	suite.Require().NoError(err)
+
+	// the lock is still bonded to the new validator
+	err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, lock.ID, lock.Owner)
+	suite.Require().ErrorIs(err, types.ErrBondingLockupNotSupported)
+
+	err = suite.App.SuperfluidKeeper.SuperfluidUndelegate(suite.Ctx, lock.Owner, lock.ID)
+	suite.Require().NoError(err)
+	synthLocks := suite.App.LockupKeeper.GetAllSyntheticLockupsByLockup(suite.Ctx, lock.ID)
+	suite.Require().Len(synthLocks, 2)
+	for _, valAddr := range valAddrs {
+		unstakingDenom := keeper.UnstakingSyntheticDenom(lock.Coins[0].Denom, valAddr.String())
+		synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, lock.ID, unstakingDenom)
+		suite.Require().NoError(err)
+		suite.Require().True(synthLock.IsUnlocking())
+	}
+
+	err = suite.App.SuperfluidKeeper.SuperfluidUnbondLock(suite.Ctx, lock.ID, lock.Owner)
+	suite.Require().NoError(err)
+	updatedLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(updatedLock.IsUnlocking())
+	reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+	suite.Require().False(broken, reason)
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidRedelegate() {
+	testCases := []struct {
+		name                    string
+		validatorStats          []stakingtypes.BondStatus
+		superDelegations        []superfluidDelegation
+		superRedelegations      []superfluidRedelegation
+		expSuperRedelegationErr []bool
+	}{
+		{
+			"with single validator and single superfluid delegation with single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+		},
+		{
+			"with multiple superfluid delegations with single redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+		},
+		{
+			"with multiple superfluid delegations with multiple redelegations",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {2, 0, 1}}, // lock1 => val0 -> val1, lock2 => val0 -> val1
+			[]bool{false, false},
+		},
+		{
+			"redelegation to a validator with existing superfluid delegations",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 1, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+		},
+		{
+			"redelegation to an unbonded validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Unbonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}}, // lock1 => val0 -> val1
+			[]bool{false},
+		},
+		{
+			"try redelegating back from new validator to original validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 0}}, // lock1 => val0 -> val1, lock1 => val1 -> val0
+			[]bool{false, true},
+		},
+		{
+			"try transitive redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 1}, {1, 1, 2}}, // lock1 => val0 -> val1, lock1 => val1 -> val2
+			[]bool{false, true},
+		},
+		{
+			"not available lock id redelegation",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{2, 0, 1}}, // lock2 => val0 -> val1
+			[]bool{true},
+		},
+		{
+			"redelegation for same validator",
+			[]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded},
+			[]superfluidDelegation{{0, 0, 0, 1000000}},
+			[]superfluidRedelegation{{1, 0, 0}}, // lock1 => val0 -> val0
+			[]bool{true},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			bondDenom := suite.App.StakingKeeper.GetParams(suite.Ctx).BondDenom
+
+			// setup validators
+			valAddrs := suite.SetupValidators(tc.validatorStats)
+
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20), sdk.NewDec(20)})
+
+			// setup superfluid delegations
+			delAddrs := CreateRandomAccounts(2)
+			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(delAddrs, valAddrs, tc.superDelegations, denoms)
+			suite.checkIntermediaryAccountDelegations(intermediaryAccs)
+
+			// locks redelegating between the same validators don't share the staking module's redelegation entries
+			stakingParams := suite.App.StakingKeeper.GetParams(suite.Ctx)
+			stakingParams.MaxEntries = 1
+			suite.App.StakingKeeper.SetParams(suite.Ctx, stakingParams)
+
+			// execute redelegation and check changes on store
+			for index, srd := range tc.superRedelegations {
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				if err != nil {
+					lock = &lockuptypes.PeriodLock{}
+				}
+
+				presupply := suite.App.BankKeeper.GetSupply(suite.Ctx, bondDenom)
+				presupplyWithOffset := suite.App.BankKeeper.GetSupplyWithOffset(suite.Ctx, bondDenom)
+
+				// superfluid redelegate
+				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(suite.Ctx, lock.Owner, srd.lockId, valAddrs[srd.newValIndex].String())
+				if tc.expSuperRedelegationErr[index] {
+					suite.Require().Error(err)
+					continue
+				}
+				suite.Require().NoError(err)
+
+				// the minted osmo is moved, not minted or burnt
+				suite.Require().Equal(presupply, suite.App.BankKeeper.GetSupply(suite.Ctx, bondDenom))
+				suite.Require().Equal(presupplyWithOffset, suite.App.BankKeeper.GetSupplyWithOffset(suite.Ctx, bondDenom))
+
+				denom := lock.Coins[0].Denom
+				oldValAddr := valAddrs[srd.oldValIndex].String()
+				newValAddr := valAddrs[srd.newValIndex].String()
+
+				// check previous validator bonding synthetic lockup deletion
+				_, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(denom, oldValAddr))
+				suite.Require().Error(err)
+
+				// check unbonding synthetic lockup creation
+				unbondingDuration := suite.App.StakingKeeper.GetParams(suite.Ctx).UnbondingTime
+				synthLock, err := suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.UnstakingSyntheticDenom(denom, oldValAddr))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
+				suite.Require().Equal(synthLock.EndTime, suite.Ctx.BlockTime().Add(unbondingDuration))
+
+				// check synthetic lockup creation
+				synthLock, err = suite.App.LockupKeeper.GetSyntheticLockup(suite.Ctx, srd.lockId, keeper.StakingSyntheticDenom(denom, newValAddr))
+				suite.Require().NoError(err)
+				suite.Require().Equal(synthLock.UnderlyingLockId, srd.lockId)
+				suite.Require().Equal(synthLock.EndTime, time.Time{})
+
+				// check lockID connection with intermediary account
+				expAcc := types.NewSuperfluidIntermediaryAccount(denom, newValAddr, 0)
+				gotAcc, found := suite.App.SuperfluidKeeper.GetIntermediaryAccountFromLockId(suite.Ctx, srd.lockId)
+				suite.Require().True(found)
+				suite.Require().Equal(expAcc.GetAccAddress(), gotAcc.GetAccAddress())
+
+				// the old intermediary account records no staking redelegation
+				oldAcc := types.NewSuperfluidIntermediaryAccount(denom, oldValAddr, 0)
+				_, found = suite.App.StakingKeeper.GetRedelegation(suite.Ctx, oldAcc.GetAccAddress(), valAddrs[srd.oldValIndex], valAddrs[srd.newValIndex])
+				suite.Require().False(found)
+				_, found = suite.App.StakingKeeper.GetDelegation(suite.Ctx, oldAcc.GetAccAddress(), valAddrs[srd.newValIndex])
+				suite.Require().False(found)
+			}
+
+			// check invariants are fine
+			reason, broken := keeper.AllInvariants(*suite.App.SuperfluidKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+			reason, broken = lockupkeeper.AllInvariants(*suite.App.LockupKeeper)(suite.Ctx)
+			suite.Require().False(broken, reason)
+
+			// check intermediary account delegations match their superfluid delegated locks
+			for _, acc := range suite.App.SuperfluidKeeper.GetAllIntermediaryAccounts(suite.Ctx) {
+				valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+				suite.Require().NoError(err)
+				validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+				suite.Require().True(found)
+				delegatedAmount := sdk.ZeroInt()
+				delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, acc.GetAccAddress(), valAddr)
+				if found {
+					delegatedAmount = validator.TokensFromShares(delegation.Shares).RoundInt()
+				}
+				suite.Require().Equal(suite.App.SuperfluidKeeper.GetExpectedDelegationAmount(suite.Ctx, acc), delegatedAmount)
+			}
+
+			// try redelegating twice
+			for index, srd := range tc.superRedelegations {
+				if tc.expSuperRedelegationErr[index] {
+					continue
+				}
+				cacheCtx, _ := suite.Ctx.CacheContext()
+				lock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, srd.lockId)
+				suite.Require().NoError(err)
+				err = suite.App.SuperfluidKeeper.SuperfluidRedelegate(cacheCtx, lock.Owner, srd.lockId, valAddrs[srd.oldValIndex].String())
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
 
 func (suite *KeeperTestSuite) TestRefreshIntermediaryDelegationAmounts() {
 	testCases := []struct {
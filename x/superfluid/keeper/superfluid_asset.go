@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/osmosis-labs/osmosis/v7/osmoutils"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,21 +25,86 @@ func (k Keeper) BeginUnwindSuperfluidAsset(ctx sdk.Context, epochNum int64, asse
 	k.DeleteSuperfluidAsset(ctx, asset.Denom)
 }
 
-// Returns amount * (1 - k.RiskFactor(asset))
-// Fow now, the risk factor is a global constant.
-// It will move towards per pool functions.
+// Returns amount * (1 - k.GetRiskFactor(asset)).
 func (k Keeper) GetRiskAdjustedOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Int) sdk.Int {
-	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Sub(amount.ToDec().Mul(minRiskFactor).RoundInt())
+	riskFactor := k.GetRiskFactor(ctx, asset)
+	return amount.Sub(amount.ToDec().Mul(riskFactor).RoundInt())
 }
 
-// y = x - (x * minRisk)
-// y = x (1 - minRisk)
-// y / (1 - minRisk) = x
+// y = x - (x * riskFactor)
+// y = x (1 - riskFactor)
+// y / (1 - riskFactor) = x
+
+func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, asset types.SuperfluidAsset, amount sdk.Dec) sdk.Dec {
+	riskFactor := k.GetRiskFactor(ctx, asset)
+	return amount.Quo(sdk.OneDec().Sub(riskFactor))
+}
 
-func (k Keeper) UnriskAdjustOsmoValue(ctx sdk.Context, amount sdk.Dec) sdk.Dec {
+// GetRiskFactor returns the risk factor set by governance for the asset,
+// or the global minimum risk factor if it is higher or the asset has none.
+func (k Keeper) GetRiskFactor(ctx sdk.Context, asset types.SuperfluidAsset) sdk.Dec {
 	minRiskFactor := k.GetParams(ctx).MinimumRiskFactor
-	return amount.Quo(sdk.OneDec().Sub(minRiskFactor))
+	if asset.RiskFactor == nil || asset.RiskFactor.LT(minRiskFactor) {
+		return minRiskFactor
+	}
+	return *asset.RiskFactor
+}
+
+// ValidateSuperfluidAsset checks that the osmo equivalent multiplier of an asset can be computed.
+// LP shares must be of balancer pools, which can be valued at their fair amounts at TWAP prices.
+// LP shares of pools with OSMO are valued by the OSMO in the pool, so they mustn't have an osmo pricing route.
+// LP shares of other pools need an osmo pricing route from one of their pool's assets,
+// through existing pools, to OSMO.
+func (k Keeper) ValidateSuperfluidAsset(ctx sdk.Context, asset types.SuperfluidAsset) error {
+	if err := asset.ValidateBasic(); err != nil {
+		return err
+	}
+	if asset.AssetType != types.SuperfluidAssetTypeLPShare {
+		return nil
+	}
+
+	if err := gammtypes.ValidatePoolShareDenom(asset.Denom); err != nil {
+		return err
+	}
+	poolId := gammtypes.MustGetPoolIdFromShareDenom(asset.Denom)
+	pool, err := k.gk.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if _, ok := pool.(*balancer.Pool); !ok {
+		return fmt.Errorf("pool %d is not a balancer pool, so its LP shares can't be valued in %s", poolId, k.sk.BondDenom(ctx))
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	poolLiquidity := pool.GetTotalPoolLiquidity(ctx)
+	if poolLiquidity.AmountOf(bondDenom).IsPositive() {
+		if len(asset.OsmoPricingRoute) != 0 {
+			return fmt.Errorf("pool %d has %s, so asset %s can't have an osmo pricing route", poolId, bondDenom, asset.Denom)
+		}
+		return nil
+	}
+	if len(asset.OsmoPricingRoute) == 0 {
+		return fmt.Errorf("pool %d has no %s, so asset %s needs an osmo pricing route", poolId, bondDenom, asset.Denom)
+	}
+	if !poolLiquidity.AmountOf(asset.PricingDenom).IsPositive() {
+		return fmt.Errorf("pricing denom %s is not in pool %d", asset.PricingDenom, poolId)
+	}
+
+	denom := asset.PricingDenom
+	for _, hop := range asset.OsmoPricingRoute {
+		hopPool, err := k.gk.GetPoolAndPoke(ctx, hop.PoolId)
+		if err != nil {
+			return err
+		}
+		hopLiquidity := hopPool.GetTotalPoolLiquidity(ctx)
+		if !hopLiquidity.AmountOf(denom).IsPositive() || !hopLiquidity.AmountOf(hop.TokenOutDenom).IsPositive() {
+			return fmt.Errorf("osmo pricing route pool %d can't price %s in %s", hop.PoolId, denom, hop.TokenOutDenom)
+		}
+		denom = hop.TokenOutDenom
+	}
+	if denom != bondDenom {
+		return fmt.Errorf("osmo pricing route of asset %s ends in %s instead of %s", asset.Denom, denom, bondDenom)
+	}
+	return nil
 }
 
 func (k Keeper) AddNewSuperfluidAsset(ctx sdk.Context, asset types.SuperfluidAsset) {
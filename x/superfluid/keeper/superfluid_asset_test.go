@@ -51,3 +51,33 @@ func (suite *KeeperTestSuite) TestGetRiskAdjustedOsmoValue() {
 	)
 	suite.Require().Equal(sdk.NewInt(50), adjustedValue)
 }
+
+func (suite *KeeperTestSuite) TestGetRiskAdjustedOsmoValueWithRiskFactor() {
+	suite.SetupTest()
+
+	minRiskFactor := suite.App.SuperfluidKeeper.GetParams(suite.Ctx).MinimumRiskFactor
+	lowRiskFactor := minRiskFactor.QuoInt64(2)
+	highRiskFactor := sdk.NewDecWithPrec(75, 2)
+
+	testCases := []struct {
+		name               string
+		riskFactor         *sdk.Dec
+		expectedRiskFactor sdk.Dec
+		expectedValue      sdk.Int
+	}{
+		{"no risk factor", nil, minRiskFactor, sdk.NewInt(50)},
+		{"risk factor below the minimum", &lowRiskFactor, minRiskFactor, sdk.NewInt(50)},
+		{"risk factor above the minimum", &highRiskFactor, highRiskFactor, sdk.NewInt(25)},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			asset := types.SuperfluidAsset{Denom: "gamm/pool/1", AssetType: types.SuperfluidAssetTypeLPShare, RiskFactor: tc.riskFactor}
+			suite.Require().Equal(tc.expectedRiskFactor, suite.App.SuperfluidKeeper.GetRiskFactor(suite.Ctx, asset))
+
+			adjustedValue := suite.App.SuperfluidKeeper.GetRiskAdjustedOsmoValue(suite.Ctx, asset, sdk.NewInt(100))
+			suite.Require().Equal(tc.expectedValue, adjustedValue)
+			suite.Require().Equal(sdk.NewDec(100), suite.App.SuperfluidKeeper.UnriskAdjustOsmoValue(suite.Ctx, asset, adjustedValue.ToDec()))
+		})
+	}
+}
@@ -1,20 +1,144 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/gogo/protobuf/proto"
-	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/osmomath"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// This function calculates the osmo equivalent worth of an LP share.
-// It is intended to eventually use the TWAP of the worth of an LP share
-// once that is exposed from the gamm module.
-func (k Keeper) calculateOsmoBackingPerShare(pool gammtypes.PoolI, osmoInPool sdk.Int) sdk.Dec {
-	twap := osmoInPool.ToDec().Quo(pool.GetTotalShares().ToDec())
-	return twap
+// maxTwapSpotRatio bounds the ratio of the TWAP price of an asset to its spot price used to compute the fair
+// amounts of pools, and its inverse bounds the ratio from below. osmomath.Pow is only accurate for bases near one,
+// and the ratio only has to undo the price moves within the TWAP window.
+var maxTwapSpotRatio = sdk.NewDec(2)
+
+// calculateOsmoBackingPerShare calculates the osmo equivalent worth of an LP share,
+// as the fair osmo amount of the pool per share.
+func (k Keeper) calculateOsmoBackingPerShare(ctx sdk.Context, pool *balancer.Pool) (sdk.Dec, error) {
+	osmoAmount, err := k.calculateFairPoolAmount(ctx, pool, k.sk.BondDenom(ctx))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return osmoAmount.Quo(pool.GetTotalShares().ToDec()), nil
+}
+
+// calculateFairPoolAmount calculates the amount of denom that the balancer pool would hold
+// if its spot prices were the arithmetic TWAPs over the OsmoMultiplierTwapWindow param.
+// Swaps don't change the pool's invariant k = prod(B_i^w_i), besides increasing it by their fees,
+// so unlike the pool's current amount of denom, this can't be manipulated within a block.
+// At the TWAP price p_i of each other asset in denom, the pool would hold
+// B_denom * prod((p_i / s_i)^w_i) of denom, where s_i is the spot price and w_i the normalized weight of the asset.
+// The ratios p_i / s_i are clamped to [1 / maxTwapSpotRatio, maxTwapSpotRatio].
+// If the TWAP of an asset is unavailable, e.g. when the pool is younger than the window,
+// this falls back to the pool's current amount of denom.
+func (k Keeper) calculateFairPoolAmount(ctx sdk.Context, pool *balancer.Pool, denom string) (sdk.Dec, error) {
+	denomAsset, err := pool.GetPoolAsset(denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	spotAmount := denomAsset.Token.Amount.ToDec()
+	denomWeightedBalance := spotAmount.QuoInt(denomAsset.Weight)
+
+	endTime := ctx.BlockTime()
+	startTime := endTime.Add(-k.GetParams(ctx).OsmoMultiplierTwapWindow)
+	totalWeight := pool.GetTotalWeight().ToDec()
+
+	fairAmount := spotAmount
+	for _, asset := range pool.GetAllPoolAssets() {
+		if asset.Token.Denom == denom {
+			continue
+		}
+		twapPrice, err := k.tk.GetArithmeticTwap(ctx, pool.GetId(), denom, asset.Token.Denom, startTime, endTime)
+		if err != nil {
+			k.Logger(ctx).Info(fmt.Sprintf("using spot %s amount of pool %d: %s", denom, pool.GetId(), err.Error()))
+			return spotAmount, nil
+		}
+		if !twapPrice.IsPositive() {
+			return sdk.Dec{}, fmt.Errorf("non positive TWAP price %s of %s in %s in pool %d", twapPrice, asset.Token.Denom, denom, pool.GetId())
+		}
+		spotPrice := denomWeightedBalance.Quo(asset.Token.Amount.ToDec().QuoInt(asset.Weight))
+		if !spotPrice.IsPositive() {
+			return sdk.Dec{}, fmt.Errorf("non positive spot price %s of %s in %s in pool %d", spotPrice, asset.Token.Denom, denom, pool.GetId())
+		}
+		ratio := twapPrice.Quo(spotPrice)
+		if minRatio := sdk.OneDec().Quo(maxTwapSpotRatio); ratio.LT(minRatio) {
+			ratio = minRatio
+		} else if ratio.GT(maxTwapSpotRatio) {
+			ratio = maxTwapSpotRatio
+		}
+		fairAmount = fairAmount.Mul(pow(ratio, asset.Weight.ToDec().Quo(totalWeight)))
+	}
+	return fairAmount, nil
+}
+
+// pow returns base^exp for a positive base and an exponent in [0, 1],
+// extending osmomath.Pow to bases of at least two through base^exp = 1 / (1/base)^exp.
+// It is only accurate for bases near one, see maxTwapSpotRatio.
+func pow(base, exp sdk.Dec) sdk.Dec {
+	if base.GTE(sdk.OneDec()) {
+		return sdk.OneDec().Quo(osmomath.Pow(sdk.OneDec().Quo(base), exp))
+	}
+	return osmomath.Pow(base, exp)
+}
+
+// calculateRoutedOsmoBackingPerShare calculates the osmo equivalent worth of an LP share of a pool without osmo.
+// Like the osmo backing of pools with osmo only counts their osmo, this only counts the pool's pricing denom,
+// at its fair amount in the pool, valued in osmo at the TWAP of each hop of the asset's osmo pricing route.
+func (k Keeper) calculateRoutedOsmoBackingPerShare(ctx sdk.Context, pool *balancer.Pool, asset types.SuperfluidAsset) (sdk.Dec, error) {
+	routePrice, err := k.getOsmoPricingRoutePrice(ctx, asset)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if _, err := pool.GetPoolAsset(asset.PricingDenom); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	pricingDenomAmount, err := k.calculateFairPoolAmount(ctx, pool, asset.PricingDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return pricingDenomAmount.Mul(routePrice).Quo(pool.GetTotalShares().ToDec()), nil
+}
+
+// getOsmoPricingRoutePrice returns the price of the asset's pricing denom in osmo, through its osmo pricing route.
+func (k Keeper) getOsmoPricingRoutePrice(ctx sdk.Context, asset types.SuperfluidAsset) (sdk.Dec, error) {
+	price := sdk.OneDec()
+	denom := asset.PricingDenom
+	for _, hop := range asset.OsmoPricingRoute {
+		hopPrice, err := k.getTwapOrSpotPrice(ctx, hop.PoolId, hop.TokenOutDenom, denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		price = price.Mul(hopPrice)
+		denom = hop.TokenOutDenom
+	}
+
+	if bondDenom := k.sk.BondDenom(ctx); denom != bondDenom {
+		return sdk.Dec{}, fmt.Errorf("osmo pricing route of asset %s ends in %s instead of %s", asset.Denom, denom, bondDenom)
+	}
+	return price, nil
+}
+
+// getTwapOrSpotPrice returns the price of quoteDenom in baseDenom in the pool, at its arithmetic TWAP
+// over the OsmoMultiplierTwapWindow param, or at its spot price if the TWAP is unavailable.
+// The spot price can be moved within a block, so falling back to it is logged as an error.
+func (k Keeper) getTwapOrSpotPrice(ctx sdk.Context, poolId uint64, baseDenom, quoteDenom string) (sdk.Dec, error) {
+	endTime := ctx.BlockTime()
+	startTime := endTime.Add(-k.GetParams(ctx).OsmoMultiplierTwapWindow)
+	twapPrice, err := k.tk.GetArithmeticTwap(ctx, poolId, baseDenom, quoteDenom, startTime, endTime)
+	if err == nil {
+		if !twapPrice.IsPositive() {
+			return sdk.Dec{}, fmt.Errorf("non positive TWAP price %s of %s in %s in pool %d", twapPrice, quoteDenom, baseDenom, poolId)
+		}
+		return twapPrice, nil
+	}
+	k.Logger(ctx).Error(fmt.Sprintf("pricing %s in %s at the spot price of pool %d: %s", quoteDenom, baseDenom, poolId, err.Error()))
+	return k.gk.CalculateSpotPrice(ctx, poolId, baseDenom, quoteDenom)
 }
 
 func (k Keeper) SetOsmoEquivalentMultiplier(ctx sdk.Context, epoch int64, denom string, multiplier sdk.Dec) {
@@ -30,6 +154,7 @@ func (k Keeper) SetOsmoEquivalentMultiplier(ctx sdk.Context, epoch int64, denom
 		panic(err)
 	}
 	prefixStore.Set([]byte(denom), bz)
+	store.Set(types.GetKeyTokenMultiplierHistory(denom, epoch), bz)
 }
 
 func (k Keeper) GetSuperfluidOSMOTokens(ctx sdk.Context, denom string, amount sdk.Int) sdk.Int {
@@ -83,3 +208,25 @@ func (k Keeper) GetAllOsmoEquivalentMultipliers(ctx sdk.Context) []types.OsmoEqu
 	}
 	return priceRecords
 }
+
+// GetOsmoEquivalentMultiplierHistory returns the multipliers of denom set at each epoch, in epoch order.
+// Deleting the current multiplier of denom does not delete its history.
+func (k Keeper) GetOsmoEquivalentMultiplierHistory(ctx sdk.Context, denom string) []types.OsmoEquivalentMultiplierRecord {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.GetKeyPrefixTokenMultiplierHistory(denom))
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	priceRecords := []types.OsmoEquivalentMultiplierRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		priceRecord := types.OsmoEquivalentMultiplierRecord{}
+
+		err := proto.Unmarshal(iterator.Value(), &priceRecord)
+		if err != nil {
+			panic(err)
+		}
+
+		priceRecords = append(priceRecords, priceRecord)
+	}
+	return priceRecords
+}
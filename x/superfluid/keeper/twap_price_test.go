@@ -1,7 +1,13 @@
 package keeper_test
 
 import (
+	"fmt"
+	"math"
+	"time"
+
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/v7/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -57,3 +63,312 @@ func (suite *KeeperTestSuite) TestOsmoEquivalentMultiplierSetGetDeleteFlow() {
 	multiplier = suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, "gamm/pool/1")
 	suite.Require().Equal(multiplier, sdk.NewDec(0))
 }
+
+func (suite *KeeperTestSuite) TestOsmoEquivalentMultiplierHistory() {
+	suite.SetupTest()
+
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 1, "gamm/pool/1", sdk.NewDec(2))
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 1, "gamm/pool/10", sdk.NewDec(5))
+	suite.App.SuperfluidKeeper.SetOsmoEquivalentMultiplier(suite.Ctx, 2, "gamm/pool/1", sdk.NewDec(3))
+
+	expectedHistory := []types.OsmoEquivalentMultiplierRecord{
+		{EpochNumber: 1, Denom: "gamm/pool/1", Multiplier: sdk.NewDec(2)},
+		{EpochNumber: 2, Denom: "gamm/pool/1", Multiplier: sdk.NewDec(3)},
+	}
+	suite.Require().Equal(expectedHistory, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplierHistory(suite.Ctx, "gamm/pool/1"))
+
+	// history is kept after the current multiplier is deleted
+	suite.App.SuperfluidKeeper.DeleteOsmoEquivalentMultiplier(suite.Ctx, "gamm/pool/1")
+	res, err := suite.querier.AssetMultiplierHistory(sdk.WrapSDKContext(suite.Ctx), &types.AssetMultiplierHistoryRequest{Denom: "gamm/pool/1"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedHistory, res.OsmoEquivalentMultipliers)
+
+	res, err = suite.querier.AssetMultiplierHistory(sdk.WrapSDKContext(suite.Ctx), &types.AssetMultiplierHistoryRequest{Denom: "gamm/pool/10"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.OsmoEquivalentMultipliers, 1)
+
+	_, err = suite.querier.AssetMultiplierHistory(sdk.WrapSDKContext(suite.Ctx), &types.AssetMultiplierHistoryRequest{})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliersUsesTwap() {
+	suite.SetupTest()
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	poolId := suite.createGammPool([]string{bondDenom, "foo"})
+	asset := types.SuperfluidAsset{
+		Denom:     gammtypes.GetPoolShareDenom(poolId),
+		AssetType: types.SuperfluidAssetTypeLPShare,
+	}
+
+	spotMultiplier := func() sdk.Dec {
+		pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+		suite.Require().NoError(err)
+		return pool.GetTotalPoolLiquidity(suite.Ctx).AmountOf(bondDenom).ToDec().Quo(pool.GetTotalShares().ToDec())
+	}
+	originalMultiplier := spotMultiplier()
+
+	// the pool has no price history over the twap window, so the spot multiplier is used
+	err := suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(originalMultiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+
+	// without swaps over the twap window, the multiplier equals the spot multiplier
+	twapWindow := suite.App.SuperfluidKeeper.GetParams(suite.Ctx).OsmoMultiplierTwapWindow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow))
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(originalMultiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+
+	// a large swap in the epoch's block moves the spot multiplier, while the multiplier only grows by the swap fee
+	swapper := CreateRandomAccounts(1)[0]
+	tokenIn := sdk.NewInt64Coin("foo", 100000000000000000)
+	suite.FundAcc(swapper, sdk.NewCoins(tokenIn))
+	_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId, tokenIn, bondDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 3)
+	suite.Require().NoError(err)
+	twapMultiplier := suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom)
+	suite.Require().True(spotMultiplier().LT(originalMultiplier.Mul(sdk.NewDecWithPrec(95, 2))), "spot multiplier %s", spotMultiplier())
+	suite.Require().True(twapMultiplier.GTE(originalMultiplier), "twap multiplier %s", twapMultiplier)
+	suite.Require().True(twapMultiplier.LTE(originalMultiplier.Mul(sdk.NewDecWithPrec(1001, 3))), "twap multiplier %s", twapMultiplier)
+
+	history := suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplierHistory(suite.Ctx, asset.Denom)
+	suite.Require().Len(history, 3)
+	suite.Require().Equal(twapMultiplier, history[2].Multiplier)
+}
+
+func (suite *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliersWithSwapsInEpochBlock() {
+	suite.SetupTest()
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+
+	// a pool without swap fees, so that swaps don't change its invariant
+	poolCreator := CreateRandomAccounts(1)[0]
+	suite.FundAcc(poolCreator, suite.App.GAMMKeeper.GetParams(suite.Ctx).PoolCreationFee.Add(sdk.NewCoins(
+		sdk.NewInt64Coin(bondDenom, 1000000000000000000), sdk.NewInt64Coin("foo", 3000000000000000000))...))
+	poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(poolCreator, balancer.PoolParams{
+		SwapFee: sdk.ZeroDec(),
+		ExitFee: sdk.ZeroDec(),
+	}, []balancer.PoolAsset{
+		{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin(bondDenom, 1000000000000000000)},
+		{Weight: sdk.NewInt(300), Token: sdk.NewInt64Coin("foo", 3000000000000000000)},
+	}, ""))
+	suite.Require().NoError(err)
+	asset := types.SuperfluidAsset{
+		Denom:     gammtypes.GetPoolShareDenom(poolId),
+		AssetType: types.SuperfluidAssetTypeLPShare,
+	}
+
+	twapWindow := suite.App.SuperfluidKeeper.GetParams(suite.Ctx).OsmoMultiplierTwapWindow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow))
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 1)
+	suite.Require().NoError(err)
+	originalMultiplier := suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom)
+
+	// swaps that keep the spot price within a factor maxTwapSpotRatio of the TWAP price
+	swapper := CreateRandomAccounts(1)[0]
+	for i, tokenIn := range []sdk.Coin{
+		sdk.NewInt64Coin("foo", 200000000000000000),
+		sdk.NewInt64Coin(bondDenom, 500000000000000000),
+	} {
+		suite.FundAcc(swapper, sdk.NewCoins(tokenIn))
+		tokenOutDenom := bondDenom
+		if tokenIn.Denom == bondDenom {
+			tokenOutDenom = "foo"
+		}
+		_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, poolId, tokenIn, tokenOutDenom, sdk.OneInt())
+		suite.Require().NoError(err)
+
+		// swaps in the epoch's block don't move the multiplier
+		err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, int64(i+2))
+		suite.Require().NoError(err)
+		multiplier := suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom)
+		suite.Require().True(multiplier.Sub(originalMultiplier).Abs().LTE(originalMultiplier.Mul(sdk.NewDecWithPrec(1, 6))),
+			"multiplier %s, original multiplier %s", multiplier, originalMultiplier)
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliersWithPricingRoute() {
+	suite.SetupTest()
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	poolId := suite.createGammPool([]string{"foo", "bar"})
+	routePoolId := suite.createGammPool([]string{"foo", bondDenom})
+	asset := types.SuperfluidAsset{
+		Denom:            gammtypes.GetPoolShareDenom(poolId),
+		AssetType:        types.SuperfluidAssetTypeLPShare,
+		PricingDenom:     "foo",
+		OsmoPricingRoute: []types.PricingRouteHop{{PoolId: routePoolId, TokenOutDenom: bondDenom}},
+	}
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+
+	// foo is worth one osmo, and only the pool's foo backs its shares, like only osmo backs the shares of the route pool
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	expectedMultiplier := pool.GetTotalPoolLiquidity(suite.Ctx).AmountOf("foo").ToDec().Quo(pool.GetTotalShares().ToDec())
+	routePoolAsset := types.SuperfluidAsset{
+		Denom:     gammtypes.GetPoolShareDenom(routePoolId),
+		AssetType: types.SuperfluidAssetTypeLPShare,
+	}
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, routePoolAsset, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedMultiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, routePoolAsset.Denom))
+
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedMultiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+
+	twapWindow := suite.App.SuperfluidKeeper.GetParams(suite.Ctx).OsmoMultiplierTwapWindow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow))
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedMultiplier, suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+	suite.Require().Len(suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplierHistory(suite.Ctx, asset.Denom), 2)
+
+	// a route that doesn't end in osmo unwinds the asset
+	asset.OsmoPricingRoute = []types.PricingRouteHop{{PoolId: poolId, TokenOutDenom: "bar"}}
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 3)
+	suite.Require().Error(err)
+	suite.Require().Equal("", suite.App.SuperfluidKeeper.GetSuperfluidAsset(suite.Ctx, asset.Denom).Denom)
+}
+
+// fixedTwapKeeper returns the same TWAP price for all the denoms of a pool, or of every pool if poolId is zero,
+// and no TWAP for other pools.
+type fixedTwapKeeper struct {
+	poolId uint64
+	price  sdk.Dec
+}
+
+func (tk fixedTwapKeeper) GetArithmeticTwap(_ sdk.Context, poolId uint64, _, _ string, _, _ time.Time) (sdk.Dec, error) {
+	if tk.poolId != 0 && poolId != tk.poolId {
+		return sdk.Dec{}, fmt.Errorf("no twap for pool %d", poolId)
+	}
+	return tk.price, nil
+}
+
+func (suite *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliersTwapSpotRatio() {
+	tests := []struct {
+		name       string
+		twapPrice  sdk.Dec
+		fairRatio  float64
+		expectsErr bool
+	}{
+		{
+			name:      "ratio above one",
+			twapPrice: sdk.MustNewDecFromStr("1.5"),
+			fairRatio: 1.5,
+		},
+		{
+			name:      "ratio below one",
+			twapPrice: sdk.MustNewDecFromStr("0.6"),
+			fairRatio: 0.6,
+		},
+		{
+			name:      "large ratio is clamped",
+			twapPrice: sdk.NewDec(1000000),
+			fairRatio: 2,
+		},
+		{
+			name:      "small ratio is clamped",
+			twapPrice: sdk.MustNewDecFromStr("0.000001"),
+			fairRatio: 0.5,
+		},
+		{
+			name:       "zero twap unwinds the asset",
+			twapPrice:  sdk.ZeroDec(),
+			expectsErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+
+			// the spot price of foo in osmo is one
+			poolCreator := CreateRandomAccounts(1)[0]
+			suite.FundAcc(poolCreator, suite.App.GAMMKeeper.GetParams(suite.Ctx).PoolCreationFee.Add(sdk.NewCoins(
+				sdk.NewInt64Coin(bondDenom, 1000000000000000000), sdk.NewInt64Coin("foo", 3000000000000000000))...))
+			poolId, err := suite.App.GAMMKeeper.CreatePool(suite.Ctx, balancer.NewMsgCreateBalancerPool(poolCreator, balancer.PoolParams{
+				SwapFee: sdk.ZeroDec(),
+				ExitFee: sdk.ZeroDec(),
+			}, []balancer.PoolAsset{
+				{Weight: sdk.NewInt(100), Token: sdk.NewInt64Coin(bondDenom, 1000000000000000000)},
+				{Weight: sdk.NewInt(300), Token: sdk.NewInt64Coin("foo", 3000000000000000000)},
+			}, ""))
+			suite.Require().NoError(err)
+			asset := types.SuperfluidAsset{
+				Denom:     gammtypes.GetPoolShareDenom(poolId),
+				AssetType: types.SuperfluidAssetTypeLPShare,
+			}
+			suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+
+			superfluidKeeper := suite.App.SuperfluidKeeper.WithTwapKeeper(fixedTwapKeeper{price: tc.twapPrice})
+			err = superfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 1)
+			if tc.expectsErr {
+				suite.Require().Error(err)
+				suite.Require().Equal("", suite.App.SuperfluidKeeper.GetSuperfluidAsset(suite.Ctx, asset.Denom).Denom)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the fair osmo amount is the osmo amount times the clamped ratio to the power of foo's weight
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			totalShares, err := pool.GetTotalShares().ToDec().Float64()
+			suite.Require().NoError(err)
+			expectedMultiplier := 1e18 * math.Pow(tc.fairRatio, 0.75) / totalShares
+			multiplier, err := suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom).Float64()
+			suite.Require().NoError(err)
+			suite.Require().InEpsilon(expectedMultiplier, multiplier, 1e-6)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateOsmoEquivalentMultipliersRouteSpotFallback() {
+	suite.SetupTest()
+	bondDenom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	poolId := suite.createGammPool([]string{"foo", "bar"})
+	routePoolId := suite.createGammPool([]string{"foo", bondDenom})
+	asset := types.SuperfluidAsset{
+		Denom:            gammtypes.GetPoolShareDenom(poolId),
+		AssetType:        types.SuperfluidAssetTypeLPShare,
+		PricingDenom:     "foo",
+		OsmoPricingRoute: []types.PricingRouteHop{{PoolId: routePoolId, TokenOutDenom: bondDenom}},
+	}
+	suite.App.SuperfluidKeeper.SetSuperfluidAsset(suite.Ctx, asset)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	fooPerShare := pool.GetTotalPoolLiquidity(suite.Ctx).AmountOf("foo").ToDec().Quo(pool.GetTotalShares().ToDec())
+
+	swapper := CreateRandomAccounts(1)[0]
+	swapFoo := func(amount int64) {
+		tokenIn := sdk.NewInt64Coin("foo", amount)
+		suite.FundAcc(swapper, sdk.NewCoins(tokenIn))
+		_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, swapper, routePoolId, tokenIn, bondDenom, sdk.OneInt())
+		suite.Require().NoError(err)
+	}
+
+	// the route pool has no price history over the twap window, so foo is priced at its spot price
+	swapFoo(100000000000000000)
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, routePoolId, bondDenom, "foo")
+	suite.Require().NoError(err)
+	suite.Require().True(spotPrice.LT(sdk.OneDec()))
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fooPerShare.Mul(spotPrice), suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+
+	// once it has, swaps in the route pool in the epoch's block don't move the multiplier
+	twapWindow := suite.App.SuperfluidKeeper.GetParams(suite.Ctx).OsmoMultiplierTwapWindow
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(twapWindow))
+	swapFoo(500000000000000000)
+	err = suite.App.SuperfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fooPerShare.Mul(spotPrice), suite.App.SuperfluidKeeper.GetOsmoEquivalentMultiplier(suite.Ctx, asset.Denom))
+
+	// a zero twap price of the route unwinds the asset
+	superfluidKeeper := suite.App.SuperfluidKeeper.WithTwapKeeper(fixedTwapKeeper{poolId: routePoolId, price: sdk.ZeroDec()})
+	err = superfluidKeeper.UpdateOsmoEquivalentMultipliers(suite.Ctx, asset, 3)
+	suite.Require().ErrorContains(err, "non positive TWAP price")
+	suite.Require().Equal("", suite.App.SuperfluidKeeper.GetSuperfluidAsset(suite.Ctx, asset.Denom).Denom)
+}
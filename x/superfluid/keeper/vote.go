@@ -0,0 +1,372 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/osmosis-labs/osmosis/v7/osmoutils"
+	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SuperfluidVote sets the vote of a superfluid delegator on a proposal in the voting period.
+// When the voting period ends, the vote overrides the vote of the validators of the superfluid delegated
+// locks of the voter for their osmo equivalent, see BeforeProposalTally.
+func (k Keeper) SuperfluidVote(ctx sdk.Context, sender string, proposalID uint64, options govtypes.WeightedVoteOptions) error {
+	voter, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	proposal, found := k.govk.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(govtypes.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.Status != govtypes.StatusVotingPeriod {
+		return sdkerrors.Wrapf(govtypes.ErrInactiveProposal, "%d", proposalID)
+	}
+	if len(k.getSuperfluidVoteOverrides(ctx, voter, options)) == 0 {
+		return types.ErrNoSuperfluidDelegation
+	}
+
+	k.SetSuperfluidVote(ctx, types.SuperfluidVote{
+		ProposalId: proposalID,
+		Voter:      sender,
+		Options:    options,
+	})
+	return nil
+}
+
+// GetSuperfluidVoteBreakdown returns, for each intermediary account with a delegation or with superfluid delegators
+// that voted on a proposal, the vote of its validator, the superfluid votes overriding it, and the vote that is cast
+// before the proposal is tallied.
+func (k Keeper) GetSuperfluidVoteBreakdown(ctx sdk.Context, proposalID uint64) []types.IntermediaryAccountVoteBreakdown {
+	overrides := make(map[string][]types.SuperfluidVoteOverride)
+	for _, vote := range k.GetSuperfluidVotes(ctx, proposalID) {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+		for addr, accOverrides := range k.getSuperfluidVoteOverrides(ctx, voter, vote.Options) {
+			overrides[addr] = append(overrides[addr], accOverrides...)
+		}
+	}
+
+	breakdowns := []types.IntermediaryAccountVoteBreakdown{}
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		addr := acc.GetAccAddress()
+		accOverrides := overrides[addr.String()]
+		delegationAmount := k.getIntermediaryAccountDelegationAmount(ctx, acc)
+		if delegationAmount.IsZero() && len(accOverrides) == 0 {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+		if err != nil {
+			panic(err)
+		}
+		validatorVote := govtypes.WeightedVoteOptions{}
+		if vote, found := k.govk.GetVote(ctx, proposalID, sdk.AccAddress(valAddr)); found {
+			validatorVote = vote.Options
+		}
+
+		overriddenAmount := sdk.ZeroInt()
+		amounts := []sdk.Int{}
+		votes := []govtypes.WeightedVoteOptions{}
+		for _, override := range accOverrides {
+			overriddenAmount = overriddenAmount.Add(override.Amount)
+			amounts = append(amounts, override.Amount)
+			votes = append(votes, override.Options)
+		}
+		inheritedAmount := sdk.ZeroInt()
+		if delegationAmount.GT(overriddenAmount) {
+			inheritedAmount = delegationAmount.Sub(overriddenAmount)
+		}
+
+		breakdown := types.IntermediaryAccountVoteBreakdown{
+			IntermediaryAccount: types.SuperfluidIntermediaryAccountInfo{
+				Denom:   acc.Denom,
+				ValAddr: acc.ValAddr,
+				GaugeId: acc.GaugeId,
+				Address: addr.String(),
+			},
+			DelegationAmount: delegationAmount,
+			ValidatorVote:    validatorVote,
+			InheritedAmount:  inheritedAmount,
+			Overrides:        accOverrides,
+			Vote:             govtypes.WeightedVoteOptions{},
+			VotingAmount:     sdk.ZeroInt(),
+		}
+		switch {
+		case len(accOverrides) == 0:
+		case len(validatorVote) != 0:
+			// The intermediary account votes for its whole delegation, the inherited amount as the validator.
+			breakdown.Voter = addr.String()
+			breakdown.VotingAmount = delegationAmount
+			breakdown.Vote = combineVotes(append(amounts, inheritedAmount), append(votes, validatorVote))
+		default:
+			// The inherited amount doesn't vote, as the validator didn't, so the overridden amount is moved to the vote
+			// account of the intermediary account, which votes as the superfluid delegators.
+			breakdown.Voter = types.GetSuperfluidVoteAccountAddr(addr).String()
+			breakdown.VotingAmount = sdk.MinInt(overriddenAmount, delegationAmount)
+			if breakdown.VotingAmount.IsPositive() {
+				breakdown.Vote = combineVotes(amounts, votes)
+			}
+		}
+		breakdowns = append(breakdowns, breakdown)
+	}
+	return breakdowns
+}
+
+// BeforeProposalTally casts the superfluid votes on a proposal, just before it is tallied.
+// For each intermediary account whose superfluid delegators voted on the proposal:
+//   - If its validator voted, the intermediary account casts a weighted vote, where the overridden share of its
+//     delegation votes as the superfluid delegators, and the rest inherits the vote of the validator. The gov module
+//     then deducts the delegation of the intermediary account from the voting power of the validator, as for any
+//     delegator that votes.
+//   - Otherwise, the overridden amount is moved from the delegation of the intermediary account to its vote account,
+//     which votes as the superfluid delegators. The rest of the delegation doesn't vote, like the validator.
+//     AfterProposalTally moves the overridden amount back.
+//
+// Delegations to a validator vote as it on every proposal, so the vote accounts only delegate while the proposal
+// they vote on is tallied, see BeforeNextProposalTally.
+// It is a no-op for intermediary accounts without superfluid votes, which keep voting as their validator.
+func (k Keeper) BeforeProposalTally(ctx sdk.Context, proposalID uint64) {
+	for _, breakdown := range k.GetSuperfluidVoteBreakdown(ctx, proposalID) {
+		if len(breakdown.Vote) == 0 {
+			continue
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			voter, err := sdk.AccAddressFromBech32(breakdown.Voter)
+			if err != nil {
+				return err
+			}
+			if breakdown.Voter != breakdown.IntermediaryAccount.Address {
+				err = k.moveToVoteAccount(cacheCtx, breakdown.IntermediaryAccount, voter, breakdown.VotingAmount)
+				if err != nil {
+					return err
+				}
+			}
+			return k.govk.AddVote(cacheCtx, proposalID, voter, breakdown.Vote)
+		})
+		if err != nil {
+			k.Logger(ctx).Error(err.Error())
+		}
+	}
+}
+
+// AfterProposalTally deletes the superfluid votes on a tallied proposal, and moves the delegations of the vote
+// accounts back to their intermediary accounts.
+func (k Keeper) AfterProposalTally(ctx sdk.Context, proposalID uint64) {
+	k.DeleteSuperfluidVotes(ctx, proposalID)
+
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		err := k.moveFromVoteAccount(ctx, acc)
+		if err != nil {
+			k.Logger(ctx).Error(err.Error())
+		}
+	}
+}
+
+// BeforeNextProposalTally runs BeforeProposalTally on the next proposal whose voting period ends in this block.
+// It must run before the gov end blocker tallies the proposals, and after each one is tallied.
+func (k Keeper) BeforeNextProposalTally(ctx sdk.Context) {
+	proposalIDs := []uint64{}
+	k.govk.IterateActiveProposalsQueue(ctx, ctx.BlockTime(), func(proposal govtypes.Proposal) bool {
+		proposalIDs = append(proposalIDs, proposal.ProposalId)
+		return true
+	})
+
+	if len(proposalIDs) != 0 {
+		k.BeforeProposalTally(ctx, proposalIDs[0])
+	}
+}
+
+// moveToVoteAccount moves osmoAmount of the delegation of an intermediary account to its vote account.
+func (k Keeper) moveToVoteAccount(ctx sdk.Context, accInfo types.SuperfluidIntermediaryAccountInfo, voteAccAddr sdk.AccAddress, osmoAmount sdk.Int) error {
+	acc := types.NewSuperfluidIntermediaryAccount(accInfo.Denom, accInfo.ValAddr, accInfo.GaugeId)
+	err := k.forceUndelegateAndBurnOsmoTokens(ctx, osmoAmount, acc)
+	if err != nil {
+		return err
+	}
+
+	if !k.ak.HasAccount(ctx, voteAccAddr) {
+		k.ak.SetAccount(ctx, authtypes.NewBaseAccount(voteAccAddr, nil, 0, 0))
+	}
+	return k.mintOsmoTokensAndDelegateFrom(ctx, osmoAmount, voteAccAddr, acc.ValAddr)
+}
+
+// moveFromVoteAccount moves the delegation of the vote account of an intermediary account back to it.
+func (k Keeper) moveFromVoteAccount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) error {
+	voteAccAddr := types.GetSuperfluidVoteAccountAddr(acc.GetAccAddress())
+	valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		return err
+	}
+	delegation, found := k.sk.GetDelegation(ctx, voteAccAddr, valAddr)
+	if !found {
+		return nil
+	}
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return stakingtypes.ErrNoValidatorFound
+	}
+	osmoAmount := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	if osmoAmount.IsZero() {
+		return nil
+	}
+
+	return osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		err := k.forceUndelegateAndBurnOsmoTokensFrom(cacheCtx, osmoAmount, voteAccAddr, acc.ValAddr)
+		if err != nil {
+			return err
+		}
+		return k.mintOsmoTokensAndDelegate(cacheCtx, osmoAmount, acc)
+	})
+}
+
+// getSuperfluidVoteOverrides returns the vote overrides of the superfluid delegated locks of a voter,
+// grouped by the address of their intermediary accounts.
+func (k Keeper) getSuperfluidVoteOverrides(ctx sdk.Context, voter sdk.AccAddress, options govtypes.WeightedVoteOptions) map[string][]types.SuperfluidVoteOverride {
+	overrides := make(map[string][]types.SuperfluidVoteOverride)
+	for _, lock := range k.lk.GetAccountPeriodLocks(ctx, voter) {
+		acc, found := k.GetIntermediaryAccountFromLockId(ctx, lock.ID)
+		if !found {
+			continue
+		}
+		addr := acc.GetAccAddress().String()
+		overrides[addr] = append(overrides[addr], types.SuperfluidVoteOverride{
+			Voter:   voter.String(),
+			LockId:  lock.ID,
+			Amount:  k.GetSuperfluidOSMOTokens(ctx, acc.Denom, lock.Coins.AmountOf(acc.Denom)),
+			Options: options,
+		})
+	}
+	return overrides
+}
+
+// getIntermediaryAccountDelegationAmount returns the osmo delegated by an intermediary account to its validator.
+func (k Keeper) getIntermediaryAccountDelegationAmount(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) sdk.Int {
+	valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+	if err != nil {
+		panic(err)
+	}
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	delegation, found := k.sk.GetDelegation(ctx, acc.GetAccAddress(), valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// combineVotes returns the weighted vote of votes, each weighing its options by its share of the sum of amounts.
+// The weights of the returned vote sum to 1, the rounding error being added to its last option.
+// Returns an empty vote if the amounts sum to zero.
+func combineVotes(amounts []sdk.Int, votes []govtypes.WeightedVoteOptions) govtypes.WeightedVoteOptions {
+	totalAmount := sdk.ZeroInt()
+	for _, amount := range amounts {
+		totalAmount = totalAmount.Add(amount)
+	}
+	if totalAmount.IsZero() {
+		return govtypes.WeightedVoteOptions{}
+	}
+
+	weights := make(map[govtypes.VoteOption]sdk.Dec)
+	for i, options := range votes {
+		share := amounts[i].ToDec().Quo(totalAmount.ToDec())
+		for _, option := range options {
+			weight, ok := weights[option.Option]
+			if !ok {
+				weight = sdk.ZeroDec()
+			}
+			weights[option.Option] = weight.Add(share.Mul(option.Weight))
+		}
+	}
+
+	vote := govtypes.WeightedVoteOptions{}
+	totalWeight := sdk.ZeroDec()
+	for option := govtypes.OptionYes; option <= govtypes.OptionNoWithVeto; option++ {
+		if weight, ok := weights[option]; ok && weight.IsPositive() {
+			vote = append(vote, govtypes.WeightedVoteOption{Option: option, Weight: weight})
+			totalWeight = totalWeight.Add(weight)
+		}
+	}
+	if len(vote) != 0 {
+		last := &vote[len(vote)-1]
+		last.Weight = last.Weight.Add(sdk.OneDec().Sub(totalWeight))
+	}
+	return vote
+}
+
+func (k Keeper) SetSuperfluidVote(ctx sdk.Context, vote types.SuperfluidVote) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz, err := proto.Marshal(&vote)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetKeySuperfluidVote(vote.ProposalId, voter), bz)
+}
+
+func (k Keeper) GetSuperfluidVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.SuperfluidVote, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeySuperfluidVote(proposalID, voter))
+	if bz == nil {
+		return types.SuperfluidVote{}, false
+	}
+	vote := types.SuperfluidVote{}
+	err := proto.Unmarshal(bz, &vote)
+	if err != nil {
+		panic(err)
+	}
+	return vote, true
+}
+
+// GetSuperfluidVotes returns the superfluid votes on a proposal.
+func (k Keeper) GetSuperfluidVotes(ctx sdk.Context, proposalID uint64) []types.SuperfluidVote {
+	return k.getSuperfluidVotesByPrefix(ctx, types.GetKeyPrefixSuperfluidVotes(proposalID))
+}
+
+// GetAllSuperfluidVotes returns the superfluid votes on all the proposals.
+func (k Keeper) GetAllSuperfluidVotes(ctx sdk.Context) []types.SuperfluidVote {
+	return k.getSuperfluidVotesByPrefix(ctx, types.KeyPrefixSuperfluidVote)
+}
+
+func (k Keeper) getSuperfluidVotesByPrefix(ctx sdk.Context, keyPrefix []byte) []types.SuperfluidVote {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, keyPrefix)
+
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	votes := []types.SuperfluidVote{}
+	for ; iterator.Valid(); iterator.Next() {
+		vote := types.SuperfluidVote{}
+		err := proto.Unmarshal(iterator.Value(), &vote)
+		if err != nil {
+			panic(err)
+		}
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+// DeleteSuperfluidVotes deletes the superfluid votes on a proposal.
+func (k Keeper) DeleteSuperfluidVotes(ctx sdk.Context, proposalID uint64) {
+	for _, vote := range k.GetSuperfluidVotes(ctx, proposalID) {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			panic(err)
+		}
+		ctx.KVStore(k.storeKey).Delete(types.GetKeySuperfluidVote(proposalID, voter))
+	}
+}
@@ -0,0 +1,343 @@
+package keeper_test
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
+)
+
+func (suite *KeeperTestSuite) submitProposal(activate bool) govtypes.Proposal {
+	proposal, err := suite.App.GovKeeper.SubmitProposal(suite.Ctx, govtypes.NewTextProposal("title", "description"))
+	suite.Require().NoError(err)
+	if activate {
+		suite.App.GovKeeper.ActivateVotingPeriod(suite.Ctx, proposal)
+		proposal, _ = suite.App.GovKeeper.GetProposal(suite.Ctx, proposal.ProposalId)
+	}
+	return proposal
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidVote() {
+	testCases := []struct {
+		name             string
+		delegatorIndex   int64
+		proposalID       uint64
+		activateProposal bool
+		expectedErr      error
+	}{
+		{
+			name:             "superfluid delegator votes on a proposal in the voting period",
+			delegatorIndex:   0,
+			activateProposal: true,
+		},
+		{
+			name:             "account without superfluid delegation",
+			delegatorIndex:   1,
+			activateProposal: true,
+			expectedErr:      types.ErrNoSuperfluidDelegation,
+		},
+		{
+			name:             "proposal in the deposit period",
+			delegatorIndex:   0,
+			activateProposal: false,
+			expectedErr:      govtypes.ErrInactiveProposal,
+		},
+		{
+			name:             "unknown proposal",
+			delegatorIndex:   0,
+			proposalID:       100,
+			activateProposal: true,
+			expectedErr:      govtypes.ErrUnknownProposal,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			delAddrs := CreateRandomAccounts(2)
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+			suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+
+			proposal := suite.submitProposal(tc.activateProposal)
+			proposalID := proposal.ProposalId
+			if tc.proposalID != 0 {
+				proposalID = tc.proposalID
+			}
+
+			voter := delAddrs[tc.delegatorIndex]
+			options := govtypes.NewNonSplitVoteOption(govtypes.OptionNo)
+			err := suite.App.SuperfluidKeeper.SuperfluidVote(suite.Ctx, voter.String(), proposalID, options)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				_, found := suite.App.SuperfluidKeeper.GetSuperfluidVote(suite.Ctx, proposalID, voter)
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().NoError(err)
+
+			vote, found := suite.App.SuperfluidKeeper.GetSuperfluidVote(suite.Ctx, proposalID, voter)
+			suite.Require().True(found)
+			suite.Require().Equal(options, vote.Options)
+
+			// voting again replaces the vote
+			options = govtypes.NewNonSplitVoteOption(govtypes.OptionYes)
+			err = suite.App.SuperfluidKeeper.SuperfluidVote(suite.Ctx, voter.String(), proposalID, options)
+			suite.Require().NoError(err)
+			suite.Require().Equal([]types.SuperfluidVote{{
+				ProposalId: proposalID,
+				Voter:      voter.String(),
+				Options:    options,
+			}}, suite.App.SuperfluidKeeper.GetSuperfluidVotes(suite.Ctx, proposalID))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeforeProposalTally() {
+	half := sdk.NewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name string
+		// vote of the validator, none if empty
+		validatorVote govtypes.VoteOption
+		// superfluid votes by delegator index
+		superfluidVotes   map[int64]govtypes.WeightedVoteOptions
+		expectedOverrides int
+		// whether the intermediary account casts the vote, rather than its vote account
+		expectedIntermediaryVoter bool
+		// expected tally of the votes of the validator and the superfluid delegators,
+		// given the tokens of the validator and the osmo equivalent of each lock
+		expectedTally  func(valTokens, lockOsmo sdk.Dec) govtypes.TallyResult
+		quorum         sdk.Dec
+		expectedQuorum bool
+		expectedStatus govtypes.ProposalStatus
+	}{
+		{
+			name:           "validator votes for its superfluid delegators",
+			validatorVote:  govtypes.OptionYes,
+			expectedStatus: govtypes.StatusPassed,
+			expectedQuorum: true,
+			expectedTally: func(valTokens, lockOsmo sdk.Dec) govtypes.TallyResult {
+				return newTallyResult(valTokens, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+			},
+		},
+		{
+			name:          "superfluid delegator overrides the vote of the validator",
+			validatorVote: govtypes.OptionYes,
+			superfluidVotes: map[int64]govtypes.WeightedVoteOptions{
+				0: govtypes.NewNonSplitVoteOption(govtypes.OptionNo),
+			},
+			expectedOverrides:         1,
+			expectedIntermediaryVoter: true,
+			expectedStatus:            govtypes.StatusPassed,
+			expectedQuorum:            true,
+			expectedTally: func(valTokens, lockOsmo sdk.Dec) govtypes.TallyResult {
+				return newTallyResult(valTokens.Sub(lockOsmo), sdk.ZeroDec(), lockOsmo, sdk.ZeroDec())
+			},
+		},
+		{
+			// only the osmo equivalent of the lock votes, the rest of the delegation of the intermediary account
+			// doesn't count towards the quorum nor the threshold, so the proposal passes.
+			name: "superfluid delegator votes without validator vote",
+			superfluidVotes: map[int64]govtypes.WeightedVoteOptions{
+				0: govtypes.NewNonSplitVoteOption(govtypes.OptionYes),
+			},
+			expectedOverrides: 1,
+			expectedStatus:    govtypes.StatusPassed,
+			expectedQuorum:    true,
+			expectedTally: func(valTokens, lockOsmo sdk.Dec) govtypes.TallyResult {
+				return newTallyResult(lockOsmo, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+			},
+		},
+		{
+			name: "superfluid delegator votes without validator vote below the quorum",
+			superfluidVotes: map[int64]govtypes.WeightedVoteOptions{
+				0: govtypes.NewNonSplitVoteOption(govtypes.OptionYes),
+			},
+			expectedOverrides: 1,
+			quorum:            sdk.NewDecWithPrec(6, 1),
+			expectedStatus:    govtypes.StatusRejected,
+			expectedQuorum:    false,
+			expectedTally: func(valTokens, lockOsmo sdk.Dec) govtypes.TallyResult {
+				return newTallyResult(lockOsmo, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+			},
+		},
+		{
+			name: "superfluid delegators vote differently without validator vote",
+			superfluidVotes: map[int64]govtypes.WeightedVoteOptions{
+				0: govtypes.NewNonSplitVoteOption(govtypes.OptionYes),
+				1: {
+					{Option: govtypes.OptionNo, Weight: half},
+					{Option: govtypes.OptionAbstain, Weight: half},
+				},
+			},
+			expectedOverrides: 2,
+			expectedStatus:    govtypes.StatusPassed,
+			expectedQuorum:    true,
+			expectedTally: func(valTokens, lockOsmo sdk.Dec) govtypes.TallyResult {
+				return newTallyResult(lockOsmo, lockOsmo.Mul(half), lockOsmo.Mul(half), sdk.ZeroDec())
+			},
+		},
+		{
+			name:          "all superfluid delegators override the vote of the validator with weighted votes",
+			validatorVote: govtypes.OptionNo,
+			superfluidVotes: map[int64]govtypes.WeightedVoteOptions{
+				0: {
+					{Option: govtypes.OptionYes, Weight: half},
+					{Option: govtypes.OptionAbstain, Weight: half},
+				},
+				1: govtypes.NewNonSplitVoteOption(govtypes.OptionNoWithVeto),
+			},
+			expectedOverrides:         2,
+			expectedIntermediaryVoter: true,
+			expectedStatus:            govtypes.StatusRejected,
+			expectedQuorum:            true,
+			expectedTally: func(valTokens, lockOsmo sdk.Dec) govtypes.TallyResult {
+				return newTallyResult(lockOsmo.Mul(half), lockOsmo.Mul(half), valTokens.Sub(lockOsmo.MulInt64(2)), lockOsmo)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if !tc.quorum.IsNil() {
+				tallyParams := suite.App.GovKeeper.GetTallyParams(suite.Ctx)
+				tallyParams.Quorum = tc.quorum
+				suite.App.GovKeeper.SetTallyParams(suite.Ctx, tallyParams)
+			}
+
+			delAddrs := CreateRandomAccounts(2)
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+			intermediaryAccs, _ := suite.SetupSuperfluidDelegations(delAddrs, valAddrs,
+				[]superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+			intermediaryAcc := intermediaryAccs[0].GetAccAddress()
+			voteAcc := types.GetSuperfluidVoteAccountAddr(intermediaryAcc)
+			lockOsmo := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(1000000))
+
+			proposal := suite.submitProposal(true)
+			if tc.validatorVote != govtypes.OptionEmpty {
+				err := suite.App.GovKeeper.AddVote(suite.Ctx, proposal.ProposalId, sdk.AccAddress(valAddrs[0]),
+					govtypes.NewNonSplitVoteOption(tc.validatorVote))
+				suite.Require().NoError(err)
+			}
+			for delIndex, options := range tc.superfluidVotes {
+				err := suite.App.SuperfluidKeeper.SuperfluidVote(suite.Ctx, delAddrs[delIndex].String(), proposal.ProposalId, options)
+				suite.Require().NoError(err)
+			}
+
+			// check the vote breakdown of the intermediary account
+			res, err := suite.querier.SuperfluidVoteBreakdown(sdk.WrapSDKContext(suite.Ctx), &types.SuperfluidVoteBreakdownRequest{
+				ProposalId: proposal.ProposalId,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Breakdowns, 1)
+			breakdown := res.Breakdowns[0]
+			suite.Require().Equal(intermediaryAcc.String(), breakdown.IntermediaryAccount.Address)
+			suite.Require().Equal(lockOsmo.MulRaw(2), breakdown.DelegationAmount)
+			suite.Require().Equal(lockOsmo.MulRaw(int64(2-tc.expectedOverrides)), breakdown.InheritedAmount)
+			suite.Require().Len(breakdown.Overrides, tc.expectedOverrides)
+			switch {
+			case tc.expectedOverrides == 0:
+				suite.Require().Empty(breakdown.Vote)
+				suite.Require().Empty(breakdown.Voter)
+			case tc.expectedIntermediaryVoter:
+				suite.Require().Equal(intermediaryAcc.String(), breakdown.Voter)
+				suite.Require().Equal(breakdown.DelegationAmount, breakdown.VotingAmount)
+			default:
+				suite.Require().Equal(voteAcc.String(), breakdown.Voter)
+				suite.Require().Equal(lockOsmo.MulRaw(int64(tc.expectedOverrides)), breakdown.VotingAmount)
+			}
+			if len(breakdown.Vote) != 0 {
+				totalWeight := sdk.ZeroDec()
+				for _, option := range breakdown.Vote {
+					totalWeight = totalWeight.Add(option.Weight)
+				}
+				suite.Require().Equal(sdk.OneDec(), totalWeight)
+			}
+
+			val, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+			suite.Require().True(found)
+
+			// superfluid end blocker casts the votes before the gov end blocker tallies
+			suite.Ctx = suite.Ctx.WithBlockTime(proposal.VotingEndTime)
+			suite.App.EndBlocker(suite.Ctx, abci.RequestEndBlock{Height: suite.Ctx.BlockHeight()})
+
+			proposal, found = suite.App.GovKeeper.GetProposal(suite.Ctx, proposal.ProposalId)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expectedTally(val.Tokens.ToDec(), lockOsmo.ToDec()), proposal.FinalTallyResult)
+			suite.Require().Equal(tc.expectedStatus, proposal.Status)
+
+			// the tallied votes reach the quorum of the bonded tokens
+			tally := proposal.FinalTallyResult
+			votingPower := tally.Yes.Add(tally.Abstain).Add(tally.No).Add(tally.NoWithVeto)
+			bondedTokens := suite.App.StakingKeeper.TotalBondedTokens(suite.Ctx)
+			quorum := suite.App.GovKeeper.GetTallyParams(suite.Ctx).Quorum
+			suite.Require().Equal(tc.expectedQuorum, votingPower.ToDec().Quo(bondedTokens.ToDec()).GTE(quorum))
+
+			// superfluid votes are deleted with the gov votes
+			suite.Require().Empty(suite.App.SuperfluidKeeper.GetSuperfluidVotes(suite.Ctx, proposal.ProposalId))
+			_, found = suite.App.GovKeeper.GetVote(suite.Ctx, proposal.ProposalId, intermediaryAcc)
+			suite.Require().False(found)
+			_, found = suite.App.GovKeeper.GetVote(suite.Ctx, proposal.ProposalId, voteAcc)
+			suite.Require().False(found)
+
+			// the delegation moved to the vote account is moved back to the intermediary account
+			_, found = suite.App.StakingKeeper.GetDelegation(suite.Ctx, voteAcc, valAddrs[0])
+			suite.Require().False(found)
+			delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, intermediaryAcc, valAddrs[0])
+			suite.Require().True(found)
+			val, found = suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+			suite.Require().True(found)
+			suite.Require().Equal(lockOsmo.MulRaw(2), val.TokensFromShares(delegation.Shares).TruncateInt())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSuperfluidVotesOnProposalsEndingInSameBlock() {
+	delAddrs := CreateRandomAccounts(1)
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := suite.SetupGammPoolsAndSuperfluidAssets([]sdk.Dec{sdk.NewDec(20)})
+	suite.SetupSuperfluidDelegations(delAddrs, valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+	lockOsmo := suite.App.SuperfluidKeeper.GetSuperfluidOSMOTokens(suite.Ctx, denoms[0], sdk.NewInt(1000000)).ToDec()
+
+	// the validator only votes on the second proposal, which is tallied after the first one
+	proposals := []govtypes.Proposal{suite.submitProposal(true), suite.submitProposal(true)}
+	err := suite.App.GovKeeper.AddVote(suite.Ctx, proposals[1].ProposalId, sdk.AccAddress(valAddrs[0]),
+		govtypes.NewNonSplitVoteOption(govtypes.OptionYes))
+	suite.Require().NoError(err)
+	for _, proposal := range proposals {
+		err = suite.App.SuperfluidKeeper.SuperfluidVote(suite.Ctx, delAddrs[0].String(), proposal.ProposalId,
+			govtypes.NewNonSplitVoteOption(govtypes.OptionNo))
+		suite.Require().NoError(err)
+	}
+	val, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddrs[0])
+	suite.Require().True(found)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(proposals[1].VotingEndTime)
+	suite.App.EndBlocker(suite.Ctx, abci.RequestEndBlock{Height: suite.Ctx.BlockHeight()})
+
+	// the vote account only delegates the osmo equivalent of the lock while the first proposal is tallied,
+	// so it doesn't vote as the validator on the second one.
+	expectedTallies := []govtypes.TallyResult{
+		newTallyResult(sdk.ZeroDec(), sdk.ZeroDec(), lockOsmo, sdk.ZeroDec()),
+		newTallyResult(val.Tokens.ToDec().Sub(lockOsmo), sdk.ZeroDec(), lockOsmo, sdk.ZeroDec()),
+	}
+	expectedStatuses := []govtypes.ProposalStatus{govtypes.StatusRejected, govtypes.StatusRejected}
+	for i, proposal := range proposals {
+		proposal, found = suite.App.GovKeeper.GetProposal(suite.Ctx, proposal.ProposalId)
+		suite.Require().True(found)
+		suite.Require().Equal(expectedTallies[i], proposal.FinalTallyResult)
+		suite.Require().Equal(expectedStatuses[i], proposal.Status)
+	}
+}
+
+func newTallyResult(yes, abstain, no, noWithVeto sdk.Dec) govtypes.TallyResult {
+	return govtypes.NewTallyResult(yes.TruncateInt(), abstain.TruncateInt(), no.TruncateInt(), noWithVeto.TruncateInt())
+}
@@ -3,6 +3,7 @@ package simulation
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/v7/x/superfluid/types"
 
@@ -14,7 +15,8 @@ import (
 func RandomizedGenState(simState *module.SimulationState) {
 	superfluidGenesis := &types.GenesisState{
 		Params: types.Params{
-			MinimumRiskFactor: sdk.NewDecWithPrec(5, 2), // 5%
+			MinimumRiskFactor:        sdk.NewDecWithPrec(5, 2), // 5%
+			OsmoMultiplierTwapWindow: time.Hour,
 		},
 		SuperfluidAssets:          []types.SuperfluidAsset{},
 		OsmoEquivalentMultipliers: []types.OsmoEquivalentMultiplierRecord{},
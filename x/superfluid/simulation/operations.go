@@ -24,12 +24,14 @@ const (
 	DefaultWeightMsgSuperfluidDelegate          int = 100
 	DefaultWeightMsgSuperfluidUndelegate        int = 50
 	DefaultWeightMsgSuperfluidRedelegate        int = 50
+	DefaultWeightMsgSuperfluidUndelegatePartial int = 50
 	DefaultWeightSetSuperfluidAssetsProposal    int = 5
 	DefaultWeightRemoveSuperfluidAssetsProposal int = 2
 
-	OpWeightMsgSuperfluidDelegate   = "op_weight_msg_superfluid_delegate"
-	OpWeightMsgSuperfluidUndelegate = "op_weight_msg_superfluid_undelegate"
-	OpWeightMsgSuperfluidRedelegate = "op_weight_msg_superfluid_redelegate"
+	OpWeightMsgSuperfluidDelegate          = "op_weight_msg_superfluid_delegate"
+	OpWeightMsgSuperfluidUndelegate        = "op_weight_msg_superfluid_undelegate"
+	OpWeightMsgSuperfluidRedelegate        = "op_weight_msg_superfluid_redelegate"
+	OpWeightMsgSuperfluidUndelegatePartial = "op_weight_msg_superfluid_undelegate_partial"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights.
@@ -38,9 +40,10 @@ func WeightedOperations(
 	bk stakingtypes.BankKeeper, sk types.StakingKeeper, lk types.LockupKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgSuperfluidDelegate   int
-		weightMsgSuperfluidUndelegate int
-		// weightMsgSuperfluidRedelegate int
+		weightMsgSuperfluidDelegate          int
+		weightMsgSuperfluidUndelegate        int
+		weightMsgSuperfluidRedelegate        int
+		weightMsgSuperfluidUndelegatePartial int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSuperfluidDelegate, &weightMsgSuperfluidDelegate, nil,
@@ -55,11 +58,17 @@ func WeightedOperations(
 		},
 	)
 
-	// appParams.GetOrGenerate(cdc, OpWeightMsgSuperfluidRedelegate, &weightMsgSuperfluidRedelegate, nil,
-	// 	func(_ *rand.Rand) {
-	// 		weightMsgSuperfluidRedelegate = DefaultWeightMsgSuperfluidRedelegate
-	// 	},
-	// )
+	appParams.GetOrGenerate(cdc, OpWeightMsgSuperfluidRedelegate, &weightMsgSuperfluidRedelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgSuperfluidRedelegate = DefaultWeightMsgSuperfluidRedelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSuperfluidUndelegatePartial, &weightMsgSuperfluidUndelegatePartial, nil,
+		func(_ *rand.Rand) {
+			weightMsgSuperfluidUndelegatePartial = DefaultWeightMsgSuperfluidUndelegatePartial
+		},
+	)
 
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
@@ -70,10 +79,14 @@ func WeightedOperations(
 			weightMsgSuperfluidUndelegate,
 			SimulateMsgSuperfluidUndelegate(ak, bk, lk, k),
 		),
-		// simulation.NewWeightedOperation(
-		// 	weightMsgSuperfluidRedelegate,
-		// 	SimulateMsgSuperfluidRedelegate(ak, bk, sk, lk, k),
-		// ),
+		simulation.NewWeightedOperation(
+			weightMsgSuperfluidRedelegate,
+			SimulateMsgSuperfluidRedelegate(ak, bk, sk, lk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSuperfluidUndelegatePartial,
+			SimulateMsgSuperfluidUndelegatePartial(ak, bk, lk, k),
+		),
 	}
 }
 
@@ -145,41 +158,89 @@ func SimulateMsgSuperfluidUndelegate(ak stakingtypes.AccountKeeper, bk stakingty
 	}
 }
 
-// func SimulateMsgSuperfluidRedelegate(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, sk types.StakingKeeper, lk types.LockupKeeper, k keeper.Keeper) simtypes.Operation {
-// 	return func(
-// 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-// 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-// 		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-// 		// select random validator
-// 		validator := RandomValidator(ctx, r, sk)
-// 		if validator == nil {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "No validator"), nil, nil
-// 		}
-
-// 		lock, simAccount := RandomLockAndAccount(ctx, r, lk, accs)
-// 		if lock == nil {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Account have no period lock"), nil, nil
-// 		}
-
-// 		if k.GetLockIdIntermediaryAccountConnection(ctx, lock.ID).Empty() {
-// 			return simtypes.NoOpMsg(
-// 				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is not used for superfluid staking"), nil, nil
-// 		}
-
-// 		msg := types.MsgSuperfluidRedelegate{
-// 			Sender:     lock.Owner,
-// 			LockId:     lock.ID,
-// 			NewValAddr: validator.OperatorAddress,
-// 		}
-
-// 		txGen := simappparams.MakeTestEncodingConfig().TxConfig
-// 		return osmo_simulation.GenAndDeliverTxWithRandFees(
-// 			r, app, txGen, &msg, nil, ctx, simAccount, ak, bk, types.ModuleName)
-// 	}
-// }
+// SimulateMsgSuperfluidUndelegatePartial generates a MsgSuperfluidUndelegatePartial with random values.
+func SimulateMsgSuperfluidUndelegatePartial(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, lk types.LockupKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		lock, simAccount := RandomLockAndAccount(ctx, r, lk, accs)
+		if lock == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidUndelegatePartial, "Account have no period lock"), nil, nil
+		}
+
+		if k.GetLockIdIntermediaryAccountConnection(ctx, lock.ID).Empty() {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidUndelegatePartial, "Lock is not used for superfluid staking"), nil, nil
+		}
+
+		lockedCoin := lock.Coins[0]
+		if lockedCoin.Amount.LT(sdk.NewInt(2)) {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidUndelegatePartial, "Lock is too small to split"), nil, nil
+		}
+
+		// undelegate between 1 and all but 1 of the locked amount
+		amount := simtypes.RandomAmount(r, lockedCoin.Amount.SubRaw(2)).AddRaw(1)
+		msg := types.MsgSuperfluidUndelegatePartial{
+			Sender: simAccount.Address.String(),
+			LockId: lock.ID,
+			Coin:   sdk.NewCoin(lockedCoin.Denom, amount),
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, nil, ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
+
+// SimulateMsgSuperfluidRedelegate generates a MsgSuperfluidRedelegate with random values.
+func SimulateMsgSuperfluidRedelegate(ak stakingtypes.AccountKeeper, bk stakingtypes.BankKeeper, sk types.StakingKeeper, lk types.LockupKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// select random validator
+		validator := RandomValidator(ctx, r, sk)
+		if validator == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "No validator"), nil, nil
+		}
+
+		lock, simAccount := RandomLockAndAccount(ctx, r, lk, accs)
+		if lock == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Account have no period lock"), nil, nil
+		}
+
+		acc, found := k.GetIntermediaryAccountFromLockId(ctx, lock.ID)
+		if !found {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is not used for superfluid staking"), nil, nil
+		}
+
+		if acc.ValAddr == validator.OperatorAddress {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock is already delegated to the validator"), nil, nil
+		}
+
+		for _, synthLock := range lk.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+			if synthLock.IsUnlocking() {
+				return simtypes.NoOpMsg(
+					types.ModuleName, types.TypeMsgSuperfluidRedelegate, "Lock was redelegated in the last unbonding period"), nil, nil
+			}
+		}
+
+		msg := types.MsgSuperfluidRedelegate{
+			Sender:     lock.Owner,
+			LockId:     lock.ID,
+			NewValAddr: validator.OperatorAddress,
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		return osmo_simulation.GenAndDeliverTxWithRandFees(
+			r, app, txGen, &msg, nil, ctx, simAccount, ak, bk, types.ModuleName)
+	}
+}
 
 func RandomLockAndAccount(ctx sdk.Context, r *rand.Rand, lk types.LockupKeeper, accs []simtypes.Account) (*lockuptypes.PeriodLock, simtypes.Account) {
 	simAccount, _ := simtypes.RandomAcc(r, accs)
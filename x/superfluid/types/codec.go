@@ -11,8 +11,11 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSuperfluidDelegate{}, "osmosis/superfluid-delegate", nil)
 	cdc.RegisterConcrete(&MsgSuperfluidUndelegate{}, "osmosis/superfluid-undelegate", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidRedelegate{}, "osmosis/superfluid-redelegate", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidUndelegatePartial{}, "osmosis/superfluid-undelegate-partial", nil)
 	cdc.RegisterConcrete(&MsgLockAndSuperfluidDelegate{}, "osmosis/lock-and-superfluid-delegate", nil)
 	cdc.RegisterConcrete(&MsgSuperfluidUnbondLock{}, "osmosis/superfluid-unbond-lock", nil)
+	cdc.RegisterConcrete(&MsgSuperfluidVote{}, "osmosis/superfluid-vote", nil)
 	cdc.RegisterConcrete(&SetSuperfluidAssetsProposal{}, "osmosis/set-superfluid-assets-proposal", nil)
 	cdc.RegisterConcrete(&RemoveSuperfluidAssetsProposal{}, "osmosis/del-superfluid-assets-proposal", nil)
 }
@@ -22,8 +25,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSuperfluidDelegate{},
 		&MsgSuperfluidUndelegate{},
+		&MsgSuperfluidRedelegate{},
+		&MsgSuperfluidUndelegatePartial{},
 		&MsgLockAndSuperfluidDelegate{},
 		&MsgSuperfluidUnbondLock{},
+		&MsgSuperfluidVote{},
 	)
 
 	registry.RegisterImplementations(
@@ -17,4 +17,6 @@ var (
 	ErrBondingLockupNotSupported       = sdkerrors.Register(ModuleName, 9, "bonded superfluid stake is not allowed to have underlying lock unlocked")
 
 	ErrNonSuperfluidAsset = sdkerrors.Register(ModuleName, 10, "provided asset is not supported for superfluid staking")
+
+	ErrNoSuperfluidDelegation = sdkerrors.Register(ModuleName, 11, "voter has no superfluid delegated lockup")
 )
@@ -7,11 +7,18 @@ const (
 	TypeEvtSuperfluidDelegate           = "superfluid_delegate"
 	TypeEvtSuperfluidIncreaseDelegation = "superfluid_increase_delegation"
 	TypeEvtSuperfluidUndelegate         = "superfluid_undelegate"
+	TypeEvtSuperfluidRedelegate         = "superfluid_redelegate"
+	TypeEvtSuperfluidUndelegatePartial  = "superfluid_undelegate_partial"
 	TypeEvtSuperfluidUnbondLock         = "superfluid_unbond_lock"
+	TypeEvtSuperfluidVote               = "superfluid_vote"
 
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
 	AttributeLockId              = "lock_id"
+	AttributeSplitLockId         = "split_lock_id"
 	AttributeValidator           = "validator"
 	AttributeAmount              = "amount"
+	AttributeProposalId          = "proposal_id"
+	AttributeVoter               = "voter"
+	AttributeOption              = "option"
 )
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	epochstypes "github.com/osmosis-labs/osmosis/v7/x/epochs/types"
@@ -23,6 +24,7 @@ type LockupKeeper interface {
 	GetPeriodLocks(ctx sdk.Context) ([]lockuptypes.PeriodLock, error)
 	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
 	BeginForceUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) error
+	SplitLock(ctx sdk.Context, lockID uint64, coins sdk.Coins) (lockuptypes.PeriodLock, error)
 
 	SlashTokensFromLockByID(ctx sdk.Context, lockID uint64, coins sdk.Coins) (*lockuptypes.PeriodLock, error)
 
@@ -44,6 +46,11 @@ type GammKeeper interface {
 	GetPoolsAndPoke(ctx sdk.Context) (res []gammtypes.PoolI, err error)
 }
 
+// TwapKeeper defines the expected interface needed for superfluid module.
+type TwapKeeper interface {
+	GetArithmeticTwap(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time, endTime time.Time) (sdk.Dec, error)
+}
+
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
@@ -82,8 +89,11 @@ type IncentivesKeeper interface {
 	AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error
 
 	GetActiveGauges(ctx sdk.Context) []incentivestypes.Gauge
+	GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*incentivestypes.Gauge, error)
 	Distribute(ctx sdk.Context, gauges []incentivestypes.Gauge) (sdk.Coins, error)
 
+	GetLockRewards(ctx sdk.Context, lockID uint64, fromEpoch, toEpoch int64) ([]incentivestypes.LockEpochReward, sdk.DecCoins)
+	GetEpochInfo(ctx sdk.Context) epochstypes.EpochInfo
 	GetParams(ctx sdk.Context) incentivestypes.Params
 }
 
@@ -91,3 +101,11 @@ type EpochKeeper interface {
 	GetEpochInfo(ctx sdk.Context, identifier string) epochstypes.EpochInfo
 	NumBlocksSinceEpochStart(ctx sdk.Context, identifier string) (int64, error)
 }
+
+// GovKeeper expected gov keeper.
+type GovKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (govtypes.Proposal, bool)
+	GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (govtypes.Vote, bool)
+	AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options govtypes.WeightedVoteOptions) error
+	IterateActiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal govtypes.Proposal) (stop bool))
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default capability global index.
@@ -33,5 +34,13 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	for _, vote := range gs.SuperfluidVotes {
+		if _, err := sdk.AccAddressFromBech32(vote.Voter); err != nil {
+			return err
+		}
+		if err := ValidateVoteOptions(vote.Options); err != nil {
+			return err
+		}
+	}
 	return nil
 }
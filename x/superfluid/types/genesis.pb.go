@@ -30,6 +30,7 @@ type GenesisState struct {
 	OsmoEquivalentMultipliers     []OsmoEquivalentMultiplierRecord      `protobuf:"bytes,3,rep,name=osmo_equivalent_multipliers,json=osmoEquivalentMultipliers,proto3" json:"osmo_equivalent_multipliers"`
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	SuperfluidVotes               []SuperfluidVote                      `protobuf:"bytes,6,rep,name=superfluid_votes,json=superfluidVotes,proto3" json:"superfluid_votes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +101,13 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetSuperfluidVotes() []SuperfluidVote {
+	if m != nil {
+		return m.SuperfluidVotes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -107,31 +115,33 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x4f, 0xe2, 0x50,
-	0x10, 0xc7, 0xdb, 0x85, 0xe5, 0x50, 0xf6, 0xb0, 0xdb, 0xb0, 0x49, 0xc5, 0x58, 0x88, 0x5c, 0xb8,
-	0xd8, 0x46, 0x8c, 0xc1, 0x2b, 0x18, 0x63, 0x48, 0x34, 0x18, 0x48, 0x3c, 0x78, 0x69, 0x1e, 0xe5,
-	0x59, 0x5f, 0x6c, 0x3b, 0xb5, 0xf3, 0x4a, 0xe0, 0x03, 0x78, 0xe7, 0x63, 0x71, 0xe4, 0xe8, 0xc9,
-	0x18, 0xf8, 0x22, 0xa6, 0xed, 0xb3, 0xa0, 0x54, 0x6f, 0xd3, 0xce, 0xef, 0x3f, 0xbf, 0x79, 0xc9,
-	0x28, 0x75, 0x40, 0x0f, 0x90, 0xa1, 0x89, 0x51, 0x40, 0xc3, 0x7b, 0x37, 0x62, 0x63, 0xd3, 0xa1,
-	0x3e, 0x45, 0x86, 0x46, 0x10, 0x02, 0x07, 0x55, 0x15, 0x84, 0xb1, 0x21, 0xaa, 0x15, 0x07, 0x1c,
-	0x48, 0xda, 0x66, 0x5c, 0xa5, 0x64, 0xb5, 0x91, 0x33, 0x6b, 0x53, 0x0a, 0xa8, 0x96, 0x03, 0x05,
-	0x24, 0x24, 0x9e, 0xf0, 0x1d, 0xce, 0x8b, 0xca, 0x9f, 0xcb, 0x74, 0x83, 0x21, 0x27, 0x9c, 0xaa,
-	0x67, 0x4a, 0x29, 0x05, 0x34, 0xb9, 0x2e, 0x37, 0xcb, 0xad, 0xaa, 0xb1, 0xbb, 0x91, 0x71, 0x93,
-	0x10, 0xdd, 0xe2, 0xe2, 0xb5, 0x26, 0x0d, 0x04, 0xaf, 0xde, 0x2a, 0xff, 0x36, 0x88, 0x45, 0x10,
-	0x29, 0x47, 0xed, 0x57, 0xbd, 0xd0, 0x2c, 0xb7, 0x1a, 0x79, 0x43, 0x86, 0x59, 0xd9, 0x89, 0x59,
-	0x31, 0xed, 0x2f, 0x7e, 0xfe, 0x8d, 0xea, 0x54, 0xd9, 0x8f, 0xd3, 0x16, 0x7d, 0x8a, 0xd8, 0x84,
-	0xb8, 0xd4, 0xe7, 0x96, 0x17, 0xb9, 0x9c, 0x05, 0x2e, 0xa3, 0x21, 0x6a, 0x85, 0xc4, 0xd0, 0xca,
-	0x33, 0xf4, 0xd1, 0x83, 0x8b, 0x2c, 0x75, 0x9d, 0x85, 0x06, 0xd4, 0x86, 0x70, 0x2c, 0x84, 0x7b,
-	0xf0, 0x0d, 0x85, 0xaa, 0xab, 0xfc, 0x67, 0x3e, 0xa7, 0xa1, 0x47, 0xc7, 0x8c, 0x84, 0x33, 0x8b,
-	0xd8, 0x36, 0x44, 0x3e, 0x47, 0xad, 0x98, 0x38, 0x8f, 0x7f, 0x7e, 0x55, 0x6f, 0x2b, 0xda, 0x49,
-	0x93, 0x42, 0x59, 0x61, 0xbb, 0x2d, 0x54, 0x9f, 0x65, 0xa5, 0x16, 0x37, 0xbe, 0xd8, 0x2c, 0x1b,
-	0x7c, 0x9f, 0xda, 0x9c, 0x81, 0x8f, 0xda, 0xef, 0x44, 0xdc, 0xce, 0x13, 0x5f, 0x81, 0xfd, 0xd8,
-	0xcb, 0x93, 0x9e, 0x67, 0x79, 0xa1, 0x3f, 0xd8, 0xb2, 0xec, 0x30, 0xd8, 0xed, 0x2f, 0x56, 0xba,
-	0xbc, 0x5c, 0xe9, 0xf2, 0xdb, 0x4a, 0x97, 0xe7, 0x6b, 0x5d, 0x5a, 0xae, 0x75, 0xe9, 0x65, 0xad,
-	0x4b, 0x77, 0xa7, 0x0e, 0xe3, 0x0f, 0xd1, 0xc8, 0xb0, 0xc1, 0x33, 0xc5, 0x06, 0x47, 0x2e, 0x19,
-	0xe1, 0xc7, 0x87, 0x39, 0x69, 0x9b, 0xd3, 0xed, 0x53, 0xe3, 0xb3, 0x80, 0xe2, 0xa8, 0x94, 0x9c,
-	0xda, 0xc9, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x30, 0xda, 0x43, 0xfe, 0x02, 0x00, 0x00,
+	// 403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x0e, 0xd2, 0x40,
+	0x10, 0x86, 0x5b, 0x41, 0x0e, 0x8b, 0x89, 0xba, 0xc1, 0xa4, 0x62, 0x2c, 0x04, 0x2e, 0x5c, 0x6c,
+	0x23, 0xc6, 0xe0, 0x15, 0x8c, 0x31, 0x24, 0x1a, 0x0c, 0x24, 0x1c, 0xbc, 0x34, 0x4b, 0x59, 0xeb,
+	0xc6, 0xb6, 0x53, 0x3b, 0x5b, 0x02, 0x0f, 0xe0, 0xdd, 0xc7, 0xe2, 0xc8, 0xd1, 0x93, 0x31, 0x10,
+	0xdf, 0xc3, 0xb4, 0x5d, 0x4b, 0x91, 0xca, 0x6d, 0xba, 0xf3, 0xfd, 0xf3, 0xed, 0xa6, 0x43, 0xba,
+	0x80, 0x01, 0xa0, 0x40, 0x1b, 0x93, 0x88, 0xc7, 0x9f, 0xfc, 0x44, 0xac, 0x6d, 0x8f, 0x87, 0x1c,
+	0x05, 0x5a, 0x51, 0x0c, 0x12, 0x28, 0x55, 0x84, 0x75, 0x26, 0xda, 0x2d, 0x0f, 0x3c, 0xc8, 0xda,
+	0x76, 0x5a, 0xe5, 0x64, 0xbb, 0x5f, 0x31, 0xeb, 0x5c, 0x2a, 0xa8, 0x53, 0x01, 0x45, 0x2c, 0x66,
+	0x81, 0xf2, 0xf5, 0x7e, 0xd7, 0xc9, 0xbd, 0xb7, 0xf9, 0x0d, 0x16, 0x92, 0x49, 0x4e, 0x5f, 0x91,
+	0x46, 0x0e, 0x18, 0x7a, 0x57, 0x1f, 0x34, 0x87, 0x6d, 0xeb, 0xfa, 0x46, 0xd6, 0x87, 0x8c, 0x98,
+	0xd4, 0xf7, 0x3f, 0x3b, 0xda, 0x5c, 0xf1, 0x74, 0x49, 0x1e, 0x9e, 0x11, 0x87, 0x21, 0x72, 0x89,
+	0xc6, 0x9d, 0x6e, 0x6d, 0xd0, 0x1c, 0xf6, 0xab, 0x86, 0x2c, 0x8a, 0x72, 0x9c, 0xb2, 0x6a, 0xda,
+	0x03, 0xbc, 0x3c, 0x46, 0xba, 0x25, 0x4f, 0xd2, 0xb4, 0xc3, 0xbf, 0x26, 0x62, 0xc3, 0x7c, 0x1e,
+	0x4a, 0x27, 0x48, 0x7c, 0x29, 0x22, 0x5f, 0xf0, 0x18, 0x8d, 0x5a, 0x66, 0x18, 0x56, 0x19, 0x66,
+	0x18, 0xc0, 0x9b, 0x22, 0xf5, 0xbe, 0x08, 0xcd, 0xb9, 0x0b, 0xf1, 0x5a, 0x09, 0x1f, 0xc3, 0x7f,
+	0x28, 0xa4, 0x3e, 0x79, 0x24, 0x42, 0xc9, 0xe3, 0x80, 0xaf, 0x05, 0x8b, 0x77, 0x0e, 0x73, 0x5d,
+	0x48, 0x42, 0x89, 0x46, 0x3d, 0x73, 0x3e, 0xbf, 0xfd, 0xaa, 0x69, 0x29, 0x3a, 0xce, 0x93, 0x4a,
+	0xd9, 0x12, 0xd7, 0x2d, 0xa4, 0xdf, 0x74, 0xd2, 0x49, 0x1b, 0xff, 0xd8, 0x1c, 0x17, 0xc2, 0x90,
+	0xbb, 0x52, 0x40, 0x88, 0xc6, 0xdd, 0x4c, 0x3c, 0xaa, 0x12, 0xbf, 0x03, 0xf7, 0xcb, 0xb4, 0x4a,
+	0xfa, 0xba, 0xc8, 0x2b, 0xfd, 0xd3, 0x92, 0xe5, 0x8a, 0x41, 0xba, 0x20, 0xa5, 0x7f, 0xe0, 0x6c,
+	0x40, 0x72, 0x34, 0x1a, 0x99, 0xb7, 0x77, 0xfb, 0xc1, 0x4b, 0x90, 0x5c, 0x29, 0xee, 0xe3, 0xc5,
+	0x29, 0x4e, 0x66, 0xfb, 0xa3, 0xa9, 0x1f, 0x8e, 0xa6, 0xfe, 0xeb, 0x68, 0xea, 0xdf, 0x4f, 0xa6,
+	0x76, 0x38, 0x99, 0xda, 0x8f, 0x93, 0xa9, 0x7d, 0x7c, 0xe9, 0x09, 0xf9, 0x39, 0x59, 0x59, 0x2e,
+	0x04, 0xb6, 0x1a, 0xff, 0xcc, 0x67, 0x2b, 0xfc, 0xfb, 0x61, 0x6f, 0x46, 0xf6, 0xb6, 0xbc, 0xbf,
+	0x72, 0x17, 0x71, 0x5c, 0x35, 0xb2, 0xfd, 0x7d, 0xf1, 0x67, 0x00, 0xd9, 0x99, 0xc7, 0x8b, 0x53,
+	0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -154,6 +164,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SuperfluidVotes) > 0 {
+		for iNdEx := len(m.SuperfluidVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -266,6 +290,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SuperfluidVotes) > 0 {
+		for _, e := range m.SuperfluidVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -473,6 +503,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuperfluidVotes = append(m.SuperfluidVotes, SuperfluidVote{})
+			if err := m.SuperfluidVotes[len(m.SuperfluidVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -56,6 +56,9 @@ func (p *SetSuperfluidAssetsProposal) ValidateBasic() error {
 			if err = gammtypes.ValidatePoolShareDenom(asset.Denom); err != nil {
 				return err
 			}
+			if err = asset.ValidateBasic(); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unsupported superfluid asset type")
 		}
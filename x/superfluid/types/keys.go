@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 var (
 	// ModuleName defines the module name.
 	ModuleName = "superfluid"
@@ -24,4 +29,30 @@ var (
 
 	// KeyPrefixLockIntermediaryAccAddr defines prefix to connect lockId and intermediary account address.
 	KeyPrefixLockIntermediaryAccAddr = []byte{0x05}
+
+	// KeyPrefixTokenMultiplierHistory defines prefix key for the multipliers of past epochs.
+	KeyPrefixTokenMultiplierHistory = []byte{0x06}
+
+	// KeyPrefixSuperfluidVote defines prefix key for the votes of superfluid delegators on proposals.
+	KeyPrefixSuperfluidVote = []byte{0x07}
 )
+
+// GetKeyPrefixTokenMultiplierHistory returns the prefix of the multiplier history of denom.
+func GetKeyPrefixTokenMultiplierHistory(denom string) []byte {
+	return append(append(KeyPrefixTokenMultiplierHistory, []byte(denom)...), '|')
+}
+
+// GetKeyTokenMultiplierHistory returns the key of the multiplier of denom at epoch.
+func GetKeyTokenMultiplierHistory(denom string, epoch int64) []byte {
+	return append(GetKeyPrefixTokenMultiplierHistory(denom), sdk.Uint64ToBigEndian(uint64(epoch))...)
+}
+
+// GetKeyPrefixSuperfluidVotes returns the prefix of the superfluid votes on a proposal.
+func GetKeyPrefixSuperfluidVotes(proposalID uint64) []byte {
+	return append(KeyPrefixSuperfluidVote, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetKeySuperfluidVote returns the key of the superfluid vote of voter on a proposal.
+func GetKeySuperfluidVote(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(GetKeyPrefixSuperfluidVotes(proposalID), address.MustLengthPrefix(voter)...)
+}
@@ -4,15 +4,18 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // constants.
 const (
-	TypeMsgSuperfluidDelegate        = "superfluid_delegate"
-	TypeMsgSuperfluidUndelegate      = "superfluid_undelegate"
-	TypeMsgSuperfluidRedelegate      = "superfluid_redelegate"
-	TypeMsgSuperfluidUnbondLock      = "superfluid_unbond_underlying_lock"
-	TypeMsgLockAndSuperfluidDelegate = "lock_and_superfluid_delegate"
+	TypeMsgSuperfluidDelegate          = "superfluid_delegate"
+	TypeMsgSuperfluidUndelegate        = "superfluid_undelegate"
+	TypeMsgSuperfluidRedelegate        = "superfluid_redelegate"
+	TypeMsgSuperfluidUndelegatePartial = "superfluid_undelegate_partial"
+	TypeMsgSuperfluidUnbondLock        = "superfluid_unbond_underlying_lock"
+	TypeMsgLockAndSuperfluidDelegate   = "lock_and_superfluid_delegate"
+	TypeMsgSuperfluidVote              = "superfluid_vote"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -81,38 +84,75 @@ func (m MsgSuperfluidUndelegate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
-// var _ sdk.Msg = &MsgSuperfluidRedelegate{}
-
-// // NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation
-// func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
-// 	return &MsgSuperfluidRedelegate{
-// 		Sender:     sender.String(),
-// 		LockId:     lockId,
-// 		NewValAddr: newValAddr.String(),
-// 	}
-// }
-
-// func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
-// func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
-// func (m MsgSuperfluidRedelegate) ValidateBasic() error {
-// 	if m.Sender == "" {
-// 		return fmt.Errorf("sender should not be an empty address")
-// 	}
-// 	if m.LockId == 0 {
-// 		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
-// 	}
-// 	if m.NewValAddr == "" {
-// 		return fmt.Errorf("NewValAddr should not be empty")
-// 	}
-// 	return nil
-// }
-// func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
-// 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
-// }
-// func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
-// 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
-// 	return []sdk.AccAddress{sender}
-// }
+var _ sdk.Msg = &MsgSuperfluidRedelegate{}
+
+// NewMsgSuperfluidRedelegate creates a message to do superfluid redelegation.
+func NewMsgSuperfluidRedelegate(sender sdk.AccAddress, lockId uint64, newValAddr sdk.ValAddress) *MsgSuperfluidRedelegate {
+	return &MsgSuperfluidRedelegate{
+		Sender:     sender.String(),
+		LockId:     lockId,
+		NewValAddr: newValAddr.String(),
+	}
+}
+
+func (m MsgSuperfluidRedelegate) Route() string { return RouterKey }
+func (m MsgSuperfluidRedelegate) Type() string  { return TypeMsgSuperfluidRedelegate }
+func (m MsgSuperfluidRedelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if m.NewValAddr == "" {
+		return fmt.Errorf("NewValAddr should not be empty")
+	}
+	return nil
+}
+
+func (m MsgSuperfluidRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSuperfluidUndelegatePartial{}
+
+// NewMsgSuperfluidUndelegatePartial creates a message to do superfluid undelegation of a part of a lock.
+func NewMsgSuperfluidUndelegatePartial(sender sdk.AccAddress, lockId uint64, coin sdk.Coin) *MsgSuperfluidUndelegatePartial {
+	return &MsgSuperfluidUndelegatePartial{
+		Sender: sender.String(),
+		LockId: lockId,
+		Coin:   coin,
+	}
+}
+
+func (m MsgSuperfluidUndelegatePartial) Route() string { return RouterKey }
+func (m MsgSuperfluidUndelegatePartial) Type() string  { return TypeMsgSuperfluidUndelegatePartial }
+func (m MsgSuperfluidUndelegatePartial) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", m.LockId)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return fmt.Errorf("coin should be positive: %s", m.Coin)
+	}
+	return nil
+}
+
+func (m MsgSuperfluidUndelegatePartial) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidUndelegatePartial) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
 
 var _ sdk.Msg = &MsgSuperfluidUnbondLock{}
 
@@ -184,3 +224,58 @@ func (m MsgLockAndSuperfluidDelegate) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSuperfluidVote{}
+
+// NewMsgSuperfluidVote creates a message to vote on a proposal with superfluid delegated locks.
+func NewMsgSuperfluidVote(sender sdk.AccAddress, proposalID uint64, options govtypes.WeightedVoteOptions) *MsgSuperfluidVote {
+	return &MsgSuperfluidVote{
+		Sender:     sender.String(),
+		ProposalId: proposalID,
+		Options:    options,
+	}
+}
+
+func (m MsgSuperfluidVote) Route() string { return RouterKey }
+func (m MsgSuperfluidVote) Type() string  { return TypeMsgSuperfluidVote }
+func (m MsgSuperfluidVote) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	return ValidateVoteOptions(m.Options)
+}
+
+func (m MsgSuperfluidVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSuperfluidVote) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateVoteOptions checks that the options of a vote are valid and distinct, and that their weights sum to 1,
+// as for a weighted vote of the gov module.
+func ValidateVoteOptions(options govtypes.WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return fmt.Errorf("vote options should not be empty")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[govtypes.VoteOption]bool)
+	for _, option := range options {
+		if !govtypes.ValidWeightedVoteOption(option) {
+			return fmt.Errorf("invalid vote option: %s", option)
+		}
+		if usedOptions[option.Option] {
+			return fmt.Errorf("duplicated vote option: %s", option.Option)
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("total weight of vote options should be 1: %s", totalWeight)
+	}
+	return nil
+}
@@ -12,6 +12,9 @@ import (
 var (
 	KeyMinimumRiskFactor     = []byte("MinimumRiskFactor")
 	defaultMinimumRiskFactor = sdk.NewDecWithPrec(5, 1) // 50%
+
+	KeyOsmoMultiplierTwapWindow     = []byte("OsmoMultiplierTwapWindow")
+	defaultOsmoMultiplierTwapWindow = 24 * time.Hour
 )
 
 // ParamTable for minting module.
@@ -19,16 +22,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(minimumRiskFactor sdk.Dec) Params {
+func NewParams(minimumRiskFactor sdk.Dec, osmoMultiplierTwapWindow time.Duration) Params {
 	return Params{
-		MinimumRiskFactor: minimumRiskFactor,
+		MinimumRiskFactor:        minimumRiskFactor,
+		OsmoMultiplierTwapWindow: osmoMultiplierTwapWindow,
 	}
 }
 
 // default minting module parameters.
 func DefaultParams() Params {
 	return Params{
-		MinimumRiskFactor: defaultMinimumRiskFactor, // 5%
+		MinimumRiskFactor:        defaultMinimumRiskFactor, // 5%
+		OsmoMultiplierTwapWindow: defaultOsmoMultiplierTwapWindow,
 	}
 }
 
@@ -41,6 +46,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMinimumRiskFactor, &p.MinimumRiskFactor, ValidateMinimumRiskFactor),
+		paramtypes.NewParamSetPair(KeyOsmoMultiplierTwapWindow, &p.OsmoMultiplierTwapWindow, ValidateOsmoMultiplierTwapWindow),
 	}
 }
 
@@ -69,3 +75,16 @@ func ValidateUnbondingDuration(i interface{}) error {
 
 	return nil
 }
+
+func ValidateOsmoMultiplierTwapWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("osmo multiplier twap window should be positive: %s", v.String())
+	}
+
+	return nil
+}
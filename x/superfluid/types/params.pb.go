@@ -8,16 +8,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +33,10 @@ type Params struct {
 	// the risk_factor is to be cut on OSMO equivalent value of lp tokens for
 	// superfluid staking, default: 5%
 	MinimumRiskFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=minimum_risk_factor,json=minimumRiskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"minimum_risk_factor" yaml:"minimum_risk_factor"`
+	// osmo_multiplier_twap_window is the window of the arithmetic TWAP of pool
+	// prices used to calculate the OSMO equivalent multipliers of LP shares at
+	// each epoch, default: 24h
+	OsmoMultiplierTwapWindow time.Duration `protobuf:"bytes,2,opt,name=osmo_multiplier_twap_window,json=osmoMultiplierTwapWindow,proto3,stdduration" json:"osmo_multiplier_twap_window" yaml:"osmo_multiplier_twap_window"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -65,6 +72,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetOsmoMultiplierTwapWindow() time.Duration {
+	if m != nil {
+		return m.OsmoMultiplierTwapWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.superfluid.Params")
 }
@@ -72,24 +86,28 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/params.proto", fileDescriptor_0985261dfaf2a82e) }
 
 var fileDescriptor_0985261dfaf2a82e = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x2d, 0x48, 0x2d, 0x4a, 0xcb, 0x29, 0xcd, 0x4c, 0xd1, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x2a, 0xd0,
-	0x43, 0x28, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58, 0x10, 0x95, 0x52,
-	0x72, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x4a, 0x69,
-	0x51, 0x62, 0x49, 0x66, 0x7e, 0x1e, 0x44, 0x5e, 0xa9, 0x8d, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xb4,
-	0x50, 0x0d, 0x97, 0x70, 0x6e, 0x66, 0x5e, 0x66, 0x6e, 0x69, 0x6e, 0x7c, 0x51, 0x66, 0x71, 0x76,
-	0x7c, 0x5a, 0x62, 0x72, 0x49, 0x7e, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xcf, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0xab, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
-	0xe7, 0xea, 0x27, 0x83, 0x5d, 0x01, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x4b, 0x2a, 0x0b, 0x52,
-	0x8b, 0xf5, 0x5c, 0x52, 0x93, 0x3f, 0xdd, 0x93, 0x97, 0xaa, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2,
-	0x62, 0xa4, 0x52, 0x90, 0x20, 0x54, 0x34, 0x28, 0xb3, 0x38, 0xdb, 0x0d, 0x2c, 0xe6, 0xe4, 0x7f,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xa6, 0x48, 0x56, 0x42, 0xfd, 0xad,
-	0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x99, 0xeb, 0x57, 0x20, 0x07, 0x15, 0xd8, 0x15,
-	0x49, 0x6c, 0x60, 0x0f, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x13, 0x35, 0x9c, 0x41, 0x4d,
-	0x01, 0x00, 0x00,
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x73, 0x1d, 0x0a, 0xc6, 0xc9, 0xe8, 0x50, 0x2b, 0x24, 0x25, 0x83, 0x74, 0xe9, 0x1d,
+	0x28, 0x22, 0x38, 0x96, 0xe2, 0xa4, 0x28, 0x45, 0x10, 0x5c, 0xc2, 0xe5, 0x4f, 0xe3, 0xd1, 0x5c,
+	0xef, 0xb8, 0x3f, 0xc6, 0x82, 0x1f, 0xc0, 0x51, 0x37, 0x3f, 0x52, 0xc7, 0x8e, 0xe2, 0x10, 0xa5,
+	0xfd, 0x06, 0xfd, 0x04, 0x92, 0x4b, 0xaa, 0x1d, 0xc4, 0xe9, 0xee, 0x7d, 0xdf, 0x1f, 0xcf, 0x3d,
+	0xcf, 0xbd, 0xb6, 0xc7, 0x24, 0x65, 0x92, 0x48, 0x24, 0x35, 0x4f, 0xc4, 0x28, 0xd3, 0x24, 0x46,
+	0x1c, 0x0b, 0x4c, 0x25, 0xe4, 0x82, 0x29, 0xe6, 0x38, 0x35, 0x00, 0x7f, 0x81, 0xf6, 0x5e, 0xca,
+	0x52, 0x66, 0xc6, 0xa8, 0xbc, 0x55, 0x64, 0xdb, 0x4d, 0x19, 0x4b, 0xb3, 0x04, 0x99, 0x2a, 0xd4,
+	0x23, 0x14, 0x6b, 0x81, 0x15, 0x61, 0x93, 0x6a, 0xee, 0xbf, 0x36, 0xec, 0xe6, 0xb5, 0x91, 0x76,
+	0x9e, 0xec, 0x5d, 0x4a, 0x26, 0x84, 0x6a, 0x1a, 0x08, 0x22, 0xc7, 0xc1, 0x08, 0x47, 0x8a, 0x89,
+	0x16, 0xe8, 0x80, 0xee, 0x56, 0xff, 0x62, 0x56, 0x78, 0xd6, 0x47, 0xe1, 0x1d, 0xa6, 0x44, 0xdd,
+	0xeb, 0x10, 0x46, 0x8c, 0xa2, 0xc8, 0xb8, 0xa8, 0x8f, 0x9e, 0x8c, 0xc7, 0x48, 0x4d, 0x79, 0x22,
+	0xe1, 0x20, 0x89, 0x56, 0x85, 0xd7, 0x9e, 0x62, 0x9a, 0x9d, 0xf9, 0x7f, 0x48, 0xfa, 0xc3, 0x9d,
+	0xba, 0x3b, 0x24, 0x72, 0x7c, 0x6e, 0x7a, 0xce, 0x33, 0xb0, 0x0f, 0x4a, 0xa1, 0x80, 0xea, 0x4c,
+	0x11, 0x9e, 0x91, 0x44, 0x04, 0x2a, 0xc7, 0x3c, 0xc8, 0xc9, 0x24, 0x66, 0x79, 0xab, 0xd1, 0x01,
+	0xdd, 0xed, 0xa3, 0x7d, 0x58, 0xe5, 0x81, 0xeb, 0x3c, 0x70, 0x50, 0xe7, 0xe9, 0xc3, 0xd2, 0xe1,
+	0xaa, 0xf0, 0xfc, 0xea, 0xdd, 0x7f, 0xb4, 0xfc, 0xb7, 0x4f, 0x0f, 0x0c, 0x5b, 0x25, 0x71, 0xf9,
+	0x03, 0xdc, 0xe4, 0x98, 0xdf, 0x9a, 0x71, 0xff, 0x6a, 0xb6, 0x70, 0xc1, 0x7c, 0xe1, 0x82, 0xaf,
+	0x85, 0x0b, 0x5e, 0x96, 0xae, 0x35, 0x5f, 0xba, 0xd6, 0xfb, 0xd2, 0xb5, 0xee, 0x4e, 0x36, 0xd2,
+	0xd7, 0x2b, 0xe8, 0x65, 0x38, 0x94, 0xeb, 0x02, 0x3d, 0x9c, 0xa2, 0xc7, 0xcd, 0xad, 0x99, 0x0f,
+	0x09, 0x9b, 0xc6, 0xed, 0xf1, 0xf7, 0x00, 0xbc, 0x55, 0x78, 0x7c, 0xd8, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +130,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.OsmoMultiplierTwapWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.OsmoMultiplierTwapWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.MinimumRiskFactor.Size()
 		i -= size
@@ -144,6 +170,8 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MinimumRiskFactor.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.OsmoMultiplierTwapWindow)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -216,6 +244,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoMultiplierTwapWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.OsmoMultiplierTwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
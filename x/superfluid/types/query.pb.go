@@ -9,9 +9,12 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	github_com_cosmos_cosmos_sdk_x_gov_types "github.com/cosmos/cosmos-sdk/x/gov/types"
+	types3 "github.com/cosmos/cosmos-sdk/x/gov/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types2 "github.com/osmosis-labs/osmosis/v7/x/incentives/types"
 	types1 "github.com/osmosis-labs/osmosis/v7/x/lockup/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -372,6 +375,110 @@ func (m *AssetMultiplierResponse) GetOsmoEquivalentMultiplier() *OsmoEquivalentM
 	return nil
 }
 
+type AssetMultiplierHistoryRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *AssetMultiplierHistoryRequest) Reset()         { *m = AssetMultiplierHistoryRequest{} }
+func (m *AssetMultiplierHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AssetMultiplierHistoryRequest) ProtoMessage()    {}
+func (*AssetMultiplierHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{8}
+}
+func (m *AssetMultiplierHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetMultiplierHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetMultiplierHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetMultiplierHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetMultiplierHistoryRequest.Merge(m, src)
+}
+func (m *AssetMultiplierHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetMultiplierHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetMultiplierHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetMultiplierHistoryRequest proto.InternalMessageInfo
+
+func (m *AssetMultiplierHistoryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AssetMultiplierHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type AssetMultiplierHistoryResponse struct {
+	OsmoEquivalentMultipliers []OsmoEquivalentMultiplierRecord `protobuf:"bytes,1,rep,name=osmo_equivalent_multipliers,json=osmoEquivalentMultipliers,proto3" json:"osmo_equivalent_multipliers"`
+	Pagination                *query.PageResponse              `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *AssetMultiplierHistoryResponse) Reset()         { *m = AssetMultiplierHistoryResponse{} }
+func (m *AssetMultiplierHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*AssetMultiplierHistoryResponse) ProtoMessage()    {}
+func (*AssetMultiplierHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{9}
+}
+func (m *AssetMultiplierHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetMultiplierHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetMultiplierHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetMultiplierHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetMultiplierHistoryResponse.Merge(m, src)
+}
+func (m *AssetMultiplierHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetMultiplierHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetMultiplierHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetMultiplierHistoryResponse proto.InternalMessageInfo
+
+func (m *AssetMultiplierHistoryResponse) GetOsmoEquivalentMultipliers() []OsmoEquivalentMultiplierRecord {
+	if m != nil {
+		return m.OsmoEquivalentMultipliers
+	}
+	return nil
+}
+
+func (m *AssetMultiplierHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type SuperfluidIntermediaryAccountInfo struct {
 	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	ValAddr string `protobuf:"bytes,2,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
@@ -383,7 +490,7 @@ func (m *SuperfluidIntermediaryAccountInfo) Reset()         { *m = SuperfluidInt
 func (m *SuperfluidIntermediaryAccountInfo) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidIntermediaryAccountInfo) ProtoMessage()    {}
 func (*SuperfluidIntermediaryAccountInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{8}
+	return fileDescriptor_e3d9448e4ed3943f, []int{10}
 }
 func (m *SuperfluidIntermediaryAccountInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +555,7 @@ func (m *AllIntermediaryAccountsRequest) Reset()         { *m = AllIntermediaryA
 func (m *AllIntermediaryAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*AllIntermediaryAccountsRequest) ProtoMessage()    {}
 func (*AllIntermediaryAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{9}
+	return fileDescriptor_e3d9448e4ed3943f, []int{11}
 }
 func (m *AllIntermediaryAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,7 +600,7 @@ func (m *AllIntermediaryAccountsResponse) Reset()         { *m = AllIntermediary
 func (m *AllIntermediaryAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*AllIntermediaryAccountsResponse) ProtoMessage()    {}
 func (*AllIntermediaryAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{10}
+	return fileDescriptor_e3d9448e4ed3943f, []int{12}
 }
 func (m *AllIntermediaryAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +651,7 @@ func (m *ConnectedIntermediaryAccountRequest) Reset()         { *m = ConnectedIn
 func (m *ConnectedIntermediaryAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectedIntermediaryAccountRequest) ProtoMessage()    {}
 func (*ConnectedIntermediaryAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{11}
+	return fileDescriptor_e3d9448e4ed3943f, []int{13}
 }
 func (m *ConnectedIntermediaryAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +695,7 @@ func (m *ConnectedIntermediaryAccountResponse) Reset()         { *m = ConnectedI
 func (m *ConnectedIntermediaryAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectedIntermediaryAccountResponse) ProtoMessage()    {}
 func (*ConnectedIntermediaryAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{12}
+	return fileDescriptor_e3d9448e4ed3943f, []int{14}
 }
 func (m *ConnectedIntermediaryAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +738,7 @@ func (m *TotalSuperfluidDelegationsRequest) Reset()         { *m = TotalSuperflu
 func (m *TotalSuperfluidDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*TotalSuperfluidDelegationsRequest) ProtoMessage()    {}
 func (*TotalSuperfluidDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{13}
+	return fileDescriptor_e3d9448e4ed3943f, []int{15}
 }
 func (m *TotalSuperfluidDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -668,7 +775,7 @@ func (m *TotalSuperfluidDelegationsResponse) Reset()         { *m = TotalSuperfl
 func (m *TotalSuperfluidDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*TotalSuperfluidDelegationsResponse) ProtoMessage()    {}
 func (*TotalSuperfluidDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{14}
+	return fileDescriptor_e3d9448e4ed3943f, []int{16}
 }
 func (m *TotalSuperfluidDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +814,7 @@ func (m *SuperfluidDelegationAmountRequest) Reset()         { *m = SuperfluidDel
 func (m *SuperfluidDelegationAmountRequest) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationAmountRequest) ProtoMessage()    {}
 func (*SuperfluidDelegationAmountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{15}
+	return fileDescriptor_e3d9448e4ed3943f, []int{17}
 }
 func (m *SuperfluidDelegationAmountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +872,7 @@ func (m *SuperfluidDelegationAmountResponse) Reset()         { *m = SuperfluidDe
 func (m *SuperfluidDelegationAmountResponse) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationAmountResponse) ProtoMessage()    {}
 func (*SuperfluidDelegationAmountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{16}
+	return fileDescriptor_e3d9448e4ed3943f, []int{18}
 }
 func (m *SuperfluidDelegationAmountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +918,7 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Reset() {
 func (m *SuperfluidDelegationsByDelegatorRequest) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationsByDelegatorRequest) ProtoMessage()    {}
 func (*SuperfluidDelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{17}
+	return fileDescriptor_e3d9448e4ed3943f, []int{19}
 }
 func (m *SuperfluidDelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +965,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Reset() {
 func (m *SuperfluidDelegationsByDelegatorResponse) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationsByDelegatorResponse) ProtoMessage()    {}
 func (*SuperfluidDelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{18}
+	return fileDescriptor_e3d9448e4ed3943f, []int{20}
 }
 func (m *SuperfluidDelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,7 +1021,7 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) String() string {
 }
 func (*SuperfluidUndelegationsByDelegatorRequest) ProtoMessage() {}
 func (*SuperfluidUndelegationsByDelegatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{19}
+	return fileDescriptor_e3d9448e4ed3943f, []int{21}
 }
 func (m *SuperfluidUndelegationsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1078,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) String() string {
 }
 func (*SuperfluidUndelegationsByDelegatorResponse) ProtoMessage() {}
 func (*SuperfluidUndelegationsByDelegatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{20}
+	return fileDescriptor_e3d9448e4ed3943f, []int{22}
 }
 func (m *SuperfluidUndelegationsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1141,7 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomRequest) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{21}
+	return fileDescriptor_e3d9448e4ed3943f, []int{23}
 }
 func (m *SuperfluidDelegationsByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1089,7 +1196,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) String() string {
 }
 func (*SuperfluidDelegationsByValidatorDenomResponse) ProtoMessage() {}
 func (*SuperfluidDelegationsByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{22}
+	return fileDescriptor_e3d9448e4ed3943f, []int{24}
 }
 func (m *SuperfluidDelegationsByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,7 +1245,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) String() stri
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{23}
+	return fileDescriptor_e3d9448e4ed3943f, []int{25}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1193,7 +1300,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) String() str
 }
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) ProtoMessage() {}
 func (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3d9448e4ed3943f, []int{24}
+	return fileDescriptor_e3d9448e4ed3943f, []int{26}
 }
 func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,1135 +1336,1384 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) GetTotalDele
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.superfluid.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.superfluid.QueryParamsResponse")
-	proto.RegisterType((*AssetTypeRequest)(nil), "osmosis.superfluid.AssetTypeRequest")
-	proto.RegisterType((*AssetTypeResponse)(nil), "osmosis.superfluid.AssetTypeResponse")
-	proto.RegisterType((*AllAssetsRequest)(nil), "osmosis.superfluid.AllAssetsRequest")
-	proto.RegisterType((*AllAssetsResponse)(nil), "osmosis.superfluid.AllAssetsResponse")
-	proto.RegisterType((*AssetMultiplierRequest)(nil), "osmosis.superfluid.AssetMultiplierRequest")
-	proto.RegisterType((*AssetMultiplierResponse)(nil), "osmosis.superfluid.AssetMultiplierResponse")
-	proto.RegisterType((*SuperfluidIntermediaryAccountInfo)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccountInfo")
-	proto.RegisterType((*AllIntermediaryAccountsRequest)(nil), "osmosis.superfluid.AllIntermediaryAccountsRequest")
-	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
-	proto.RegisterType((*ConnectedIntermediaryAccountRequest)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountRequest")
-	proto.RegisterType((*ConnectedIntermediaryAccountResponse)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountResponse")
-	proto.RegisterType((*TotalSuperfluidDelegationsRequest)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsRequest")
-	proto.RegisterType((*TotalSuperfluidDelegationsResponse)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsResponse")
-	proto.RegisterType((*SuperfluidDelegationAmountRequest)(nil), "osmosis.superfluid.SuperfluidDelegationAmountRequest")
-	proto.RegisterType((*SuperfluidDelegationAmountResponse)(nil), "osmosis.superfluid.SuperfluidDelegationAmountResponse")
-	proto.RegisterType((*SuperfluidDelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorRequest")
-	proto.RegisterType((*SuperfluidDelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorResponse")
-	proto.RegisterType((*SuperfluidUndelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorRequest")
-	proto.RegisterType((*SuperfluidUndelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorResponse")
-	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomRequest")
-	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomResponse")
-	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomRequest")
-	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse")
+type SuperfluidLockRewardsRequest struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// number of past epochs to return the realized rewards of, up to the
+	// incentives reward history; the whole reward history if zero
+	NumEpochs int64 `protobuf:"varint,2,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
 }
 
-func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
-
-var fileDescriptor_e3d9448e4ed3943f = []byte{
-	// 1516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6f, 0x14, 0x55,
-	0x14, 0xef, 0xdd, 0x42, 0x0b, 0x87, 0x04, 0xca, 0x05, 0x65, 0x19, 0x61, 0x0b, 0x53, 0x68, 0xd7,
-	0x02, 0x33, 0x52, 0x2c, 0x54, 0x14, 0xc2, 0x96, 0x02, 0x36, 0x29, 0x16, 0x17, 0x5a, 0x12, 0x3f,
-	0x32, 0x99, 0xdd, 0xb9, 0x2c, 0x13, 0x66, 0x67, 0xb6, 0x7b, 0x67, 0x2a, 0x1b, 0x42, 0x8c, 0x18,
-	0x13, 0x89, 0x2f, 0x26, 0xfc, 0x03, 0xbe, 0x98, 0xa8, 0x0f, 0xc6, 0x3f, 0xc0, 0x17, 0xe3, 0x0b,
-	0x89, 0x31, 0x21, 0xf1, 0xc5, 0xf8, 0x00, 0x06, 0x7c, 0xf5, 0xc5, 0x47, 0x7d, 0x31, 0x73, 0xef,
-	0x9d, 0x8f, 0xed, 0xce, 0xcc, 0xee, 0x56, 0x84, 0xa7, 0x9d, 0xb9, 0xe7, 0xf3, 0x77, 0xce, 0xb9,
-	0x67, 0xcf, 0x19, 0x28, 0x38, 0xb4, 0xee, 0x50, 0x93, 0xaa, 0xd4, 0x6b, 0x90, 0xe6, 0x35, 0xcb,
-	0x33, 0x0d, 0x75, 0xc5, 0x23, 0xcd, 0x96, 0xd2, 0x68, 0x3a, 0xae, 0x83, 0xb1, 0xa0, 0x2b, 0x11,
-	0x5d, 0xda, 0x59, 0x73, 0x6a, 0x0e, 0x23, 0xab, 0xfe, 0x13, 0xe7, 0x94, 0x0a, 0x55, 0xc6, 0xaa,
-	0x56, 0x74, 0x4a, 0xd4, 0xd5, 0xa3, 0x15, 0xe2, 0xea, 0x47, 0xd5, 0xaa, 0x63, 0xda, 0x82, 0xbe,
-	0xa7, 0xe6, 0x38, 0x35, 0x8b, 0xa8, 0x7a, 0xc3, 0x54, 0x75, 0xdb, 0x76, 0x5c, 0xdd, 0x35, 0x1d,
-	0x9b, 0x0a, 0xea, 0xa8, 0xa0, 0xb2, 0xb7, 0x8a, 0x77, 0x4d, 0x75, 0xcd, 0x3a, 0xa1, 0xae, 0x5e,
-	0x6f, 0x04, 0xea, 0xd7, 0x32, 0x18, 0x5e, 0x93, 0x69, 0x10, 0xf4, 0xb1, 0x04, 0x20, 0xd1, 0x63,
-	0x60, 0x25, 0x81, 0xa9, 0xa1, 0x37, 0xf5, 0x7a, 0xe0, 0xc6, 0xee, 0x80, 0xc1, 0x72, 0xaa, 0x37,
-	0xbc, 0x06, 0xfb, 0x11, 0xa4, 0xc9, 0x38, 0x3e, 0x16, 0xa2, 0x10, 0x65, 0x43, 0xaf, 0x99, 0x76,
-	0xcc, 0x19, 0x79, 0x27, 0xe0, 0xb7, 0x7d, 0x8e, 0x4b, 0x4c, 0x77, 0x99, 0xac, 0x78, 0x84, 0xba,
-	0xf2, 0x22, 0xec, 0x68, 0x3b, 0xa5, 0x0d, 0xc7, 0xa6, 0x04, 0xcf, 0xc0, 0x10, 0xf7, 0x21, 0x8f,
-	0xf6, 0xa1, 0xe2, 0x96, 0x29, 0x49, 0xe9, 0x8c, 0xb9, 0xc2, 0x65, 0x66, 0x37, 0xdc, 0x7f, 0x38,
-	0x3a, 0x50, 0x16, 0xfc, 0x72, 0x11, 0x46, 0x4a, 0x94, 0x12, 0xf7, 0x4a, 0xab, 0x41, 0x84, 0x11,
-	0xbc, 0x13, 0x36, 0x1a, 0xc4, 0x76, 0xea, 0x4c, 0xd9, 0xe6, 0x32, 0x7f, 0x91, 0xdf, 0x85, 0xed,
-	0x31, 0x4e, 0x61, 0xf8, 0x3c, 0x80, 0xee, 0x1f, 0x6a, 0x6e, 0xab, 0x41, 0x18, 0xff, 0xd6, 0xa9,
-	0x89, 0x24, 0xe3, 0x97, 0xc3, 0xc7, 0x48, 0xc9, 0x66, 0x3d, 0x78, 0x94, 0x31, 0x8c, 0x94, 0x2c,
-	0x8b, 0x91, 0x42, 0xac, 0xcb, 0xb0, 0x3d, 0x76, 0x26, 0x0c, 0x96, 0x60, 0x88, 0x49, 0xf9, 0x48,
-	0x07, 0x8b, 0x5b, 0xa6, 0xc6, 0x7a, 0x30, 0x16, 0x40, 0xe6, 0x82, 0xb2, 0x02, 0x2f, 0xb2, 0xe3,
-	0x8b, 0x9e, 0xe5, 0x9a, 0x0d, 0xcb, 0x24, 0xcd, 0x6c, 0xe0, 0x9f, 0x21, 0xd8, 0xd5, 0x21, 0x20,
-	0xdc, 0x69, 0x80, 0xe4, 0xdb, 0xd7, 0xc8, 0x8a, 0x67, 0xae, 0xea, 0x16, 0xb1, 0x5d, 0xad, 0x1e,
-	0x72, 0x89, 0x64, 0x4c, 0x25, 0xb9, 0xb8, 0x48, 0xeb, 0xce, 0xb9, 0x50, 0x28, 0xae, 0xb9, 0xea,
-	0x34, 0x8d, 0x72, 0xde, 0x49, 0xa1, 0xcb, 0x77, 0x11, 0xec, 0x8f, 0xf0, 0xcd, 0xdb, 0x2e, 0x69,
-	0xd6, 0x89, 0x61, 0xea, 0xcd, 0x56, 0xa9, 0x5a, 0x75, 0x3c, 0xdb, 0x9d, 0xb7, 0xaf, 0x39, 0xc9,
-	0x48, 0xf0, 0x6e, 0xd8, 0xb4, 0xaa, 0x5b, 0x9a, 0x6e, 0x18, 0xcd, 0x7c, 0x8e, 0x11, 0x86, 0x57,
-	0x75, 0xab, 0x64, 0x18, 0x4d, 0x9f, 0x54, 0xd3, 0xbd, 0x1a, 0xd1, 0x4c, 0x23, 0x3f, 0xb8, 0x0f,
-	0x15, 0x37, 0x94, 0x87, 0xd9, 0xfb, 0xbc, 0x81, 0xf3, 0x30, 0xec, 0x4b, 0x10, 0x4a, 0xf3, 0x1b,
-	0xb8, 0x90, 0x78, 0x95, 0xaf, 0x43, 0xa1, 0x64, 0x59, 0x09, 0x3e, 0x04, 0x39, 0xf4, 0xeb, 0x23,
-	0xaa, 0x6c, 0x11, 0x8f, 0x71, 0x85, 0x5f, 0x03, 0xc5, 0xbf, 0x06, 0x0a, 0xef, 0x14, 0xe2, 0x1a,
-	0x28, 0x97, 0xf4, 0x5a, 0x50, 0x86, 0xe5, 0x98, 0xa4, 0xfc, 0x23, 0x82, 0xd1, 0x54, 0x53, 0x22,
-	0x17, 0x57, 0x61, 0x93, 0x2e, 0xce, 0x44, 0x71, 0x4c, 0x67, 0x17, 0x47, 0x4a, 0xf0, 0x44, 0xb9,
-	0x84, 0xca, 0xf0, 0x85, 0x36, 0x10, 0x39, 0x06, 0x62, 0xa2, 0x2b, 0x08, 0xee, 0x55, 0x1b, 0x8a,
-	0xd3, 0x30, 0x76, 0xd6, 0xb1, 0x6d, 0x52, 0x75, 0x49, 0x92, 0xf1, 0x20, 0x68, 0xbb, 0x60, 0xd8,
-	0x6f, 0x1a, 0x7e, 0x2a, 0x10, 0x4b, 0xc5, 0x90, 0xff, 0x3a, 0x6f, 0xc8, 0x1f, 0xc0, 0x81, 0x6c,
-	0x79, 0x11, 0x89, 0x45, 0x18, 0x16, 0xce, 0x8b, 0x90, 0xaf, 0x2f, 0x10, 0xe5, 0x40, 0x8b, 0x3c,
-	0x06, 0xfb, 0xaf, 0x38, 0xae, 0x6e, 0x45, 0x22, 0x73, 0xc4, 0x22, 0x35, 0xde, 0x7e, 0x83, 0xfb,
-	0xfa, 0x15, 0x02, 0x39, 0x8b, 0x4b, 0x38, 0xf7, 0x11, 0x82, 0x11, 0xd7, 0x67, 0x8b, 0x11, 0x79,
-	0x99, 0xce, 0x2e, 0xf9, 0x81, 0xff, 0xed, 0xe1, 0xe8, 0x78, 0xcd, 0x74, 0xaf, 0x7b, 0x15, 0xa5,
-	0xea, 0xd4, 0x55, 0xd1, 0x32, 0xf9, 0xcf, 0x11, 0x6a, 0xdc, 0x50, 0xfd, 0x56, 0x43, 0x95, 0x79,
-	0xdb, 0xfd, 0xeb, 0xe1, 0xe8, 0x58, 0x4b, 0xaf, 0x5b, 0x27, 0x65, 0xa6, 0x4f, 0x8b, 0xb0, 0x69,
-	0x46, 0xa4, 0x5b, 0x2e, 0x77, 0x98, 0x93, 0xef, 0xb5, 0x5d, 0xa2, 0x88, 0x52, 0xaa, 0xc7, 0xf3,
-	0x70, 0x08, 0xb6, 0x0b, 0x3d, 0x4e, 0x53, 0x0b, 0xae, 0x00, 0xbf, 0x50, 0x23, 0x21, 0xa1, 0xc4,
-	0xcf, 0x7d, 0xe6, 0x55, 0xdd, 0x32, 0x8d, 0x36, 0x66, 0x7e, 0xc9, 0x46, 0x42, 0x42, 0xc0, 0x1c,
-	0x5e, 0xcf, 0xc1, 0x78, 0xa3, 0xb9, 0x8b, 0x40, 0xce, 0xf2, 0x4a, 0x04, 0xb0, 0x0a, 0x43, 0x7a,
-	0x5d, 0x24, 0xd7, 0xaf, 0xf2, 0xdd, 0x6d, 0xa5, 0x18, 0x14, 0xe1, 0x59, 0xc7, 0xb4, 0x67, 0x5f,
-	0xf1, 0x03, 0xfa, 0xcd, 0xa3, 0xd1, 0x62, 0x0f, 0x01, 0xf5, 0x05, 0x68, 0x59, 0xa8, 0x96, 0x97,
-	0x61, 0x22, 0x31, 0x8d, 0xb3, 0xad, 0xb9, 0x00, 0xf9, 0x7a, 0xc2, 0x24, 0x7f, 0x99, 0x83, 0x62,
-	0x77, 0xc5, 0x02, 0xe9, 0x4d, 0xd8, 0x9b, 0x98, 0x53, 0xad, 0xc9, 0xba, 0x64, 0x70, 0xcd, 0x95,
-	0xec, 0xea, 0x8e, 0x8c, 0xf0, 0xe6, 0x2a, 0xee, 0xf7, 0x4b, 0x34, 0x95, 0x83, 0xe2, 0x0f, 0xe1,
-	0x05, 0x5e, 0x53, 0xc2, 0x28, 0x31, 0x34, 0x7f, 0x0e, 0xf1, 0x33, 0xfa, 0xd4, 0x43, 0xbe, 0x23,
-	0x5e, 0x9e, 0xc4, 0x60, 0x87, 0xb2, 0x0d, 0x2f, 0x47, 0x08, 0x96, 0x6c, 0xe3, 0xa9, 0x65, 0x20,
-	0xaa, 0xbd, 0x5c, 0xbc, 0xf6, 0xfe, 0xce, 0xc1, 0x64, 0x2f, 0x06, 0x9f, 0x7b, 0x66, 0x3e, 0x46,
-	0xb0, 0x8b, 0xa7, 0xc6, 0xb3, 0x9f, 0x41, 0x72, 0x78, 0x19, 0x2c, 0x45, 0xa6, 0xd8, 0x31, 0x5e,
-	0x80, 0x6d, 0xb4, 0x65, 0xbb, 0xd7, 0x89, 0x6b, 0x56, 0x35, 0xbf, 0x3b, 0xd3, 0xfc, 0x20, 0x33,
-	0xbe, 0x37, 0x44, 0xcc, 0xc7, 0x3f, 0xe5, 0x72, 0xc0, 0xb6, 0xe0, 0x54, 0x6f, 0x08, 0x80, 0x5b,
-	0x69, 0xfc, 0x90, 0xca, 0x2b, 0x70, 0x38, 0xe5, 0x4e, 0x2c, 0x07, 0x9d, 0x63, 0xce, 0xcf, 0x52,
-	0x2c, 0xdf, 0x9d, 0xbd, 0x06, 0x75, 0xeb, 0x35, 0x6d, 0xf9, 0xfe, 0x1a, 0xc1, 0x91, 0x1e, 0x6d,
-	0x3e, 0xef, 0x94, 0xcb, 0xb7, 0x61, 0xe6, 0x1c, 0x75, 0xcd, 0xba, 0xee, 0x92, 0x0e, 0x45, 0xc4,
-	0xe0, 0xdd, 0xf1, 0x7f, 0x0c, 0xd5, 0xf7, 0x08, 0x5e, 0x5b, 0x87, 0x7d, 0x11, 0xb6, 0xd4, 0x4e,
-	0x82, 0x9e, 0x4d, 0x27, 0x99, 0xfa, 0x6e, 0x07, 0x6c, 0x64, 0x3b, 0x03, 0xfe, 0x04, 0xc1, 0x10,
-	0x5f, 0x02, 0xf0, 0x78, 0x52, 0x96, 0x3a, 0xf7, 0x0d, 0x69, 0xa2, 0x2b, 0x1f, 0x87, 0x29, 0x4f,
-	0xde, 0xf9, 0xe5, 0x8f, 0x7b, 0xb9, 0x03, 0x58, 0x56, 0x13, 0xf6, 0xa3, 0x68, 0xc9, 0x61, 0xc6,
-	0x3f, 0x45, 0xb0, 0x39, 0xdc, 0x02, 0xf0, 0x81, 0x24, 0x13, 0x6b, 0x77, 0x12, 0xe9, 0x60, 0x17,
-	0x2e, 0xe1, 0x86, 0xc2, 0xdc, 0x28, 0xe2, 0xf1, 0x2c, 0x37, 0xa2, 0x8d, 0x85, 0xbb, 0x12, 0x2c,
-	0x19, 0x29, 0xae, 0xac, 0xd9, 0x4b, 0x52, 0x5c, 0x59, 0xbb, 0xa9, 0xf4, 0xe8, 0x8a, 0x65, 0x69,
-	0x7c, 0x2d, 0xc1, 0x5f, 0x20, 0xd8, 0xb6, 0x66, 0xcd, 0xc0, 0x93, 0xa9, 0xa8, 0x3b, 0x96, 0x17,
-	0xe9, 0x50, 0x4f, 0xbc, 0xc2, 0xb9, 0x57, 0x99, 0x73, 0x0a, 0x3e, 0xdc, 0x3d, 0x4e, 0xd1, 0x3e,
-	0x83, 0x7f, 0xf0, 0x37, 0xa1, 0xe4, 0x29, 0x1c, 0x4f, 0xa5, 0x44, 0x25, 0x63, 0x3b, 0x90, 0x8e,
-	0xf5, 0x25, 0x23, 0x5c, 0x3f, 0xc5, 0x5c, 0x3f, 0x81, 0xa7, 0xbb, 0xc5, 0xd5, 0x8c, 0x69, 0xd1,
-	0xc2, 0x61, 0xfe, 0x11, 0x82, 0x3d, 0x59, 0x43, 0x34, 0x3e, 0x91, 0xe4, 0x54, 0x0f, 0x63, 0xbb,
-	0x34, 0xd3, 0xbf, 0xa0, 0x80, 0xb4, 0xc0, 0x20, 0x9d, 0xc7, 0x73, 0x59, 0x90, 0xaa, 0x81, 0xa6,
-	0x44, 0x60, 0xea, 0x2d, 0xb1, 0x32, 0xdc, 0xc6, 0x3f, 0x21, 0x90, 0xd2, 0xe7, 0x70, 0x9c, 0xb8,
-	0x0b, 0x74, 0x9d, 0xee, 0xa5, 0xe3, 0xfd, 0x8a, 0x09, 0x6c, 0xa7, 0x19, 0xb6, 0x19, 0x7c, 0xbc,
-	0x5b, 0xba, 0x92, 0xa7, 0x77, 0xfc, 0x33, 0x02, 0x29, 0x7d, 0x28, 0xc6, 0xd3, 0xbd, 0xfe, 0xdd,
-	0xb4, 0x8d, 0xf6, 0xc9, 0x68, 0xba, 0xcf, 0xde, 0xf2, 0x19, 0x86, 0xe6, 0x24, 0x9e, 0xc9, 0x42,
-	0x93, 0xfc, 0x37, 0xc9, 0x07, 0x6b, 0xfc, 0x27, 0x82, 0x7d, 0xdd, 0x06, 0x60, 0xfc, 0x7a, 0xaf,
-	0xee, 0x25, 0x4c, 0x83, 0xd2, 0x1b, 0xeb, 0x13, 0x16, 0x08, 0xdf, 0x62, 0x08, 0xdf, 0xc4, 0xe7,
-	0xfb, 0x46, 0x48, 0xd5, 0x5b, 0x1d, 0x53, 0xe8, 0x6d, 0x7c, 0x27, 0x17, 0x5f, 0x6a, 0xd2, 0x06,
-	0x4b, 0x7c, 0x2a, 0xdb, 0xe9, 0x2e, 0x13, 0xb0, 0x74, 0x7a, 0xbd, 0xe2, 0x02, 0xf5, 0xfb, 0x0c,
-	0xf5, 0x55, 0xbc, 0xd4, 0x23, 0x6a, 0x2f, 0xae, 0x50, 0xab, 0xb4, 0xb4, 0x10, 0x79, 0x62, 0x10,
-	0xfe, 0x41, 0x70, 0xb0, 0xa7, 0x69, 0x0b, 0x9f, 0xe9, 0x23, 0x79, 0x89, 0x13, 0x8f, 0x54, 0xfa,
-	0x0f, 0x1a, 0x44, 0x34, 0x2e, 0xb2, 0x68, 0x5c, 0xc0, 0xe7, 0xfa, 0xaf, 0x01, 0x3f, 0x16, 0xd1,
-	0xc0, 0xc5, 0x3f, 0x3b, 0x7d, 0x9b, 0x83, 0xa3, 0x7d, 0x0f, 0x50, 0x78, 0x21, 0x09, 0xc7, 0x7a,
-	0xe7, 0x40, 0xe9, 0xe2, 0x53, 0xd2, 0x26, 0x22, 0xf4, 0x1e, 0x8b, 0xd0, 0x32, 0xbe, 0x92, 0x15,
-	0x21, 0x22, 0xd4, 0x6b, 0x59, 0x0d, 0x21, 0x21, 0x60, 0xb3, 0x8b, 0xf7, 0x1f, 0x17, 0xd0, 0x83,
-	0xc7, 0x05, 0xf4, 0xfb, 0xe3, 0x02, 0xfa, 0xfc, 0x49, 0x61, 0xe0, 0xc1, 0x93, 0xc2, 0xc0, 0xaf,
-	0x4f, 0x0a, 0x03, 0xef, 0x4c, 0xc7, 0x66, 0x41, 0x61, 0xf9, 0x88, 0xa5, 0x57, 0x68, 0xe8, 0xc6,
-	0xea, 0x09, 0xf5, 0x66, 0xdc, 0x17, 0x36, 0x1e, 0x56, 0x86, 0xd8, 0x37, 0xe5, 0x63, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0xc7, 0xdc, 0x64, 0xab, 0x17, 0x00, 0x00,
+func (m *SuperfluidLockRewardsRequest) Reset()         { *m = SuperfluidLockRewardsRequest{} }
+func (m *SuperfluidLockRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewardsRequest) ProtoMessage()    {}
+func (*SuperfluidLockRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{27}
+}
+func (m *SuperfluidLockRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidLockRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidLockRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewardsRequest.Merge(m, src)
+}
+func (m *SuperfluidLockRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidLockRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewardsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_SuperfluidLockRewardsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Returns superfluid asset type
-	AssetType(ctx context.Context, in *AssetTypeRequest, opts ...grpc.CallOption) (*AssetTypeResponse, error)
-	// Returns all superfluid asset types
-	AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error)
-	// Returns superfluid asset Multiplier
-	AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error)
-	// Returns all superfluid intermediary account
-	AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error)
-	// Returns intermediary account connected to a superfluid staked lock by id
-	ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error)
-	// Returns the total amount of osmo superfluidly staked
-	// response denominated in uosmo
-	TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error)
-	// Returns the coins superfluid delegated for a delegator, validator, denom
-	// triplet
-	SuperfluidDelegationAmount(ctx context.Context, in *SuperfluidDelegationAmountRequest, opts ...grpc.CallOption) (*SuperfluidDelegationAmountResponse, error)
-	// Returns all the superfluid poistions for a specific delegator
-	SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error)
-	SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error)
-	// Returns all the superfluid positions of a specific denom delegated to one
-	// validator
-	SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error)
-	// Returns the amount of a specific denom delegated to a specific validator
-	// This is labeled an estimate, because the way it calculates the amount can
-	// lead rounding errors from the true delegated amount
-	EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
+func (m *SuperfluidLockRewardsRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *SuperfluidLockRewardsRequest) GetNumEpochs() int64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type SuperfluidLockRewards struct {
+	LockId              uint64                            `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	IntermediaryAccount SuperfluidIntermediaryAccountInfo `protobuf:"bytes,2,opt,name=intermediary_account,json=intermediaryAccount,proto3" json:"intermediary_account"`
+	// risk adjusted osmo equivalent amount delegated on behalf of the lock
+	DelegationAmount types.Coin `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount"`
+	// staking rewards of the intermediary account that the lock will receive at
+	// the next epoch, if its share of the superfluid gauge doesn't change
+	PendingRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=pending_rewards,json=pendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"pending_rewards"`
+	// rewards the lock received from the superfluid gauge in each past epoch
+	RealizedRewards      []types2.LockEpochReward                    `protobuf:"bytes,5,rep,name=realized_rewards,json=realizedRewards,proto3" json:"realized_rewards"`
+	TotalRealizedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=total_realized_rewards,json=totalRealizedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total_realized_rewards"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SuperfluidLockRewards) Reset()         { *m = SuperfluidLockRewards{} }
+func (m *SuperfluidLockRewards) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewards) ProtoMessage()    {}
+func (*SuperfluidLockRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{28}
+}
+func (m *SuperfluidLockRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidLockRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SuperfluidLockRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewards.Merge(m, src)
+}
+func (m *SuperfluidLockRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidLockRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewards.DiscardUnknown(m)
 }
 
-func (c *queryClient) AssetType(ctx context.Context, in *AssetTypeRequest, opts ...grpc.CallOption) (*AssetTypeResponse, error) {
-	out := new(AssetTypeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetType", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SuperfluidLockRewards proto.InternalMessageInfo
+
+func (m *SuperfluidLockRewards) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error) {
-	out := new(AllAssetsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllAssets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SuperfluidLockRewards) GetIntermediaryAccount() SuperfluidIntermediaryAccountInfo {
+	if m != nil {
+		return m.IntermediaryAccount
 	}
-	return out, nil
+	return SuperfluidIntermediaryAccountInfo{}
 }
 
-func (c *queryClient) AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error) {
-	out := new(AssetMultiplierResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetMultiplier", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SuperfluidLockRewards) GetDelegationAmount() types.Coin {
+	if m != nil {
+		return m.DelegationAmount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error) {
-	out := new(AllIntermediaryAccountsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllIntermediaryAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SuperfluidLockRewards) GetPendingRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.PendingRewards
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error) {
-	out := new(ConnectedIntermediaryAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/ConnectedIntermediaryAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SuperfluidLockRewards) GetRealizedRewards() []types2.LockEpochReward {
+	if m != nil {
+		return m.RealizedRewards
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error) {
-	out := new(TotalSuperfluidDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalSuperfluidDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SuperfluidLockRewards) GetTotalRealizedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.TotalRealizedRewards
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SuperfluidDelegationAmount(ctx context.Context, in *SuperfluidDelegationAmountRequest, opts ...grpc.CallOption) (*SuperfluidDelegationAmountResponse, error) {
-	out := new(SuperfluidDelegationAmountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SuperfluidLockRewardsResponse struct {
+	LockRewards SuperfluidLockRewards `protobuf:"bytes,1,opt,name=lock_rewards,json=lockRewards,proto3" json:"lock_rewards"`
 }
 
-func (c *queryClient) SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error) {
-	out := new(SuperfluidDelegationsByDelegatorResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByDelegator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SuperfluidLockRewardsResponse) Reset()         { *m = SuperfluidLockRewardsResponse{} }
+func (m *SuperfluidLockRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewardsResponse) ProtoMessage()    {}
+func (*SuperfluidLockRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{29}
+}
+func (m *SuperfluidLockRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidLockRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SuperfluidLockRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewardsResponse.Merge(m, src)
+}
+func (m *SuperfluidLockRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidLockRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewardsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error) {
-	out := new(SuperfluidUndelegationsByDelegatorResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidUndelegationsByDelegator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SuperfluidLockRewardsResponse proto.InternalMessageInfo
+
+func (m *SuperfluidLockRewardsResponse) GetLockRewards() SuperfluidLockRewards {
+	if m != nil {
+		return m.LockRewards
 	}
-	return out, nil
+	return SuperfluidLockRewards{}
 }
 
-func (c *queryClient) SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
-	out := new(SuperfluidDelegationsByValidatorDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+type SuperfluidLockRewardsByDelegatorRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	NumEpochs        int64  `protobuf:"varint,2,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+}
+
+func (m *SuperfluidLockRewardsByDelegatorRequest) Reset() {
+	*m = SuperfluidLockRewardsByDelegatorRequest{}
+}
+func (m *SuperfluidLockRewardsByDelegatorRequest) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewardsByDelegatorRequest) ProtoMessage()    {}
+func (*SuperfluidLockRewardsByDelegatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{30}
+}
+func (m *SuperfluidLockRewardsByDelegatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidLockRewardsByDelegatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewardsByDelegatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SuperfluidLockRewardsByDelegatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewardsByDelegatorRequest.Merge(m, src)
+}
+func (m *SuperfluidLockRewardsByDelegatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidLockRewardsByDelegatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewardsByDelegatorRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
-	out := new(EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SuperfluidLockRewardsByDelegatorRequest proto.InternalMessageInfo
+
+func (m *SuperfluidLockRewardsByDelegatorRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Returns superfluid asset type
-	AssetType(context.Context, *AssetTypeRequest) (*AssetTypeResponse, error)
-	// Returns all superfluid asset types
-	AllAssets(context.Context, *AllAssetsRequest) (*AllAssetsResponse, error)
-	// Returns superfluid asset Multiplier
-	AssetMultiplier(context.Context, *AssetMultiplierRequest) (*AssetMultiplierResponse, error)
-	// Returns all superfluid intermediary account
-	AllIntermediaryAccounts(context.Context, *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error)
-	// Returns intermediary account connected to a superfluid staked lock by id
-	ConnectedIntermediaryAccount(context.Context, *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error)
-	// Returns the total amount of osmo superfluidly staked
-	// response denominated in uosmo
-	TotalSuperfluidDelegations(context.Context, *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error)
-	// Returns the coins superfluid delegated for a delegator, validator, denom
-	// triplet
-	SuperfluidDelegationAmount(context.Context, *SuperfluidDelegationAmountRequest) (*SuperfluidDelegationAmountResponse, error)
-	// Returns all the superfluid poistions for a specific delegator
-	SuperfluidDelegationsByDelegator(context.Context, *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error)
-	SuperfluidUndelegationsByDelegator(context.Context, *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error)
-	// Returns all the superfluid positions of a specific denom delegated to one
-	// validator
-	SuperfluidDelegationsByValidatorDenom(context.Context, *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error)
-	// Returns the amount of a specific denom delegated to a specific validator
-	// This is labeled an estimate, because the way it calculates the amount can
-	// lead rounding errors from the true delegated amount
-	EstimateSuperfluidDelegatedAmountByValidatorDenom(context.Context, *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
+func (m *SuperfluidLockRewardsByDelegatorRequest) GetNumEpochs() int64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type SuperfluidLockRewardsByDelegatorResponse struct {
+	LockRewards []SuperfluidLockRewards `protobuf:"bytes,1,rep,name=lock_rewards,json=lockRewards,proto3" json:"lock_rewards"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *SuperfluidLockRewardsByDelegatorResponse) Reset() {
+	*m = SuperfluidLockRewardsByDelegatorResponse{}
 }
-func (*UnimplementedQueryServer) AssetType(ctx context.Context, req *AssetTypeRequest) (*AssetTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetType not implemented")
+func (m *SuperfluidLockRewardsByDelegatorResponse) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidLockRewardsByDelegatorResponse) ProtoMessage()    {}
+func (*SuperfluidLockRewardsByDelegatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{31}
 }
-func (*UnimplementedQueryServer) AllAssets(ctx context.Context, req *AllAssetsRequest) (*AllAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllAssets not implemented")
+func (m *SuperfluidLockRewardsByDelegatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AssetMultiplier(ctx context.Context, req *AssetMultiplierRequest) (*AssetMultiplierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetMultiplier not implemented")
+func (m *SuperfluidLockRewardsByDelegatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidLockRewardsByDelegatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllIntermediaryAccounts(ctx context.Context, req *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllIntermediaryAccounts not implemented")
+func (m *SuperfluidLockRewardsByDelegatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidLockRewardsByDelegatorResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ConnectedIntermediaryAccount(ctx context.Context, req *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectedIntermediaryAccount not implemented")
+func (m *SuperfluidLockRewardsByDelegatorResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalSuperfluidDelegations(ctx context.Context, req *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalSuperfluidDelegations not implemented")
+func (m *SuperfluidLockRewardsByDelegatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidLockRewardsByDelegatorResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SuperfluidDelegationAmount(ctx context.Context, req *SuperfluidDelegationAmountRequest) (*SuperfluidDelegationAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationAmount not implemented")
+
+var xxx_messageInfo_SuperfluidLockRewardsByDelegatorResponse proto.InternalMessageInfo
+
+func (m *SuperfluidLockRewardsByDelegatorResponse) GetLockRewards() []SuperfluidLockRewards {
+	if m != nil {
+		return m.LockRewards
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) SuperfluidDelegationsByDelegator(ctx context.Context, req *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByDelegator not implemented")
+
+type SuperfluidVoteBreakdownRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
-func (*UnimplementedQueryServer) SuperfluidUndelegationsByDelegator(ctx context.Context, req *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegationsByDelegator not implemented")
+
+func (m *SuperfluidVoteBreakdownRequest) Reset()         { *m = SuperfluidVoteBreakdownRequest{} }
+func (m *SuperfluidVoteBreakdownRequest) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidVoteBreakdownRequest) ProtoMessage()    {}
+func (*SuperfluidVoteBreakdownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{32}
 }
-func (*UnimplementedQueryServer) SuperfluidDelegationsByValidatorDenom(ctx context.Context, req *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByValidatorDenom not implemented")
+func (m *SuperfluidVoteBreakdownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, req *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSuperfluidDelegatedAmountByValidatorDenom not implemented")
+func (m *SuperfluidVoteBreakdownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidVoteBreakdownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *SuperfluidVoteBreakdownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidVoteBreakdownRequest.Merge(m, src)
+}
+func (m *SuperfluidVoteBreakdownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidVoteBreakdownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidVoteBreakdownRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+var xxx_messageInfo_SuperfluidVoteBreakdownRequest proto.InternalMessageInfo
+
+func (m *SuperfluidVoteBreakdownRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/Params",
+	return 0
+}
+
+// SuperfluidVoteOverride is the part of the delegation of an intermediary
+// account that a superfluid delegator votes with instead of the validator.
+type SuperfluidVoteOverride struct {
+	Voter  string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	LockId uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// risk adjusted osmo equivalent of the lock
+	Amount  github_com_cosmos_cosmos_sdk_types.Int                       `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Options github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/gov/types.WeightedVoteOptions" json:"options"`
+}
+
+func (m *SuperfluidVoteOverride) Reset()         { *m = SuperfluidVoteOverride{} }
+func (m *SuperfluidVoteOverride) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidVoteOverride) ProtoMessage()    {}
+func (*SuperfluidVoteOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{33}
+}
+func (m *SuperfluidVoteOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidVoteOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidVoteOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *SuperfluidVoteOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidVoteOverride.Merge(m, src)
+}
+func (m *SuperfluidVoteOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidVoteOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidVoteOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidVoteOverride proto.InternalMessageInfo
+
+func (m *SuperfluidVoteOverride) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AssetType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssetTypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *SuperfluidVoteOverride) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetType(ctx, in)
+	return 0
+}
+
+func (m *SuperfluidVoteOverride) GetOptions() github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions {
+	if m != nil {
+		return m.Options
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/AssetType",
+	return nil
+}
+
+type IntermediaryAccountVoteBreakdown struct {
+	IntermediaryAccount SuperfluidIntermediaryAccountInfo `protobuf:"bytes,1,opt,name=intermediary_account,json=intermediaryAccount,proto3" json:"intermediary_account"`
+	// osmo delegated by the intermediary account to its validator
+	DelegationAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=delegation_amount,json=delegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_amount"`
+	// vote of the validator, empty if it didn't vote
+	ValidatorVote github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions `protobuf:"bytes,3,rep,name=validator_vote,json=validatorVote,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/gov/types.WeightedVoteOptions" json:"validator_vote"`
+	// part of the delegation that isn't overridden and votes as the validator
+	InheritedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=inherited_amount,json=inheritedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inherited_amount"`
+	Overrides       []SuperfluidVoteOverride               `protobuf:"bytes,5,rep,name=overrides,proto3" json:"overrides"`
+	// vote cast before the proposal is tallied, empty if no superfluid
+	// delegator overrides the validator
+	Vote github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions `protobuf:"bytes,6,rep,name=vote,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/gov/types.WeightedVoteOptions" json:"vote"`
+	// account casting the vote: the intermediary account if the validator voted,
+	// and otherwise the vote account of the intermediary account, which the
+	// overridden amount is moved to while the proposal is tallied
+	Voter string `protobuf:"bytes,7,opt,name=voter,proto3" json:"voter,omitempty"`
+	// osmo delegated by the voter when the proposal is tallied
+	VotingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=voting_amount,json=votingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_amount"`
+}
+
+func (m *IntermediaryAccountVoteBreakdown) Reset()         { *m = IntermediaryAccountVoteBreakdown{} }
+func (m *IntermediaryAccountVoteBreakdown) String() string { return proto.CompactTextString(m) }
+func (*IntermediaryAccountVoteBreakdown) ProtoMessage()    {}
+func (*IntermediaryAccountVoteBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{34}
+}
+func (m *IntermediaryAccountVoteBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntermediaryAccountVoteBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntermediaryAccountVoteBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetType(ctx, req.(*AssetTypeRequest))
+}
+func (m *IntermediaryAccountVoteBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntermediaryAccountVoteBreakdown.Merge(m, src)
+}
+func (m *IntermediaryAccountVoteBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntermediaryAccountVoteBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntermediaryAccountVoteBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntermediaryAccountVoteBreakdown proto.InternalMessageInfo
+
+func (m *IntermediaryAccountVoteBreakdown) GetIntermediaryAccount() SuperfluidIntermediaryAccountInfo {
+	if m != nil {
+		return m.IntermediaryAccount
 	}
-	return interceptor(ctx, in, info, handler)
+	return SuperfluidIntermediaryAccountInfo{}
 }
 
-func _Query_AllAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllAssetsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *IntermediaryAccountVoteBreakdown) GetValidatorVote() github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions {
+	if m != nil {
+		return m.ValidatorVote
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllAssets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/AllAssets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllAssets(ctx, req.(*AllAssetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AssetMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssetMultiplierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetMultiplier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/AssetMultiplier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetMultiplier(ctx, req.(*AssetMultiplierRequest))
+func (m *IntermediaryAccountVoteBreakdown) GetOverrides() []SuperfluidVoteOverride {
+	if m != nil {
+		return m.Overrides
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AllIntermediaryAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllIntermediaryAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *IntermediaryAccountVoteBreakdown) GetVote() github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions {
+	if m != nil {
+		return m.Vote
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllIntermediaryAccounts(ctx, in)
+	return nil
+}
+
+func (m *IntermediaryAccountVoteBreakdown) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/AllIntermediaryAccounts",
+	return ""
+}
+
+type SuperfluidVoteBreakdownResponse struct {
+	Breakdowns []IntermediaryAccountVoteBreakdown `protobuf:"bytes,1,rep,name=breakdowns,proto3" json:"breakdowns"`
+}
+
+func (m *SuperfluidVoteBreakdownResponse) Reset()         { *m = SuperfluidVoteBreakdownResponse{} }
+func (m *SuperfluidVoteBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidVoteBreakdownResponse) ProtoMessage()    {}
+func (*SuperfluidVoteBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{35}
+}
+func (m *SuperfluidVoteBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidVoteBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidVoteBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllIntermediaryAccounts(ctx, req.(*AllIntermediaryAccountsRequest))
+}
+func (m *SuperfluidVoteBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidVoteBreakdownResponse.Merge(m, src)
+}
+func (m *SuperfluidVoteBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidVoteBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidVoteBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidVoteBreakdownResponse proto.InternalMessageInfo
+
+func (m *SuperfluidVoteBreakdownResponse) GetBreakdowns() []IntermediaryAccountVoteBreakdown {
+	if m != nil {
+		return m.Breakdowns
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ConnectedIntermediaryAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectedIntermediaryAccountRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.superfluid.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.superfluid.QueryParamsResponse")
+	proto.RegisterType((*AssetTypeRequest)(nil), "osmosis.superfluid.AssetTypeRequest")
+	proto.RegisterType((*AssetTypeResponse)(nil), "osmosis.superfluid.AssetTypeResponse")
+	proto.RegisterType((*AllAssetsRequest)(nil), "osmosis.superfluid.AllAssetsRequest")
+	proto.RegisterType((*AllAssetsResponse)(nil), "osmosis.superfluid.AllAssetsResponse")
+	proto.RegisterType((*AssetMultiplierRequest)(nil), "osmosis.superfluid.AssetMultiplierRequest")
+	proto.RegisterType((*AssetMultiplierResponse)(nil), "osmosis.superfluid.AssetMultiplierResponse")
+	proto.RegisterType((*AssetMultiplierHistoryRequest)(nil), "osmosis.superfluid.AssetMultiplierHistoryRequest")
+	proto.RegisterType((*AssetMultiplierHistoryResponse)(nil), "osmosis.superfluid.AssetMultiplierHistoryResponse")
+	proto.RegisterType((*SuperfluidIntermediaryAccountInfo)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccountInfo")
+	proto.RegisterType((*AllIntermediaryAccountsRequest)(nil), "osmosis.superfluid.AllIntermediaryAccountsRequest")
+	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
+	proto.RegisterType((*ConnectedIntermediaryAccountRequest)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountRequest")
+	proto.RegisterType((*ConnectedIntermediaryAccountResponse)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountResponse")
+	proto.RegisterType((*TotalSuperfluidDelegationsRequest)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsRequest")
+	proto.RegisterType((*TotalSuperfluidDelegationsResponse)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsResponse")
+	proto.RegisterType((*SuperfluidDelegationAmountRequest)(nil), "osmosis.superfluid.SuperfluidDelegationAmountRequest")
+	proto.RegisterType((*SuperfluidDelegationAmountResponse)(nil), "osmosis.superfluid.SuperfluidDelegationAmountResponse")
+	proto.RegisterType((*SuperfluidDelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidDelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidUndelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidUndelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomRequest")
+	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomResponse")
+	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomRequest")
+	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse")
+	proto.RegisterType((*SuperfluidLockRewardsRequest)(nil), "osmosis.superfluid.SuperfluidLockRewardsRequest")
+	proto.RegisterType((*SuperfluidLockRewards)(nil), "osmosis.superfluid.SuperfluidLockRewards")
+	proto.RegisterType((*SuperfluidLockRewardsResponse)(nil), "osmosis.superfluid.SuperfluidLockRewardsResponse")
+	proto.RegisterType((*SuperfluidLockRewardsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidLockRewardsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidLockRewardsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidLockRewardsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidVoteBreakdownRequest)(nil), "osmosis.superfluid.SuperfluidVoteBreakdownRequest")
+	proto.RegisterType((*SuperfluidVoteOverride)(nil), "osmosis.superfluid.SuperfluidVoteOverride")
+	proto.RegisterType((*IntermediaryAccountVoteBreakdown)(nil), "osmosis.superfluid.IntermediaryAccountVoteBreakdown")
+	proto.RegisterType((*SuperfluidVoteBreakdownResponse)(nil), "osmosis.superfluid.SuperfluidVoteBreakdownResponse")
+}
+
+func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
+
+var fileDescriptor_e3d9448e4ed3943f = []byte{
+	// 2200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcb, 0x73, 0x14, 0xc7,
+	0x19, 0xa7, 0x57, 0xf2, 0x0a, 0x7d, 0xd8, 0x48, 0x34, 0x32, 0x88, 0x31, 0x5a, 0xc9, 0x23, 0x40,
+	0xb2, 0x30, 0xbb, 0x96, 0x78, 0x29, 0x0e, 0x10, 0xaf, 0x90, 0xb0, 0x55, 0x11, 0x96, 0xb3, 0x80,
+	0xa8, 0xd8, 0x49, 0x4d, 0x8d, 0x76, 0x9a, 0xd5, 0x14, 0xb3, 0xd3, 0xcb, 0xcc, 0xec, 0x82, 0x4c,
+	0x29, 0x0f, 0xa7, 0xe2, 0xc4, 0x95, 0x4b, 0xaa, 0xb8, 0xe4, 0x94, 0xca, 0x25, 0x55, 0x49, 0x0e,
+	0xf9, 0x0b, 0x92, 0x43, 0xca, 0x17, 0x57, 0x52, 0xa9, 0xb8, 0x2a, 0x97, 0x54, 0x0e, 0x38, 0x05,
+	0xb9, 0xe6, 0x92, 0x63, 0x7c, 0x49, 0x4d, 0x3f, 0x66, 0x66, 0xb5, 0xf3, 0xd8, 0x5d, 0xc0, 0x9c,
+	0xd8, 0xe9, 0xfe, 0x5e, 0xbf, 0xef, 0xd5, 0xdd, 0x9f, 0x80, 0x02, 0x75, 0xeb, 0xd4, 0x35, 0xdd,
+	0x92, 0xdb, 0x6c, 0x10, 0xe7, 0x96, 0xd5, 0x34, 0x8d, 0xd2, 0x9d, 0x26, 0x71, 0xb6, 0x8b, 0x0d,
+	0x87, 0x7a, 0x14, 0x63, 0xb1, 0x5f, 0x0c, 0xf7, 0x95, 0xb1, 0x1a, 0xad, 0x51, 0xb6, 0x5d, 0xf2,
+	0x7f, 0x71, 0x4a, 0xa5, 0x50, 0x65, 0xa4, 0xa5, 0x4d, 0xdd, 0x25, 0xa5, 0xd6, 0xfc, 0x26, 0xf1,
+	0xf4, 0xf9, 0x52, 0x95, 0x9a, 0xb6, 0xd8, 0x3f, 0x2a, 0xf6, 0x6b, 0xb4, 0x15, 0x6c, 0xd7, 0x68,
+	0x4b, 0xee, 0xd6, 0x28, 0xad, 0x59, 0xa4, 0xa4, 0x37, 0xcc, 0x92, 0x6e, 0xdb, 0xd4, 0xd3, 0x3d,
+	0x93, 0xda, 0xae, 0xd8, 0x9d, 0x14, 0xbb, 0xec, 0x6b, 0xb3, 0x79, 0xab, 0xe4, 0x99, 0x75, 0xe2,
+	0x7a, 0x7a, 0xbd, 0x21, 0x95, 0xef, 0x26, 0x30, 0x9a, 0x0e, 0x93, 0x20, 0xf6, 0xa7, 0x63, 0x60,
+	0x86, 0x3f, 0xa5, 0x96, 0x18, 0xa2, 0x86, 0xee, 0xe8, 0x75, 0x69, 0xc6, 0x11, 0x49, 0x60, 0xd1,
+	0xea, 0xed, 0x66, 0x83, 0xfd, 0x23, 0xb6, 0xa6, 0xe4, 0x96, 0x69, 0x57, 0x89, 0xed, 0x99, 0x2d,
+	0xe2, 0x96, 0x1c, 0x72, 0x57, 0x77, 0x0c, 0xc9, 0x3c, 0x17, 0xf5, 0x0f, 0x73, 0x71, 0xe0, 0x86,
+	0x86, 0x5e, 0x33, 0xed, 0x88, 0xb9, 0xea, 0x18, 0xe0, 0x6f, 0xf9, 0x14, 0xef, 0x31, 0xed, 0x15,
+	0x72, 0xa7, 0x49, 0x5c, 0x4f, 0x5d, 0x87, 0x83, 0x6d, 0xab, 0x6e, 0x83, 0xda, 0x2e, 0xc1, 0x8b,
+	0x90, 0xe7, 0x56, 0x8e, 0xa3, 0x29, 0x34, 0xbb, 0x6f, 0x41, 0x29, 0x76, 0xc6, 0xac, 0xc8, 0x79,
+	0x96, 0x06, 0x3f, 0x7b, 0x38, 0xb9, 0xa7, 0x22, 0xe8, 0xd5, 0x59, 0x18, 0x2d, 0xbb, 0x2e, 0xf1,
+	0xae, 0x6f, 0x37, 0x88, 0x50, 0x82, 0xc7, 0xe0, 0x05, 0x83, 0xd8, 0xb4, 0xce, 0x84, 0x0d, 0x57,
+	0xf8, 0x87, 0xfa, 0x01, 0x1c, 0x88, 0x50, 0x0a, 0xc5, 0x57, 0x00, 0x74, 0x7f, 0x51, 0xf3, 0xb6,
+	0x1b, 0x84, 0xd1, 0xef, 0x5f, 0x98, 0x89, 0x53, 0x7e, 0x2d, 0xf8, 0x19, 0x0a, 0x19, 0xd6, 0xe5,
+	0x4f, 0x15, 0xc3, 0x68, 0xd9, 0xb2, 0xd8, 0x56, 0x80, 0x75, 0x03, 0x0e, 0x44, 0xd6, 0x84, 0xc2,
+	0x32, 0xe4, 0x19, 0x97, 0x8f, 0x74, 0x60, 0x76, 0xdf, 0xc2, 0x74, 0x17, 0xca, 0x24, 0x64, 0xce,
+	0xa8, 0x16, 0xe1, 0x10, 0x5b, 0xbe, 0xda, 0xb4, 0x3c, 0xb3, 0x61, 0x99, 0xc4, 0x49, 0x07, 0xfe,
+	0x33, 0x04, 0x87, 0x3b, 0x18, 0x84, 0x39, 0x0d, 0x50, 0x7c, 0xfd, 0x1a, 0xb9, 0xd3, 0x34, 0x5b,
+	0xba, 0x45, 0x6c, 0x4f, 0xab, 0x07, 0x54, 0x22, 0x18, 0x0b, 0x71, 0x26, 0xae, 0xbb, 0x75, 0xba,
+	0x12, 0x30, 0x45, 0x25, 0x57, 0xa9, 0x63, 0x54, 0xc6, 0x69, 0xc2, 0xbe, 0xba, 0x03, 0x13, 0xbb,
+	0x8c, 0x79, 0xc7, 0x74, 0x3d, 0xea, 0x6c, 0xa7, 0x82, 0xf0, 0x03, 0x15, 0xa6, 0xd8, 0x78, 0x8e,
+	0x19, 0x76, 0xa2, 0xc8, 0xf3, 0xb1, 0xe8, 0xe7, 0x63, 0x91, 0x97, 0xbc, 0xc8, 0xc7, 0xe2, 0x7b,
+	0x7a, 0x4d, 0xe6, 0x43, 0x25, 0xc2, 0xa9, 0x3e, 0x46, 0x50, 0x48, 0xd2, 0x2f, 0x7c, 0x72, 0x0f,
+	0x5e, 0x49, 0xf6, 0x89, 0x8c, 0x5b, 0x1f, 0x4e, 0x11, 0x61, 0x3c, 0x92, 0xe4, 0x1a, 0x17, 0xbf,
+	0x1d, 0x03, 0x72, 0x26, 0x13, 0x24, 0x37, 0xbb, 0x0d, 0xe5, 0x27, 0x08, 0x5e, 0x0d, 0x93, 0x68,
+	0xd5, 0xf6, 0x88, 0x53, 0x27, 0x86, 0xa9, 0x3b, 0xdb, 0xe5, 0x6a, 0x95, 0x36, 0x6d, 0x6f, 0xd5,
+	0xbe, 0x45, 0x13, 0x3c, 0x7d, 0x04, 0xf6, 0xb6, 0x74, 0x4b, 0xd3, 0x0d, 0xc3, 0x61, 0x26, 0x0c,
+	0x57, 0x86, 0x5a, 0xba, 0x55, 0x36, 0x0c, 0xc7, 0xdf, 0xaa, 0xe9, 0xcd, 0x1a, 0xd1, 0x4c, 0x63,
+	0x7c, 0x60, 0x0a, 0xcd, 0x0e, 0x56, 0x86, 0xd8, 0xf7, 0xaa, 0x81, 0xc7, 0x61, 0xc8, 0xe7, 0x20,
+	0xae, 0x3b, 0x3e, 0xc8, 0x99, 0xc4, 0xa7, 0xba, 0x05, 0x85, 0xb2, 0x65, 0xc5, 0xd8, 0x20, 0x0b,
+	0x65, 0x57, 0x6c, 0x51, 0xdf, 0xb1, 0xfd, 0x14, 0xc1, 0x64, 0xa2, 0x2a, 0x11, 0xdc, 0x9b, 0xb0,
+	0x57, 0x17, 0x6b, 0x22, 0x92, 0x67, 0xd3, 0x2b, 0x30, 0xc1, 0x79, 0x22, 0x98, 0x81, 0xb0, 0xa7,
+	0x17, 0xbb, 0x4b, 0x30, 0x7d, 0x99, 0xda, 0x36, 0xa9, 0x7a, 0x24, 0x4e, 0xb9, 0x74, 0xda, 0x61,
+	0x18, 0xf2, 0x7b, 0xb7, 0x1f, 0x0a, 0xc4, 0x42, 0x91, 0xf7, 0x3f, 0x57, 0x0d, 0xf5, 0x2e, 0x1c,
+	0x4b, 0xe7, 0x17, 0x9e, 0x58, 0x87, 0x21, 0x61, 0xbc, 0x70, 0x79, 0x7f, 0x8e, 0xa8, 0x48, 0x29,
+	0xea, 0x34, 0xbc, 0x7a, 0x9d, 0x7a, 0xba, 0x15, 0xb2, 0x2c, 0x13, 0x8b, 0xd4, 0xf8, 0x29, 0x28,
+	0x9b, 0xe2, 0x6f, 0x10, 0xa8, 0x69, 0x54, 0xc2, 0xb8, 0x1f, 0x22, 0x18, 0xf5, 0x7c, 0xb2, 0xc8,
+	0x26, 0x4f, 0xd3, 0xa5, 0x1b, 0xbe, 0xe3, 0xff, 0xf9, 0x70, 0xf2, 0x44, 0xcd, 0xf4, 0xb6, 0x9a,
+	0x9b, 0xc5, 0x2a, 0xad, 0x97, 0xc4, 0xb9, 0xc4, 0xff, 0x39, 0xe5, 0x1a, 0xb7, 0x4b, 0x7e, 0x3f,
+	0x77, 0x8b, 0xab, 0xb6, 0xf7, 0xdf, 0x87, 0x93, 0xd3, 0xdb, 0x7a, 0xdd, 0x7a, 0x53, 0x65, 0xf2,
+	0xb4, 0x10, 0x9b, 0x66, 0x84, 0xb2, 0xd5, 0x4a, 0x87, 0x3a, 0xf5, 0x41, 0x5b, 0x11, 0x85, 0x3b,
+	0xe5, 0x7a, 0x34, 0x0e, 0x27, 0xe1, 0x80, 0x90, 0x43, 0x1d, 0x4d, 0x96, 0x00, 0x2f, 0xa8, 0xd1,
+	0x60, 0xa3, 0xcc, 0xd7, 0x7d, 0xe2, 0x96, 0x6e, 0x99, 0x46, 0x1b, 0x31, 0x2f, 0xb2, 0xd1, 0x60,
+	0x43, 0x12, 0x07, 0xe5, 0x39, 0x10, 0xed, 0xe6, 0x9f, 0x20, 0x50, 0xd3, 0xac, 0x12, 0x0e, 0xac,
+	0x42, 0x5e, 0xaf, 0x8b, 0xe0, 0xfa, 0x59, 0x7e, 0xa4, 0x2d, 0x15, 0x65, 0x12, 0x5e, 0xa6, 0xa6,
+	0xbd, 0xf4, 0x86, 0xef, 0xd0, 0xdf, 0x7d, 0x31, 0x39, 0xdb, 0x85, 0x43, 0x7d, 0x06, 0xb7, 0x22,
+	0x44, 0xab, 0x1b, 0x30, 0x13, 0x1b, 0xc6, 0xa5, 0xed, 0x65, 0x89, 0xbc, 0x1f, 0x37, 0xa9, 0xbf,
+	0xce, 0xc1, 0x6c, 0xb6, 0xe0, 0xa0, 0x5d, 0x4f, 0xc4, 0xc6, 0x54, 0x73, 0x58, 0xd7, 0x95, 0x65,
+	0x5e, 0x4c, 0xcf, 0xee, 0x50, 0x49, 0x5b, 0xb3, 0x7e, 0xc5, 0x4d, 0xa4, 0x70, 0xf1, 0xf7, 0xe1,
+	0x65, 0x9e, 0x53, 0x42, 0x29, 0x31, 0x34, 0xff, 0xb2, 0xe8, 0x47, 0xf4, 0xa9, 0xbb, 0xfc, 0x60,
+	0x34, 0x3d, 0x89, 0xc1, 0x16, 0x55, 0x1b, 0x5e, 0x0b, 0x11, 0xdc, 0xb0, 0x8d, 0xa7, 0x16, 0x81,
+	0x30, 0xf7, 0x72, 0xd1, 0xdc, 0xfb, 0x5f, 0x0e, 0xe6, 0xba, 0x51, 0xf8, 0xdc, 0x23, 0xf3, 0x23,
+	0x04, 0x87, 0x79, 0x68, 0x9a, 0xf6, 0x57, 0x10, 0x1c, 0x9e, 0x06, 0x37, 0x42, 0x55, 0x6c, 0x19,
+	0xaf, 0xc1, 0x88, 0xbb, 0x6d, 0x7b, 0x5b, 0xc4, 0x33, 0xab, 0x9a, 0xdf, 0x9d, 0xdd, 0xf1, 0x01,
+	0xa6, 0x7c, 0x22, 0x40, 0xcc, 0x6f, 0xe1, 0xc5, 0x6b, 0x92, 0x6c, 0x8d, 0x56, 0x6f, 0x0b, 0x80,
+	0xfb, 0xdd, 0xe8, 0xa2, 0xab, 0xde, 0x81, 0xd7, 0x13, 0x6a, 0x62, 0x43, 0x76, 0x8e, 0x65, 0x3f,
+	0x4a, 0x91, 0x78, 0x77, 0xf6, 0x1a, 0x94, 0xd5, 0x6b, 0xda, 0xe2, 0xfd, 0x5b, 0x04, 0xa7, 0xba,
+	0xd4, 0xf9, 0xbc, 0x43, 0xae, 0xee, 0xc0, 0xe2, 0x8a, 0xeb, 0x99, 0x75, 0xdd, 0x23, 0x1d, 0x82,
+	0x88, 0xc1, 0xbb, 0xe3, 0x33, 0x74, 0xd5, 0x1f, 0x10, 0x7c, 0xad, 0x0f, 0xfd, 0xc2, 0x6d, 0x89,
+	0x9d, 0x04, 0x7d, 0x45, 0x9d, 0x64, 0x03, 0x8e, 0x86, 0x56, 0xfb, 0xf9, 0x56, 0xe1, 0x0f, 0xbf,
+	0xac, 0xdb, 0x06, 0x9e, 0x00, 0xb0, 0x9b, 0x75, 0x8d, 0x34, 0x68, 0x75, 0x8b, 0x1f, 0x65, 0x03,
+	0x95, 0x61, 0xbb, 0x59, 0x5f, 0x61, 0x0b, 0xea, 0x9f, 0x07, 0xe1, 0xe5, 0x58, 0xc1, 0xc9, 0x12,
+	0x6d, 0x18, 0x33, 0x23, 0x57, 0x0d, 0x4d, 0x5e, 0x52, 0x72, 0x4f, 0x70, 0x49, 0x11, 0x09, 0x74,
+	0xd0, 0xec, 0xdc, 0xc6, 0x6b, 0x70, 0x20, 0x92, 0xa7, 0xe2, 0xd0, 0x1c, 0x98, 0x42, 0xe9, 0x7e,
+	0xe7, 0x02, 0x47, 0x8d, 0x5d, 0xe7, 0x2f, 0xfe, 0x10, 0x46, 0x1a, 0xc4, 0x36, 0x4c, 0xbb, 0xa6,
+	0x89, 0xb7, 0xf3, 0xf8, 0x20, 0x8b, 0xe1, 0xd1, 0x58, 0x59, 0xcb, 0xa4, 0xca, 0xc4, 0x9d, 0x16,
+	0x61, 0x3c, 0xd9, 0x45, 0x18, 0x05, 0x8f, 0x5b, 0xd9, 0x2f, 0x34, 0x49, 0x97, 0x5e, 0x87, 0x51,
+	0x87, 0xe8, 0x96, 0xf9, 0x21, 0x31, 0x02, 0xe5, 0x2f, 0xec, 0x7a, 0x65, 0x86, 0x6f, 0xfb, 0xa2,
+	0x1f, 0x0d, 0x16, 0x26, 0xce, 0x2f, 0x20, 0x8d, 0x48, 0x11, 0x52, 0xea, 0x4f, 0x10, 0x1c, 0xe2,
+	0xc9, 0xd9, 0x21, 0x3c, 0xff, 0xac, 0x90, 0x8d, 0x31, 0x85, 0x95, 0x76, 0x4b, 0x54, 0x17, 0x26,
+	0x12, 0x92, 0x54, 0x94, 0x51, 0x05, 0x5e, 0x64, 0x39, 0x25, 0xed, 0xe3, 0xf7, 0xda, 0xd7, 0xd2,
+	0x53, 0x26, 0x22, 0x48, 0xb8, 0x60, 0x9f, 0x15, 0x2e, 0xa9, 0x4d, 0x98, 0x89, 0xa7, 0x7d, 0xc2,
+	0x13, 0x36, 0xa3, 0x70, 0xbe, 0x07, 0xb3, 0xd9, 0x6a, 0x13, 0x61, 0x0f, 0x3c, 0x31, 0xec, 0x32,
+	0x14, 0x42, 0xda, 0x0d, 0xea, 0x91, 0x25, 0x87, 0xe8, 0xb7, 0x0d, 0x7a, 0xd7, 0x96, 0x68, 0x27,
+	0x61, 0x5f, 0xc3, 0xa1, 0x0d, 0xea, 0xea, 0x56, 0x58, 0xc4, 0x20, 0x97, 0x56, 0x0d, 0xf5, 0x17,
+	0x39, 0x38, 0xd4, 0x2e, 0x63, 0xbd, 0x45, 0x1c, 0xc7, 0x34, 0x88, 0xdf, 0x43, 0x5b, 0xd4, 0x13,
+	0x13, 0x86, 0xe1, 0x0a, 0xff, 0x88, 0xb6, 0x84, 0x5c, 0x5b, 0x4b, 0xb8, 0x02, 0xf9, 0x48, 0x5d,
+	0x0e, 0x2f, 0x15, 0x7b, 0x7b, 0x02, 0xc8, 0xfb, 0x2a, 0xfe, 0x18, 0xc1, 0x10, 0x6d, 0xf0, 0xc7,
+	0x04, 0xaf, 0xca, 0xe0, 0x99, 0xe9, 0x8f, 0xf1, 0x64, 0xea, 0xde, 0x24, 0x66, 0x6d, 0xcb, 0x23,
+	0xdc, 0x64, 0x46, 0xbe, 0xb4, 0x2c, 0xb2, 0xf8, 0x42, 0xaa, 0xc6, 0x7b, 0x6c, 0x32, 0xc8, 0xf5,
+	0x76, 0x0a, 0x71, 0x2b, 0x52, 0xb9, 0xfa, 0xcb, 0x3c, 0x4c, 0xc5, 0xb4, 0xaa, 0x36, 0x3f, 0x27,
+	0x36, 0x42, 0xf4, 0x8c, 0x1a, 0xe1, 0x07, 0x71, 0x8d, 0x30, 0xd7, 0x97, 0xc3, 0x3b, 0xfb, 0xe2,
+	0x03, 0x04, 0xfb, 0xc3, 0x33, 0xd6, 0x8f, 0xf7, 0xf8, 0xc0, 0x73, 0x88, 0xc0, 0x4b, 0x81, 0x0d,
+	0xfe, 0x2a, 0xfe, 0x36, 0x8c, 0x9a, 0xf6, 0x16, 0x71, 0x4c, 0xff, 0xc4, 0x15, 0x88, 0x07, 0xfb,
+	0x42, 0x3c, 0x12, 0xc8, 0x11, 0x80, 0xdf, 0x85, 0x61, 0x2a, 0xd2, 0x5d, 0x76, 0xe1, 0xb9, 0xf4,
+	0x90, 0x45, 0x2b, 0x44, 0xc4, 0x29, 0x14, 0x81, 0x7f, 0x80, 0x60, 0x90, 0xb9, 0x2d, 0xff, 0x1c,
+	0xdc, 0xc6, 0x34, 0x87, 0x55, 0x3b, 0x14, 0xad, 0xda, 0x6b, 0xf0, 0x52, 0x8b, 0x7a, 0xfe, 0x81,
+	0x27, 0x1c, 0xb8, 0xb7, 0x2f, 0x07, 0xbe, 0xc8, 0x85, 0x70, 0xef, 0xa9, 0x3b, 0x30, 0x99, 0xd8,
+	0x7e, 0x44, 0xd7, 0x7b, 0x1f, 0x60, 0x53, 0x2e, 0xca, 0x9e, 0x77, 0x26, 0xce, 0xc3, 0x59, 0x85,
+	0x26, 0x7c, 0x1d, 0x91, 0xb6, 0xf0, 0xb1, 0x02, 0x2f, 0xb0, 0x39, 0x35, 0xfe, 0x31, 0x82, 0x3c,
+	0x1f, 0x3c, 0xe3, 0x13, 0x71, 0xc2, 0x3b, 0x67, 0xdc, 0xca, 0x4c, 0x26, 0x1d, 0x47, 0xa0, 0xce,
+	0x7d, 0xf4, 0xf7, 0x7f, 0x3f, 0xc8, 0x1d, 0xc3, 0x6a, 0x29, 0x66, 0x6a, 0x1f, 0x0e, 0xd6, 0x99,
+	0xf2, 0x9f, 0x22, 0x18, 0x0e, 0x26, 0xcf, 0xf8, 0x58, 0x9c, 0x8a, 0xdd, 0x73, 0x70, 0xe5, 0x78,
+	0x06, 0x95, 0x30, 0xa3, 0xc8, 0xcc, 0x98, 0xc5, 0x27, 0xd2, 0xcc, 0x08, 0xa7, 0xe4, 0xdc, 0x14,
+	0x39, 0xd8, 0x4e, 0x30, 0x65, 0xd7, 0x2c, 0x5c, 0x39, 0x9e, 0x41, 0xd5, 0x93, 0x29, 0x96, 0xa5,
+	0xe9, 0x5c, 0xf9, 0xaf, 0x10, 0x8c, 0xec, 0x9a, 0xe6, 0xe2, 0xb9, 0x44, 0xd4, 0x1d, 0x03, 0x73,
+	0xe5, 0x64, 0x57, 0xb4, 0xc2, 0xb8, 0x33, 0xcc, 0xb8, 0x22, 0x7e, 0x3d, 0xdb, 0x4f, 0xe1, 0xbc,
+	0x18, 0xff, 0x11, 0xc1, 0xa1, 0xf8, 0x81, 0x33, 0x9e, 0xef, 0x42, 0x7b, 0xfb, 0x70, 0x5c, 0x59,
+	0xe8, 0x85, 0x45, 0xd8, 0x7d, 0x81, 0xd9, 0x7d, 0x0e, 0x9f, 0xe9, 0xc5, 0x6e, 0x6d, 0x4b, 0x18,
+	0xf9, 0x27, 0xff, 0xaf, 0x07, 0xf1, 0x43, 0x55, 0xbc, 0x90, 0x10, 0xd5, 0x94, 0x61, 0xaf, 0x72,
+	0xba, 0x27, 0x1e, 0x01, 0xe1, 0x22, 0x83, 0x70, 0x1e, 0x9f, 0xcd, 0xca, 0x8b, 0xb8, 0x03, 0xd3,
+	0xc5, 0x5f, 0x20, 0x38, 0x9a, 0x36, 0x13, 0xc5, 0xe7, 0xe3, 0x8c, 0xea, 0x62, 0x0a, 0xab, 0x2c,
+	0xf6, 0xce, 0x28, 0x20, 0xad, 0x31, 0x48, 0x57, 0xf0, 0x72, 0x1a, 0xa4, 0xaa, 0x94, 0x14, 0x0b,
+	0xac, 0x74, 0x5f, 0x5c, 0x97, 0x76, 0xf0, 0x5f, 0x10, 0x28, 0xc9, 0x63, 0x55, 0x1c, 0x7b, 0x59,
+	0xc8, 0x1c, 0xd6, 0x2a, 0xe7, 0x7a, 0x65, 0x13, 0xd8, 0x2e, 0x31, 0x6c, 0x8b, 0xf8, 0x5c, 0x56,
+	0xb8, 0xe2, 0x87, 0xb1, 0xf8, 0xaf, 0x08, 0x94, 0xe4, 0x19, 0x27, 0x3e, 0xdb, 0xed, 0xf4, 0xa0,
+	0x6d, 0x52, 0xab, 0x9c, 0xeb, 0x95, 0x4d, 0xa0, 0x79, 0x8b, 0xa1, 0x79, 0x13, 0x2f, 0xa6, 0xa1,
+	0x89, 0x9f, 0x7a, 0x88, 0x7b, 0xe7, 0x7f, 0x10, 0x4c, 0x65, 0xcd, 0x33, 0xf1, 0xd7, 0xbb, 0x35,
+	0x2f, 0xe6, 0xe9, 0xa1, 0x5c, 0xe8, 0x8f, 0x59, 0x20, 0x7c, 0x97, 0x21, 0x7c, 0x07, 0x5f, 0xe9,
+	0x19, 0xa1, 0x5b, 0xba, 0xdf, 0xf1, 0xe4, 0xd9, 0xc1, 0x1f, 0xe5, 0xa2, 0x33, 0xea, 0xa4, 0x39,
+	0x21, 0xbe, 0x98, 0x6e, 0x74, 0xc6, 0x40, 0x53, 0xb9, 0xd4, 0x2f, 0xbb, 0x40, 0xfd, 0x5d, 0x86,
+	0xfa, 0x26, 0xbe, 0xd1, 0x25, 0xea, 0x66, 0x54, 0xa0, 0xb6, 0xb9, 0xad, 0x05, 0xc8, 0x63, 0x9d,
+	0xf0, 0x25, 0x82, 0xe3, 0x5d, 0x0d, 0xcf, 0xf0, 0x5b, 0x3d, 0x04, 0x2f, 0x76, 0x80, 0xa5, 0x94,
+	0x9f, 0x40, 0x82, 0xf0, 0xc6, 0x55, 0xe6, 0x8d, 0xb7, 0xf1, 0x4a, 0xef, 0x39, 0xe0, 0xfb, 0x22,
+	0xbc, 0xdb, 0xf3, 0xbf, 0x22, 0xfe, 0x3e, 0x07, 0xf3, 0x3d, 0xcf, 0xc3, 0xf0, 0x5a, 0x1c, 0x8e,
+	0x7e, 0xc7, 0x7a, 0xca, 0xd5, 0xa7, 0x24, 0x4d, 0x78, 0xe8, 0x3b, 0xcc, 0x43, 0x1b, 0xf8, 0x7a,
+	0x9a, 0x87, 0x88, 0x10, 0xaf, 0xa5, 0x35, 0x84, 0x38, 0x87, 0x7d, 0x8a, 0x92, 0x26, 0x65, 0x6f,
+	0x74, 0xfd, 0x90, 0x97, 0xc0, 0xe7, 0x7b, 0xe0, 0x10, 0xe0, 0x56, 0x18, 0xb8, 0x6f, 0xe0, 0x8b,
+	0x5d, 0x86, 0x3f, 0x3a, 0x70, 0x88, 0x9c, 0x43, 0x5f, 0xb6, 0x75, 0xba, 0xf8, 0xb9, 0x45, 0x56,
+	0xa7, 0x4b, 0x1d, 0xb2, 0x28, 0x17, 0xfa, 0x63, 0xee, 0x25, 0x86, 0x09, 0x30, 0xb3, 0x4b, 0xfe,
+	0x6f, 0x08, 0x0e, 0x27, 0x3c, 0x5b, 0xe2, 0xef, 0x4a, 0xe9, 0x23, 0x16, 0xe5, 0x74, 0x4f, 0x3c,
+	0x02, 0xe2, 0x37, 0x19, 0xc4, 0x15, 0x7c, 0xb9, 0x4b, 0x88, 0xfe, 0x2b, 0x4e, 0x0b, 0xde, 0x3e,
+	0xa5, 0xfb, 0x91, 0xa1, 0xce, 0xce, 0xd2, 0xfa, 0x67, 0x8f, 0x0a, 0xe8, 0xf3, 0x47, 0x05, 0xf4,
+	0xaf, 0x47, 0x05, 0xf4, 0xf3, 0xc7, 0x85, 0x3d, 0x9f, 0x3f, 0x2e, 0xec, 0xf9, 0xc7, 0xe3, 0xc2,
+	0x9e, 0xf7, 0xcf, 0x46, 0xde, 0x75, 0x42, 0xd1, 0x29, 0x4b, 0xdf, 0x74, 0x03, 0xad, 0xad, 0xf3,
+	0xa5, 0x7b, 0x51, 0xd5, 0xec, 0xa9, 0xb7, 0x99, 0x67, 0xff, 0x3b, 0xe8, 0xf4, 0xff, 0x07, 0x00,
+	0xbe, 0x83, 0xad, 0x8a, 0xb5, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Returns superfluid asset type
+	AssetType(ctx context.Context, in *AssetTypeRequest, opts ...grpc.CallOption) (*AssetTypeResponse, error)
+	// Returns all superfluid asset types
+	AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error)
+	// Returns superfluid asset Multiplier
+	AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error)
+	// Returns the superfluid asset multipliers set at each epoch
+	AssetMultiplierHistory(ctx context.Context, in *AssetMultiplierHistoryRequest, opts ...grpc.CallOption) (*AssetMultiplierHistoryResponse, error)
+	// Returns all superfluid intermediary account
+	AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error)
+	// Returns intermediary account connected to a superfluid staked lock by id
+	ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error)
+	// Returns the total amount of osmo superfluidly staked
+	// response denominated in uosmo
+	TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error)
+	// Returns the coins superfluid delegated for a delegator, validator, denom
+	// triplet
+	SuperfluidDelegationAmount(ctx context.Context, in *SuperfluidDelegationAmountRequest, opts ...grpc.CallOption) (*SuperfluidDelegationAmountResponse, error)
+	// Returns all the superfluid poistions for a specific delegator
+	SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error)
+	SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error)
+	// Returns all the superfluid positions of a specific denom delegated to one
+	// validator
+	SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error)
+	// Returns the amount of a specific denom delegated to a specific validator
+	// This is labeled an estimate, because the way it calculates the amount can
+	// lead rounding errors from the true delegated amount
+	EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
+	// Returns the intermediary account, the osmo equivalent delegation and the
+	// pending and realized staking rewards of a superfluid delegated lock
+	SuperfluidLockRewards(ctx context.Context, in *SuperfluidLockRewardsRequest, opts ...grpc.CallOption) (*SuperfluidLockRewardsResponse, error)
+	// Returns the superfluid lock rewards of all the superfluid delegated locks
+	// of a delegator
+	SuperfluidLockRewardsByDelegator(ctx context.Context, in *SuperfluidLockRewardsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidLockRewardsByDelegatorResponse, error)
+	// Returns how the votes of the intermediary accounts on a proposal break
+	// down into the votes of superfluid delegators and the votes inherited from
+	// their validators
+	SuperfluidVoteBreakdown(ctx context.Context, in *SuperfluidVoteBreakdownRequest, opts ...grpc.CallOption) (*SuperfluidVoteBreakdownResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConnectedIntermediaryAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/ConnectedIntermediaryAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConnectedIntermediaryAccount(ctx, req.(*ConnectedIntermediaryAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalSuperfluidDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalSuperfluidDelegationsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AssetType(ctx context.Context, in *AssetTypeRequest, opts ...grpc.CallOption) (*AssetTypeResponse, error) {
+	out := new(AssetTypeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetType", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalSuperfluidDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/TotalSuperfluidDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalSuperfluidDelegations(ctx, req.(*TotalSuperfluidDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SuperfluidDelegationAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidDelegationAmountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error) {
+	out := new(AllAssetsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllAssets", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidDelegationAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidDelegationAmount(ctx, req.(*SuperfluidDelegationAmountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SuperfluidDelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidDelegationsByDelegatorRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error) {
+	out := new(AssetMultiplierResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetMultiplier", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidDelegationsByDelegator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByDelegator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidDelegationsByDelegator(ctx, req.(*SuperfluidDelegationsByDelegatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SuperfluidUndelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidUndelegationsByDelegatorRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AssetMultiplierHistory(ctx context.Context, in *AssetMultiplierHistoryRequest, opts ...grpc.CallOption) (*AssetMultiplierHistoryResponse, error) {
+	out := new(AssetMultiplierHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetMultiplierHistory", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidUndelegationsByDelegator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidUndelegationsByDelegator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidUndelegationsByDelegator(ctx, req.(*SuperfluidUndelegationsByDelegatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SuperfluidDelegationsByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidDelegationsByValidatorDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error) {
+	out := new(AllIntermediaryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllIntermediaryAccounts", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, req.(*SuperfluidDelegationsByValidatorDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error) {
+	out := new(ConnectedIntermediaryAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/ConnectedIntermediaryAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, req.(*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.superfluid.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AssetType",
-			Handler:    _Query_AssetType_Handler,
-		},
-		{
-			MethodName: "AllAssets",
-			Handler:    _Query_AllAssets_Handler,
-		},
-		{
-			MethodName: "AssetMultiplier",
-			Handler:    _Query_AssetMultiplier_Handler,
-		},
-		{
-			MethodName: "AllIntermediaryAccounts",
-			Handler:    _Query_AllIntermediaryAccounts_Handler,
-		},
-		{
-			MethodName: "ConnectedIntermediaryAccount",
-			Handler:    _Query_ConnectedIntermediaryAccount_Handler,
-		},
-		{
-			MethodName: "TotalSuperfluidDelegations",
-			Handler:    _Query_TotalSuperfluidDelegations_Handler,
-		},
-		{
-			MethodName: "SuperfluidDelegationAmount",
-			Handler:    _Query_SuperfluidDelegationAmount_Handler,
-		},
-		{
-			MethodName: "SuperfluidDelegationsByDelegator",
-			Handler:    _Query_SuperfluidDelegationsByDelegator_Handler,
-		},
-		{
-			MethodName: "SuperfluidUndelegationsByDelegator",
-			Handler:    _Query_SuperfluidUndelegationsByDelegator_Handler,
-		},
-		{
-			MethodName: "SuperfluidDelegationsByValidatorDenom",
-			Handler:    _Query_SuperfluidDelegationsByValidatorDenom_Handler,
-		},
-		{
-			MethodName: "EstimateSuperfluidDelegatedAmountByValidatorDenom",
-			Handler:    _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/superfluid/query.proto",
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error) {
+	out := new(TotalSuperfluidDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalSuperfluidDelegations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SuperfluidDelegationAmount(ctx context.Context, in *SuperfluidDelegationAmountRequest, opts ...grpc.CallOption) (*SuperfluidDelegationAmountResponse, error) {
+	out := new(SuperfluidDelegationAmountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationAmount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error) {
+	out := new(SuperfluidDelegationsByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AssetTypeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error) {
+	out := new(SuperfluidUndelegationsByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidUndelegationsByDelegator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AssetTypeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *AssetTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
+	out := new(SuperfluidDelegationsByValidatorDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AssetTypeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
+	out := new(EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AssetTypeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SuperfluidLockRewards(ctx context.Context, in *SuperfluidLockRewardsRequest, opts ...grpc.CallOption) (*SuperfluidLockRewardsResponse, error) {
+	out := new(SuperfluidLockRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidLockRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AssetTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AssetType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) SuperfluidLockRewardsByDelegator(ctx context.Context, in *SuperfluidLockRewardsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidLockRewardsByDelegatorResponse, error) {
+	out := new(SuperfluidLockRewardsByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidLockRewardsByDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AllAssetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SuperfluidVoteBreakdown(ctx context.Context, in *SuperfluidVoteBreakdownRequest, opts ...grpc.CallOption) (*SuperfluidVoteBreakdownResponse, error) {
+	out := new(SuperfluidVoteBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidVoteBreakdown", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AllAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns superfluid asset type
+	AssetType(context.Context, *AssetTypeRequest) (*AssetTypeResponse, error)
+	// Returns all superfluid asset types
+	AllAssets(context.Context, *AllAssetsRequest) (*AllAssetsResponse, error)
+	// Returns superfluid asset Multiplier
+	AssetMultiplier(context.Context, *AssetMultiplierRequest) (*AssetMultiplierResponse, error)
+	// Returns the superfluid asset multipliers set at each epoch
+	AssetMultiplierHistory(context.Context, *AssetMultiplierHistoryRequest) (*AssetMultiplierHistoryResponse, error)
+	// Returns all superfluid intermediary account
+	AllIntermediaryAccounts(context.Context, *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error)
+	// Returns intermediary account connected to a superfluid staked lock by id
+	ConnectedIntermediaryAccount(context.Context, *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error)
+	// Returns the total amount of osmo superfluidly staked
+	// response denominated in uosmo
+	TotalSuperfluidDelegations(context.Context, *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error)
+	// Returns the coins superfluid delegated for a delegator, validator, denom
+	// triplet
+	SuperfluidDelegationAmount(context.Context, *SuperfluidDelegationAmountRequest) (*SuperfluidDelegationAmountResponse, error)
+	// Returns all the superfluid poistions for a specific delegator
+	SuperfluidDelegationsByDelegator(context.Context, *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error)
+	SuperfluidUndelegationsByDelegator(context.Context, *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error)
+	// Returns all the superfluid positions of a specific denom delegated to one
+	// validator
+	SuperfluidDelegationsByValidatorDenom(context.Context, *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error)
+	// Returns the amount of a specific denom delegated to a specific validator
+	// This is labeled an estimate, because the way it calculates the amount can
+	// lead rounding errors from the true delegated amount
+	EstimateSuperfluidDelegatedAmountByValidatorDenom(context.Context, *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
+	// Returns the intermediary account, the osmo equivalent delegation and the
+	// pending and realized staking rewards of a superfluid delegated lock
+	SuperfluidLockRewards(context.Context, *SuperfluidLockRewardsRequest) (*SuperfluidLockRewardsResponse, error)
+	// Returns the superfluid lock rewards of all the superfluid delegated locks
+	// of a delegator
+	SuperfluidLockRewardsByDelegator(context.Context, *SuperfluidLockRewardsByDelegatorRequest) (*SuperfluidLockRewardsByDelegatorResponse, error)
+	// Returns how the votes of the intermediary accounts on a proposal break
+	// down into the votes of superfluid delegators and the votes inherited from
+	// their validators
+	SuperfluidVoteBreakdown(context.Context, *SuperfluidVoteBreakdownRequest) (*SuperfluidVoteBreakdownResponse, error)
 }
 
-func (m *AllAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *AllAssetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *AllAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) AssetType(ctx context.Context, req *AssetTypeRequest) (*AssetTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetType not implemented")
 }
-
-func (m *AllAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) AllAssets(ctx context.Context, req *AllAssetsRequest) (*AllAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllAssets not implemented")
 }
-
-func (m *AssetMultiplierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) AssetMultiplier(ctx context.Context, req *AssetMultiplierRequest) (*AssetMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetMultiplier not implemented")
 }
-
-func (m *AssetMultiplierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) AssetMultiplierHistory(ctx context.Context, req *AssetMultiplierHistoryRequest) (*AssetMultiplierHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetMultiplierHistory not implemented")
+}
+func (*UnimplementedQueryServer) AllIntermediaryAccounts(ctx context.Context, req *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllIntermediaryAccounts not implemented")
+}
+func (*UnimplementedQueryServer) ConnectedIntermediaryAccount(ctx context.Context, req *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectedIntermediaryAccount not implemented")
+}
+func (*UnimplementedQueryServer) TotalSuperfluidDelegations(ctx context.Context, req *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSuperfluidDelegations not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidDelegationAmount(ctx context.Context, req *SuperfluidDelegationAmountRequest) (*SuperfluidDelegationAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationAmount not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidDelegationsByDelegator(ctx context.Context, req *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByDelegator not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidUndelegationsByDelegator(ctx context.Context, req *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegationsByDelegator not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidDelegationsByValidatorDenom(ctx context.Context, req *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByValidatorDenom not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, req *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSuperfluidDelegatedAmountByValidatorDenom not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidLockRewards(ctx context.Context, req *SuperfluidLockRewardsRequest) (*SuperfluidLockRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidLockRewards not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidLockRewardsByDelegator(ctx context.Context, req *SuperfluidLockRewardsByDelegatorRequest) (*SuperfluidLockRewardsByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidLockRewardsByDelegator not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidVoteBreakdown(ctx context.Context, req *SuperfluidVoteBreakdownRequest) (*SuperfluidVoteBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidVoteBreakdown not implemented")
 }
 
-func (m *AssetMultiplierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *AssetMultiplierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AssetType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/AssetType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetType(ctx, req.(*AssetTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OsmoEquivalentMultiplier != nil {
-		{
-			size, err := m.OsmoEquivalentMultiplier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_AllAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/AllAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllAssets(ctx, req.(*AllAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AssetMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetMultiplierRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AssetMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/AssetMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetMultiplier(ctx, req.(*AssetMultiplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AssetMultiplierHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetMultiplierHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetMultiplierHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/AssetMultiplierHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetMultiplierHistory(ctx, req.(*AssetMultiplierHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x22
+func _Query_AllIntermediaryAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllIntermediaryAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.GaugeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).AllIntermediaryAccounts(ctx, in)
 	}
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/AllIntermediaryAccounts",
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllIntermediaryAccounts(ctx, req.(*AllIntermediaryAccountsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllIntermediaryAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ConnectedIntermediaryAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectedIntermediaryAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectedIntermediaryAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/ConnectedIntermediaryAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectedIntermediaryAccount(ctx, req.(*ConnectedIntermediaryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllIntermediaryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AllIntermediaryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_TotalSuperfluidDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalSuperfluidDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSuperfluidDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/TotalSuperfluidDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSuperfluidDelegations(ctx, req.(*TotalSuperfluidDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllIntermediaryAccountsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SuperfluidDelegationAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidDelegationAmountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AllIntermediaryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AllIntermediaryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationAmount(ctx, in)
 	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationAmount",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationAmount(ctx, req.(*SuperfluidDelegationAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConnectedIntermediaryAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SuperfluidDelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidDelegationsByDelegatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConnectedIntermediaryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConnectedIntermediaryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LockId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationsByDelegator(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationsByDelegator(ctx, req.(*SuperfluidDelegationsByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ConnectedIntermediaryAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SuperfluidUndelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidUndelegationsByDelegatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ConnectedIntermediaryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ConnectedIntermediaryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidUndelegationsByDelegator(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidUndelegationsByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidUndelegationsByDelegator(ctx, req.(*SuperfluidUndelegationsByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TotalSuperfluidDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SuperfluidDelegationsByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidDelegationsByValidatorDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, req.(*SuperfluidDelegationsByValidatorDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TotalSuperfluidDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, req.(*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TotalSuperfluidDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_SuperfluidLockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidLockRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidLockRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidLockRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidLockRewards(ctx, req.(*SuperfluidLockRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TotalSuperfluidDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SuperfluidLockRewardsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidLockRewardsByDelegatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidLockRewardsByDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidLockRewardsByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidLockRewardsByDelegator(ctx, req.(*SuperfluidLockRewardsByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TotalSuperfluidDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SuperfluidVoteBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidVoteBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidVoteBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidVoteBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidVoteBreakdown(ctx, req.(*SuperfluidVoteBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TotalSuperfluidDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalDelegations.Size()
-		i -= size
-		if _, err := m.TotalDelegations.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.superfluid.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AssetType",
+			Handler:    _Query_AssetType_Handler,
+		},
+		{
+			MethodName: "AllAssets",
+			Handler:    _Query_AllAssets_Handler,
+		},
+		{
+			MethodName: "AssetMultiplier",
+			Handler:    _Query_AssetMultiplier_Handler,
+		},
+		{
+			MethodName: "AssetMultiplierHistory",
+			Handler:    _Query_AssetMultiplierHistory_Handler,
+		},
+		{
+			MethodName: "AllIntermediaryAccounts",
+			Handler:    _Query_AllIntermediaryAccounts_Handler,
+		},
+		{
+			MethodName: "ConnectedIntermediaryAccount",
+			Handler:    _Query_ConnectedIntermediaryAccount_Handler,
+		},
+		{
+			MethodName: "TotalSuperfluidDelegations",
+			Handler:    _Query_TotalSuperfluidDelegations_Handler,
+		},
+		{
+			MethodName: "SuperfluidDelegationAmount",
+			Handler:    _Query_SuperfluidDelegationAmount_Handler,
+		},
+		{
+			MethodName: "SuperfluidDelegationsByDelegator",
+			Handler:    _Query_SuperfluidDelegationsByDelegator_Handler,
+		},
+		{
+			MethodName: "SuperfluidUndelegationsByDelegator",
+			Handler:    _Query_SuperfluidUndelegationsByDelegator_Handler,
+		},
+		{
+			MethodName: "SuperfluidDelegationsByValidatorDenom",
+			Handler:    _Query_SuperfluidDelegationsByValidatorDenom_Handler,
+		},
+		{
+			MethodName: "EstimateSuperfluidDelegatedAmountByValidatorDenom",
+			Handler:    _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler,
+		},
+		{
+			MethodName: "SuperfluidLockRewards",
+			Handler:    _Query_SuperfluidLockRewards_Handler,
+		},
+		{
+			MethodName: "SuperfluidLockRewardsByDelegator",
+			Handler:    _Query_SuperfluidLockRewardsByDelegator_Handler,
+		},
+		{
+			MethodName: "SuperfluidVoteBreakdown",
+			Handler:    _Query_SuperfluidVoteBreakdown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/superfluid/query.proto",
 }
 
-func (m *SuperfluidDelegationAmountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2367,41 +2723,20 @@ func (m *SuperfluidDelegationAmountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationAmountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2411,34 +2746,30 @@ func (m *SuperfluidDelegationAmountResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *AssetTypeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2448,27 +2779,27 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetTypeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *AssetTypeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2478,48 +2809,25 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetTypeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalDelegatedCoins) > 0 {
-		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.AssetType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllAssetsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2529,34 +2837,20 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidUndelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *AllAssetsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2566,48 +2860,20 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SyntheticLocks) > 0 {
-		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SyntheticLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TotalUndelegatedCoins) > 0 {
-		for iNdEx := len(m.TotalUndelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalUndelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2621,7 +2887,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *AssetMultiplierRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2631,12 +2897,12 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetMultiplierRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetMultiplierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2646,19 +2912,12 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA
 		copy(dAtA[i:], m.Denom)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *AssetMultiplierResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2668,34 +2927,32 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.OsmoEquivalentMultiplier != nil {
+		{
+			size, err := m.OsmoEquivalentMultiplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *AssetMultiplierHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2705,34 +2962,39 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dA
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetMultiplierHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetMultiplierHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *AssetMultiplierHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2742,20 +3004,32 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (d
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetMultiplierHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetMultiplierHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalDelegatedCoins) > 0 {
-		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OsmoEquivalentMultipliers) > 0 {
+		for iNdEx := len(m.OsmoEquivalentMultipliers) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.OsmoEquivalentMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2769,398 +3043,2938 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSiz
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidIntermediaryAccountInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *SuperfluidIntermediaryAccountInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SuperfluidIntermediaryAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *AssetTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.AssetType != 0 {
-		n += 1 + sovQuery(uint64(m.AssetType))
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AllAssetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AllIntermediaryAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *AllIntermediaryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetMultiplierRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllIntermediaryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetMultiplierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OsmoEquivalentMultiplier != nil {
-		l = m.OsmoEquivalentMultiplier.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *AllIntermediaryAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GaugeId != 0 {
-		n += 1 + sovQuery(uint64(m.GaugeId))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *AllIntermediaryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllIntermediaryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AllIntermediaryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *AllIntermediaryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ConnectedIntermediaryAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConnectedIntermediaryAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectedIntermediaryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectedIntermediaryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.LockId != 0 {
-		n += 1 + sovQuery(uint64(m.LockId))
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ConnectedIntermediaryAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConnectedIntermediaryAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectedIntermediaryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectedIntermediaryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TotalSuperfluidDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TotalSuperfluidDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalSuperfluidDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalSuperfluidDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TotalSuperfluidDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TotalSuperfluidDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalSuperfluidDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalSuperfluidDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalDelegations.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.TotalDelegations.Size()
+		i -= size
+		if _, err := m.TotalDelegations.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationAmountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidDelegationAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidDelegationAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidDelegationAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidDelegationAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidDelegationAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidDelegationAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SuperfluidDelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for _, e := range m.SuperfluidDelegationRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *SuperfluidDelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidDelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidDelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidDelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidDelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if len(m.TotalDelegatedCoins) > 0 {
-		for _, e := range m.TotalDelegatedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidUndelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidUndelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidUndelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidUndelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for _, e := range m.SuperfluidDelegationRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.SyntheticLocks) > 0 {
+		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SyntheticLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
 	if len(m.TotalUndelegatedCoins) > 0 {
-		for _, e := range m.TotalUndelegatedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.TotalUndelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalUndelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.SyntheticLocks) > 0 {
-		for _, e := range m.SyntheticLocks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.SuperfluidDelegationRecords) > 0 {
-		for _, e := range m.SuperfluidDelegationRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalDelegatedCoins) > 0 {
+		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidLockRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidLockRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidLockRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidLockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidLockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidLockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRealizedRewards) > 0 {
+		for iNdEx := len(m.TotalRealizedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRealizedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RealizedRewards) > 0 {
+		for iNdEx := len(m.RealizedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RealizedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.DelegationAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.IntermediaryAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidLockRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidLockRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidLockRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LockRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidLockRewardsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidLockRewardsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidLockRewardsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidLockRewardsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidLockRewardsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidLockRewardsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockRewards) > 0 {
+		for iNdEx := len(m.LockRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidVoteBreakdownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidVoteBreakdownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidVoteBreakdownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidVoteOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidVoteOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidVoteOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntermediaryAccountVoteBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntermediaryAccountVoteBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntermediaryAccountVoteBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VotingAmount.Size()
+		i -= size
+		if _, err := m.VotingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Vote) > 0 {
+		for iNdEx := len(m.Vote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.InheritedAmount.Size()
+		i -= size
+		if _, err := m.InheritedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorVote) > 0 {
+		for iNdEx := len(m.ValidatorVote) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorVote[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.DelegationAmount.Size()
+		i -= size
+		if _, err := m.DelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.IntermediaryAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidVoteBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidVoteBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidVoteBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Breakdowns) > 0 {
+		for iNdEx := len(m.Breakdowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakdowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AssetTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		n += 1 + sovQuery(uint64(m.AssetType))
+	}
+	return n
+}
+
+func (m *AllAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetMultiplierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OsmoEquivalentMultiplier != nil {
+		l = m.OsmoEquivalentMultiplier.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetMultiplierHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetMultiplierHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OsmoEquivalentMultipliers) > 0 {
+		for _, e := range m.OsmoEquivalentMultipliers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidIntermediaryAccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllIntermediaryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllIntermediaryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ConnectedIntermediaryAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *ConnectedIntermediaryAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TotalSuperfluidDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TotalSuperfluidDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalDelegations.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SuperfluidDelegationAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationsByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationsByDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for _, e := range m.SuperfluidDelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalDelegatedCoins) > 0 {
+		for _, e := range m.TotalDelegatedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidUndelegationsByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidUndelegationsByDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for _, e := range m.SuperfluidDelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalUndelegatedCoins) > 0 {
+		for _, e := range m.TotalUndelegatedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SyntheticLocks) > 0 {
+		for _, e := range m.SyntheticLocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationsByValidatorDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidDelegationsByValidatorDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for _, e := range m.SuperfluidDelegationRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalDelegatedCoins) > 0 {
+		for _, e := range m.TotalDelegatedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidLockRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *SuperfluidLockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	l = m.IntermediaryAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RealizedRewards) > 0 {
+		for _, e := range m.RealizedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalRealizedRewards) > 0 {
+		for _, e := range m.TotalRealizedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidLockRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LockRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SuperfluidLockRewardsByDelegatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *SuperfluidLockRewardsByDelegatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockRewards) > 0 {
+		for _, e := range m.LockRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidVoteBreakdownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *SuperfluidVoteOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IntermediaryAccountVoteBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IntermediaryAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegationAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorVote) > 0 {
+		for _, e := range m.ValidatorVote {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.InheritedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Vote) > 0 {
+		for _, e := range m.Vote {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.VotingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SuperfluidVoteBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Breakdowns) > 0 {
+		for _, e := range m.Breakdowns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= SuperfluidAssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, SuperfluidAsset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultiplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OsmoEquivalentMultiplier == nil {
+				m.OsmoEquivalentMultiplier = &OsmoEquivalentMultiplierRecord{}
+			}
+			if err := m.OsmoEquivalentMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsmoEquivalentMultipliers = append(m.OsmoEquivalentMultipliers, OsmoEquivalentMultiplierRecord{})
+			if err := m.OsmoEquivalentMultipliers[len(m.OsmoEquivalentMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, SuperfluidIntermediaryAccountInfo{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TotalDelegatedCoins) > 0 {
-		for _, e := range m.TotalDelegatedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3183,12 +5997,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3210,7 +6043,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3233,15 +6066,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3268,7 +6101,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Account == nil {
+				m.Account = &SuperfluidIntermediaryAccountInfo{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3293,7 +6129,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3316,15 +6152,65 @@ func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3352,7 +6238,9 @@ func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3375,7 +6263,7 @@ func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3398,17 +6286,49 @@ func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.AssetType = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3418,61 +6338,56 @@ func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetType |= SuperfluidAssetType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllAssetsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllAssetsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3494,7 +6409,7 @@ func (m *AllAssetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3517,15 +6432,15 @@ func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllAssetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3552,8 +6467,8 @@ func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, SuperfluidAsset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3578,7 +6493,7 @@ func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3601,15 +6516,15 @@ func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetMultiplierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetMultiplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3637,7 +6552,7 @@ func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3660,7 +6575,7 @@ func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3683,15 +6598,15 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetMultiplierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3718,10 +6633,42 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OsmoEquivalentMultiplier == nil {
-				m.OsmoEquivalentMultiplier = &OsmoEquivalentMultiplierRecord{}
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.OsmoEquivalentMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
+			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3746,7 +6693,7 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3769,15 +6716,15 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3805,11 +6752,11 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3837,13 +6784,63 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GaugeId = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3853,16 +6850,31 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GaugeId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUndelegatedCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3872,77 +6884,29 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.TotalUndelegatedCoins = append(m.TotalUndelegatedCoins, types.Coin{})
+			if err := m.TotalUndelegatedCoins[len(m.TotalUndelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticLocks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3969,10 +6933,8 @@ func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SyntheticLocks = append(m.SyntheticLocks, types1.SyntheticLock{})
+			if err := m.SyntheticLocks[len(m.SyntheticLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3997,7 +6959,7 @@ func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4020,17 +6982,17 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4040,31 +7002,29 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, SuperfluidIntermediaryAccountInfo{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4074,27 +7034,23 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4117,7 +7073,7 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4140,17 +7096,17 @@ func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
 			}
-			m.LockId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4160,11 +7116,26 @@ func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4186,7 +7157,7 @@ func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4209,17 +7180,17 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4229,27 +7200,55 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &SuperfluidIntermediaryAccountInfo{}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4272,7 +7271,7 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4295,12 +7294,46 @@ func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
+			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4322,7 +7355,7 @@ func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4345,17 +7378,17 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			var stringLen uint64
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4365,26 +7398,30 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4406,7 +7443,7 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4429,17 +7466,36 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4449,29 +7505,30 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.IntermediaryAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4481,29 +7538,30 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4513,23 +7571,93 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.PendingRewards = append(m.PendingRewards, types.DecCoin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RealizedRewards = append(m.RealizedRewards, types2.LockEpochReward{})
+			if err := m.RealizedRewards[len(m.RealizedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRealizedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRealizedRewards = append(m.TotalRealizedRewards, types.DecCoin{})
+			if err := m.TotalRealizedRewards[len(m.TotalRealizedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4552,7 +7680,7 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4575,15 +7703,15 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4610,8 +7738,7 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4636,7 +7763,7 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewardsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4659,10 +7786,10 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewardsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewardsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4697,6 +7824,25 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4718,7 +7864,7 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidLockRewardsByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4741,49 +7887,15 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidLockRewardsByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidLockRewardsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4810,8 +7922,8 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
-			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockRewards = append(m.LockRewards, SuperfluidLockRewards{})
+			if err := m.LockRewards[len(m.LockRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4836,7 +7948,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidVoteBreakdownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4859,49 +7971,17 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidVoteBreakdownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidVoteBreakdownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4911,24 +7991,11 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4950,7 +8017,7 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidVoteOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4973,17 +8040,17 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidVoteOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidVoteOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4993,31 +8060,48 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUndelegatedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5027,29 +8111,29 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalUndelegatedCoins = append(m.TotalUndelegatedCoins, types.Coin{})
-			if err := m.TotalUndelegatedCoins[len(m.TotalUndelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticLocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5076,8 +8160,8 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyntheticLocks = append(m.SyntheticLocks, types1.SyntheticLock{})
-			if err := m.SyntheticLocks[len(m.SyntheticLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, types3.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5102,7 +8186,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *IntermediaryAccountVoteBreakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5125,17 +8209,17 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IntermediaryAccountVoteBreakdown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IntermediaryAccountVoteBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5145,27 +8229,28 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.IntermediaryAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5193,61 +8278,81 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.DelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ValidatorVote = append(m.ValidatorVote, types3.WeightedVoteOption{})
+			if err := m.ValidatorVote[len(m.ValidatorVote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InheritedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5274,64 +8379,48 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Overrides = append(m.Overrides, SuperfluidVoteOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Vote = append(m.Vote, types3.WeightedVoteOption{})
+			if err := m.Vote[len(m.Vote)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5359,11 +8448,11 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5391,7 +8480,9 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.VotingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5414,7 +8505,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 	}
 	return nil
 }
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidVoteBreakdownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5437,15 +8528,15 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dA
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidVoteBreakdownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidVoteBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdowns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5472,8 +8563,8 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dA
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
-			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Breakdowns = append(m.Breakdowns, IntermediaryAccountVoteBreakdown{})
+			if err := m.Breakdowns[len(m.Breakdowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -13,6 +15,34 @@ func NewSuperfluidAsset(assetType SuperfluidAssetType, denom string) SuperfluidA
 	}
 }
 
+// ValidateBasic checks the risk factor of the asset, and that its osmo pricing route is well formed.
+// Whether the route prices the asset in osmo depends on the pools, so it is checked by the keeper.
+func (a SuperfluidAsset) ValidateBasic() error {
+	// a risk factor of 1 would value the asset at zero osmo, and can't be unrisk adjusted.
+	if a.RiskFactor != nil && (a.RiskFactor.IsNegative() || a.RiskFactor.GTE(sdk.OneDec())) {
+		return fmt.Errorf("risk factor should be at least 0 and less than 1: %s", a.RiskFactor)
+	}
+
+	if len(a.OsmoPricingRoute) == 0 {
+		if a.PricingDenom != "" {
+			return fmt.Errorf("pricing denom %s is set without an osmo pricing route", a.PricingDenom)
+		}
+		return nil
+	}
+	if err := sdk.ValidateDenom(a.PricingDenom); err != nil {
+		return fmt.Errorf("invalid pricing denom: %w", err)
+	}
+	for _, hop := range a.OsmoPricingRoute {
+		if hop.PoolId == 0 {
+			return fmt.Errorf("osmo pricing route pool id should be positive")
+		}
+		if err := sdk.ValidateDenom(hop.TokenOutDenom); err != nil {
+			return fmt.Errorf("invalid osmo pricing route denom: %w", err)
+		}
+	}
+	return nil
+}
+
 func NewSuperfluidIntermediaryAccount(denom string, valAddr string, gaugeId uint64) SuperfluidIntermediaryAccount {
 	return SuperfluidIntermediaryAccount{
 		Denom:   denom,
@@ -37,3 +67,9 @@ func GetSuperfluidIntermediaryAccountAddr(denom, valAddr string) sdk.AccAddress
 	// We are launching with the address as is, so this will have to be done as a migration in the future.
 	return authtypes.NewModuleAddress(denom + valAddr)
 }
+
+// GetSuperfluidVoteAccountAddr returns the address of the account that casts the superfluid votes of an intermediary
+// account when its validator didn't vote.
+func GetSuperfluidVoteAccountAddr(intermediaryAccAddr sdk.AccAddress) sdk.AccAddress {
+	return authtypes.NewModuleAddress(ModuleName + "/vote/" + intermediaryAccAddr.String())
+}
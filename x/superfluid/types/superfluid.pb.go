@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_x_gov_types "github.com/cosmos/cosmos-sdk/x/gov/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/gov/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/protobuf/types/known/durationpb"
@@ -56,6 +58,15 @@ func (SuperfluidAssetType) EnumDescriptor() ([]byte, []int) {
 type SuperfluidAsset struct {
 	Denom     string              `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	AssetType SuperfluidAssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=osmosis.superfluid.SuperfluidAssetType" json:"asset_type,omitempty"`
+	// For LP shares of pools without OSMO, the pool asset that is priced in OSMO
+	// through the osmo_pricing_route.
+	PricingDenom string `protobuf:"bytes,3,opt,name=pricing_denom,json=pricingDenom,proto3" json:"pricing_denom,omitempty" yaml:"pricing_denom"`
+	// Pools through which the pricing_denom is priced in OSMO, each hop at its
+	// TWAP. The last hop has to be priced in OSMO.
+	OsmoPricingRoute []PricingRouteHop `protobuf:"bytes,4,rep,name=osmo_pricing_route,json=osmoPricingRoute,proto3" json:"osmo_pricing_route" yaml:"osmo_pricing_route"`
+	// Risk factor of the asset. The minimum_risk_factor param applies instead
+	// when it is higher, or when this is unset.
+	RiskFactor *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=risk_factor,json=riskFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"risk_factor,omitempty" yaml:"risk_factor"`
 }
 
 func (m *SuperfluidAsset) Reset()         { *m = SuperfluidAsset{} }
@@ -91,6 +102,60 @@ func (m *SuperfluidAsset) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuperfluidAsset proto.InternalMessageInfo
 
+// PricingRouteHop prices the previous denom of an osmo pricing route in
+// token_out_denom, in the pool of pool_id.
+type PricingRouteHop struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *PricingRouteHop) Reset()         { *m = PricingRouteHop{} }
+func (m *PricingRouteHop) String() string { return proto.CompactTextString(m) }
+func (*PricingRouteHop) ProtoMessage()    {}
+func (*PricingRouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{1}
+}
+func (m *PricingRouteHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricingRouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricingRouteHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricingRouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricingRouteHop.Merge(m, src)
+}
+func (m *PricingRouteHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricingRouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricingRouteHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricingRouteHop proto.InternalMessageInfo
+
+func (m *PricingRouteHop) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PricingRouteHop) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
 // SuperfluidIntermediaryAccount takes the role of intermediary between LP token
 // and OSMO tokens for superfluid staking
 type SuperfluidIntermediaryAccount struct {
@@ -104,7 +169,7 @@ func (m *SuperfluidIntermediaryAccount) Reset()         { *m = SuperfluidInterme
 func (m *SuperfluidIntermediaryAccount) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidIntermediaryAccount) ProtoMessage()    {}
 func (*SuperfluidIntermediaryAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{1}
+	return fileDescriptor_79d3c29d82dbb734, []int{2}
 }
 func (m *SuperfluidIntermediaryAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,7 +237,7 @@ func (m *OsmoEquivalentMultiplierRecord) Reset()         { *m = OsmoEquivalentMu
 func (m *OsmoEquivalentMultiplierRecord) String() string { return proto.CompactTextString(m) }
 func (*OsmoEquivalentMultiplierRecord) ProtoMessage()    {}
 func (*OsmoEquivalentMultiplierRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{2}
+	return fileDescriptor_79d3c29d82dbb734, []int{3}
 }
 func (m *OsmoEquivalentMultiplierRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +292,7 @@ func (m *SuperfluidDelegationRecord) Reset()         { *m = SuperfluidDelegation
 func (m *SuperfluidDelegationRecord) String() string { return proto.CompactTextString(m) }
 func (*SuperfluidDelegationRecord) ProtoMessage()    {}
 func (*SuperfluidDelegationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{3}
+	return fileDescriptor_79d3c29d82dbb734, []int{4}
 }
 func (m *SuperfluidDelegationRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +351,7 @@ func (m *LockIdIntermediaryAccountConnection) Reset()         { *m = LockIdInter
 func (m *LockIdIntermediaryAccountConnection) String() string { return proto.CompactTextString(m) }
 func (*LockIdIntermediaryAccountConnection) ProtoMessage()    {}
 func (*LockIdIntermediaryAccountConnection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_79d3c29d82dbb734, []int{4}
+	return fileDescriptor_79d3c29d82dbb734, []int{5}
 }
 func (m *LockIdIntermediaryAccountConnection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,13 +394,78 @@ func (m *LockIdIntermediaryAccountConnection) GetIntermediaryAccount() string {
 	return ""
 }
 
+// SuperfluidVote is the vote of a superfluid delegator on a governance
+// proposal. It overrides the vote of the validators of its superfluid
+// delegated locks for their osmo equivalent.
+type SuperfluidVote struct {
+	ProposalId uint64                                                       `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      string                                                       `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	Options    github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/gov/types.WeightedVoteOptions" json:"options"`
+}
+
+func (m *SuperfluidVote) Reset()         { *m = SuperfluidVote{} }
+func (m *SuperfluidVote) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidVote) ProtoMessage()    {}
+func (*SuperfluidVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{6}
+}
+func (m *SuperfluidVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperfluidVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidVote.Merge(m, src)
+}
+func (m *SuperfluidVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuperfluidVote proto.InternalMessageInfo
+
+func (m *SuperfluidVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *SuperfluidVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *SuperfluidVote) GetOptions() github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
 	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
+	proto.RegisterType((*PricingRouteHop)(nil), "osmosis.superfluid.PricingRouteHop")
 	proto.RegisterType((*SuperfluidIntermediaryAccount)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccount")
 	proto.RegisterType((*OsmoEquivalentMultiplierRecord)(nil), "osmosis.superfluid.OsmoEquivalentMultiplierRecord")
 	proto.RegisterType((*SuperfluidDelegationRecord)(nil), "osmosis.superfluid.SuperfluidDelegationRecord")
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
+	proto.RegisterType((*SuperfluidVote)(nil), "osmosis.superfluid.SuperfluidVote")
 }
 
 func init() {
@@ -343,47 +473,65 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x4f, 0xd4, 0x50,
-	0x10, 0x6e, 0x01, 0x81, 0x7d, 0x18, 0x5d, 0x0a, 0x89, 0xb0, 0x09, 0x2d, 0x96, 0x44, 0x88, 0x86,
-	0x36, 0x60, 0x8c, 0x09, 0xb7, 0x05, 0x34, 0x21, 0x41, 0x30, 0xc5, 0x13, 0x97, 0xe6, 0xb5, 0x6f,
-	0x28, 0x2f, 0xfb, 0xda, 0x57, 0xfa, 0xda, 0xea, 0xfe, 0x03, 0x8e, 0xfe, 0x04, 0x12, 0x6f, 0xfe,
-	0x08, 0xcf, 0x1c, 0x39, 0x1a, 0x0f, 0x68, 0xe0, 0xe2, 0xcd, 0xc4, 0x5f, 0x60, 0xfa, 0xda, 0xed,
-	0x56, 0xc0, 0xc4, 0xd3, 0xbe, 0x99, 0x6f, 0xe6, 0x9b, 0xf9, 0x66, 0xa6, 0x8b, 0x96, 0xb8, 0x08,
-	0xb9, 0xa0, 0xc2, 0x16, 0x59, 0x0c, 0xc9, 0x11, 0xcb, 0x28, 0x69, 0x3c, 0xad, 0x38, 0xe1, 0x29,
-	0xd7, 0xb4, 0x2a, 0xc8, 0x1a, 0x22, 0x9d, 0xd9, 0x80, 0x07, 0x5c, 0xc2, 0x76, 0xf1, 0x2a, 0x23,
-	0x3b, 0x7a, 0xc0, 0x79, 0xc0, 0xc0, 0x96, 0x96, 0x97, 0x1d, 0xd9, 0x24, 0x4b, 0x70, 0x4a, 0x79,
-	0x54, 0xe1, 0xc6, 0x4d, 0x3c, 0xa5, 0x21, 0x88, 0x14, 0x87, 0xf1, 0x80, 0xc0, 0x97, 0xb5, 0x6c,
-	0x0f, 0x0b, 0xb0, 0xf3, 0x35, 0x0f, 0x52, 0xbc, 0x66, 0xfb, 0x9c, 0x56, 0x04, 0x66, 0x1f, 0x3d,
-	0x3c, 0xa8, 0x9b, 0xe8, 0x0a, 0x01, 0xa9, 0x36, 0x8b, 0xee, 0x11, 0x88, 0x78, 0x38, 0xa7, 0x2e,
-	0xaa, 0x2b, 0x2d, 0xa7, 0x34, 0xb4, 0xd7, 0x08, 0xe1, 0x02, 0x76, 0xd3, 0x7e, 0x0c, 0x73, 0x23,
-	0x8b, 0xea, 0xca, 0x83, 0xf5, 0x65, 0xeb, 0xb6, 0x10, 0xeb, 0x06, 0xdd, 0xbb, 0x7e, 0x0c, 0x4e,
-	0x0b, 0x0f, 0x9e, 0x1b, 0x93, 0xa7, 0x67, 0x86, 0xf2, 0xf3, 0xcc, 0x50, 0xcd, 0x1e, 0x5a, 0x18,
-	0xc6, 0xee, 0x44, 0x29, 0x24, 0x21, 0x10, 0x8a, 0x93, 0x7e, 0xd7, 0xf7, 0x79, 0x16, 0xfd, 0xab,
-	0x91, 0x79, 0x34, 0x99, 0x63, 0xe6, 0x62, 0x42, 0x12, 0xd9, 0x46, 0xcb, 0x99, 0xc8, 0x31, 0xeb,
-	0x12, 0x92, 0x14, 0x50, 0x80, 0xb3, 0x00, 0x5c, 0x4a, 0xe6, 0x46, 0x17, 0xd5, 0x95, 0x31, 0x67,
-	0x42, 0xda, 0x3b, 0xc4, 0xfc, 0xa2, 0x22, 0x7d, 0x5f, 0x84, 0xfc, 0xd5, 0x49, 0x46, 0x73, 0xcc,
-	0x20, 0x4a, 0xdf, 0x64, 0x2c, 0xa5, 0x31, 0xa3, 0x90, 0x38, 0xe0, 0xf3, 0x84, 0x68, 0x8f, 0xd1,
-	0x7d, 0x88, 0xb9, 0x7f, 0xec, 0x46, 0x59, 0xe8, 0x41, 0x22, 0xab, 0x8e, 0x3a, 0x53, 0xd2, 0xb7,
-	0x27, 0x5d, 0xc3, 0x8e, 0x46, 0x9a, 0x1d, 0xf9, 0x08, 0x85, 0x35, 0x99, 0x2c, 0xdc, 0xda, 0xdc,
-	0x3a, 0xbf, 0x34, 0x94, 0x6f, 0x97, 0xc6, 0x93, 0x80, 0xa6, 0xc7, 0x99, 0x67, 0xf9, 0x3c, 0xb4,
-	0xab, 0x55, 0x94, 0x3f, 0xab, 0x82, 0xf4, 0xec, 0x62, 0x96, 0xc2, 0xda, 0x06, 0xff, 0xf7, 0xa5,
-	0x31, 0xdd, 0xc7, 0x21, 0xdb, 0x30, 0x87, 0x4c, 0xa6, 0xd3, 0xa0, 0x35, 0x7f, 0xa9, 0xa8, 0x33,
-	0x1c, 0xd7, 0x36, 0x30, 0x08, 0xe4, 0x21, 0x54, 0xcd, 0x3f, 0x43, 0xd3, 0xa4, 0xf4, 0xf1, 0x44,
-	0xce, 0x06, 0x84, 0xa8, 0xe6, 0xd6, 0xae, 0x81, 0x6e, 0xe9, 0x2f, 0x82, 0x73, 0xcc, 0x28, 0xf9,
-	0x2b, 0xb8, 0x94, 0xd4, 0xae, 0x81, 0x41, 0xf0, 0xfb, 0x9a, 0x99, 0xf2, 0xc8, 0xc5, 0x61, 0xb1,
-	0x1a, 0x29, 0x72, 0x6a, 0x7d, 0xde, 0x2a, 0xb5, 0x58, 0xc5, 0x75, 0x59, 0xd5, 0x75, 0x59, 0x5b,
-	0x9c, 0x46, 0x9b, 0x76, 0xa1, 0xff, 0xf3, 0x77, 0x63, 0xf9, 0x3f, 0xf4, 0x17, 0x09, 0x75, 0x97,
-	0x94, 0x47, 0x5d, 0x59, 0xc3, 0x3c, 0x41, 0x4b, 0xbb, 0xdc, 0xef, 0xed, 0xdc, 0x75, 0x1b, 0x5b,
-	0x3c, 0x8a, 0xc0, 0x2f, 0x82, 0xb5, 0x47, 0x68, 0x82, 0x71, 0xbf, 0x57, 0xec, 0x5c, 0x95, 0x3b,
-	0x1f, 0x67, 0x32, 0x4b, 0x5b, 0x43, 0xb3, 0xb4, 0x91, 0xe9, 0xe2, 0x32, 0xb5, 0x12, 0x3a, 0x43,
-	0x6f, 0xb3, 0x3e, 0x3d, 0x44, 0x33, 0x77, 0x9c, 0xaf, 0xb6, 0x80, 0xe6, 0xef, 0x70, 0xef, 0xe1,
-	0x94, 0xe6, 0xd0, 0x56, 0x34, 0xbd, 0xb9, 0x99, 0x1a, 0xde, 0x7d, 0x7b, 0x70, 0x8c, 0x13, 0x68,
-	0xab, 0x9d, 0xb1, 0xd3, 0x4f, 0xba, 0xb2, 0xb9, 0x7f, 0x7e, 0xa5, 0xab, 0x17, 0x57, 0xba, 0xfa,
-	0xe3, 0x4a, 0x57, 0x3f, 0x5e, 0xeb, 0xca, 0xc5, 0xb5, 0xae, 0x7c, 0xbd, 0xd6, 0x95, 0xc3, 0x17,
-	0x8d, 0x19, 0x55, 0x1f, 0xd4, 0x2a, 0xc3, 0x9e, 0x18, 0x18, 0x76, 0xfe, 0xd2, 0xfe, 0xd0, 0xfc,
-	0x43, 0x91, 0x63, 0xf3, 0xc6, 0xe5, 0x17, 0xfc, 0xfc, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaf,
-	0xd9, 0x77, 0xb7, 0x73, 0x04, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0xd9, 0x4a, 0x56, 0x8e, 0xad, 0x30, 0x46, 0x2a, 0x0b, 0x0d, 0xe9, 0xd0, 0x80,
+	0x63, 0x34, 0x08, 0x09, 0xbb, 0x28, 0x02, 0x18, 0x2d, 0x50, 0xc9, 0x6e, 0x50, 0x01, 0x69, 0x1c,
+	0x30, 0x45, 0x0b, 0xe4, 0x42, 0xac, 0xc8, 0x35, 0xbd, 0x10, 0xc9, 0x61, 0xc8, 0x25, 0x1b, 0xbf,
+	0x40, 0xe1, 0x63, 0xfb, 0x06, 0x01, 0x7a, 0xeb, 0x43, 0xf4, 0x9c, 0x63, 0x8e, 0x45, 0x0f, 0x6a,
+	0x61, 0x5f, 0x7a, 0x2b, 0xa0, 0x63, 0x4f, 0xc5, 0xee, 0x92, 0x12, 0x6d, 0xa9, 0x45, 0x73, 0xd2,
+	0xce, 0x7c, 0xf3, 0xf3, 0xcd, 0xb7, 0xcb, 0x11, 0xda, 0x86, 0x34, 0x84, 0x94, 0xa6, 0x56, 0x9a,
+	0xc5, 0x24, 0x39, 0x09, 0x32, 0xea, 0x55, 0x8e, 0x66, 0x9c, 0x00, 0x03, 0x55, 0x2d, 0x82, 0xcc,
+	0x19, 0xd2, 0xdd, 0xf0, 0xc1, 0x07, 0x01, 0x5b, 0xfc, 0x24, 0x23, 0xbb, 0x9a, 0x0f, 0xe0, 0x07,
+	0xc4, 0x12, 0xd6, 0x30, 0x3b, 0xb1, 0xbc, 0x2c, 0xc1, 0x8c, 0x42, 0x54, 0xe0, 0xfa, 0x75, 0x9c,
+	0xd1, 0x90, 0xa4, 0x0c, 0x87, 0x71, 0x59, 0xc0, 0x15, 0xbd, 0xac, 0x21, 0x4e, 0x89, 0x95, 0xef,
+	0x0d, 0x09, 0xc3, 0x7b, 0x96, 0x0b, 0xb4, 0x2c, 0xf0, 0x61, 0x81, 0xfb, 0x90, 0x4f, 0x61, 0x1f,
+	0x72, 0x89, 0x1a, 0x3f, 0xd6, 0xd1, 0xfa, 0x8b, 0x29, 0xc7, 0x5e, 0x9a, 0x12, 0xa6, 0x6e, 0xa0,
+	0x65, 0x8f, 0x44, 0x10, 0x76, 0x94, 0x2d, 0x65, 0xf7, 0xa6, 0x2d, 0x0d, 0xf5, 0x09, 0x42, 0x98,
+	0xc3, 0x0e, 0x3b, 0x8b, 0x49, 0x67, 0x69, 0x4b, 0xd9, 0x5d, 0xdb, 0x7f, 0x60, 0xce, 0xcf, 0x69,
+	0x5e, 0x2b, 0xf7, 0xf5, 0x59, 0x4c, 0xec, 0x9b, 0xb8, 0x3c, 0xaa, 0x9f, 0xa1, 0x5b, 0x71, 0x42,
+	0x5d, 0x1a, 0xf9, 0x8e, 0xec, 0x52, 0xe7, 0x5d, 0xfa, 0x9d, 0xc9, 0x58, 0xdf, 0x38, 0xc3, 0x61,
+	0x70, 0x60, 0x5c, 0x81, 0x0d, 0x7b, 0xb5, 0xb0, 0x8f, 0x04, 0x0d, 0x86, 0x84, 0xb6, 0x4e, 0x19,
+	0x94, 0x40, 0xc6, 0x48, 0xa7, 0xb1, 0x55, 0xdf, 0x6d, 0xed, 0x6f, 0x2f, 0xa2, 0xf3, 0x5c, 0x06,
+	0xda, 0x3c, 0xee, 0x4b, 0x88, 0xfb, 0xf7, 0xdf, 0x8e, 0xf5, 0xda, 0x64, 0xac, 0x6f, 0xca, 0x66,
+	0xf3, 0xc5, 0x0c, 0xbb, 0xcd, 0x9d, 0xd5, 0x3c, 0x15, 0xa3, 0x56, 0x42, 0xd3, 0x91, 0x73, 0x82,
+	0x5d, 0x06, 0x49, 0x67, 0x59, 0x50, 0xfe, 0xfc, 0xb7, 0xb1, 0xbe, 0xe3, 0x53, 0x76, 0x9a, 0x0d,
+	0x4d, 0x17, 0x42, 0xab, 0x10, 0x5a, 0xfe, 0x3c, 0x4a, 0xbd, 0x91, 0xc5, 0xa5, 0x4a, 0xcd, 0x23,
+	0xe2, 0x4e, 0xc6, 0xba, 0x2a, 0xfb, 0x55, 0xca, 0x18, 0x36, 0xe2, 0xd6, 0x13, 0x61, 0x1c, 0xdc,
+	0x38, 0x7f, 0xa3, 0xd7, 0xfe, 0x7c, 0xa3, 0x2b, 0xc6, 0xb9, 0x82, 0xd6, 0xaf, 0xb1, 0x56, 0x1f,
+	0xa2, 0x66, 0x0c, 0x10, 0x38, 0xd4, 0x13, 0xb7, 0xd2, 0xe8, 0xab, 0x93, 0xb1, 0xbe, 0x56, 0xe8,
+	0x25, 0x01, 0xc3, 0x5e, 0xe1, 0xa7, 0x81, 0xa7, 0xf6, 0xd1, 0x3a, 0x83, 0x11, 0x89, 0x1c, 0xc8,
+	0x58, 0x21, 0xf2, 0x92, 0x60, 0xdc, 0x9d, 0x8c, 0xf5, 0xbb, 0x32, 0xe9, 0x5a, 0x80, 0x61, 0xdf,
+	0x12, 0x9e, 0xe3, 0x8c, 0x09, 0x9d, 0x0f, 0x1a, 0x82, 0xca, 0x08, 0xdd, 0x9b, 0x5d, 0xe7, 0x20,
+	0x62, 0x24, 0x09, 0x89, 0x47, 0x71, 0x72, 0xd6, 0x73, 0x5d, 0xc8, 0xa2, 0x7f, 0x7b, 0x2b, 0x9b,
+	0xe8, 0x46, 0x8e, 0x03, 0x07, 0x7b, 0x5e, 0x22, 0x3b, 0xdb, 0xcd, 0x1c, 0x07, 0x3d, 0xcf, 0x4b,
+	0x38, 0xe4, 0xe3, 0xcc, 0x27, 0x7c, 0x12, 0x7e, 0xf3, 0x0d, 0xbb, 0x29, 0xec, 0x81, 0x67, 0xfc,
+	0xa2, 0x20, 0xed, 0x38, 0x0d, 0xe1, 0x8b, 0x57, 0x19, 0xcd, 0x71, 0x40, 0x22, 0xf6, 0x55, 0x16,
+	0x30, 0x1a, 0x07, 0x94, 0x24, 0x36, 0x71, 0x21, 0xf1, 0xd4, 0xfb, 0x68, 0x95, 0xc4, 0xe0, 0x9e,
+	0x3a, 0x51, 0x16, 0x0e, 0x49, 0x22, 0xba, 0xd6, 0xed, 0x96, 0xf0, 0x3d, 0x13, 0xae, 0x19, 0xa3,
+	0xa5, 0x2a, 0x23, 0x17, 0xa1, 0x70, 0x5a, 0xac, 0x78, 0x72, 0x87, 0xfc, 0x25, 0xbc, 0xd7, 0x1d,
+	0xde, 0x96, 0xda, 0xcd, 0x2a, 0x19, 0x76, 0xa5, 0xac, 0xf1, 0x97, 0x82, 0xba, 0x33, 0xb9, 0x8e,
+	0x48, 0x40, 0x7c, 0xf1, 0x29, 0x17, 0xe4, 0x1f, 0xa2, 0xdb, 0x9e, 0xf4, 0x41, 0x22, 0xb4, 0x21,
+	0x69, 0x5a, 0xe8, 0xd6, 0x9e, 0x02, 0x3d, 0xe9, 0xe7, 0xc1, 0x39, 0x0e, 0xa8, 0x77, 0x25, 0x58,
+	0x8e, 0xd4, 0x9e, 0x02, 0x65, 0xf0, 0x77, 0xd3, 0xca, 0x14, 0x22, 0x07, 0x87, 0xfc, 0x6a, 0xc4,
+	0x90, 0xad, 0xfd, 0x4d, 0x53, 0xce, 0x62, 0xf2, 0xfd, 0x60, 0x16, 0x0b, 0xc0, 0x3c, 0x04, 0x1a,
+	0xf5, 0x2d, 0x3e, 0xff, 0xcf, 0xbf, 0xeb, 0x0f, 0xfe, 0xc7, 0xfc, 0x3c, 0x61, 0xca, 0x92, 0x42,
+	0xd4, 0x13, 0x3d, 0x8c, 0x57, 0x68, 0xfb, 0x29, 0xb8, 0xa3, 0xc1, 0xa2, 0xb7, 0x71, 0x08, 0x51,
+	0x44, 0x5c, 0x1e, 0xac, 0x7e, 0x80, 0x9a, 0x01, 0xb8, 0xa3, 0xe9, 0xeb, 0xb5, 0x57, 0x02, 0x91,
+	0xa5, 0xee, 0xa1, 0x0d, 0x5a, 0xc9, 0x74, 0xb0, 0x4c, 0x2d, 0x06, 0xbd, 0x43, 0xe7, 0xab, 0x1a,
+	0x7f, 0x2b, 0x68, 0x6d, 0x26, 0xf2, 0x37, 0xc0, 0x88, 0xfa, 0x18, 0xb5, 0xe2, 0x04, 0x62, 0x48,
+	0x71, 0xe5, 0x03, 0xb9, 0x3b, 0xfb, 0xe6, 0x2a, 0xa0, 0x61, 0xa3, 0xd2, 0x1a, 0x78, 0xea, 0x0e,
+	0x5a, 0xce, 0x81, 0x91, 0xe2, 0x91, 0xf6, 0xdb, 0x93, 0xb1, 0xbe, 0x2a, 0x53, 0x84, 0xdb, 0xb0,
+	0x25, 0xac, 0x7e, 0xaf, 0xa0, 0x26, 0xc4, 0x7c, 0x94, 0xb4, 0x53, 0x17, 0xab, 0x66, 0xa7, 0x94,
+	0x95, 0xaf, 0xd2, 0x52, 0xd5, 0x6f, 0x09, 0xf5, 0x4f, 0x19, 0x11, 0xa4, 0x8e, 0x45, 0x78, 0xff,
+	0xa8, 0xd0, 0xf8, 0xd3, 0xff, 0xd4, 0xf8, 0xb5, 0xd8, 0xce, 0x52, 0xe9, 0xf9, 0x22, 0xa9, 0x5d,
+	0x36, 0xff, 0xe8, 0x25, 0xba, 0xb3, 0x60, 0xbd, 0xaa, 0xf7, 0xd0, 0xe6, 0x02, 0xf7, 0x33, 0xcc,
+	0x68, 0x4e, 0xda, 0x35, 0x55, 0x43, 0xdd, 0x05, 0xf0, 0xd3, 0xe7, 0x2f, 0x4e, 0x71, 0x42, 0xda,
+	0x4a, 0xb7, 0x71, 0xfe, 0x93, 0x56, 0xeb, 0x1f, 0xbf, 0xbd, 0xd0, 0x94, 0x77, 0x17, 0x9a, 0xf2,
+	0xc7, 0x85, 0xa6, 0xfc, 0x70, 0xa9, 0xd5, 0xde, 0x5d, 0x6a, 0xb5, 0x5f, 0x2f, 0xb5, 0xda, 0xcb,
+	0x4f, 0x2a, 0xe4, 0x8b, 0x0d, 0xfb, 0x28, 0xc0, 0xc3, 0xb4, 0x34, 0xac, 0xfc, 0xb1, 0xf5, 0xba,
+	0xfa, 0x7f, 0x28, 0x26, 0x19, 0xae, 0x88, 0xbf, 0x98, 0x8f, 0xff, 0x19, 0x00, 0xb5, 0x92, 0xe6,
+	0x4c, 0x32, 0x07, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -411,6 +559,51 @@ func (this *SuperfluidAsset) Equal(that interface{}) bool {
 	if this.AssetType != that1.AssetType {
 		return false
 	}
+	if this.PricingDenom != that1.PricingDenom {
+		return false
+	}
+	if len(this.OsmoPricingRoute) != len(that1.OsmoPricingRoute) {
+		return false
+	}
+	for i := range this.OsmoPricingRoute {
+		if !this.OsmoPricingRoute[i].Equal(&that1.OsmoPricingRoute[i]) {
+			return false
+		}
+	}
+	if that1.RiskFactor == nil {
+		if this.RiskFactor != nil {
+			return false
+		}
+	} else if !this.RiskFactor.Equal(*that1.RiskFactor) {
+		return false
+	}
+	return true
+}
+func (this *PricingRouteHop) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PricingRouteHop)
+	if !ok {
+		that2, ok := that.(PricingRouteHop)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PoolId != that1.PoolId {
+		return false
+	}
+	if this.TokenOutDenom != that1.TokenOutDenom {
+		return false
+	}
 	return true
 }
 func (m *SuperfluidAsset) Marshal() (dAtA []byte, err error) {
@@ -433,6 +626,39 @@ func (m *SuperfluidAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RiskFactor != nil {
+		{
+			size := m.RiskFactor.Size()
+			i -= size
+			if _, err := m.RiskFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OsmoPricingRoute) > 0 {
+		for iNdEx := len(m.OsmoPricingRoute) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OsmoPricingRoute[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PricingDenom) > 0 {
+		i -= len(m.PricingDenom)
+		copy(dAtA[i:], m.PricingDenom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.PricingDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.AssetType != 0 {
 		i = encodeVarintSuperfluid(dAtA, i, uint64(m.AssetType))
 		i--
@@ -448,6 +674,41 @@ func (m *SuperfluidAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PricingRouteHop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricingRouteHop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricingRouteHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SuperfluidIntermediaryAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -617,6 +878,55 @@ func (m *LockIdIntermediaryAccountConnection) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *SuperfluidVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSuperfluid(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSuperfluid(v)
 	base := offset
@@ -641,6 +951,36 @@ func (m *SuperfluidAsset) Size() (n int) {
 	if m.AssetType != 0 {
 		n += 1 + sovSuperfluid(uint64(m.AssetType))
 	}
+	l = len(m.PricingDenom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if len(m.OsmoPricingRoute) > 0 {
+		for _, e := range m.OsmoPricingRoute {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	if m.RiskFactor != nil {
+		l = m.RiskFactor.Size()
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	return n
+}
+
+func (m *PricingRouteHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
 	return n
 }
 
@@ -717,6 +1057,28 @@ func (m *LockIdIntermediaryAccountConnection) Size() (n int) {
 	return n
 }
 
+func (m *SuperfluidVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSuperfluid(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -803,38 +1165,241 @@ func (m *SuperfluidAsset) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSuperfluid(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSuperfluid
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSuperfluid
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
 			}
-			if iNdEx >= l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricingDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoPricingRoute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsmoPricingRoute = append(m.OsmoPricingRoute, PricingRouteHop{})
+			if err := m.OsmoPricingRoute[len(m.OsmoPricingRoute)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RiskFactor = &v
+			if err := m.RiskFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PricingRouteHop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricingRouteHop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricingRouteHop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidIntermediaryAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -1340,6 +1905,141 @@ func (m *LockIdIntermediaryAccountConnection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SuperfluidVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, types1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSuperfluid(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
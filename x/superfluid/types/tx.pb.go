@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_x_gov_types "github.com/cosmos/cosmos-sdk/x/gov/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/gov/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -303,6 +305,211 @@ func (m *MsgSuperfluidUnbondLockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSuperfluidUnbondLockResponse proto.InternalMessageInfo
 
+type MsgSuperfluidRedelegate struct {
+	Sender     string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId     uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	NewValAddr string `protobuf:"bytes,3,opt,name=new_val_addr,json=newValAddr,proto3" json:"new_val_addr,omitempty"`
+}
+
+func (m *MsgSuperfluidRedelegate) Reset()         { *m = MsgSuperfluidRedelegate{} }
+func (m *MsgSuperfluidRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegate) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{6}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegate.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegate proto.InternalMessageInfo
+
+func (m *MsgSuperfluidRedelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidRedelegate) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidRedelegate) GetNewValAddr() string {
+	if m != nil {
+		return m.NewValAddr
+	}
+	return ""
+}
+
+type MsgSuperfluidRedelegateResponse struct {
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Reset()         { *m = MsgSuperfluidRedelegateResponse{} }
+func (m *MsgSuperfluidRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidRedelegateResponse) ProtoMessage()    {}
+func (*MsgSuperfluidRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{7}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidRedelegateResponse proto.InternalMessageInfo
+
+// MsgSuperfluidUndelegatePartial splits the coin off the lock into a new
+// lockup, and superfluid undelegates the new lockup. The rest of the lock
+// stays superfluid delegated.
+type MsgSuperfluidUndelegatePartial struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId uint64     `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	Coin   types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *MsgSuperfluidUndelegatePartial) Reset()         { *m = MsgSuperfluidUndelegatePartial{} }
+func (m *MsgSuperfluidUndelegatePartial) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidUndelegatePartial) ProtoMessage()    {}
+func (*MsgSuperfluidUndelegatePartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{8}
+}
+func (m *MsgSuperfluidUndelegatePartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidUndelegatePartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidUndelegatePartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidUndelegatePartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidUndelegatePartial.Merge(m, src)
+}
+func (m *MsgSuperfluidUndelegatePartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidUndelegatePartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidUndelegatePartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidUndelegatePartial proto.InternalMessageInfo
+
+func (m *MsgSuperfluidUndelegatePartial) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidUndelegatePartial) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidUndelegatePartial) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+type MsgSuperfluidUndelegatePartialResponse struct {
+	SplitLockId uint64 `protobuf:"varint,1,opt,name=split_lock_id,json=splitLockId,proto3" json:"split_lock_id,omitempty"`
+}
+
+func (m *MsgSuperfluidUndelegatePartialResponse) Reset() {
+	*m = MsgSuperfluidUndelegatePartialResponse{}
+}
+func (m *MsgSuperfluidUndelegatePartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidUndelegatePartialResponse) ProtoMessage()    {}
+func (*MsgSuperfluidUndelegatePartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{9}
+}
+func (m *MsgSuperfluidUndelegatePartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidUndelegatePartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidUndelegatePartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidUndelegatePartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidUndelegatePartialResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidUndelegatePartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidUndelegatePartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidUndelegatePartialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidUndelegatePartialResponse proto.InternalMessageInfo
+
+func (m *MsgSuperfluidUndelegatePartialResponse) GetSplitLockId() uint64 {
+	if m != nil {
+		return m.SplitLockId
+	}
+	return 0
+}
+
 // MsgLockAndSuperfluidDelegate locks coins with the unbonding period duration,
 // and then does a superfluid lock from the newly created lockup, to the
 // specified validator addr.
@@ -316,7 +523,7 @@ func (m *MsgLockAndSuperfluidDelegate) Reset()         { *m = MsgLockAndSuperflu
 func (m *MsgLockAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegate) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{6}
+	return fileDescriptor_55b645f187d22814, []int{10}
 }
 func (m *MsgLockAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +581,7 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Reset()         { *m = MsgLockAnd
 func (m *MsgLockAndSuperfluidDelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLockAndSuperfluidDelegateResponse) ProtoMessage()    {}
 func (*MsgLockAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_55b645f187d22814, []int{7}
+	return fileDescriptor_55b645f187d22814, []int{11}
 }
 func (m *MsgLockAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,6 +617,105 @@ func (m *MsgLockAndSuperfluidDelegateResponse) GetID() uint64 {
 	return 0
 }
 
+// MsgSuperfluidVote votes on a governance proposal in the voting period with
+// the osmo equivalent of the superfluid delegated locks of the sender, instead
+// of the validators they are delegated to.
+type MsgSuperfluidVote struct {
+	Sender     string                                                       `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	ProposalId uint64                                                       `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Options    github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/gov/types.WeightedVoteOptions" json:"options"`
+}
+
+func (m *MsgSuperfluidVote) Reset()         { *m = MsgSuperfluidVote{} }
+func (m *MsgSuperfluidVote) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidVote) ProtoMessage()    {}
+func (*MsgSuperfluidVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{12}
+}
+func (m *MsgSuperfluidVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidVote.Merge(m, src)
+}
+func (m *MsgSuperfluidVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidVote proto.InternalMessageInfo
+
+func (m *MsgSuperfluidVote) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSuperfluidVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgSuperfluidVote) GetOptions() github_com_cosmos_cosmos_sdk_x_gov_types.WeightedVoteOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type MsgSuperfluidVoteResponse struct {
+}
+
+func (m *MsgSuperfluidVoteResponse) Reset()         { *m = MsgSuperfluidVoteResponse{} }
+func (m *MsgSuperfluidVoteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSuperfluidVoteResponse) ProtoMessage()    {}
+func (*MsgSuperfluidVoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{13}
+}
+func (m *MsgSuperfluidVoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSuperfluidVoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSuperfluidVoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSuperfluidVoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSuperfluidVoteResponse.Merge(m, src)
+}
+func (m *MsgSuperfluidVoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSuperfluidVoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSuperfluidVoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSuperfluidVoteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -417,46 +723,67 @@ func init() {
 	proto.RegisterType((*MsgSuperfluidUndelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateResponse")
 	proto.RegisterType((*MsgSuperfluidUnbondLock)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLock")
 	proto.RegisterType((*MsgSuperfluidUnbondLockResponse)(nil), "osmosis.superfluid.MsgSuperfluidUnbondLockResponse")
+	proto.RegisterType((*MsgSuperfluidRedelegate)(nil), "osmosis.superfluid.MsgSuperfluidRedelegate")
+	proto.RegisterType((*MsgSuperfluidRedelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidRedelegateResponse")
+	proto.RegisterType((*MsgSuperfluidUndelegatePartial)(nil), "osmosis.superfluid.MsgSuperfluidUndelegatePartial")
+	proto.RegisterType((*MsgSuperfluidUndelegatePartialResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegatePartialResponse")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegate")
 	proto.RegisterType((*MsgLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgLockAndSuperfluidDelegateResponse")
+	proto.RegisterType((*MsgSuperfluidVote)(nil), "osmosis.superfluid.MsgSuperfluidVote")
+	proto.RegisterType((*MsgSuperfluidVoteResponse)(nil), "osmosis.superfluid.MsgSuperfluidVoteResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x93, 0x92, 0xc2, 0x22, 0x90, 0x58, 0x15, 0x35, 0x31, 0x60, 0x07, 0xc3, 0x21, 0x08,
-	0x75, 0xb7, 0x69, 0xc5, 0x8f, 0xb8, 0x35, 0xe4, 0x12, 0x89, 0x08, 0xc9, 0x88, 0x0b, 0x12, 0xaa,
-	0xd6, 0xde, 0xed, 0x62, 0xc5, 0xf1, 0x46, 0x5e, 0xdb, 0x4a, 0x9f, 0x80, 0x2b, 0xcf, 0xc1, 0x8b,
-	0xd0, 0x63, 0x8f, 0x9c, 0x0a, 0x4a, 0xde, 0x80, 0x0b, 0x57, 0xe4, 0xdf, 0x50, 0xb0, 0x43, 0x2a,
-	0xe0, 0xe4, 0x9d, 0x99, 0x6f, 0xbe, 0xf9, 0x46, 0xfb, 0x79, 0xc1, 0x2d, 0x21, 0x27, 0x42, 0x3a,
-	0x12, 0xcb, 0x70, 0xca, 0xfc, 0x23, 0x37, 0x74, 0x28, 0x0e, 0x66, 0x68, 0xea, 0x8b, 0x40, 0x40,
-	0x98, 0x15, 0xd1, 0xb2, 0xa8, 0x6e, 0x71, 0xc1, 0x45, 0x52, 0xc6, 0xf1, 0x29, 0x45, 0xaa, 0x1a,
-	0x17, 0x82, 0xbb, 0x0c, 0x27, 0x91, 0x15, 0x1e, 0x61, 0x1a, 0xfa, 0x24, 0x70, 0x84, 0x97, 0xd7,
-	0xed, 0x84, 0x0a, 0x5b, 0x44, 0x32, 0x1c, 0xf5, 0x2c, 0x16, 0x90, 0x1e, 0xb6, 0x85, 0x93, 0xd7,
-	0xef, 0x95, 0xc8, 0x58, 0x1e, 0x53, 0x90, 0x11, 0x81, 0x9b, 0x23, 0xc9, 0x5f, 0x15, 0xe9, 0x01,
-	0x73, 0x19, 0x27, 0x01, 0x83, 0x0f, 0x40, 0x53, 0x32, 0x8f, 0x32, 0xbf, 0xa5, 0x74, 0x94, 0xee,
-	0x95, 0xfe, 0x8d, 0x6f, 0x67, 0xfa, 0xb5, 0x63, 0x32, 0x71, 0x9f, 0x19, 0x69, 0xde, 0x30, 0x33,
-	0x00, 0xdc, 0x06, 0x9b, 0xae, 0xb0, 0xc7, 0x87, 0x0e, 0x6d, 0xd5, 0x3b, 0x4a, 0x77, 0xc3, 0x6c,
-	0xc6, 0xe1, 0x90, 0xc2, 0x36, 0xb8, 0x1c, 0x11, 0xf7, 0x90, 0x50, 0xea, 0xb7, 0x1a, 0x31, 0x8b,
-	0xb9, 0x19, 0x11, 0xf7, 0x80, 0x52, 0xdf, 0xd0, 0xc1, 0x9d, 0xd2, 0xb9, 0x26, 0x93, 0x53, 0xe1,
-	0x49, 0x66, 0xbc, 0x05, 0xdb, 0xe7, 0x00, 0xaf, 0x3d, 0xfa, 0x0f, 0xa5, 0x19, 0x77, 0x81, 0x5e,
-	0x41, 0xbf, 0x42, 0x81, 0x25, 0x3c, 0xfa, 0x42, 0xd8, 0xe3, 0xff, 0xa4, 0x20, 0xa7, 0x2f, 0x14,
-	0x7c, 0x52, 0xc0, 0xed, 0x91, 0xe4, 0x71, 0xee, 0xc0, 0xa3, 0x7f, 0x77, 0x49, 0x04, 0x5c, 0x8a,
-	0xbd, 0x21, 0x5b, 0xf5, 0x4e, 0xa3, 0x7b, 0x75, 0xaf, 0x8d, 0x52, 0xf7, 0xa0, 0xd8, 0x3d, 0x28,
-	0x73, 0x0f, 0x7a, 0x2e, 0x1c, 0xaf, 0xbf, 0x7b, 0x72, 0xa6, 0xd7, 0x3e, 0x7e, 0xd1, 0xbb, 0xdc,
-	0x09, 0xde, 0x85, 0x16, 0xb2, 0xc5, 0x04, 0x67, 0x56, 0x4b, 0x3f, 0x3b, 0x92, 0x8e, 0x71, 0x70,
-	0x3c, 0x65, 0x32, 0x69, 0x90, 0x66, 0xca, 0xbc, 0xea, 0xba, 0x1f, 0x83, 0xfb, 0xab, 0x16, 0xc9,
-	0x37, 0x86, 0xd7, 0x41, 0x7d, 0x38, 0x48, 0x96, 0xd9, 0x30, 0xeb, 0xc3, 0xc1, 0xde, 0xf7, 0x06,
-	0x68, 0x8c, 0x24, 0x87, 0x3e, 0x80, 0x65, 0xeb, 0xa3, 0xdf, 0x7f, 0x26, 0x54, 0x6a, 0x2b, 0xb5,
-	0xb7, 0x36, 0xb4, 0xd0, 0x32, 0x03, 0x5b, 0xa5, 0xf6, 0x7b, 0xf8, 0x47, 0xaa, 0x25, 0x58, 0xdd,
-	0xbf, 0x00, 0xb8, 0x6a, 0x72, 0x61, 0xbb, 0x75, 0x26, 0xe7, 0xe0, 0xb5, 0x26, 0xff, 0xea, 0x38,
-	0xf8, 0x5e, 0x01, 0xed, 0x6a, 0xbb, 0xed, 0x56, 0x50, 0x56, 0x76, 0xa8, 0x4f, 0x2f, 0xda, 0x91,
-	0x2b, 0xe9, 0xbf, 0x3c, 0x99, 0x6b, 0xca, 0xe9, 0x5c, 0x53, 0xbe, 0xce, 0x35, 0xe5, 0xc3, 0x42,
-	0xab, 0x9d, 0x2e, 0xb4, 0xda, 0xe7, 0x85, 0x56, 0x7b, 0xf3, 0xe8, 0x27, 0x5f, 0x66, 0xec, 0x3b,
-	0x2e, 0xb1, 0x64, 0x1e, 0xe0, 0xe8, 0x09, 0x9e, 0x9d, 0x7b, 0x7b, 0x63, 0xab, 0x5a, 0xcd, 0xe4,
-	0xc1, 0xdb, 0xff, 0x11, 0x00, 0x00, 0xff, 0xff, 0x01, 0x37, 0xd6, 0x6a, 0x9e, 0x05, 0x00, 0x00,
+	// 749 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x4f, 0xd4, 0x40,
+	0x14, 0xdf, 0xee, 0xe2, 0xa2, 0x0f, 0x21, 0xa1, 0x41, 0xd9, 0x2d, 0xd8, 0x5d, 0xab, 0x12, 0x8c,
+	0xa1, 0x05, 0x36, 0x8a, 0x21, 0x5e, 0x58, 0xf7, 0xb2, 0x09, 0x04, 0x53, 0x23, 0x26, 0x26, 0x66,
+	0xd3, 0xdd, 0x19, 0x4a, 0x43, 0xe9, 0x34, 0x9d, 0x6e, 0x59, 0x4e, 0x7a, 0xd2, 0x93, 0x89, 0x57,
+	0xbf, 0x82, 0x5f, 0x44, 0x8e, 0x1c, 0x3d, 0xa1, 0x81, 0x93, 0x57, 0x3e, 0x81, 0xe9, 0x9f, 0xe9,
+	0xc2, 0xfe, 0x29, 0x34, 0xe2, 0xa9, 0x9d, 0x79, 0xbf, 0x79, 0xef, 0xf7, 0x7e, 0x6f, 0xde, 0x6b,
+	0x61, 0x86, 0xd0, 0x3d, 0x42, 0x0d, 0xaa, 0xd0, 0xb6, 0x8d, 0x9d, 0x6d, 0xb3, 0x6d, 0x20, 0xc5,
+	0xed, 0xc8, 0xb6, 0x43, 0x5c, 0xc2, 0xf3, 0x91, 0x51, 0xee, 0x1a, 0x85, 0x29, 0x9d, 0xe8, 0x24,
+	0x30, 0x2b, 0xfe, 0x5b, 0x88, 0x14, 0x44, 0x9d, 0x10, 0xdd, 0xc4, 0x4a, 0xb0, 0x6a, 0xb6, 0xb7,
+	0x15, 0xd4, 0x76, 0x34, 0xd7, 0x20, 0x16, 0xb3, 0xb7, 0x02, 0x57, 0x4a, 0x53, 0xa3, 0x58, 0xf1,
+	0x96, 0x9a, 0xd8, 0xd5, 0x96, 0x94, 0x16, 0x31, 0x98, 0x7d, 0x36, 0xb2, 0xeb, 0xc4, 0x8b, 0xcd,
+	0x3a, 0xf1, 0x22, 0xeb, 0x83, 0x01, 0x24, 0xbb, 0xaf, 0x21, 0x48, 0xf2, 0xe0, 0xce, 0x06, 0xd5,
+	0x5f, 0xc7, 0xdb, 0x35, 0x6c, 0x62, 0x5d, 0x73, 0x31, 0xff, 0x18, 0xf2, 0x14, 0x5b, 0x08, 0x3b,
+	0x05, 0xae, 0xcc, 0xcd, 0xdf, 0xaa, 0x4e, 0x9e, 0x1d, 0x97, 0xc6, 0x0f, 0xb4, 0x3d, 0x73, 0x55,
+	0x0a, 0xf7, 0x25, 0x35, 0x02, 0xf0, 0xd3, 0x30, 0x6a, 0x92, 0xd6, 0x6e, 0xc3, 0x40, 0x85, 0x6c,
+	0x99, 0x9b, 0x1f, 0x51, 0xf3, 0xfe, 0xb2, 0x8e, 0xf8, 0x22, 0xdc, 0xf4, 0x34, 0xb3, 0xa1, 0x21,
+	0xe4, 0x14, 0x72, 0xbe, 0x17, 0x75, 0xd4, 0xd3, 0xcc, 0x35, 0x84, 0x1c, 0xa9, 0x04, 0xf7, 0x06,
+	0xc6, 0x55, 0x31, 0xb5, 0x89, 0x45, 0xb1, 0xf4, 0x1e, 0xa6, 0x2f, 0x00, 0xde, 0x58, 0xe8, 0x1a,
+	0xa9, 0x49, 0xf7, 0xa1, 0x34, 0xc4, 0x7d, 0x02, 0x83, 0x26, 0xb1, 0xd0, 0x3a, 0x69, 0xed, 0xfe,
+	0x27, 0x06, 0xcc, 0x7d, 0xcc, 0xe0, 0x43, 0x0f, 0x03, 0x15, 0x5f, 0xa7, 0x06, 0x7c, 0x19, 0x6e,
+	0x5b, 0x78, 0xbf, 0xd1, 0x53, 0x22, 0xb0, 0xf0, 0xfe, 0x56, 0x54, 0xa5, 0x5e, 0x8e, 0x5d, 0x02,
+	0x31, 0xc7, 0x6f, 0x1c, 0x88, 0x43, 0x94, 0x7c, 0xa5, 0x39, 0xae, 0xa1, 0x99, 0xd7, 0xc2, 0xb5,
+	0x02, 0x23, 0xfe, 0xc5, 0x0f, 0x38, 0x8e, 0x2d, 0x17, 0xe5, 0xf0, 0xe6, 0xcb, 0x7e, 0x67, 0xc8,
+	0xd1, 0xd5, 0x97, 0x5f, 0x12, 0xc3, 0xaa, 0x8e, 0x1c, 0x1e, 0x97, 0x32, 0x6a, 0x00, 0x96, 0xd6,
+	0x61, 0x2e, 0x99, 0x1a, 0xcb, 0x82, 0x97, 0x60, 0x9c, 0xda, 0xa6, 0xe1, 0x36, 0x58, 0x74, 0x2e,
+	0x88, 0x3e, 0x16, 0x6c, 0xae, 0x87, 0x05, 0xfb, 0xc1, 0xc1, 0xec, 0x06, 0xd5, 0xfd, 0xd5, 0x9a,
+	0x85, 0xfe, 0xad, 0x65, 0x34, 0xb8, 0xe1, 0x33, 0xa4, 0x85, 0x6c, 0x39, 0x97, 0x9c, 0xcf, 0xa2,
+	0x9f, 0xcf, 0xf7, 0x5f, 0xa5, 0x79, 0xdd, 0x70, 0x77, 0xda, 0x4d, 0xb9, 0x45, 0xf6, 0x94, 0xa8,
+	0xed, 0xc3, 0xc7, 0x02, 0x45, 0xbb, 0x8a, 0x7b, 0x60, 0x63, 0x1a, 0x1c, 0xa0, 0x6a, 0xe8, 0x39,
+	0xa9, 0xf9, 0x9e, 0xc1, 0xc3, 0xa4, 0x44, 0x62, 0x55, 0x26, 0x20, 0x5b, 0xaf, 0x45, 0x52, 0x64,
+	0xeb, 0x35, 0xe9, 0x63, 0x16, 0x26, 0x2f, 0x08, 0xba, 0x45, 0xd2, 0xa5, 0xbd, 0x02, 0x63, 0xb6,
+	0x43, 0x6c, 0x42, 0x35, 0x33, 0x2e, 0x71, 0xf5, 0xee, 0xd9, 0x71, 0x89, 0x0f, 0xf1, 0xe7, 0x8c,
+	0x92, 0x0a, 0x6c, 0x55, 0x47, 0xfc, 0x27, 0x0e, 0x46, 0x89, 0xed, 0x8f, 0x46, 0x5a, 0xc8, 0x05,
+	0x92, 0xcd, 0x31, 0xc9, 0xfc, 0x81, 0xc7, 0x14, 0x7b, 0x8b, 0x0d, 0x7d, 0xc7, 0xc5, 0x01, 0xaf,
+	0xcd, 0x00, 0x5e, 0xad, 0x45, 0xfa, 0xbd, 0x48, 0xd4, 0xaf, 0x13, 0xcc, 0xd0, 0x50, 0xc5, 0x7e,
+	0x27, 0x54, 0x65, 0xc1, 0xa5, 0x19, 0x28, 0xf6, 0x29, 0xc0, 0xf4, 0x5a, 0xfe, 0x93, 0x87, 0xdc,
+	0x06, 0xd5, 0x79, 0x07, 0xf8, 0x41, 0xd7, 0x43, 0xee, 0xff, 0x30, 0xc8, 0x03, 0x87, 0xa0, 0xb0,
+	0x74, 0x65, 0x68, 0x5c, 0xab, 0x0e, 0x4c, 0x0d, 0x1c, 0x96, 0x4f, 0x2e, 0x75, 0xd5, 0x05, 0x0b,
+	0x95, 0x14, 0xe0, 0xc1, 0x91, 0x55, 0x9c, 0x22, 0xb2, 0x8a, 0x53, 0x44, 0xee, 0x9f, 0x3d, 0xfc,
+	0x17, 0x0e, 0x66, 0x92, 0x06, 0xcf, 0x72, 0x8a, 0x74, 0xa2, 0x33, 0xc2, 0x6a, 0xfa, 0x33, 0xc3,
+	0x6a, 0x10, 0x7f, 0x2e, 0xae, 0x52, 0x03, 0x06, 0x16, 0x2a, 0x29, 0xc0, 0x71, 0xe4, 0xcf, 0x1c,
+	0x14, 0x87, 0x0f, 0xa6, 0xc5, 0x21, 0x2e, 0x87, 0x9e, 0x10, 0x9e, 0xa7, 0x3d, 0x11, 0x33, 0xd9,
+	0x86, 0x89, 0x9e, 0xf9, 0xf0, 0xe8, 0xd2, 0x84, 0x7c, 0x98, 0xb0, 0x70, 0x25, 0x18, 0x8b, 0x53,
+	0xdd, 0x3c, 0x3c, 0x11, 0xb9, 0xa3, 0x13, 0x91, 0xfb, 0x7d, 0x22, 0x72, 0x5f, 0x4f, 0xc5, 0xcc,
+	0xd1, 0xa9, 0x98, 0xf9, 0x79, 0x2a, 0x66, 0xde, 0x3d, 0x3d, 0xd7, 0xe9, 0x91, 0xcb, 0x05, 0x53,
+	0x6b, 0x52, 0xb6, 0x50, 0xbc, 0x15, 0xa5, 0x73, 0xe1, 0xcf, 0xcd, 0x6f, 0xfb, 0x66, 0x3e, 0xf8,
+	0x21, 0xaa, 0xfc, 0x1d, 0x00, 0x8a, 0x11, 0xa9, 0xbd, 0xdc, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -475,11 +802,19 @@ type MsgClient interface {
 	SuperfluidDelegate(ctx context.Context, in *MsgSuperfluidDelegate, opts ...grpc.CallOption) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error)
+	// Execute superfluid undelegation for a part of a lockup, which is split
+	// into a new lockup
+	SuperfluidUndelegatePartial(ctx context.Context, in *MsgSuperfluidUndelegatePartial, opts ...grpc.CallOption) (*MsgSuperfluidUndelegatePartialResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error)
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgLockAndSuperfluidDelegateResponse, error)
+	// Vote on a governance proposal for the superfluid delegated locks of the
+	// sender, overriding the vote of their validators
+	SuperfluidVote(ctx context.Context, in *MsgSuperfluidVote, opts ...grpc.CallOption) (*MsgSuperfluidVoteResponse, error)
 }
 
 type msgClient struct {
@@ -508,6 +843,24 @@ func (c *msgClient) SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidU
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error) {
+	out := new(MsgSuperfluidRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SuperfluidUndelegatePartial(ctx context.Context, in *MsgSuperfluidUndelegatePartial, opts ...grpc.CallOption) (*MsgSuperfluidUndelegatePartialResponse, error) {
+	out := new(MsgSuperfluidUndelegatePartialResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUndelegatePartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SuperfluidUnbondLock(ctx context.Context, in *MsgSuperfluidUnbondLock, opts ...grpc.CallOption) (*MsgSuperfluidUnbondLockResponse, error) {
 	out := new(MsgSuperfluidUnbondLockResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidUnbondLock", in, out, opts...)
@@ -526,17 +879,34 @@ func (c *msgClient) LockAndSuperfluidDelegate(ctx context.Context, in *MsgLockAn
 	return out, nil
 }
 
+func (c *msgClient) SuperfluidVote(ctx context.Context, in *MsgSuperfluidVote, opts ...grpc.CallOption) (*MsgSuperfluidVoteResponse, error) {
+	out := new(MsgSuperfluidVoteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SuperfluidVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Execute superfluid delegation for a lockup
 	SuperfluidDelegate(context.Context, *MsgSuperfluidDelegate) (*MsgSuperfluidDelegateResponse, error)
 	// Execute superfluid undelegation for a lockup
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
+	// Execute superfluid redelegation for a lockup
+	SuperfluidRedelegate(context.Context, *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error)
+	// Execute superfluid undelegation for a part of a lockup, which is split
+	// into a new lockup
+	SuperfluidUndelegatePartial(context.Context, *MsgSuperfluidUndelegatePartial) (*MsgSuperfluidUndelegatePartialResponse, error)
 	// For a given lock that is being superfluidly undelegated,
 	// also unbond the underlying lock.
 	SuperfluidUnbondLock(context.Context, *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error)
 	// Execute lockup lock and superfluid delegation in a single msg
 	LockAndSuperfluidDelegate(context.Context, *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error)
+	// Vote on a governance proposal for the superfluid delegated locks of the
+	// sender, overriding the vote of their validators
+	SuperfluidVote(context.Context, *MsgSuperfluidVote) (*MsgSuperfluidVoteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -549,12 +919,21 @@ func (*UnimplementedMsgServer) SuperfluidDelegate(ctx context.Context, req *MsgS
 func (*UnimplementedMsgServer) SuperfluidUndelegate(ctx context.Context, req *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidRedelegate(ctx context.Context, req *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegate not implemented")
+}
+func (*UnimplementedMsgServer) SuperfluidUndelegatePartial(ctx context.Context, req *MsgSuperfluidUndelegatePartial) (*MsgSuperfluidUndelegatePartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegatePartial not implemented")
+}
 func (*UnimplementedMsgServer) SuperfluidUnbondLock(ctx context.Context, req *MsgSuperfluidUnbondLock) (*MsgSuperfluidUnbondLockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUnbondLock not implemented")
 }
 func (*UnimplementedMsgServer) LockAndSuperfluidDelegate(ctx context.Context, req *MsgLockAndSuperfluidDelegate) (*MsgLockAndSuperfluidDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockAndSuperfluidDelegate not implemented")
 }
+func (*UnimplementedMsgServer) SuperfluidVote(ctx context.Context, req *MsgSuperfluidVote) (*MsgSuperfluidVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidVote not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -596,6 +975,42 @@ func _Msg_SuperfluidUndelegate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidRedelegate(ctx, req.(*MsgSuperfluidRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SuperfluidUndelegatePartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidUndelegatePartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidUndelegatePartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidUndelegatePartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidUndelegatePartial(ctx, req.(*MsgSuperfluidUndelegatePartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SuperfluidUnbondLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSuperfluidUnbondLock)
 	if err := dec(in); err != nil {
@@ -632,6 +1047,24 @@ func _Msg_LockAndSuperfluidDelegate_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SuperfluidVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSuperfluidVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SuperfluidVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SuperfluidVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SuperfluidVote(ctx, req.(*MsgSuperfluidVote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -644,6 +1077,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidUndelegate",
 			Handler:    _Msg_SuperfluidUndelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidRedelegate",
+			Handler:    _Msg_SuperfluidRedelegate_Handler,
+		},
+		{
+			MethodName: "SuperfluidUndelegatePartial",
+			Handler:    _Msg_SuperfluidUndelegatePartial_Handler,
+		},
 		{
 			MethodName: "SuperfluidUnbondLock",
 			Handler:    _Msg_SuperfluidUnbondLock_Handler,
@@ -652,6 +1093,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LockAndSuperfluidDelegate",
 			Handler:    _Msg_LockAndSuperfluidDelegate_Handler,
 		},
+		{
+			MethodName: "SuperfluidVote",
+			Handler:    _Msg_SuperfluidVote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -838,7 +1283,7 @@ func (m *MsgSuperfluidUnbondLockResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgSuperfluidRedelegate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -848,18 +1293,156 @@ func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLockAndSuperfluidDelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidRedelegate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLockAndSuperfluidDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSuperfluidRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
+	if len(m.NewValAddr) > 0 {
+		i -= len(m.NewValAddr)
+		copy(dAtA[i:], m.NewValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidUndelegatePartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidUndelegatePartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidUndelegatePartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidUndelegatePartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidUndelegatePartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidUndelegatePartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SplitLockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SplitLockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockAndSuperfluidDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockAndSuperfluidDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
 		copy(dAtA[i:], m.ValAddr)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
 		i--
@@ -917,6 +1500,78 @@ func (m *MsgLockAndSuperfluidDelegateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSuperfluidVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSuperfluidVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSuperfluidVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSuperfluidVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1007,6 +1662,65 @@ func (m *MsgSuperfluidUnbondLockResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSuperfluidRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = len(m.NewValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSuperfluidUndelegatePartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSuperfluidUndelegatePartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SplitLockId != 0 {
+		n += 1 + sovTx(uint64(m.SplitLockId))
+	}
+	return n
+}
+
 func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1023,32 +1737,548 @@ func (m *MsgLockAndSuperfluidDelegate) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockAndSuperfluidDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgSuperfluidVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSuperfluidVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgLockAndSuperfluidDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1071,10 +2301,10 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1130,7 +2360,7 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1158,7 +2388,7 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.NewValAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1181,7 +2411,7 @@ func (m *MsgSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1204,10 +2434,10 @@ func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1231,7 +2461,7 @@ func (m *MsgSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidUndelegatePartial) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1254,10 +2484,10 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidUndelegatePartial: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidUndelegatePartial: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1311,6 +2541,39 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1332,7 +2595,7 @@ func (m *MsgSuperfluidUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidUndelegatePartialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1355,12 +2618,31 @@ func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidUndelegatePartialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidUndelegatePartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitLockId", wireType)
+			}
+			m.SplitLockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SplitLockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1382,7 +2664,7 @@ func (m *MsgSuperfluidUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
+func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1405,10 +2687,10 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1444,10 +2726,10 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
-			m.LockId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1457,11 +2739,58 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1483,7 +2812,7 @@ func (m *MsgSuperfluidUnbondLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1506,12 +2835,31 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSuperfluidUnbondLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1533,7 +2881,7 @@ func (m *MsgSuperfluidUnbondLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1556,10 +2904,10 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1595,10 +2943,10 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1608,31 +2956,16 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1642,23 +2975,25 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.Options = append(m.Options, types1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1681,7 +3016,7 @@ func (m *MsgLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSuperfluidVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,31 +3039,12 @@ func (m *MsgLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSuperfluidVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockAndSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSuperfluidVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdParams(),
+		GetCmdArithmeticTwap(),
+		GetCmdLatestAccumulator(),
+	)
+
+	return cmd
+}
+
+// GetCmdParams returns the params of the module.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the params for the x/twap module",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.ParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdArithmeticTwap returns the arithmetic twap of a pool's asset pair.
+func GetCmdArithmeticTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "arithmetic <poolID> <base denom> <quote denom> <start time> [end time]",
+		Short: "Query the arithmetic twap of the quote denom's price, in units of the base denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the arithmetic time weighted average price of the quote denom, in units of the base denom.
+Times are in RFC3339 format. The end time defaults to the latest block time.
+Example:
+$ %s query twap arithmetic 1 uosmo uatom 2022-06-01T00:00:00Z 2022-06-02T00:00:00Z
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			var endTime *time.Time
+			if len(args) == 5 {
+				t, err := time.Parse(time.RFC3339, args[4])
+				if err != nil {
+					return err
+				}
+				endTime = &t
+			}
+
+			res, err := queryClient.ArithmeticTwap(cmd.Context(), &types.ArithmeticTwapRequest{
+				PoolId:     poolID,
+				BaseAsset:  args[1],
+				QuoteAsset: args[2],
+				StartTime:  startTime,
+				EndTime:    endTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdLatestAccumulator returns the latest twap record of a pool's asset pair.
+func GetCmdLatestAccumulator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-accumulator <poolID> <denom> <denom>",
+		Short: "Query the twap accumulators of a pool's asset pair at the latest block time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the twap accumulators of a pool's asset pair at the latest block time.
+Example:
+$ %s query twap latest-accumulator 1 uosmo uatom
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GetLatestAccumulator(cmd.Context(), &types.GetLatestAccumulatorRequest{
+				PoolId:     poolID,
+				BaseAsset:  args[1],
+				QuoteAsset: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier defines a wrapper around the x/twap keeper providing gRPC method
+// handlers.
+type Querier struct {
+	Keeper
+}
+
+func NewQuerier(k Keeper) Querier {
+	return Querier{Keeper: k}
+}
+
+func (q Querier) Params(ctx context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.ParamsResponse{Params: q.Keeper.GetParams(sdkCtx)}, nil
+}
+
+func (q Querier) ArithmeticTwap(ctx context.Context, req *types.ArithmeticTwapRequest) (*types.ArithmeticTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	endTime := sdkCtx.BlockTime()
+	if req.EndTime != nil {
+		endTime = *req.EndTime
+	}
+
+	twap, err := q.Keeper.GetArithmeticTwap(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset, req.StartTime, endTime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.ArithmeticTwapResponse{ArithmeticTwap: twap}, nil
+}
+
+func (q Querier) GetLatestAccumulator(ctx context.Context, req *types.GetLatestAccumulatorRequest) (*types.GetLatestAccumulatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record, err := q.Keeper.GetLatestAccumulatorRecord(sdkCtx, req.PoolId, req.BaseAsset, req.QuoteAsset)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.GetLatestAccumulatorResponse{Record: record}, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+type gammhook struct {
+	k Keeper
+}
+
+var _ gammtypes.GammHooks = &gammhook{}
+
+// GammHooks returns the hooks that keep the twap records up to date with the pools.
+func (k Keeper) GammHooks() gammtypes.GammHooks {
+	return &gammhook{k}
+}
+
+// AfterPoolCreated creates the twap records of the new pool.
+func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	if err := hook.k.afterCreatePool(ctx, poolId); err != nil {
+		hook.k.Logger(ctx).Error("failed to create twap records", "pool_id", poolId, "error", err)
+	}
+}
+
+// AfterSwap updates the twap records of the pool.
+func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	hook.updateRecords(ctx, poolId)
+}
+
+// AfterJoinPool updates the twap records of the pool.
+func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	hook.updateRecords(ctx, poolId)
+}
+
+// AfterExitPool updates the twap records of the pool.
+func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	hook.updateRecords(ctx, poolId)
+}
+
+// updateRecords updates the twap records of the pool. A failure to update them,
+// e.g. because a pool was drained of an asset and has no spot price, is logged rather than
+// failing the pool operation, and the prior spot prices keep accumulating.
+func (hook *gammhook) updateRecords(ctx sdk.Context, poolId uint64) {
+	if err := hook.k.updateRecords(ctx, poolId); err != nil {
+		hook.k.Logger(ctx).Error("failed to update twap records", "pool_id", poolId, "error", err)
+	}
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v7/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+// prepareFooBarPool creates a pool with 1_000_000 foo and 1_000_000 bar, so that their spot price is 1.
+func (suite *KeeperTestSuite) prepareFooBarPool() uint64 {
+	return suite.PrepareUni2PoolWithAssets(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000))
+}
+
+func (suite *KeeperTestSuite) advanceTime(d time.Duration) {
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(d)).WithBlockHeight(suite.Ctx.BlockHeight() + 1)
+}
+
+// swapFooForBar swaps foo into the pool, and returns the new spot price of bar, in units of foo.
+func (suite *KeeperTestSuite) swapFooForBar(poolId uint64, fooIn int64) sdk.Dec {
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", fooIn)))
+	_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewInt64Coin("foo", fooIn), "bar", sdk.OneInt())
+	suite.Require().NoError(err)
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+	return spotPrice
+}
+
+func (suite *KeeperTestSuite) TestAfterPoolCreated() {
+	suite.SetupTest()
+	poolId := suite.PrepareBalancerPool()
+
+	// one record for each of the foo/bar, foo/baz and bar/baz pairs
+	records := suite.App.TwapKeeper.ExportGenesis(suite.Ctx).Twaps
+	suite.Require().Len(records, 3)
+	for _, record := range records {
+		suite.Require().Equal(poolId, record.PoolId)
+		suite.Require().True(record.Asset0Denom < record.Asset1Denom)
+		suite.Require().Equal(suite.Ctx.BlockTime(), record.Time)
+		suite.Require().True(record.P0ArithmeticTwapAccumulator.IsZero())
+		suite.Require().True(record.P1ArithmeticTwapAccumulator.IsZero())
+
+		p0, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, record.Asset1Denom, record.Asset0Denom)
+		suite.Require().NoError(err)
+		suite.Require().Equal(p0, record.P0LastSpotPrice)
+	}
+}
+
+func (suite *KeeperTestSuite) TestArithmeticTwap() {
+	suite.SetupTest()
+	poolId := suite.prepareFooBarPool()
+	startTime := suite.Ctx.BlockTime()
+
+	// the price of bar is 1 foo for an hour, then p foo for two hours
+	suite.advanceTime(time.Hour)
+	p := suite.swapFooForBar(poolId, 100_000)
+	suite.Require().True(p.GT(sdk.OneDec()))
+	suite.advanceTime(2 * time.Hour)
+
+	tests := map[string]struct {
+		baseAsset, quoteAsset string
+		start, end            time.Time
+		expectedTwap          sdk.Dec
+		expectErr             bool
+	}{
+		"before the swap": {
+			baseAsset: "foo", quoteAsset: "bar",
+			start: startTime, end: startTime.Add(time.Hour),
+			expectedTwap: sdk.OneDec(),
+		},
+		"after the swap": {
+			baseAsset: "foo", quoteAsset: "bar",
+			start: startTime.Add(time.Hour), end: startTime.Add(3 * time.Hour),
+			expectedTwap: p,
+		},
+		"over the swap": {
+			baseAsset: "foo", quoteAsset: "bar",
+			start: startTime, end: startTime.Add(3 * time.Hour),
+			expectedTwap: sdk.OneDec().Add(p.MulInt64(2)).QuoInt64(3),
+		},
+		"over the swap, reverse pair": {
+			baseAsset: "bar", quoteAsset: "foo",
+			start: startTime, end: startTime.Add(3 * time.Hour),
+			expectedTwap: sdk.OneDec().Add(sdk.OneDec().Quo(p).MulInt64(2)).QuoInt64(3),
+		},
+		"start before the pool existed": {
+			baseAsset: "foo", quoteAsset: "bar",
+			start: startTime.Add(-time.Second), end: startTime.Add(time.Hour),
+			expectErr: true,
+		},
+		"end after the block time": {
+			baseAsset: "foo", quoteAsset: "bar",
+			start: startTime, end: startTime.Add(4 * time.Hour),
+			expectErr: true,
+		},
+		"start after end": {
+			baseAsset: "foo", quoteAsset: "bar",
+			start: startTime.Add(time.Hour), end: startTime,
+			expectErr: true,
+		},
+		"asset not in pool": {
+			baseAsset: "foo", quoteAsset: "baz",
+			start: startTime, end: startTime.Add(time.Hour),
+			expectErr: true,
+		},
+		"same asset": {
+			baseAsset: "foo", quoteAsset: "foo",
+			start: startTime, end: startTime.Add(time.Hour),
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			twap, err := suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, poolId, tc.baseAsset, tc.quoteAsset, tc.start, tc.end)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(tc.expectedTwap.Sub(twap).Abs().LTE(sdk.NewDecWithPrec(1, 8)),
+				"expected %s, got %s", tc.expectedTwap, twap)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestLastSwapInBlockSetsPrice() {
+	suite.SetupTest()
+	poolId := suite.prepareFooBarPool()
+	startTime := suite.Ctx.BlockTime()
+
+	// only the price at the end of a block is used for the time until the next record
+	suite.advanceTime(time.Hour)
+	suite.swapFooForBar(poolId, 500_000)
+	p := suite.swapFooForBar(poolId, 1_000)
+	suite.advanceTime(time.Hour)
+
+	twap, err := suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, poolId, "foo", "bar", startTime.Add(time.Hour), suite.Ctx.BlockTime())
+	suite.Require().NoError(err)
+	suite.Require().Equal(p, twap)
+}
+
+func (suite *KeeperTestSuite) TestGetLatestAccumulatorRecord() {
+	suite.SetupTest()
+	poolId := suite.prepareFooBarPool()
+
+	suite.advanceTime(time.Hour)
+	p := suite.swapFooForBar(poolId, 100_000)
+	suite.advanceTime(time.Hour)
+
+	record, err := suite.App.TwapKeeper.GetLatestAccumulatorRecord(suite.Ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+	suite.Require().Equal("bar", record.Asset0Denom)
+	suite.Require().Equal("foo", record.Asset1Denom)
+	suite.Require().Equal(suite.Ctx.BlockTime(), record.Time)
+	suite.Require().Equal(p, record.P0LastSpotPrice)
+	hourMs := time.Hour.Milliseconds()
+	suite.Require().Equal(sdk.NewDec(hourMs).Add(p.MulInt64(hourMs)), record.P0ArithmeticTwapAccumulator)
+
+	_, err = suite.App.TwapKeeper.GetLatestAccumulatorRecord(suite.Ctx, poolId+1, "foo", "bar")
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestJoinAndExitUpdateRecords() {
+	suite.SetupTest()
+	poolId := suite.prepareFooBarPool()
+
+	suite.advanceTime(time.Hour)
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("foo", 100_000)))
+	_, err := suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolId, sdk.NewCoins(sdk.NewInt64Coin("foo", 100_000)), sdk.OneInt())
+	suite.Require().NoError(err)
+
+	records := suite.App.TwapKeeper.ExportGenesis(suite.Ctx).Twaps
+	suite.Require().Len(records, 2)
+	spotPrice, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolId, "foo", "bar")
+	suite.Require().NoError(err)
+	suite.Require().Equal(spotPrice, records[1].P0LastSpotPrice)
+
+	suite.advanceTime(time.Hour)
+	_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, suite.TestAccs[0], poolId, gammtypes.InitPoolSharesSupply.QuoRaw(10), sdk.Coins{})
+	suite.Require().NoError(err)
+	suite.Require().Len(suite.App.TwapKeeper.ExportGenesis(suite.Ctx).Twaps, 3)
+}
+
+func (suite *KeeperTestSuite) TestPruneRecords() {
+	suite.SetupTest()
+	suite.App.TwapKeeper.SetParams(suite.Ctx, types.NewParams(2*time.Hour))
+	poolId := suite.prepareFooBarPool()
+	startTime := suite.Ctx.BlockTime()
+
+	for i := 0; i < 5; i++ {
+		suite.advanceTime(time.Hour)
+		suite.swapFooForBar(poolId, 10_000)
+	}
+
+	// records at 3h, 4h and 5h are within the keep period, and the one at 2h is kept
+	// to compute accumulators at the start of it
+	records := suite.App.TwapKeeper.ExportGenesis(suite.Ctx).Twaps
+	suite.Require().Len(records, 4)
+	suite.Require().Equal(startTime.Add(2*time.Hour), records[0].Time)
+
+	_, err := suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, poolId, "foo", "bar", startTime.Add(3*time.Hour), suite.Ctx.BlockTime())
+	suite.Require().NoError(err)
+	_, err = suite.App.TwapKeeper.GetArithmeticTwap(suite.Ctx, poolId, "foo", "bar", startTime.Add(time.Hour), suite.Ctx.BlockTime())
+	suite.Require().Error(err)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// storeHistoricalTWAP writes a twap record to state, keyed by its pool, asset pair and time.
+// A record already stored for the same pool, asset pair and time is overwritten.
+func (k Keeper) storeHistoricalTWAP(ctx sdk.Context, record types.TwapRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FormatHistoricalTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time)
+	store.Set(key, k.cdc.MustMarshal(&record))
+}
+
+// getRecordAtOrBeforeTime returns the most recent record of the asset pair in the pool,
+// that is at or before time t.
+func (k Keeper) getRecordAtOrBeforeTime(ctx sdk.Context, poolId uint64, denom0, denom1 string, t time.Time) (types.TwapRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+	pairPrefix := types.FormatHistoricalPairTWAPPrefix(poolId, denom0, denom1)
+	end := sdk.PrefixEndBytes(types.FormatHistoricalTWAPKey(poolId, denom0, denom1, t))
+
+	iter := store.ReverseIterator(pairPrefix, end)
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrRecordNotFound,
+			"no record for pool %d, pair %s/%s at or before %s", poolId, denom0, denom1, t)
+	}
+
+	var record types.TwapRecord
+	k.cdc.MustUnmarshal(iter.Value(), &record)
+	return record, nil
+}
+
+// getMostRecentRecord returns the latest record of the asset pair in the pool.
+func (k Keeper) getMostRecentRecord(ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.TwapRecord, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FormatHistoricalPairTWAPPrefix(poolId, denom0, denom1))
+
+	iter := store.ReverseIterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.TwapRecord{}, sdkerrors.Wrapf(types.ErrRecordNotFound,
+			"no record for pool %d, pair %s/%s", poolId, denom0, denom1)
+	}
+
+	var record types.TwapRecord
+	k.cdc.MustUnmarshal(iter.Value(), &record)
+	return record, nil
+}
+
+// pruneRecordsBeforeTime deletes the records of the asset pair in the pool that are older than t,
+// except for the most recent of them, which is needed to compute accumulators at time t.
+func (k Keeper) pruneRecordsBeforeTime(ctx sdk.Context, poolId uint64, denom0, denom1 string, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	pairPrefix := types.FormatHistoricalPairTWAPPrefix(poolId, denom0, denom1)
+	end := types.FormatHistoricalTWAPKey(poolId, denom0, denom1, t)
+
+	iter := store.ReverseIterator(pairPrefix, end)
+	defer iter.Close()
+	if !iter.Valid() {
+		return
+	}
+
+	// keep the most recent record before t
+	iter.Next()
+	keysToDelete := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keysToDelete = append(keysToDelete, iter.Key())
+	}
+	for _, key := range keysToDelete {
+		store.Delete(key)
+	}
+}
+
+// getAllHistoricalTWAPs returns all the twap records in state.
+func (k Keeper) getAllHistoricalTWAPs(ctx sdk.Context) []types.TwapRecord {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HistoricalTWAPPoolIndexPrefix)
+	defer iter.Close()
+
+	records := []types.TwapRecord{}
+	for ; iter.Valid(); iter.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v7/x/twap/types"
+)
+
+// afterCreatePool creates a twap record with empty accumulators
+// for every asset pair of the newly created pool.
+func (k Keeper) afterCreatePool(ctx sdk.Context, poolId uint64) error {
+	denom0s, denom1s, err := k.getPoolDenomPairs(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	records := make([]types.TwapRecord, len(denom0s))
+	for i := range denom0s {
+		records[i], err = k.newTwapRecord(ctx, poolId, denom0s[i], denom1s[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, record := range records {
+		k.storeHistoricalTWAP(ctx, record)
+	}
+	return nil
+}
+
+// updateRecords accumulates the prior spot prices of every asset pair of the pool
+// up to the current block time, and records the pool's new spot prices.
+// Pairs without records, e.g. of pools created before this module existed, get new records.
+func (k Keeper) updateRecords(ctx sdk.Context, poolId uint64) error {
+	denom0s, denom1s, err := k.getPoolDenomPairs(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	// compute every new record before writing any, so that the records of a pool stay consistent
+	newRecords := make([]types.TwapRecord, len(denom0s))
+	for i := range denom0s {
+		newRecords[i], err = k.newTwapRecord(ctx, poolId, denom0s[i], denom1s[i])
+		if err != nil {
+			return err
+		}
+
+		lastRecord, err := k.getMostRecentRecord(ctx, poolId, denom0s[i], denom1s[i])
+		if err == nil {
+			accumulated := lastRecord.AccumulateTo(ctx.BlockTime())
+			newRecords[i].P0ArithmeticTwapAccumulator = accumulated.P0ArithmeticTwapAccumulator
+			newRecords[i].P1ArithmeticTwapAccumulator = accumulated.P1ArithmeticTwapAccumulator
+		}
+	}
+
+	pruneTime := ctx.BlockTime().Add(-k.GetParams(ctx).RecordHistoryKeepPeriod)
+	for _, record := range newRecords {
+		k.storeHistoricalTWAP(ctx, record)
+		k.pruneRecordsBeforeTime(ctx, poolId, record.Asset0Denom, record.Asset1Denom, pruneTime)
+	}
+	return nil
+}
+
+// newTwapRecord returns a record of the pool's current spot prices for the asset pair, with empty accumulators.
+func (k Keeper) newTwapRecord(ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.TwapRecord, error) {
+	// CalculateSpotPrice(base, quote) is the price of one unit of quote, in units of base.
+	p0, err := k.ammkeeper.CalculateSpotPrice(ctx, poolId, denom1, denom0)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	p1, err := k.ammkeeper.CalculateSpotPrice(ctx, poolId, denom0, denom1)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+
+	return types.TwapRecord{
+		PoolId:                      poolId,
+		Asset0Denom:                 denom0,
+		Asset1Denom:                 denom1,
+		Height:                      ctx.BlockHeight(),
+		Time:                        ctx.BlockTime(),
+		P0LastSpotPrice:             p0,
+		P1LastSpotPrice:             p1,
+		P0ArithmeticTwapAccumulator: sdk.ZeroDec(),
+		P1ArithmeticTwapAccumulator: sdk.ZeroDec(),
+	}, nil
+}
+
+// getPoolDenomPairs returns every lexicographically sorted asset pair of the pool.
+func (k Keeper) getPoolDenomPairs(ctx sdk.Context, poolId uint64) (denom0s, denom1s []string, err error) {
+	pool, err := k.ammkeeper.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	denoms := make([]string, len(liquidity))
+	for i, coin := range liquidity {
+		denoms[i] = coin.Denom
+	}
+	denom0s, denom1s = types.GetAllUniqueDenomPairs(denoms)
+	return denom0s, denom1s, nil
+}
+
+// GetArithmeticTwap returns the arithmetic time weighted average of the spot price
+// of quoteAsset, in units of baseAsset, in the pool over [startTime, endTime].
+// endTime must not be after the current block time, and startTime must be before endTime.
+// Records are only kept for the record history keep period, so startTime must be within it.
+func (k Keeper) GetArithmeticTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAsset string,
+	quoteAsset string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	if !startTime.Before(endTime) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange, "start time %s must be before end time %s", startTime, endTime)
+	}
+	if endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTimeRange, "end time %s must not be after the block time %s", endTime, ctx.BlockTime())
+	}
+
+	startRecord, err := k.getInterpolatedRecord(ctx, poolId, baseAsset, quoteAsset, startTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endRecord, err := k.getInterpolatedRecord(ctx, poolId, baseAsset, quoteAsset, endTime)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	startAccumulator, endAccumulator := startRecord.P1ArithmeticTwapAccumulator, endRecord.P1ArithmeticTwapAccumulator
+	if quoteAsset == startRecord.Asset0Denom {
+		startAccumulator, endAccumulator = startRecord.P0ArithmeticTwapAccumulator, endRecord.P0ArithmeticTwapAccumulator
+	}
+	elapsedMs := sdk.NewDec(endTime.Sub(startTime).Milliseconds())
+	return endAccumulator.Sub(startAccumulator).Quo(elapsedMs), nil
+}
+
+// GetLatestAccumulatorRecord returns the record of the asset pair in the pool,
+// with its accumulators updated to the current block time.
+func (k Keeper) GetLatestAccumulatorRecord(ctx sdk.Context, poolId uint64, asset0Denom string, asset1Denom string) (types.TwapRecord, error) {
+	return k.getInterpolatedRecord(ctx, poolId, asset0Denom, asset1Denom, ctx.BlockTime())
+}
+
+// getInterpolatedRecord returns the record of the asset pair in the pool,
+// with its accumulators computed at time t from the most recent record before it.
+func (k Keeper) getInterpolatedRecord(ctx sdk.Context, poolId uint64, assetA, assetB string, t time.Time) (types.TwapRecord, error) {
+	denom0, denom1, err := types.SortDenomPair(assetA, assetB)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	record, err := k.getRecordAtOrBeforeTime(ctx, poolId, denom0, denom1, t)
+	if err != nil {
+		return types.TwapRecord{}, err
+	}
+	return record.AccumulateTo(t), nil
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/twap module errors.
+var (
+	ErrInvalidTwapRecord   = sdkerrors.Register(ModuleName, 2, "invalid twap record")
+	ErrRecordNotFound      = sdkerrors.Register(ModuleName, 3, "twap record not found")
+	ErrInvalidTimeRange    = sdkerrors.Register(ModuleName, 4, "invalid twap time range")
+	ErrInvalidAssetPair    = sdkerrors.Register(ModuleName, 5, "invalid twap asset pair")
+	ErrInvalidGenesisState = sdkerrors.Register(ModuleName, 6, "invalid twap genesis state")
+)
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v7/x/gamm/types"
+)
+
+// AmmInterface is the functionality needed from a given pool ID, in order to maintain records and serve TWAPs.
+type AmmInterface interface {
+	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	// CalculateSpotPrice returns the spot price of the quote asset in terms of the base asset,
+	// using the specified pool.
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error)
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultGenesis returns the default twap genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Twaps:  []TwapRecord{},
+		Params: DefaultParams(),
+	}
+}
+
+// NewGenesisState returns a new twap genesis state.
+func NewGenesisState(params Params, twaps []TwapRecord) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Twaps:  twaps,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenRecords := map[string]bool{}
+	for _, record := range gs.Twaps {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		key := string(FormatHistoricalTWAPKey(record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time))
+		if seenRecords[key] {
+			return sdkerrors.Wrapf(ErrInvalidGenesisState, "duplicate record for pool %d, pair %s/%s at %s",
+				record.PoolId, record.Asset0Denom, record.Asset1Denom, record.Time)
+		}
+		seenRecords[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "twap"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the twap module.
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+)
+
+// HistoricalTWAPPoolIndexPrefix is the prefix of historical twap records, indexed by
+// pool id, asset pair and time.
+var HistoricalTWAPPoolIndexPrefix = []byte{0x01}
+
+// FormatHistoricalPairTWAPPrefix returns the prefix of all historical records of an asset pair in a pool.
+// Denoms are length prefixed, so that no pair's prefix is a prefix of another pair's.
+func FormatHistoricalPairTWAPPrefix(poolId uint64, denom0, denom1 string) []byte {
+	key := make([]byte, 0, len(HistoricalTWAPPoolIndexPrefix)+8+2+len(denom0)+len(denom1))
+	key = append(key, HistoricalTWAPPoolIndexPrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, byte(len(denom0)))
+	key = append(key, denom0...)
+	key = append(key, byte(len(denom1)))
+	key = append(key, denom1...)
+	return key
+}
+
+// FormatHistoricalTWAPKey returns the key of the historical record of an asset pair in a pool at time t.
+func FormatHistoricalTWAPKey(poolId uint64, denom0, denom1 string, t time.Time) []byte {
+	return append(FormatHistoricalPairTWAPPrefix(poolId, denom0, denom1), sdk.FormatTimeBytes(t)...)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+)
+
+// ParamKeyTable for the twap module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(recordHistoryKeepPeriod time.Duration) Params {
+	return Params{
+		RecordHistoryKeepPeriod: recordHistoryKeepPeriod,
+	}
+}
+
+// default twap module parameters.
+func DefaultParams() Params {
+	return Params{
+		RecordHistoryKeepPeriod: 48 * time.Hour,
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	if err := validateRecordHistoryKeepPeriod(p.RecordHistoryKeepPeriod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validateRecordHistoryKeepPeriod),
+	}
+}
+
+func validateRecordHistoryKeepPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("record history keep period must be positive: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetAllUniqueDenomPairs returns all unique pairs of denoms, with each pair
+// lexicographically sorted. denoms must be sorted, and contain no duplicates.
+func GetAllUniqueDenomPairs(denoms []string) (denom0s, denom1s []string) {
+	for i := 0; i < len(denoms); i++ {
+		for j := i + 1; j < len(denoms); j++ {
+			denom0s = append(denom0s, denoms[i])
+			denom1s = append(denom1s, denoms[j])
+		}
+	}
+	return denom0s, denom1s
+}
+
+// SortDenomPair returns the two denoms, lexicographically sorted.
+func SortDenomPair(denomA, denomB string) (denom0, denom1 string, err error) {
+	if denomA == denomB {
+		return "", "", sdkerrors.Wrapf(ErrInvalidAssetPair, "both assets are %s", denomA)
+	}
+	if denomA > denomB {
+		return denomB, denomA, nil
+	}
+	return denomA, denomB, nil
+}
+
+// Validate checks that the record is well formed.
+func (record TwapRecord) Validate() error {
+	if record.PoolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidTwapRecord, "pool id must be positive")
+	}
+	if err := sdk.ValidateDenom(record.Asset0Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTwapRecord, err.Error())
+	}
+	if err := sdk.ValidateDenom(record.Asset1Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidTwapRecord, err.Error())
+	}
+	if record.Asset0Denom >= record.Asset1Denom {
+		return sdkerrors.Wrapf(ErrInvalidTwapRecord, "denoms %s and %s are not sorted and distinct", record.Asset0Denom, record.Asset1Denom)
+	}
+	if record.Height < 0 {
+		return sdkerrors.Wrap(ErrInvalidTwapRecord, "height must not be negative")
+	}
+	for _, dec := range []sdk.Dec{record.P0LastSpotPrice, record.P1LastSpotPrice, record.P0ArithmeticTwapAccumulator, record.P1ArithmeticTwapAccumulator} {
+		if dec.IsNil() || dec.IsNegative() {
+			return sdkerrors.Wrap(ErrInvalidTwapRecord, "spot prices and accumulators must not be negative")
+		}
+	}
+	return nil
+}
+
+// AccumulateTo returns the record with its accumulators updated to time t,
+// using its last spot prices for the time elapsed since the record. t must not be before the record's time.
+func (record TwapRecord) AccumulateTo(t time.Time) TwapRecord {
+	elapsedMs := sdk.NewDec(t.Sub(record.Time).Milliseconds())
+	record.P0ArithmeticTwapAccumulator = record.P0ArithmeticTwapAccumulator.Add(record.P0LastSpotPrice.Mul(elapsedMs))
+	record.P1ArithmeticTwapAccumulator = record.P1ArithmeticTwapAccumulator.Add(record.P1LastSpotPrice.Mul(elapsedMs))
+	record.Time = t
+	return record
+}
@@ -10,7 +10,7 @@ import (
 
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {}
 
-// at the end of each epoch, swap all non-OSMO fees into OSMO and transfer to fee module account
+// at the end of each epoch, swap non-OSMO fees into OSMO within slippage bounds and transfer to fee module account
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	nonNativeFeeAddr := k.accountKeeper.GetModuleAddress(txfeestypes.NonNativeFeeCollectorName)
 	baseDenom, _ := k.GetBaseDenom(ctx)
@@ -25,16 +25,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			continue
 		}
 
-		// Do the swap of this fee token denom to base denom.
-		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
-			// We allow full slippage. Theres not really an effective way to bound slippage until TWAP's land,
-			// but even then the point is a bit moot.
-			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
-			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
-			minAmountOut := sdk.ZeroInt()
-			_, err := k.gammKeeper.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, minAmountOut)
-			return err
-		})
+		k.convertFeeToken(ctx, nonNativeFeeAddr, baseDenom, feetoken, coinBalance)
 	}
 
 	// Get all of the txfee payout denom in the module account
@@ -46,6 +37,67 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 	})
 }
 
+// convertFeeToken swaps the balance of a fee token in the non native fee collector to the base denom.
+// Each swap must get at least the amount given by the arithmetic TWAP of the fee token's pool over
+// FeeTokenConversionTwapWindow, minus MaxFeeTokenConversionSlippage, to bound what sandwiching the
+// swap at the epoch end can take. If swapping the whole balance exceeds that bound,
+// it is swapped in FeeTokenConversionSplits parts, until a part exceeds it.
+// Whatever is not swapped is left in the collector, to be converted at a later epoch.
+func (k Keeper) convertFeeToken(ctx sdk.Context, sender sdk.AccAddress, baseDenom string, feeToken txfeestypes.FeeToken, balance sdk.Coin) {
+	endTime := ctx.BlockTime()
+	startTime := endTime.Add(-txfeestypes.FeeTokenConversionTwapWindow)
+	twap, err := k.twapKeeper.GetArithmeticTwap(ctx, feeToken.PoolID, baseDenom, feeToken.Denom, startTime, endTime)
+	if err != nil {
+		ctx.EventManager().EmitEvent(txfeestypes.CreateFeeTokenConversionDeferredEvent(feeToken.PoolID, balance, err.Error()))
+		return
+	}
+
+	tokenIn := sdk.NewCoin(balance.Denom, sdk.ZeroInt())
+	tokenOut := sdk.NewCoin(baseDenom, sdk.ZeroInt())
+	numSwaps := 0
+	swap := func(part sdk.Coin) error {
+		minAmountOut := twap.MulInt(part.Amount).Mul(sdk.OneDec().Sub(txfeestypes.MaxFeeTokenConversionSlippage)).TruncateInt()
+		return osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			amountOut, err := k.gammKeeper.SwapExactAmountIn(cacheCtx, sender, feeToken.PoolID, part, baseDenom, minAmountOut)
+			if err != nil {
+				return err
+			}
+			tokenIn = tokenIn.Add(part)
+			tokenOut = tokenOut.AddAmount(amountOut)
+			numSwaps++
+			return nil
+		})
+	}
+
+	if err = swap(balance); err != nil {
+		for _, part := range splitCoin(balance, txfeestypes.FeeTokenConversionSplits) {
+			if err = swap(part); err != nil {
+				break
+			}
+		}
+	}
+
+	if tokenIn.IsPositive() {
+		ctx.EventManager().EmitEvent(txfeestypes.CreateFeeTokenConvertedEvent(feeToken.PoolID, tokenIn, tokenOut, numSwaps))
+	}
+	if deferred := balance.Sub(tokenIn); deferred.IsPositive() {
+		ctx.EventManager().EmitEvent(txfeestypes.CreateFeeTokenConversionDeferredEvent(feeToken.PoolID, deferred, err.Error()))
+	}
+}
+
+// splitCoin splits coin into n parts of equal amounts, with the remainder added to the last part.
+// Parts with zero amounts are omitted.
+func splitCoin(coin sdk.Coin, n int64) []sdk.Coin {
+	partAmount := coin.Amount.QuoRaw(n)
+	parts := []sdk.Coin{}
+	for i := int64(0); i < n-1; i++ {
+		if partAmount.IsPositive() {
+			parts = append(parts, sdk.NewCoin(coin.Denom, partAmount))
+		}
+	}
+	return append(parts, sdk.NewCoin(coin.Denom, coin.Amount.Sub(partAmount.MulRaw(n-1))))
+}
+
 // Hooks wrapper struct for incentives keeper
 type Hooks struct {
 	k Keeper
@@ -14,10 +14,14 @@ import (
 var defaultPooledAssetAmount = int64(500)
 
 func (suite *KeeperTestSuite) preparePool(denom string) (poolID uint64, pool gammtypes.PoolI) {
+	return suite.preparePoolWithLiquidity(denom, defaultPooledAssetAmount)
+}
+
+func (suite *KeeperTestSuite) preparePoolWithLiquidity(denom string, amount int64) (poolID uint64, pool gammtypes.PoolI) {
 	baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
 	poolID = suite.PrepareUni2PoolWithAssets(
-		sdk.NewInt64Coin(baseDenom, defaultPooledAssetAmount),
-		sdk.NewInt64Coin(denom, defaultPooledAssetAmount),
+		sdk.NewInt64Coin(baseDenom, amount),
+		sdk.NewInt64Coin(denom, amount),
 	)
 	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolID)
 	suite.Require().NoError(err)
@@ -75,7 +79,8 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 	suite.Require().True(suite.App.BankKeeper.HasBalance(suite.Ctx, moduleAddrNonNativeFee, coins[2]))
 
 	params := suite.App.IncentivesKeeper.GetParams(suite.Ctx)
-	futureCtx := suite.Ctx.WithBlockTime(time.Now().Add(time.Minute))
+	// the pools need a price history over the twap window for their fee tokens to be swapped
+	futureCtx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(types.FeeTokenConversionTwapWindow + time.Minute))
 
 	suite.App.EpochsKeeper.AfterEpochEnd(futureCtx, params.DistrEpochIdentifier, int64(1))
 
@@ -83,3 +88,107 @@ func (suite *KeeperTestSuite) TestTxFeesAfterEpochEnd() {
 	suite.Require().Empty(suite.App.BankKeeper.GetAllBalances(suite.Ctx, moduleAddrNonNativeFee))
 	suite.Require().True(moduleBaseDenomBalance.Amount.GTE(fullExpectedOutput.Amount))
 }
+
+// fundNonNativeFeeCollector sends coins to the non native fee collector, and returns its address.
+func (suite *KeeperTestSuite) fundNonNativeFeeCollector(coins sdk.Coins) sdk.AccAddress {
+	_, _, addr0 := testdata.KeyTestPubAddr()
+	suite.FundAcc(addr0, coins)
+	err := suite.App.BankKeeper.SendCoinsFromAccountToModule(suite.Ctx, addr0, types.NonNativeFeeCollectorName, coins)
+	suite.Require().NoError(err)
+	return suite.App.AccountKeeper.GetModuleAddress(types.NonNativeFeeCollectorName)
+}
+
+func (suite *KeeperTestSuite) epochEndEvents(ctx sdk.Context) sdk.Events {
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	params := suite.App.IncentivesKeeper.GetParams(ctx)
+	suite.App.EpochsKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, int64(1))
+	return ctx.EventManager().Events()
+}
+
+func eventsOfType(events sdk.Events, eventType string) sdk.Events {
+	filtered := sdk.Events{}
+	for _, event := range events {
+		if event.Type == eventType {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+func (suite *KeeperTestSuite) TestTxFeesAfterEpochEndSlippageBounds() {
+	tests := map[string]struct {
+		feeBalance int64
+		// swapBeforeEpochEnd is swapped into the pool just before the epoch end, manipulating its price
+		swapBeforeEpochEnd    int64
+		timeSincePoolCreation time.Duration
+
+		expectedConverted int64
+		expectedNumSwaps  string
+	}{
+		"small balance is swapped at once": {
+			feeBalance:            10_000,
+			timeSincePoolCreation: 2 * types.FeeTokenConversionTwapWindow,
+			expectedConverted:     10_000,
+			expectedNumSwaps:      "1",
+		},
+		"large balance is partially swapped in parts": {
+			feeBalance:            100_000,
+			timeSincePoolCreation: 2 * types.FeeTokenConversionTwapWindow,
+			expectedConverted:     25_000,
+			expectedNumSwaps:      "1",
+		},
+		"manipulated price defers the swap": {
+			feeBalance:            10_000,
+			swapBeforeEpochEnd:    200_000,
+			timeSincePoolCreation: 2 * types.FeeTokenConversionTwapWindow,
+			expectedConverted:     0,
+		},
+		"pool without price history over the twap window defers the swap": {
+			feeBalance:            10_000,
+			timeSincePoolCreation: types.FeeTokenConversionTwapWindow / 2,
+			expectedConverted:     0,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest(false)
+			baseDenom, _ := suite.App.TxFeesKeeper.GetBaseDenom(suite.Ctx)
+			poolID, _ := suite.preparePoolWithLiquidity("uion", 1_000_000)
+			feeCoin := sdk.NewInt64Coin("uion", tc.feeBalance)
+			moduleAddrNonNativeFee := suite.fundNonNativeFeeCollector(sdk.NewCoins(feeCoin))
+
+			suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(tc.timeSincePoolCreation))
+			if tc.swapBeforeEpochEnd > 0 {
+				tokenIn := sdk.NewInt64Coin("uion", tc.swapBeforeEpochEnd)
+				suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(tokenIn))
+				_, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], poolID, tokenIn, baseDenom, sdk.OneInt())
+				suite.Require().NoError(err)
+			}
+
+			events := suite.epochEndEvents(suite.Ctx)
+
+			convertedEvents := eventsOfType(events, types.TypeEvtFeeTokenConverted)
+			deferredEvents := eventsOfType(events, types.TypeEvtFeeTokenConversionDeferred)
+			expectedDeferred := sdk.NewInt64Coin("uion", tc.feeBalance-tc.expectedConverted)
+			suite.Require().Equal(expectedDeferred, suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddrNonNativeFee, "uion"))
+
+			if tc.expectedConverted > 0 {
+				suite.Require().Len(convertedEvents, 1)
+				attrs := convertedEvents[0].Attributes
+				suite.Require().Equal(sdk.NewInt64Coin("uion", tc.expectedConverted).String(), string(attrs[2].Value))
+				suite.Require().Equal(tc.expectedNumSwaps, string(attrs[4].Value))
+				suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, suite.App.AccountKeeper.GetModuleAddress(types.FeeCollectorName), baseDenom).IsPositive())
+			} else {
+				suite.Require().Empty(convertedEvents)
+			}
+
+			if expectedDeferred.IsPositive() {
+				suite.Require().Len(deferredEvents, 1)
+				suite.Require().Equal(expectedDeferred.String(), string(deferredEvents[0].Attributes[2].Value))
+			} else {
+				suite.Require().Empty(deferredEvents)
+			}
+		})
+	}
+}
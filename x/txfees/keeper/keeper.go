@@ -20,6 +20,7 @@ type Keeper struct {
 	bankKeeper                types.BankKeeper
 	epochKeeper               types.EpochKeeper
 	gammKeeper                types.GammKeeper
+	twapKeeper                types.TwapKeeper
 	spotPriceCalculator       types.SpotPriceCalculator
 	feeCollectorName          string
 	nonNativeFeeCollectorName string
@@ -32,6 +33,7 @@ func NewKeeper(
 	epochKeeper types.EpochKeeper,
 	storeKey sdk.StoreKey,
 	gammKeeper types.GammKeeper,
+	twapKeeper types.TwapKeeper,
 	spotPriceCalculator types.SpotPriceCalculator,
 	feeCollectorName string,
 	nonNativeFeeCollectorName string,
@@ -43,6 +45,7 @@ func NewKeeper(
 		epochKeeper:               epochKeeper,
 		storeKey:                  storeKey,
 		gammKeeper:                gammKeeper,
+		twapKeeper:                twapKeeper,
 		spotPriceCalculator:       spotPriceCalculator,
 		feeCollectorName:          feeCollectorName,
 		nonNativeFeeCollectorName: nonNativeFeeCollectorName,
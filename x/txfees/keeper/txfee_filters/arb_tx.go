@@ -31,21 +31,26 @@ func IsArbTxLoose(tx sdk.Tx) bool {
 			}
 		}
 
-		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
-		if !isSwapMsg {
-			continue
+		// Messages swapping through several routes are checked one route at a time.
+		swapMsgs := []gammtypes.SwapMsgRoute{}
+		if multiSwapMsg, isMultiSwapMsg := m.(gammtypes.MultiSwapMsgRoute); isMultiSwapMsg {
+			swapMsgs = multiSwapMsg.GetSwapMsgs()
+		} else if swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute); isSwapMsg {
+			swapMsgs = append(swapMsgs, swapMsg)
 		}
 
-		// (1) Check that swap denom in != swap denom out
-		if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
-			return true
-		}
+		for _, swapMsg := range swapMsgs {
+			// (1) Check that swap denom in != swap denom out
+			if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
+				return true
+			}
 
-		// (2)
-		if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
-			return true
+			// (2)
+			if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
+				return true
+			}
+			swapInDenom = swapMsg.TokenInDenom()
 		}
-		swapInDenom = swapMsg.TokenInDenom()
 	}
 
 	return false
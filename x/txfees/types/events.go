@@ -0,0 +1,43 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeEvtFeeTokenConverted          = "fee_token_converted"
+	TypeEvtFeeTokenConversionDeferred = "fee_token_conversion_deferred"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyTokensIn   = "tokens_in"
+	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyNumSwaps   = "num_swaps"
+	AttributeKeyDeferred   = "tokens_deferred"
+	AttributeKeyReason     = "reason"
+)
+
+// CreateFeeTokenConvertedEvent records that tokenIn was swapped to tokenOut at the end of an epoch, in numSwaps swaps.
+func CreateFeeTokenConvertedEvent(poolId uint64, tokenIn sdk.Coin, tokenOut sdk.Coin, numSwaps int) sdk.Event {
+	return sdk.NewEvent(
+		TypeEvtFeeTokenConverted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(AttributeKeyTokensOut, tokenOut.String()),
+		sdk.NewAttribute(AttributeKeyNumSwaps, strconv.Itoa(numSwaps)),
+	)
+}
+
+// CreateFeeTokenConversionDeferredEvent records that deferred was not swapped at the end of an epoch, and why.
+func CreateFeeTokenConversionDeferredEvent(poolId uint64, deferred sdk.Coin, reason string) sdk.Event {
+	return sdk.NewEvent(
+		TypeEvtFeeTokenConversionDeferred,
+		sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+		sdk.NewAttribute(AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(AttributeKeyDeferred, deferred.String()),
+		sdk.NewAttribute(AttributeKeyReason, reason),
+	)
+}
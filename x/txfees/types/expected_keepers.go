@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -25,6 +27,11 @@ type GammKeeper interface {
 	) (tokenOutAmount sdk.Int, err error)
 }
 
+// TwapKeeper defines the contract needed to get reference prices for fee token swaps.
+type TwapKeeper interface {
+	GetArithmeticTwap(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time, endTime time.Time) (sdk.Dec, error)
+}
+
 // AccountKeeper defines the contract needed for AccountKeeper related APIs.
 // Interface provides support to use non-sdk AccountKeeper for AnteHandler's decorators.
 type AccountKeeper interface {
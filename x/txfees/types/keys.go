@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName = "txfees"
@@ -24,3 +30,17 @@ var (
 	BaseDenomKey         = []byte("base_denom")
 	FeeTokensStorePrefix = []byte("fee_tokens")
 )
+
+const (
+	// FeeTokenConversionTwapWindow is the window of the arithmetic TWAP used as the reference price
+	// when swapping non-native fee tokens to the base denom at the end of an epoch.
+	FeeTokenConversionTwapWindow = time.Hour
+
+	// FeeTokenConversionSplits is the number of parts that a fee token balance is split into,
+	// when swapping the whole balance exceeds MaxFeeTokenConversionSlippage.
+	FeeTokenConversionSplits = 4
+)
+
+// MaxFeeTokenConversionSlippage is the largest fraction below the reference price that
+// non-native fee tokens are swapped to the base denom at.
+var MaxFeeTokenConversionSlippage = sdk.NewDecWithPrec(5, 2)